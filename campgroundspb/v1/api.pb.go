@@ -22,26 +22,89 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetCampsitesRequest struct {
+// Status of a booking.
+type BookingStatus int32
+
+const (
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
+	// Deposit of booking not yet captured.
+	BookingStatus_BOOKING_STATUS_PENDING     BookingStatus = 1
+	BookingStatus_BOOKING_STATUS_CONFIRMED   BookingStatus = 2
+	BookingStatus_BOOKING_STATUS_CHECKED_IN  BookingStatus = 3
+	BookingStatus_BOOKING_STATUS_CHECKED_OUT BookingStatus = 4
+	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 5
+	BookingStatus_BOOKING_STATUS_NO_SHOW     BookingStatus = 6
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_UNSPECIFIED",
+		1: "BOOKING_STATUS_PENDING",
+		2: "BOOKING_STATUS_CONFIRMED",
+		3: "BOOKING_STATUS_CHECKED_IN",
+		4: "BOOKING_STATUS_CHECKED_OUT",
+		5: "BOOKING_STATUS_CANCELLED",
+		6: "BOOKING_STATUS_NO_SHOW",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_UNSPECIFIED": 0,
+		"BOOKING_STATUS_PENDING":     1,
+		"BOOKING_STATUS_CONFIRMED":   2,
+		"BOOKING_STATUS_CHECKED_IN":  3,
+		"BOOKING_STATUS_CHECKED_OUT": 4,
+		"BOOKING_STATUS_CANCELLED":   5,
+		"BOOKING_STATUS_NO_SHOW":     6,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_campgroundspb_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_campgroundspb_v1_api_proto_enumTypes[0]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type GetCampgroundsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampsitesRequest) Reset() {
-	*x = GetCampsitesRequest{}
+func (x *GetCampgroundsRequest) Reset() {
+	*x = GetCampgroundsRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampsitesRequest) String() string {
+func (x *GetCampgroundsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampsitesRequest) ProtoMessage() {}
+func (*GetCampgroundsRequest) ProtoMessage() {}
 
-func (x *GetCampsitesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -53,32 +116,32 @@ func (x *GetCampsitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampsitesRequest.ProtoReflect.Descriptor instead.
-func (*GetCampsitesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundsRequest.ProtoReflect.Descriptor instead.
+func (*GetCampgroundsRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{0}
 }
 
-type GetCampsitesResponse struct {
+type GetCampgroundsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Campsites     []*Campsite            `protobuf:"bytes,1,rep,name=campsites,proto3" json:"campsites,omitempty"`
+	Campgrounds   []*Campground          `protobuf:"bytes,1,rep,name=campgrounds,proto3" json:"campgrounds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampsitesResponse) Reset() {
-	*x = GetCampsitesResponse{}
+func (x *GetCampgroundsResponse) Reset() {
+	*x = GetCampgroundsResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampsitesResponse) String() string {
+func (x *GetCampgroundsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampsitesResponse) ProtoMessage() {}
+func (*GetCampgroundsResponse) ProtoMessage() {}
 
-func (x *GetCampsitesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -90,44 +153,39 @@ func (x *GetCampsitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampsitesResponse.ProtoReflect.Descriptor instead.
-func (*GetCampsitesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundsResponse.ProtoReflect.Descriptor instead.
+func (*GetCampgroundsResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetCampsitesResponse) GetCampsites() []*Campsite {
+func (x *GetCampgroundsResponse) GetCampgrounds() []*Campground {
 	if x != nil {
-		return x.Campsites
+		return x.Campgrounds
 	}
 	return nil
 }
 
-type CreateCampsiteRequest struct {
+type GetCampgroundRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampsiteCode  string                 `protobuf:"bytes,1,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
-	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	DrinkingWater bool                   `protobuf:"varint,3,opt,name=drinking_water,json=drinkingWater,proto3" json:"drinking_water,omitempty"`
-	Restrooms     bool                   `protobuf:"varint,4,opt,name=restrooms,proto3" json:"restrooms,omitempty"`
-	PicnicTable   bool                   `protobuf:"varint,5,opt,name=picnic_table,json=picnicTable,proto3" json:"picnic_table,omitempty"`
-	FirePit       bool                   `protobuf:"varint,6,opt,name=fire_pit,json=firePit,proto3" json:"fire_pit,omitempty"`
+	CampgroundId  string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCampsiteRequest) Reset() {
-	*x = CreateCampsiteRequest{}
+func (x *GetCampgroundRequest) Reset() {
+	*x = GetCampgroundRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCampsiteRequest) String() string {
+func (x *GetCampgroundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCampsiteRequest) ProtoMessage() {}
+func (*GetCampgroundRequest) ProtoMessage() {}
 
-func (x *CreateCampsiteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -139,74 +197,39 @@ func (x *CreateCampsiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCampsiteRequest.ProtoReflect.Descriptor instead.
-func (*CreateCampsiteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundRequest.ProtoReflect.Descriptor instead.
+func (*GetCampgroundRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateCampsiteRequest) GetCampsiteCode() string {
+func (x *GetCampgroundRequest) GetCampgroundId() string {
 	if x != nil {
-		return x.CampsiteCode
+		return x.CampgroundId
 	}
 	return ""
 }
 
-func (x *CreateCampsiteRequest) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *CreateCampsiteRequest) GetDrinkingWater() bool {
-	if x != nil {
-		return x.DrinkingWater
-	}
-	return false
-}
-
-func (x *CreateCampsiteRequest) GetRestrooms() bool {
-	if x != nil {
-		return x.Restrooms
-	}
-	return false
-}
-
-func (x *CreateCampsiteRequest) GetPicnicTable() bool {
-	if x != nil {
-		return x.PicnicTable
-	}
-	return false
-}
-
-func (x *CreateCampsiteRequest) GetFirePit() bool {
-	if x != nil {
-		return x.FirePit
-	}
-	return false
-}
-
-type CreateCampsiteResponse struct {
+type GetCampgroundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	Campground    *Campground            `protobuf:"bytes,1,opt,name=campground,proto3" json:"campground,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCampsiteResponse) Reset() {
-	*x = CreateCampsiteResponse{}
+func (x *GetCampgroundResponse) Reset() {
+	*x = GetCampgroundResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCampsiteResponse) String() string {
+func (x *GetCampgroundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCampsiteResponse) ProtoMessage() {}
+func (*GetCampgroundResponse) ProtoMessage() {}
 
-func (x *CreateCampsiteResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,39 +241,40 @@ func (x *CreateCampsiteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCampsiteResponse.ProtoReflect.Descriptor instead.
-func (*CreateCampsiteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundResponse.ProtoReflect.Descriptor instead.
+func (*GetCampgroundResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateCampsiteResponse) GetCampsiteId() string {
+func (x *GetCampgroundResponse) GetCampground() *Campground {
 	if x != nil {
-		return x.CampsiteId
+		return x.Campground
 	}
-	return ""
+	return nil
 }
 
-type GetBookingRequest struct {
+type CreateCampgroundRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookingRequest) Reset() {
-	*x = GetBookingRequest{}
+func (x *CreateCampgroundRequest) Reset() {
+	*x = CreateCampgroundRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingRequest) String() string {
+func (x *CreateCampgroundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingRequest) ProtoMessage() {}
+func (*CreateCampgroundRequest) ProtoMessage() {}
 
-func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCampgroundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,39 +286,46 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCampgroundRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampgroundRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetBookingRequest) GetBookingId() string {
+func (x *CreateCampgroundRequest) GetName() string {
 	if x != nil {
-		return x.BookingId
+		return x.Name
 	}
 	return ""
 }
 
-type GetBookingResponse struct {
+func (x *CreateCampgroundRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateCampgroundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	CampgroundId  string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookingResponse) Reset() {
-	*x = GetBookingResponse{}
+func (x *CreateCampgroundResponse) Reset() {
+	*x = CreateCampgroundResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingResponse) String() string {
+func (x *CreateCampgroundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingResponse) ProtoMessage() {}
+func (*CreateCampgroundResponse) ProtoMessage() {}
 
-func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateCampgroundResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,43 +337,39 @@ func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
-func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCampgroundResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampgroundResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBookingResponse) GetBooking() *Booking {
+func (x *CreateCampgroundResponse) GetCampgroundId() string {
 	if x != nil {
-		return x.Booking
+		return x.CampgroundId
 	}
-	return nil
+	return ""
 }
 
-type CreateBookingRequest struct {
+type UpdateCampgroundRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Campground    *Campground            `protobuf:"bytes,1,opt,name=campground,proto3" json:"campground,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingRequest) Reset() {
-	*x = CreateBookingRequest{}
+func (x *UpdateCampgroundRequest) Reset() {
+	*x = UpdateCampgroundRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingRequest) String() string {
+func (x *UpdateCampgroundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingRequest) ProtoMessage() {}
+func (*UpdateCampgroundRequest) ProtoMessage() {}
 
-func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateCampgroundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,67 +381,38 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCampgroundRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCampgroundRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateBookingRequest) GetCampsiteId() string {
-	if x != nil {
-		return x.CampsiteId
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *CreateBookingRequest) GetEndDate() string {
+func (x *UpdateCampgroundRequest) GetCampground() *Campground {
 	if x != nil {
-		return x.EndDate
+		return x.Campground
 	}
-	return ""
+	return nil
 }
 
-type CreateBookingResponse struct {
+type UpdateCampgroundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingResponse) Reset() {
-	*x = CreateBookingResponse{}
+func (x *UpdateCampgroundResponse) Reset() {
+	*x = UpdateCampgroundResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingResponse) String() string {
+func (x *UpdateCampgroundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingResponse) ProtoMessage() {}
+func (*UpdateCampgroundResponse) ProtoMessage() {}
 
-func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCampgroundResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,39 +424,32 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
-func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCampgroundResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCampgroundResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateBookingResponse) GetBookingId() string {
-	if x != nil {
-		return x.BookingId
-	}
-	return ""
-}
-
-type UpdateBookingRequest struct {
+type DeleteCampgroundRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	CampgroundId  string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingRequest) Reset() {
-	*x = UpdateBookingRequest{}
+func (x *DeleteCampgroundRequest) Reset() {
+	*x = DeleteCampgroundRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingRequest) String() string {
+func (x *DeleteCampgroundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingRequest) ProtoMessage() {}
+func (*DeleteCampgroundRequest) ProtoMessage() {}
 
-func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteCampgroundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,38 +461,38 @@ func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCampgroundRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCampgroundRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateBookingRequest) GetBooking() *Booking {
+func (x *DeleteCampgroundRequest) GetCampgroundId() string {
 	if x != nil {
-		return x.Booking
+		return x.CampgroundId
 	}
-	return nil
+	return ""
 }
 
-type UpdateBookingResponse struct {
+type DeleteCampgroundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingResponse) Reset() {
-	*x = UpdateBookingResponse{}
+func (x *DeleteCampgroundResponse) Reset() {
+	*x = DeleteCampgroundResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingResponse) String() string {
+func (x *DeleteCampgroundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingResponse) ProtoMessage() {}
+func (*DeleteCampgroundResponse) ProtoMessage() {}
 
-func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCampgroundResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,32 +504,32 @@ func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBookingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCampgroundResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCampgroundResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
-type CancelBookingRequest struct {
+type GetCampgroundSeasonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CampgroundId  string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
+func (x *GetCampgroundSeasonRequest) Reset() {
+	*x = GetCampgroundSeasonRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *GetCampgroundSeasonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*GetCampgroundSeasonRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundSeasonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,38 +541,40 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetCampgroundSeasonRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CancelBookingRequest) GetBookingId() string {
+func (x *GetCampgroundSeasonRequest) GetCampgroundId() string {
 	if x != nil {
-		return x.BookingId
+		return x.CampgroundId
 	}
 	return ""
 }
 
-type CancelBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetCampgroundSeasonResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Season in effect for the campground, the default season if the campground has none of its own.
+	Season        *CampgroundSeason `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
+func (x *GetCampgroundSeasonResponse) Reset() {
+	*x = GetCampgroundSeasonResponse{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *GetCampgroundSeasonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*GetCampgroundSeasonResponse) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCampgroundSeasonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,34 +586,39 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCampgroundSeasonResponse.ProtoReflect.Descriptor instead.
+func (*GetCampgroundSeasonResponse) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
-type GetVacantDatesRequest struct {
+func (x *GetCampgroundSeasonResponse) GetSeason() *CampgroundSeason {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type SetCampgroundSeasonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Season        *CampgroundSeason      `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVacantDatesRequest) Reset() {
-	*x = GetVacantDatesRequest{}
+func (x *SetCampgroundSeasonRequest) Reset() {
+	*x = SetCampgroundSeasonRequest{}
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVacantDatesRequest) String() string {
+func (x *SetCampgroundSeasonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacantDatesRequest) ProtoMessage() {}
+func (*SetCampgroundSeasonRequest) ProtoMessage() {}
 
-func (x *GetVacantDatesRequest) ProtoReflect() protoreflect.Message {
+func (x *SetCampgroundSeasonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_campgroundspb_v1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,54 +630,4731 @@ func (x *GetVacantDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacantDatesRequest.ProtoReflect.Descriptor instead.
-func (*GetVacantDatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCampgroundSeasonRequest.ProtoReflect.Descriptor instead.
+func (*SetCampgroundSeasonRequest) Descriptor() ([]byte, []int) {
 	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetVacantDatesRequest) GetCampsiteId() string {
+func (x *SetCampgroundSeasonRequest) GetSeason() *CampgroundSeason {
 	if x != nil {
-		return x.CampsiteId
+		return x.Season
 	}
-	return ""
+	return nil
 }
 
-func (x *GetVacantDatesRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+type SetCampgroundSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampgroundSeasonResponse) Reset() {
+	*x = SetCampgroundSeasonResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampgroundSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampgroundSeasonResponse) ProtoMessage() {}
+
+func (x *SetCampgroundSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampgroundSeasonResponse.ProtoReflect.Descriptor instead.
+func (*SetCampgroundSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{13}
+}
+
+type GetCampsitesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the campground to list campsites for, optional.
+	CampgroundId  string `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampsitesRequest) Reset() {
+	*x = GetCampsitesRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampsitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampsitesRequest) ProtoMessage() {}
+
+func (x *GetCampsitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampsitesRequest.ProtoReflect.Descriptor instead.
+func (*GetCampsitesRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCampsitesRequest) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+type GetCampsitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campsites     []*Campsite            `protobuf:"bytes,1,rep,name=campsites,proto3" json:"campsites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampsitesResponse) Reset() {
+	*x = GetCampsitesResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampsitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampsitesResponse) ProtoMessage() {}
+
+func (x *GetCampsitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampsitesResponse.ProtoReflect.Descriptor instead.
+func (*GetCampsitesResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCampsitesResponse) GetCampsites() []*Campsite {
+	if x != nil {
+		return x.Campsites
+	}
+	return nil
+}
+
+type CreateCampsiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteCode  string                 `protobuf:"bytes,1,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	DrinkingWater bool                   `protobuf:"varint,3,opt,name=drinking_water,json=drinkingWater,proto3" json:"drinking_water,omitempty"`
+	Restrooms     bool                   `protobuf:"varint,4,opt,name=restrooms,proto3" json:"restrooms,omitempty"`
+	PicnicTable   bool                   `protobuf:"varint,5,opt,name=picnic_table,json=picnicTable,proto3" json:"picnic_table,omitempty"`
+	FirePit       bool                   `protobuf:"varint,6,opt,name=fire_pit,json=firePit,proto3" json:"fire_pit,omitempty"`
+	// Identifier of the campground the campsite belongs to, optional.
+	CampgroundId  string `protobuf:"bytes,7,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampsiteRequest) Reset() {
+	*x = CreateCampsiteRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampsiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampsiteRequest) ProtoMessage() {}
+
+func (x *CreateCampsiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampsiteRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampsiteRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCampsiteRequest) GetCampsiteCode() string {
+	if x != nil {
+		return x.CampsiteCode
+	}
+	return ""
+}
+
+func (x *CreateCampsiteRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateCampsiteRequest) GetDrinkingWater() bool {
+	if x != nil {
+		return x.DrinkingWater
+	}
+	return false
+}
+
+func (x *CreateCampsiteRequest) GetRestrooms() bool {
+	if x != nil {
+		return x.Restrooms
+	}
+	return false
+}
+
+func (x *CreateCampsiteRequest) GetPicnicTable() bool {
+	if x != nil {
+		return x.PicnicTable
+	}
+	return false
+}
+
+func (x *CreateCampsiteRequest) GetFirePit() bool {
+	if x != nil {
+		return x.FirePit
+	}
+	return false
+}
+
+func (x *CreateCampsiteRequest) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+type CreateCampsiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampsiteResponse) Reset() {
+	*x = CreateCampsiteResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampsiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampsiteResponse) ProtoMessage() {}
+
+func (x *CreateCampsiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampsiteResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampsiteResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCampsiteResponse) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+type ImportCampsitesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Import mode, one of ALL_OR_NOTHING or BEST_EFFORT, read from the first message only,
+	// defaults to ALL_OR_NOTHING.
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Payload of the message, all messages of an import must carry the same kind of payload.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportCampsitesRequest_Campsite
+	//	*ImportCampsitesRequest_CsvChunk
+	//	*ImportCampsitesRequest_JsonChunk
+	Payload       isImportCampsitesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCampsitesRequest) Reset() {
+	*x = ImportCampsitesRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCampsitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCampsitesRequest) ProtoMessage() {}
+
+func (x *ImportCampsitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCampsitesRequest.ProtoReflect.Descriptor instead.
+func (*ImportCampsitesRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportCampsitesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportCampsitesRequest) GetPayload() isImportCampsitesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportCampsitesRequest) GetCampsite() *ImportCampsite {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportCampsitesRequest_Campsite); ok {
+			return x.Campsite
+		}
+	}
+	return nil
+}
+
+func (x *ImportCampsitesRequest) GetCsvChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportCampsitesRequest_CsvChunk); ok {
+			return x.CsvChunk
+		}
+	}
+	return nil
+}
+
+func (x *ImportCampsitesRequest) GetJsonChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportCampsitesRequest_JsonChunk); ok {
+			return x.JsonChunk
+		}
+	}
+	return nil
+}
+
+type isImportCampsitesRequest_Payload interface {
+	isImportCampsitesRequest_Payload()
+}
+
+type ImportCampsitesRequest_Campsite struct {
+	// Campsite to upsert by its code.
+	Campsite *ImportCampsite `protobuf:"bytes,2,opt,name=campsite,proto3,oneof"`
+}
+
+type ImportCampsitesRequest_CsvChunk struct {
+	// Next chunk of a CSV document whose first row names the columns campsite_code, capacity,
+	// drinking_water, restrooms, picnic_table, fire_pit, campground_id and active. An updated
+	// campsite keeps its campground and active flag unless they are set.
+	CsvChunk []byte `protobuf:"bytes,3,opt,name=csv_chunk,json=csvChunk,proto3,oneof"`
+}
+
+type ImportCampsitesRequest_JsonChunk struct {
+	// Next chunk of a JSON array of objects keyed like the CSV columns.
+	JsonChunk []byte `protobuf:"bytes,4,opt,name=json_chunk,json=jsonChunk,proto3,oneof"`
+}
+
+func (*ImportCampsitesRequest_Campsite) isImportCampsitesRequest_Payload() {}
+
+func (*ImportCampsitesRequest_CsvChunk) isImportCampsitesRequest_Payload() {}
+
+func (*ImportCampsitesRequest_JsonChunk) isImportCampsitesRequest_Payload() {}
+
+type ImportCampsitesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Created int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Outcome of every row, in the order of the import.
+	Results       []*CampsiteImportResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCampsitesResponse) Reset() {
+	*x = ImportCampsitesResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCampsitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCampsitesResponse) ProtoMessage() {}
+
+func (x *ImportCampsitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCampsitesResponse.ProtoReflect.Descriptor instead.
+func (*ImportCampsitesResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportCampsitesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCampsitesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportCampsitesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCampsitesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportCampsitesResponse) GetResults() []*CampsiteImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetCampsiteRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampsiteRatesRequest) Reset() {
+	*x = GetCampsiteRatesRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampsiteRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampsiteRatesRequest) ProtoMessage() {}
+
+func (x *GetCampsiteRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampsiteRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetCampsiteRatesRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCampsiteRatesRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+type GetCampsiteRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         *CampsiteRates         `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampsiteRatesResponse) Reset() {
+	*x = GetCampsiteRatesResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampsiteRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampsiteRatesResponse) ProtoMessage() {}
+
+func (x *GetCampsiteRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampsiteRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetCampsiteRatesResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCampsiteRatesResponse) GetRates() *CampsiteRates {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetCampsiteRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         *CampsiteRates         `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampsiteRatesRequest) Reset() {
+	*x = SetCampsiteRatesRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampsiteRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampsiteRatesRequest) ProtoMessage() {}
+
+func (x *SetCampsiteRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampsiteRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetCampsiteRatesRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetCampsiteRatesRequest) GetRates() *CampsiteRates {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetCampsiteRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampsiteRatesResponse) Reset() {
+	*x = SetCampsiteRatesResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampsiteRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampsiteRatesResponse) ProtoMessage() {}
+
+func (x *SetCampsiteRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampsiteRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetCampsiteRatesResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+type QuoteBookingRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	StartDate  string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of guests, defaults to 1.
+	Guests        int32 `protobuf:"varint,4,opt,name=guests,proto3" json:"guests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteBookingRequest) Reset() {
+	*x = QuoteBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteBookingRequest) ProtoMessage() {}
+
+func (x *QuoteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteBookingRequest.ProtoReflect.Descriptor instead.
+func (*QuoteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuoteBookingRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *QuoteBookingRequest) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+type QuoteBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteBookingResponse) Reset() {
+	*x = QuoteBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteBookingResponse) ProtoMessage() {}
+
+func (x *QuoteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteBookingResponse.ProtoReflect.Descriptor instead.
+func (*QuoteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QuoteBookingResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName   string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	StartDate  string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of guests, defaults to 1.
+	Guests int32 `protobuf:"varint,6,opt,name=guests,proto3" json:"guests,omitempty"`
+	// Payment method token issued by the payment provider, required if campsite has rates
+	// to authorize the deposit.
+	PaymentMethod string `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateBookingRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type UpdateBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingRequest) Reset() {
+	*x = UpdateBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingRequest) ProtoMessage() {}
+
+func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateBookingRequest) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type UpdateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingResponse) Reset() {
+	*x = UpdateBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingResponse) ProtoMessage() {}
+
+func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Share of the deposit refunded according to cancellation policy, in percent.
+	RefundPercent int32 `protobuf:"varint,1,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"`
+	// Refunded amount, in minor currency units.
+	RefundAmount int64 `protobuf:"varint,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// Currency of refunded amount, in ISO-4217 format, empty if campsite has no rates.
+	Currency      string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelBookingResponse) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
+}
+
+func (x *CancelBookingResponse) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *CancelBookingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckInRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CheckInResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+type CheckOutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOutRequest) Reset() {
+	*x = CheckOutRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutRequest) ProtoMessage() {}
+
+func (x *CheckOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutRequest.ProtoReflect.Descriptor instead.
+func (*CheckOutRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckOutRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CheckOutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOutResponse) Reset() {
+	*x = CheckOutResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutResponse) ProtoMessage() {}
+
+func (x *CheckOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutResponse.ProtoReflect.Descriptor instead.
+func (*CheckOutResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+type MarkNoShowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNoShowRequest) Reset() {
+	*x = MarkNoShowRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowRequest) ProtoMessage() {}
+
+func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MarkNoShowRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type MarkNoShowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNoShowResponse) Reset() {
+	*x = MarkNoShowResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowResponse) ProtoMessage() {}
+
+func (x *MarkNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowResponse.ProtoReflect.Descriptor instead.
+func (*MarkNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+type GetGroupBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupBookingRequest) Reset() {
+	*x = GetGroupBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupBookingRequest) ProtoMessage() {}
+
+func (x *GetGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetGroupBookingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetGroupBookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bookings of the group, cancelled ones included.
+	Bookings      []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupBookingResponse) Reset() {
+	*x = GetGroupBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupBookingResponse) ProtoMessage() {}
+
+func (x *GetGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetGroupBookingResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type CreateGroupBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Campsites booked together, each campsite at most once.
+	Campsites []*GroupBookingCampsite `protobuf:"bytes,1,rep,name=campsites,proto3" json:"campsites,omitempty"`
+	Email     string                  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName  string                  `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	StartDate string                  `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                  `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Payment method token issued by the payment provider, required if any campsite has rates
+	// to authorize the deposit of the whole group.
+	PaymentMethod string `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupBookingRequest) Reset() {
+	*x = CreateGroupBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupBookingRequest) ProtoMessage() {}
+
+func (x *CreateGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateGroupBookingRequest) GetCampsites() []*GroupBookingCampsite {
+	if x != nil {
+		return x.Campsites
+	}
+	return nil
+}
+
+func (x *CreateGroupBookingRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type CreateGroupBookingResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Identifiers of the bookings created, in order of requested campsites.
+	BookingIds    []string `protobuf:"bytes,2,rep,name=booking_ids,json=bookingIds,proto3" json:"booking_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupBookingResponse) Reset() {
+	*x = CreateGroupBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupBookingResponse) ProtoMessage() {}
+
+func (x *CreateGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateGroupBookingResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingResponse) GetBookingIds() []string {
+	if x != nil {
+		return x.BookingIds
+	}
+	return nil
+}
+
+type UpdateGroupBookingRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Email to set on all active bookings of the group, unchanged if empty.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Full name to set on all active bookings of the group, unchanged if empty.
+	FullName string `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// New start date of all active bookings of the group, unchanged unless end date is also set.
+	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// New end date of all active bookings of the group, unchanged unless start date is also set.
+	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupBookingRequest) Reset() {
+	*x = UpdateGroupBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupBookingRequest) ProtoMessage() {}
+
+func (x *UpdateGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateGroupBookingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupBookingRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateGroupBookingRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UpdateGroupBookingRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateGroupBookingRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type UpdateGroupBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupBookingResponse) Reset() {
+	*x = UpdateGroupBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupBookingResponse) ProtoMessage() {}
+
+func (x *UpdateGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{45}
+}
+
+type CancelGroupBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupBookingRequest) Reset() {
+	*x = CancelGroupBookingRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupBookingRequest) ProtoMessage() {}
+
+func (x *CancelGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CancelGroupBookingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type CancelGroupBookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total refunded amount for all bookings cancelled, in minor currency units.
+	RefundAmount int64 `protobuf:"varint,1,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// Currency of refunded amount, in ISO-4217 format, empty if no campsite has rates.
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupBookingResponse) Reset() {
+	*x = CancelGroupBookingResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupBookingResponse) ProtoMessage() {}
+
+func (x *CancelGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CancelGroupBookingResponse) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *CancelGroupBookingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetVacantDatesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	StartDate  string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Minimum number of consecutive nights a vacant range must span to be returned, optional.
+	MinNights int32 `protobuf:"varint,4,opt,name=min_nights,json=minNights,proto3" json:"min_nights,omitempty"`
+	// Identifier of the campground the campsite must belong to, optional.
+	CampgroundId  string `protobuf:"bytes,5,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacantDatesRequest) Reset() {
+	*x = GetVacantDatesRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacantDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacantDatesRequest) ProtoMessage() {}
+
+func (x *GetVacantDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacantDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetVacantDatesRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetVacantDatesRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *GetVacantDatesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetVacantDatesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetVacantDatesRequest) GetMinNights() int32 {
+	if x != nil {
+		return x.MinNights
+	}
+	return 0
+}
+
+func (x *GetVacantDatesRequest) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+type GetVacantDatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Vacant dates sorted in ascending order, in ISO-8601 format (YYYY-MM-DD).
+	VacantDates []string `protobuf:"bytes,1,rep,name=vacant_dates,json=vacantDates,proto3" json:"vacant_dates,omitempty"`
+	// Contiguous vacant ranges sorted in ascending order of start date.
+	VacantRanges  []*DateRange `protobuf:"bytes,2,rep,name=vacant_ranges,json=vacantRanges,proto3" json:"vacant_ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacantDatesResponse) Reset() {
+	*x = GetVacantDatesResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacantDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacantDatesResponse) ProtoMessage() {}
+
+func (x *GetVacantDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacantDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetVacantDatesResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetVacantDatesResponse) GetVacantDates() []string {
+	if x != nil {
+		return x.VacantDates
+	}
+	return nil
+}
+
+func (x *GetVacantDatesResponse) GetVacantRanges() []*DateRange {
+	if x != nil {
+		return x.VacantRanges
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CampgroundId string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	// Identifier of the campsite wanted, optional; any campsite of campground with enough capacity
+	// if empty.
+	CampsiteId string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	Email      string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FullName   string `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	StartDate  string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of guests, defaults to 1.
+	Guests        int32 `protobuf:"varint,7,opt,name=guests,proto3" json:"guests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *JoinWaitlistRequest) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *JoinWaitlistResponse) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LeaveWaitlistRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{53}
+}
+
+type ListWaitlistRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CampgroundId string                 `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	// Identifier of the campsite to list entries for, optional.
+	CampsiteId    string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistRequest) Reset() {
+	*x = ListWaitlistRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistRequest) ProtoMessage() {}
+
+func (x *ListWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListWaitlistRequest) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+func (x *ListWaitlistRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+type ListWaitlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entries in order they joined waitlist.
+	Entries       []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistResponse) Reset() {
+	*x = ListWaitlistResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistResponse) ProtoMessage() {}
+
+func (x *ListWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AcceptWaitlistOfferRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EntryId string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// Payment method token issued by the payment provider, required if offered campsite has rates
+	// to authorize the deposit.
+	PaymentMethod string `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitlistOfferRequest) Reset() {
+	*x = AcceptWaitlistOfferRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AcceptWaitlistOfferRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *AcceptWaitlistOfferRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type AcceptWaitlistOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitlistOfferResponse) Reset() {
+	*x = AcceptWaitlistOfferResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitlistOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitlistOfferResponse) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitlistOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AcceptWaitlistOfferResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CreateBlackoutRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Start date of blackout (first closed night), must be in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of blackout (exclusive), must be in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Reason campsite is closed (e.g. maintenance), reported to guests denied a booking.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutRequest) Reset() {
+	*x = CreateBlackoutRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutRequest) ProtoMessage() {}
+
+func (x *CreateBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateBlackoutRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlackoutId    string                 `protobuf:"bytes,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutResponse) Reset() {
+	*x = CreateBlackoutResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutResponse) ProtoMessage() {}
+
+func (x *CreateBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateBlackoutResponse) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+type DeleteBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlackoutId    string                 `protobuf:"bytes,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutRequest) Reset() {
+	*x = DeleteBlackoutRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutRequest) ProtoMessage() {}
+
+func (x *DeleteBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteBlackoutRequest) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+type DeleteBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutResponse) Reset() {
+	*x = DeleteBlackoutResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutResponse) ProtoMessage() {}
+
+func (x *DeleteBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{61}
+}
+
+type ListBlackoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsRequest) Reset() {
+	*x = ListBlackoutsRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsRequest) ProtoMessage() {}
+
+func (x *ListBlackoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListBlackoutsRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+type ListBlackoutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Blackouts sorted in ascending order of start date.
+	Blackouts     []*Blackout `protobuf:"bytes,1,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsResponse) Reset() {
+	*x = ListBlackoutsResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsResponse) ProtoMessage() {}
+
+func (x *ListBlackoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListBlackoutsResponse) GetBlackouts() []*Blackout {
+	if x != nil {
+		return x.Blackouts
+	}
+	return nil
+}
+
+type CreateCalendarSubscriptionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Name of calendar, e.g. the platform the campsite is listed on.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Source of calendar events, each event blocks the campsite for the dates it spans.
+	//
+	// Types that are valid to be assigned to Source:
+	//
+	//	*CreateCalendarSubscriptionRequest_Url
+	//	*CreateCalendarSubscriptionRequest_Calendar
+	Source        isCreateCalendarSubscriptionRequest_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarSubscriptionRequest) Reset() {
+	*x = CreateCalendarSubscriptionRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateCalendarSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateCalendarSubscriptionRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *CreateCalendarSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCalendarSubscriptionRequest) GetSource() isCreateCalendarSubscriptionRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CreateCalendarSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*CreateCalendarSubscriptionRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *CreateCalendarSubscriptionRequest) GetCalendar() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*CreateCalendarSubscriptionRequest_Calendar); ok {
+			return x.Calendar
+		}
+	}
+	return nil
+}
+
+type isCreateCalendarSubscriptionRequest_Source interface {
+	isCreateCalendarSubscriptionRequest_Source()
+}
+
+type CreateCalendarSubscriptionRequest_Url struct {
+	// URL of iCalendar feed to sync periodically, with http, https or webcal scheme.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3,oneof"`
+}
+
+type CreateCalendarSubscriptionRequest_Calendar struct {
+	// iCalendar document to import once.
+	Calendar []byte `protobuf:"bytes,4,opt,name=calendar,proto3,oneof"`
+}
+
+func (*CreateCalendarSubscriptionRequest_Url) isCreateCalendarSubscriptionRequest_Source() {}
+
+func (*CreateCalendarSubscriptionRequest_Calendar) isCreateCalendarSubscriptionRequest_Source() {}
+
+type CreateCalendarSubscriptionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCalendarSubscriptionResponse) Reset() {
+	*x = CreateCalendarSubscriptionResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateCalendarSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateCalendarSubscriptionResponse) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type DeleteCalendarSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarSubscriptionRequest) Reset() {
+	*x = DeleteCalendarSubscriptionRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteCalendarSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type DeleteCalendarSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarSubscriptionResponse) Reset() {
+	*x = DeleteCalendarSubscriptionResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteCalendarSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{67}
+}
+
+type ListCalendarSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId    string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarSubscriptionsRequest) Reset() {
+	*x = ListCalendarSubscriptionsRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListCalendarSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListCalendarSubscriptionsRequest) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+type ListCalendarSubscriptionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Subscriptions []*CalendarSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarSubscriptionsResponse) Reset() {
+	*x = ListCalendarSubscriptionsResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListCalendarSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListCalendarSubscriptionsResponse) GetSubscriptions() []*CalendarSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type GetGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestRequest) Reset() {
+	*x = GetGuestRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestRequest) ProtoMessage() {}
+
+func (x *GetGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestRequest.ProtoReflect.Descriptor instead.
+func (*GetGuestRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetGuestRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type GetGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestResponse) Reset() {
+	*x = GetGuestResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestResponse) ProtoMessage() {}
+
+func (x *GetGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestResponse.ProtoReflect.Descriptor instead.
+func (*GetGuestResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetGuestResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type UpdateGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuestRequest) Reset() {
+	*x = UpdateGuestRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestRequest) ProtoMessage() {}
+
+func (x *UpdateGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuestRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateGuestRequest) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type UpdateGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuestResponse) Reset() {
+	*x = UpdateGuestResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestResponse) ProtoMessage() {}
+
+func (x *UpdateGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuestResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGuestResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{73}
+}
+
+type ListGuestBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuestBookingsRequest) Reset() {
+	*x = ListGuestBookingsRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuestBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuestBookingsRequest) ProtoMessage() {}
+
+func (x *ListGuestBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuestBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListGuestBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListGuestBookingsRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type ListGuestBookingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bookings of guest sorted in descending order of start date, including cancelled ones.
+	Bookings      []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuestBookingsResponse) Reset() {
+	*x = ListGuestBookingsResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuestBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuestBookingsResponse) ProtoMessage() {}
+
+func (x *ListGuestBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuestBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListGuestBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListGuestBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type ExportGuestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGuestDataRequest) Reset() {
+	*x = ExportGuestDataRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGuestDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGuestDataRequest) ProtoMessage() {}
+
+func (x *ExportGuestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGuestDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportGuestDataRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExportGuestDataRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ExportGuestDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Data held about the person with the email, as a JSON document of GuestData.
+	GuestData     string `protobuf:"bytes,1,opt,name=guest_data,json=guestData,proto3" json:"guest_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGuestDataResponse) Reset() {
+	*x = ExportGuestDataResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGuestDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGuestDataResponse) ProtoMessage() {}
+
+func (x *ExportGuestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGuestDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportGuestDataResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportGuestDataResponse) GetGuestData() string {
+	if x != nil {
+		return x.GuestData
+	}
+	return ""
+}
+
+type AnonymizeGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeGuestRequest) Reset() {
+	*x = AnonymizeGuestRequest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeGuestRequest) ProtoMessage() {}
+
+func (x *AnonymizeGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeGuestRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeGuestRequest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AnonymizeGuestRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AnonymizeGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeGuestResponse) Reset() {
+	*x = AnonymizeGuestResponse{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeGuestResponse) ProtoMessage() {}
+
+func (x *AnonymizeGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeGuestResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeGuestResponse) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{79}
+}
+
+type ImportCampsite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique code of campsite, the campsite with the code is updated if it exists.
+	CampsiteCode string `protobuf:"bytes,1,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
+	// Maximum number of people campsite can accommodate.
+	Capacity      int32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	DrinkingWater bool  `protobuf:"varint,3,opt,name=drinking_water,json=drinkingWater,proto3" json:"drinking_water,omitempty"`
+	Restrooms     bool  `protobuf:"varint,4,opt,name=restrooms,proto3" json:"restrooms,omitempty"`
+	PicnicTable   bool  `protobuf:"varint,5,opt,name=picnic_table,json=picnicTable,proto3" json:"picnic_table,omitempty"`
+	FirePit       bool  `protobuf:"varint,6,opt,name=fire_pit,json=firePit,proto3" json:"fire_pit,omitempty"`
+	// Identifier of the campground the campsite belongs to, optional, an updated campsite keeps its
+	// campground if empty.
+	CampgroundId  string `protobuf:"bytes,7,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCampsite) Reset() {
+	*x = ImportCampsite{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCampsite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCampsite) ProtoMessage() {}
+
+func (x *ImportCampsite) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCampsite.ProtoReflect.Descriptor instead.
+func (*ImportCampsite) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ImportCampsite) GetCampsiteCode() string {
+	if x != nil {
+		return x.CampsiteCode
+	}
+	return ""
+}
+
+func (x *ImportCampsite) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ImportCampsite) GetDrinkingWater() bool {
+	if x != nil {
+		return x.DrinkingWater
+	}
+	return false
+}
+
+func (x *ImportCampsite) GetRestrooms() bool {
+	if x != nil {
+		return x.Restrooms
+	}
+	return false
+}
+
+func (x *ImportCampsite) GetPicnicTable() bool {
+	if x != nil {
+		return x.PicnicTable
+	}
+	return false
+}
+
+func (x *ImportCampsite) GetFirePit() bool {
+	if x != nil {
+		return x.FirePit
+	}
+	return false
+}
+
+func (x *ImportCampsite) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+type CampsiteImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the row in the import, starting at 1.
+	Row          int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	CampsiteCode string `protobuf:"bytes,2,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
+	// Identifier of the campsite created or updated.
+	CampsiteId string `protobuf:"bytes,3,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Outcome of the row, one of CREATED, UPDATED, FAILED or SKIPPED.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Reason the row failed.
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampsiteImportResult) Reset() {
+	*x = CampsiteImportResult{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampsiteImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampsiteImportResult) ProtoMessage() {}
+
+func (x *CampsiteImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampsiteImportResult.ProtoReflect.Descriptor instead.
+func (*CampsiteImportResult) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CampsiteImportResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CampsiteImportResult) GetCampsiteCode() string {
+	if x != nil {
+		return x.CampsiteCode
+	}
+	return ""
+}
+
+func (x *CampsiteImportResult) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *CampsiteImportResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CampsiteImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Campsite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of campsite, must be in UUID format.
+	CampsiteId string `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Unique code of campsite.
+	CampsiteCode string `protobuf:"bytes,2,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
+	// Maximum number of people campsite can accommodate.
+	Capacity int32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Indicates if campsite has drinking water.
+	DrinkingWater bool `protobuf:"varint,4,opt,name=drinking_water,json=drinkingWater,proto3" json:"drinking_water,omitempty"`
+	// Indicates if campsite has restrooms.
+	Restrooms bool `protobuf:"varint,5,opt,name=restrooms,proto3" json:"restrooms,omitempty"`
+	// Indicates if campsite has a picnic table.
+	PicnicTable bool `protobuf:"varint,6,opt,name=picnic_table,json=picnicTable,proto3" json:"picnic_table,omitempty"`
+	// Indicates if campsite has a fire pit.
+	FirePit bool `protobuf:"varint,7,opt,name=fire_pit,json=firePit,proto3" json:"fire_pit,omitempty"`
+	// Indicates if campsite is active.
+	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// Identifier of the campground the campsite belongs to, empty if unassigned.
+	CampgroundId  string `protobuf:"bytes,9,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campsite) Reset() {
+	*x = Campsite{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campsite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campsite) ProtoMessage() {}
+
+func (x *Campsite) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campsite.ProtoReflect.Descriptor instead.
+func (*Campsite) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Campsite) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *Campsite) GetCampsiteCode() string {
+	if x != nil {
+		return x.CampsiteCode
+	}
+	return ""
+}
+
+func (x *Campsite) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Campsite) GetDrinkingWater() bool {
+	if x != nil {
+		return x.DrinkingWater
+	}
+	return false
+}
+
+func (x *Campsite) GetRestrooms() bool {
+	if x != nil {
+		return x.Restrooms
+	}
+	return false
+}
+
+func (x *Campsite) GetPicnicTable() bool {
+	if x != nil {
+		return x.PicnicTable
+	}
+	return false
+}
+
+func (x *Campsite) GetFirePit() bool {
+	if x != nil {
+		return x.FirePit
+	}
+	return false
+}
+
+func (x *Campsite) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Campsite) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+type Campground struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of campground, must be in UUID format.
+	CampgroundId string `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	// Unique name of campground.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of campground.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Indicates if campground is active.
+	Active        bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campground) Reset() {
+	*x = Campground{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campground) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campground) ProtoMessage() {}
+
+func (x *Campground) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campground.ProtoReflect.Descriptor instead.
+func (*Campground) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Campground) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+func (x *Campground) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Campground) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Campground) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Booking struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of booking, must be in UUID format.
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Identifier of the campsite booked, must be in UUID format.
+	CampsiteId string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Email of person who made booking.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Full name of person who made booking.
+	FullName string `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// Start date of booking, must be in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of booking, must be in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Status of booking, ignored on update.
+	Status BookingStatus `protobuf:"varint,18,opt,name=status,proto3,enum=campgroundspb.v1.BookingStatus" json:"status,omitempty"`
+	// Version of booking.
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Number of guests, unchanged on update if 0.
+	Guests int32 `protobuf:"varint,10,opt,name=guests,proto3" json:"guests,omitempty"`
+	// Total price snapshotted when booking was created or last updated, in minor currency units,
+	// ignored on update.
+	TotalPrice int64 `protobuf:"varint,11,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// Currency of total price, in ISO-4217 format, empty if campsite has no rates, ignored on update.
+	Currency string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Deposit charged when booking was created, in minor currency units, ignored on update.
+	DepositAmount int64 `protobuf:"varint,13,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	// Reference of the deposit payment at the payment provider, ignored on update.
+	PaymentRef string `protobuf:"bytes,14,opt,name=payment_ref,json=paymentRef,proto3" json:"payment_ref,omitempty"`
+	// Share of the deposit refunded when booking was cancelled, in percent, ignored on update.
+	RefundPercent int32 `protobuf:"varint,15,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"`
+	// Refunded amount when booking was cancelled, in minor currency units, ignored on update.
+	RefundAmount int64 `protobuf:"varint,16,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// Identifier of the group booking the booking belongs to, empty if booked alone, ignored on update.
+	GroupId string `protobuf:"bytes,17,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Identifier of the guest the booking was matched to by email, ignored on update.
+	GuestId       string `protobuf:"bytes,19,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Booking) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Booking) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *Booking) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Booking) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Booking) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Booking) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Booking) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *Booking) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Booking) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *Booking) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Booking) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Booking) GetDepositAmount() int64 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *Booking) GetPaymentRef() string {
+	if x != nil {
+		return x.PaymentRef
+	}
+	return ""
+}
+
+func (x *Booking) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
+}
+
+func (x *Booking) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *Booking) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Booking) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type Guest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of guest, must be in UUID format.
+	GuestId string `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	// Email of guest, bookings are matched to guests by email, unchanged on update if empty.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Full name of guest, unchanged on update if empty.
+	FullName string `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// Phone number of guest, unchanged on update if empty.
+	Phone string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// Postal address of guest, unchanged on update if empty.
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// Free-text preferences of guest, e.g. a quiet campsite, unchanged on update if empty.
+	Preferences   string `protobuf:"bytes,6,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guest) Reset() {
+	*x = Guest{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guest) ProtoMessage() {}
+
+func (x *Guest) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guest.ProtoReflect.Descriptor instead.
+func (*Guest) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Guest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *Guest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Guest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Guest) GetPreferences() string {
+	if x != nil {
+		return x.Preferences
+	}
+	return ""
+}
+
+type GuestData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Email the data was exported for.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Guest profile with the email, unset if none.
+	Guest *Guest `protobuf:"bytes,2,opt,name=guest,proto3" json:"guest,omitempty"`
+	// Bookings made with the email or matched to the guest, sorted in descending order of start
+	// date.
+	Bookings        []*Booking       `protobuf:"bytes,3,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	WaitlistEntries []*WaitlistEntry `protobuf:"bytes,4,rep,name=waitlist_entries,json=waitlistEntries,proto3" json:"waitlist_entries,omitempty"`
+	// Time of the export, in RFC-3339 format.
+	ExportedAt    string `protobuf:"bytes,5,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestData) Reset() {
+	*x = GuestData{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestData) ProtoMessage() {}
+
+func (x *GuestData) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestData.ProtoReflect.Descriptor instead.
+func (*GuestData) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GuestData) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GuestData) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+func (x *GuestData) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *GuestData) GetWaitlistEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.WaitlistEntries
+	}
+	return nil
+}
+
+func (x *GuestData) GetExportedAt() string {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return ""
+}
+
+type GroupBookingCampsite struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampsiteId string                 `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Number of guests, defaults to 1.
+	Guests        int32 `protobuf:"varint,2,opt,name=guests,proto3" json:"guests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBookingCampsite) Reset() {
+	*x = GroupBookingCampsite{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBookingCampsite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBookingCampsite) ProtoMessage() {}
+
+func (x *GroupBookingCampsite) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBookingCampsite.ProtoReflect.Descriptor instead.
+func (*GroupBookingCampsite) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GroupBookingCampsite) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *GroupBookingCampsite) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+type WaitlistEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of waitlist entry.
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// Identifier of the campground waited for.
+	CampgroundId string `protobuf:"bytes,2,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	// Identifier of the campsite waited for, empty if any campsite of campground.
+	CampsiteId string `protobuf:"bytes,3,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Email of person waiting.
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// Full name of person waiting.
+	FullName string `protobuf:"bytes,5,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// Start date of stay, in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of stay, in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of guests.
+	Guests int32 `protobuf:"varint,8,opt,name=guests,proto3" json:"guests,omitempty"`
+	// Status of entry, one of WAITING, OFFERED, ACCEPTED or EXPIRED.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Identifier of the campsite offered, set once vacated dates were offered.
+	OfferCampsiteId string `protobuf:"bytes,10,opt,name=offer_campsite_id,json=offerCampsiteId,proto3" json:"offer_campsite_id,omitempty"`
+	// Deadline to accept the offer, in RFC-3339 format, set once vacated dates were offered.
+	OfferExpiresAt string `protobuf:"bytes,11,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	// Identifier of the booking created when offer was accepted.
+	BookingId     string `protobuf:"bytes,12,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WaitlistEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferCampsiteId() string {
+	if x != nil {
+		return x.OfferCampsiteId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() string {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type Blackout struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of blackout.
+	BlackoutId string `protobuf:"bytes,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	// Identifier of the campsite closed.
+	CampsiteId string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Start date of blackout, in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of blackout (exclusive), in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Reason campsite is closed.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Blackout) Reset() {
+	*x = Blackout{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blackout) ProtoMessage() {}
+
+func (x *Blackout) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
+func (*Blackout) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Blackout) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+func (x *Blackout) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *Blackout) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Blackout) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Blackout) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CalendarSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of calendar subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Identifier of the campsite blocked by calendar events.
+	CampsiteId string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Name of calendar.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// URL of iCalendar feed, empty if calendar was imported once.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Time of last successful sync, in RFC-3339 format, empty if never synced.
+	LastSyncedAt string `protobuf:"bytes,5,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	// Reason last sync failed, empty if it succeeded; dates blocked by last successful sync are kept.
+	LastSyncError string `protobuf:"bytes,6,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarSubscription) Reset() {
+	*x = CalendarSubscription{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarSubscription) ProtoMessage() {}
+
+func (x *CalendarSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarSubscription.ProtoReflect.Descriptor instead.
+func (*CalendarSubscription) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CalendarSubscription) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetLastSyncedAt() string {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+type CampgroundSeason struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the campground, must be in UUID format.
+	CampgroundId string `protobuf:"bytes,1,opt,name=campground_id,json=campgroundId,proto3" json:"campground_id,omitempty"`
+	// First open night of every year, must be in MM-DD format, open all year if neither opens_on
+	// nor closes_on are set.
+	OpensOn string `protobuf:"bytes,2,opt,name=opens_on,json=opensOn,proto3" json:"opens_on,omitempty"`
+	// Last open night of every year (inclusive), must be in MM-DD format, may be before opens_on
+	// for a season spanning the new year.
+	ClosesOn string `protobuf:"bytes,3,opt,name=closes_on,json=closesOn,proto3" json:"closes_on,omitempty"`
+	// Weekdays the campground is closed, one of SUNDAY, MONDAY, TUESDAY, WEDNESDAY, THURSDAY,
+	// FRIDAY or SATURDAY.
+	ClosedWeekdays []string `protobuf:"bytes,4,rep,name=closed_weekdays,json=closedWeekdays,proto3" json:"closed_weekdays,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CampgroundSeason) Reset() {
+	*x = CampgroundSeason{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampgroundSeason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampgroundSeason) ProtoMessage() {}
+
+func (x *CampgroundSeason) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampgroundSeason.ProtoReflect.Descriptor instead.
+func (*CampgroundSeason) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CampgroundSeason) GetCampgroundId() string {
+	if x != nil {
+		return x.CampgroundId
+	}
+	return ""
+}
+
+func (x *CampgroundSeason) GetOpensOn() string {
+	if x != nil {
+		return x.OpensOn
+	}
+	return ""
+}
+
+func (x *CampgroundSeason) GetClosesOn() string {
+	if x != nil {
+		return x.ClosesOn
+	}
+	return ""
+}
+
+func (x *CampgroundSeason) GetClosedWeekdays() []string {
+	if x != nil {
+		return x.ClosedWeekdays
+	}
+	return nil
+}
+
+type CampsiteRates struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the campsite priced, must be in UUID format.
+	CampsiteId string `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
+	// Currency of all amounts, in ISO-4217 format (e.g. USD).
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Base nightly rate, in minor currency units (e.g. cents).
+	NightlyRate int64 `protobuf:"varint,3,opt,name=nightly_rate,json=nightlyRate,proto3" json:"nightly_rate,omitempty"`
+	// Nightly rate for Friday and Saturday nights, in minor currency units, base rate applies if 0.
+	WeekendNightlyRate int64 `protobuf:"varint,4,opt,name=weekend_nightly_rate,json=weekendNightlyRate,proto3" json:"weekend_nightly_rate,omitempty"`
+	// Number of guests included in nightly rate, defaults to 1.
+	IncludedGuests int32 `protobuf:"varint,5,opt,name=included_guests,json=includedGuests,proto3" json:"included_guests,omitempty"`
+	// Fee per night for every guest above included guests, in minor currency units.
+	ExtraGuestFee int64 `protobuf:"varint,6,opt,name=extra_guest_fee,json=extraGuestFee,proto3" json:"extra_guest_fee,omitempty"`
+	// Tax rate in basis points (e.g. 1300 is 13%).
+	TaxRateBps int32 `protobuf:"varint,7,opt,name=tax_rate_bps,json=taxRateBps,proto3" json:"tax_rate_bps,omitempty"`
+	// Seasonal overrides of nightly rates, must not overlap.
+	Seasons       []*SeasonalRate `protobuf:"bytes,8,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampsiteRates) Reset() {
+	*x = CampsiteRates{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampsiteRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampsiteRates) ProtoMessage() {}
+
+func (x *CampsiteRates) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampsiteRates.ProtoReflect.Descriptor instead.
+func (*CampsiteRates) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CampsiteRates) GetCampsiteId() string {
+	if x != nil {
+		return x.CampsiteId
+	}
+	return ""
 }
 
-func (x *GetVacantDatesRequest) GetEndDate() string {
+func (x *CampsiteRates) GetCurrency() string {
 	if x != nil {
-		return x.EndDate
+		return x.Currency
 	}
 	return ""
 }
 
-type GetVacantDatesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VacantDates   []string               `protobuf:"bytes,1,rep,name=vacant_dates,json=vacantDates,proto3" json:"vacant_dates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CampsiteRates) GetNightlyRate() int64 {
+	if x != nil {
+		return x.NightlyRate
+	}
+	return 0
 }
 
-func (x *GetVacantDatesResponse) Reset() {
-	*x = GetVacantDatesResponse{}
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[13]
+func (x *CampsiteRates) GetWeekendNightlyRate() int64 {
+	if x != nil {
+		return x.WeekendNightlyRate
+	}
+	return 0
+}
+
+func (x *CampsiteRates) GetIncludedGuests() int32 {
+	if x != nil {
+		return x.IncludedGuests
+	}
+	return 0
+}
+
+func (x *CampsiteRates) GetExtraGuestFee() int64 {
+	if x != nil {
+		return x.ExtraGuestFee
+	}
+	return 0
+}
+
+func (x *CampsiteRates) GetTaxRateBps() int32 {
+	if x != nil {
+		return x.TaxRateBps
+	}
+	return 0
+}
+
+func (x *CampsiteRates) GetSeasons() []*SeasonalRate {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type SeasonalRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of season.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Start date of season (first night), must be in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of season (exclusive), must be in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Nightly rate during season, in minor currency units.
+	NightlyRate int64 `protobuf:"varint,4,opt,name=nightly_rate,json=nightlyRate,proto3" json:"nightly_rate,omitempty"`
+	// Nightly rate for Friday and Saturday nights during season, in minor currency units,
+	// seasonal nightly rate applies if 0.
+	WeekendNightlyRate int64 `protobuf:"varint,5,opt,name=weekend_nightly_rate,json=weekendNightlyRate,proto3" json:"weekend_nightly_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SeasonalRate) Reset() {
+	*x = SeasonalRate{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVacantDatesResponse) String() string {
+func (x *SeasonalRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacantDatesResponse) ProtoMessage() {}
+func (*SeasonalRate) ProtoMessage() {}
 
-func (x *GetVacantDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[13]
+func (x *SeasonalRate) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,55 +5365,87 @@ func (x *GetVacantDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacantDatesResponse.ProtoReflect.Descriptor instead.
-func (*GetVacantDatesResponse) Descriptor() ([]byte, []int) {
-	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SeasonalRate.ProtoReflect.Descriptor instead.
+func (*SeasonalRate) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetVacantDatesResponse) GetVacantDates() []string {
+func (x *SeasonalRate) GetName() string {
 	if x != nil {
-		return x.VacantDates
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Campsite struct {
+func (x *SeasonalRate) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SeasonalRate) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SeasonalRate) GetNightlyRate() int64 {
+	if x != nil {
+		return x.NightlyRate
+	}
+	return 0
+}
+
+func (x *SeasonalRate) GetWeekendNightlyRate() int64 {
+	if x != nil {
+		return x.WeekendNightlyRate
+	}
+	return 0
+}
+
+type Quote struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of campsite, must be in UUID format.
+	// Identifier of the campsite quoted.
 	CampsiteId string `protobuf:"bytes,1,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
-	// Unique code of campsite.
-	CampsiteCode string `protobuf:"bytes,2,opt,name=campsite_code,json=campsiteCode,proto3" json:"campsite_code,omitempty"`
-	// Maximum number of people campsite can accommodate.
-	Capacity int32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	// Indicates if campsite has drinking water.
-	DrinkingWater bool `protobuf:"varint,4,opt,name=drinking_water,json=drinkingWater,proto3" json:"drinking_water,omitempty"`
-	// Indicates if campsite has restrooms.
-	Restrooms bool `protobuf:"varint,5,opt,name=restrooms,proto3" json:"restrooms,omitempty"`
-	// Indicates if campsite has a picnic table.
-	PicnicTable bool `protobuf:"varint,6,opt,name=picnic_table,json=picnicTable,proto3" json:"picnic_table,omitempty"`
-	// Indicates if campsite has a fire pit.
-	FirePit bool `protobuf:"varint,7,opt,name=fire_pit,json=firePit,proto3" json:"fire_pit,omitempty"`
-	// Indicates if campsite is active.
-	Active        bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// Start date of stay, in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of stay, in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of guests quoted.
+	Guests int32 `protobuf:"varint,4,opt,name=guests,proto3" json:"guests,omitempty"`
+	// Currency of all amounts, in ISO-4217 format.
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Price of every night of stay.
+	Nights []*NightlyPrice `protobuf:"bytes,6,rep,name=nights,proto3" json:"nights,omitempty"`
+	// Sum of nightly prices, in minor currency units.
+	NightsSubtotal int64 `protobuf:"varint,7,opt,name=nights_subtotal,json=nightsSubtotal,proto3" json:"nights_subtotal,omitempty"`
+	// Fees for guests above included guests, in minor currency units.
+	ExtraGuestFees int64 `protobuf:"varint,8,opt,name=extra_guest_fees,json=extraGuestFees,proto3" json:"extra_guest_fees,omitempty"`
+	// Taxes on nights subtotal and extra guest fees, in minor currency units.
+	Taxes int64 `protobuf:"varint,9,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	// Total price, in minor currency units.
+	Total         int64 `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Campsite) Reset() {
-	*x = Campsite{}
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[14]
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Campsite) String() string {
+func (x *Quote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Campsite) ProtoMessage() {}
+func (*Quote) ProtoMessage() {}
 
-func (x *Campsite) ProtoReflect() protoreflect.Message {
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[14]
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,104 +5456,110 @@ func (x *Campsite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Campsite.ProtoReflect.Descriptor instead.
-func (*Campsite) Descriptor() ([]byte, []int) {
-	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *Campsite) GetCampsiteId() string {
+func (x *Quote) GetCampsiteId() string {
 	if x != nil {
 		return x.CampsiteId
 	}
 	return ""
 }
 
-func (x *Campsite) GetCampsiteCode() string {
+func (x *Quote) GetStartDate() string {
 	if x != nil {
-		return x.CampsiteCode
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Campsite) GetCapacity() int32 {
+func (x *Quote) GetEndDate() string {
 	if x != nil {
-		return x.Capacity
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Quote) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
 	}
 	return 0
 }
 
-func (x *Campsite) GetDrinkingWater() bool {
+func (x *Quote) GetCurrency() string {
 	if x != nil {
-		return x.DrinkingWater
+		return x.Currency
 	}
-	return false
+	return ""
 }
 
-func (x *Campsite) GetRestrooms() bool {
+func (x *Quote) GetNights() []*NightlyPrice {
 	if x != nil {
-		return x.Restrooms
+		return x.Nights
 	}
-	return false
+	return nil
 }
 
-func (x *Campsite) GetPicnicTable() bool {
+func (x *Quote) GetNightsSubtotal() int64 {
 	if x != nil {
-		return x.PicnicTable
+		return x.NightsSubtotal
 	}
-	return false
+	return 0
 }
 
-func (x *Campsite) GetFirePit() bool {
+func (x *Quote) GetExtraGuestFees() int64 {
 	if x != nil {
-		return x.FirePit
+		return x.ExtraGuestFees
 	}
-	return false
+	return 0
 }
 
-func (x *Campsite) GetActive() bool {
+func (x *Quote) GetTaxes() int64 {
 	if x != nil {
-		return x.Active
+		return x.Taxes
 	}
-	return false
+	return 0
 }
 
-type Booking struct {
+func (x *Quote) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type NightlyPrice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of booking, must be in UUID format.
-	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	// Identifier of the campsite booked, must be in UUID format.
-	CampsiteId string `protobuf:"bytes,2,opt,name=campsite_id,json=campsiteId,proto3" json:"campsite_id,omitempty"`
-	// Email of person who made booking.
-	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	// Full name of person who made booking.
-	FullName string `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	// Start date of booking, must be in ISO-8601 format (YYYY-MM-DD).
-	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	// End date of booking, must be in ISO-8601 format (YYYY-MM-DD).
-	EndDate string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	// Indicates if booking is active.
-	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
-	// Version of booking.
-	Version       int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Date of night, in ISO-8601 format (YYYY-MM-DD).
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Price of night, in minor currency units.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Indicates if weekend rate applied.
+	Weekend bool `protobuf:"varint,3,opt,name=weekend,proto3" json:"weekend,omitempty"`
+	// Name of season applied, empty if base rates applied.
+	Season        string `protobuf:"bytes,4,opt,name=season,proto3" json:"season,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Booking) Reset() {
-	*x = Booking{}
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[15]
+func (x *NightlyPrice) Reset() {
+	*x = NightlyPrice{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Booking) String() string {
+func (x *NightlyPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Booking) ProtoMessage() {}
+func (*NightlyPrice) ProtoMessage() {}
 
-func (x *Booking) ProtoReflect() protoreflect.Message {
-	mi := &file_campgroundspb_v1_api_proto_msgTypes[15]
+func (x *NightlyPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,63 +5570,98 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
-func (*Booking) Descriptor() ([]byte, []int) {
-	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use NightlyPrice.ProtoReflect.Descriptor instead.
+func (*NightlyPrice) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *Booking) GetBookingId() string {
+func (x *NightlyPrice) GetDate() string {
 	if x != nil {
-		return x.BookingId
+		return x.Date
 	}
 	return ""
 }
 
-func (x *Booking) GetCampsiteId() string {
+func (x *NightlyPrice) GetAmount() int64 {
 	if x != nil {
-		return x.CampsiteId
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *Booking) GetEmail() string {
+func (x *NightlyPrice) GetWeekend() bool {
 	if x != nil {
-		return x.Email
+		return x.Weekend
 	}
-	return ""
+	return false
 }
 
-func (x *Booking) GetFullName() string {
+func (x *NightlyPrice) GetSeason() string {
 	if x != nil {
-		return x.FullName
+		return x.Season
 	}
 	return ""
 }
 
-func (x *Booking) GetStartDate() string {
+type DateRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start date of range (first night), in ISO-8601 format (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// End date of range (exclusive, i.e. check-out date), in ISO-8601 format (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of nights in range.
+	Nights        int32 `protobuf:"varint,3,opt,name=nights,proto3" json:"nights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_campgroundspb_v1_api_proto_msgTypes[96]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Booking) GetEndDate() string {
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_campgroundspb_v1_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DateRange) GetStartDate() string {
 	if x != nil {
-		return x.EndDate
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Booking) GetActive() bool {
+func (x *DateRange) GetEndDate() string {
 	if x != nil {
-		return x.Active
+		return x.EndDate
 	}
-	return false
+	return ""
 }
 
-func (x *Booking) GetVersion() int64 {
+func (x *DateRange) GetNights() int32 {
 	if x != nil {
-		return x.Version
+		return x.Nights
 	}
 	return 0
 }
@@ -922,25 +5670,86 @@ var File_campgroundspb_v1_api_proto protoreflect.FileDescriptor
 
 const file_campgroundspb_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x1acampgroundspb/v1/api.proto\x12\x10campgroundspb.v1\x1a\x1bbuf/validate/validate.proto\"\x15\n" +
-	"\x13GetCampsitesRequest\"P\n" +
+	"\x1acampgroundspb/v1/api.proto\x12\x10campgroundspb.v1\x1a\x1bbuf/validate/validate.proto\"\x17\n" +
+	"\x15GetCampgroundsRequest\"X\n" +
+	"\x16GetCampgroundsResponse\x12>\n" +
+	"\vcampgrounds\x18\x01 \x03(\v2\x1c.campgroundspb.v1.CampgroundR\vcampgrounds\"E\n" +
+	"\x14GetCampgroundRequest\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\"U\n" +
+	"\x15GetCampgroundResponse\x12<\n" +
+	"\n" +
+	"campground\x18\x01 \x01(\v2\x1c.campgroundspb.v1.CampgroundR\n" +
+	"campground\"X\n" +
+	"\x17CreateCampgroundRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"?\n" +
+	"\x18CreateCampgroundResponse\x12#\n" +
+	"\rcampground_id\x18\x01 \x01(\tR\fcampgroundId\"W\n" +
+	"\x17UpdateCampgroundRequest\x12<\n" +
+	"\n" +
+	"campground\x18\x01 \x01(\v2\x1c.campgroundspb.v1.CampgroundR\n" +
+	"campground\"\x1a\n" +
+	"\x18UpdateCampgroundResponse\"H\n" +
+	"\x17DeleteCampgroundRequest\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\"\x1a\n" +
+	"\x18DeleteCampgroundResponse\"K\n" +
+	"\x1aGetCampgroundSeasonRequest\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\"Y\n" +
+	"\x1bGetCampgroundSeasonResponse\x12:\n" +
+	"\x06season\x18\x01 \x01(\v2\".campgroundspb.v1.CampgroundSeasonR\x06season\"`\n" +
+	"\x1aSetCampgroundSeasonRequest\x12B\n" +
+	"\x06season\x18\x01 \x01(\v2\".campgroundspb.v1.CampgroundSeasonB\x06\xbaH\x03\xc8\x01\x01R\x06season\"\x1d\n" +
+	"\x1bSetCampgroundSeasonResponse\"G\n" +
+	"\x13GetCampsitesRequest\x120\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fcampgroundId\"P\n" +
 	"\x14GetCampsitesResponse\x128\n" +
-	"\tcampsites\x18\x01 \x03(\v2\x1a.campgroundspb.v1.CampsiteR\tcampsites\"\xed\x01\n" +
+	"\tcampsites\x18\x01 \x03(\v2\x1a.campgroundspb.v1.CampsiteR\tcampsites\"\x9f\x02\n" +
 	"\x15CreateCampsiteRequest\x12,\n" +
 	"\rcampsite_code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fcampsiteCode\x12#\n" +
 	"\bcapacity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bcapacity\x12%\n" +
 	"\x0edrinking_water\x18\x03 \x01(\bR\rdrinkingWater\x12\x1c\n" +
 	"\trestrooms\x18\x04 \x01(\bR\trestrooms\x12!\n" +
 	"\fpicnic_table\x18\x05 \x01(\bR\vpicnicTable\x12\x19\n" +
-	"\bfire_pit\x18\x06 \x01(\bR\afirePit\"9\n" +
+	"\bfire_pit\x18\x06 \x01(\bR\afirePit\x120\n" +
+	"\rcampground_id\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fcampgroundId\"9\n" +
 	"\x16CreateCampsiteResponse\x12\x1f\n" +
 	"\vcampsite_id\x18\x01 \x01(\tR\n" +
-	"campsiteId\"<\n" +
+	"campsiteId\"\xb7\x01\n" +
+	"\x16ImportCampsitesRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12>\n" +
+	"\bcampsite\x18\x02 \x01(\v2 .campgroundspb.v1.ImportCampsiteH\x00R\bcampsite\x12\x1d\n" +
+	"\tcsv_chunk\x18\x03 \x01(\fH\x00R\bcsvChunk\x12\x1f\n" +
+	"\n" +
+	"json_chunk\x18\x04 \x01(\fH\x00R\tjsonChunkB\t\n" +
+	"\apayload\"\xc1\x01\n" +
+	"\x17ImportCampsitesResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12@\n" +
+	"\aresults\x18\x05 \x03(\v2&.campgroundspb.v1.CampsiteImportResultR\aresults\"D\n" +
+	"\x17GetCampsiteRatesRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\"Q\n" +
+	"\x18GetCampsiteRatesResponse\x125\n" +
+	"\x05rates\x18\x01 \x01(\v2\x1f.campgroundspb.v1.CampsiteRatesR\x05rates\"X\n" +
+	"\x17SetCampsiteRatesRequest\x12=\n" +
+	"\x05rates\x18\x01 \x01(\v2\x1f.campgroundspb.v1.CampsiteRatesB\x06\xbaH\x03\xc8\x01\x01R\x05rates\"\x1a\n" +
+	"\x18SetCampsiteRatesResponse\"\x91\x02\n" +
+	"\x13QuoteBookingRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12X\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
+	"\bend_date\x18\x03 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12\x1f\n" +
+	"\x06guests\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06guests\"E\n" +
+	"\x14QuoteBookingResponse\x12-\n" +
+	"\x05quote\x18\x01 \x01(\v2\x17.campgroundspb.v1.QuoteR\x05quote\"<\n" +
 	"\x11GetBookingRequest\x12'\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"I\n" +
 	"\x12GetBookingResponse\x123\n" +
-	"\abooking\x18\x01 \x01(\v2\x19.campgroundspb.v1.BookingR\abooking\"\xb6\x02\n" +
+	"\abooking\x18\x01 \x01(\v2\x19.campgroundspb.v1.BookingR\abooking\"\xfe\x02\n" +
 	"\x14CreateBookingRequest\x12)\n" +
 	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"campsiteId\x12\x1d\n" +
@@ -948,7 +5757,9 @@ const file_campgroundspb_v1_api_proto_rawDesc = "" +
 	"\tfull_name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfullName\x12X\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
-	"\bend_date\x18\x05 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\"6\n" +
+	"\bend_date\x18\x05 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12\x1f\n" +
+	"\x06guests\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06guests\x12%\n" +
+	"\x0epayment_method\x18\a \x01(\tR\rpaymentMethod\"6\n" +
 	"\x15CreateBookingResponse\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\"K\n" +
@@ -957,16 +5768,162 @@ const file_campgroundspb_v1_api_proto_rawDesc = "" +
 	"\x15UpdateBookingResponse\"?\n" +
 	"\x14CancelBookingRequest\x12'\n" +
 	"\n" +
-	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"\x17\n" +
-	"\x15CancelBookingResponse\"\xf2\x01\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"\x7f\n" +
+	"\x15CancelBookingResponse\x12%\n" +
+	"\x0erefund_percent\x18\x01 \x01(\x05R\rrefundPercent\x12#\n" +
+	"\rrefund_amount\x18\x02 \x01(\x03R\frefundAmount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"9\n" +
+	"\x0eCheckInRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"\x11\n" +
+	"\x0fCheckInResponse\":\n" +
+	"\x0fCheckOutRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"\x12\n" +
+	"\x10CheckOutResponse\"<\n" +
+	"\x11MarkNoShowRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"\x14\n" +
+	"\x12MarkNoShowResponse\"=\n" +
+	"\x16GetGroupBookingRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\"P\n" +
+	"\x17GetGroupBookingResponse\x125\n" +
+	"\bbookings\x18\x01 \x03(\v2\x19.campgroundspb.v1.BookingR\bbookings\"\x87\x03\n" +
+	"\x19CreateGroupBookingRequest\x12N\n" +
+	"\tcampsites\x18\x01 \x03(\v2&.campgroundspb.v1.GroupBookingCampsiteB\b\xbaH\x05\x92\x01\x02\b\x01R\tcampsites\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12$\n" +
+	"\tfull_name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfullName\x12X\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
+	"\bend_date\x18\x05 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\"X\n" +
+	"\x1aCreateGroupBookingResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\vbooking_ids\x18\x02 \x03(\tR\n" +
+	"bookingIds\"\xb5\x02\n" +
+	"\x19UpdateGroupBookingRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02`\x01R\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12[\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tB<\xbaH9\xd8\x01\x01r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12W\n" +
+	"\bend_date\x18\x05 \x01(\tB<\xbaH9\xd8\x01\x01r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\"\x1c\n" +
+	"\x1aUpdateGroupBookingResponse\"@\n" +
+	"\x19CancelGroupBookingRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\"]\n" +
+	"\x1aCancelGroupBookingResponse\x12#\n" +
+	"\rrefund_amount\x18\x01 \x01(\x03R\frefundAmount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xcc\x02\n" +
 	"\x15GetVacantDatesRequest\x12)\n" +
 	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"campsiteId\x12X\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
-	"\bend_date\x18\x03 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\";\n" +
+	"\bend_date\x18\x03 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12&\n" +
+	"\n" +
+	"min_nights\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\tminNights\x120\n" +
+	"\rcampground_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fcampgroundId\"}\n" +
 	"\x16GetVacantDatesResponse\x12!\n" +
-	"\fvacant_dates\x18\x01 \x03(\tR\vvacantDates\"\xa3\x02\n" +
+	"\fvacant_dates\x18\x01 \x03(\tR\vvacantDates\x12@\n" +
+	"\rvacant_ranges\x18\x02 \x03(\v2\x1b.campgroundspb.v1.DateRangeR\fvacantRanges\"\x88\x03\n" +
+	"\x13JoinWaitlistRequest\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\x12,\n" +
+	"\vcampsite_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12\x1d\n" +
+	"\x05email\x18\x03 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12$\n" +
+	"\tfull_name\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfullName\x12X\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
+	"\bend_date\x18\x06 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12\x1f\n" +
+	"\x06guests\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06guests\"1\n" +
+	"\x14JoinWaitlistResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\";\n" +
+	"\x14LeaveWaitlistRequest\x12#\n" +
+	"\bentry_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aentryId\"\x17\n" +
+	"\x15LeaveWaitlistResponse\"r\n" +
+	"\x13ListWaitlistRequest\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\x12,\n" +
+	"\vcampsite_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"campsiteId\"Q\n" +
+	"\x14ListWaitlistResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.campgroundspb.v1.WaitlistEntryR\aentries\"h\n" +
+	"\x1aAcceptWaitlistOfferRequest\x12#\n" +
+	"\bentry_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aentryId\x12%\n" +
+	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\"<\n" +
+	"\x1bAcceptWaitlistOfferResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"\x93\x02\n" +
+	"\x15CreateBlackoutRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12X\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
+	"\bend_date\x18\x03 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12\x1f\n" +
+	"\x06reason\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06reason\"9\n" +
+	"\x16CreateBlackoutResponse\x12\x1f\n" +
+	"\vblackout_id\x18\x01 \x01(\tR\n" +
+	"blackoutId\"B\n" +
+	"\x15DeleteBlackoutRequest\x12)\n" +
+	"\vblackout_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"blackoutId\"\x18\n" +
+	"\x16DeleteBlackoutResponse\"A\n" +
+	"\x14ListBlackoutsRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\"Q\n" +
+	"\x15ListBlackoutsResponse\x128\n" +
+	"\tblackouts\x18\x01 \x03(\v2\x1a.campgroundspb.v1.BlackoutR\tblackouts\"\xc1\x01\n" +
+	"!CreateCalendarSubscriptionRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1c\n" +
+	"\x03url\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01H\x00R\x03url\x12%\n" +
+	"\bcalendar\x18\x04 \x01(\fB\a\xbaH\x04z\x02\x10\x01H\x00R\bcalendarB\x0f\n" +
+	"\x06source\x12\x05\xbaH\x02\b\x01\"M\n" +
+	"\"CreateCalendarSubscriptionResponse\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\"V\n" +
+	"!DeleteCalendarSubscriptionRequest\x121\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0esubscriptionId\"$\n" +
+	"\"DeleteCalendarSubscriptionResponse\"M\n" +
+	" ListCalendarSubscriptionsRequest\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\"q\n" +
+	"!ListCalendarSubscriptionsResponse\x12L\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2&.campgroundspb.v1.CalendarSubscriptionR\rsubscriptions\"6\n" +
+	"\x0fGetGuestRequest\x12#\n" +
+	"\bguest_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aguestId\"A\n" +
+	"\x10GetGuestResponse\x12-\n" +
+	"\x05guest\x18\x01 \x01(\v2\x17.campgroundspb.v1.GuestR\x05guest\"K\n" +
+	"\x12UpdateGuestRequest\x125\n" +
+	"\x05guest\x18\x01 \x01(\v2\x17.campgroundspb.v1.GuestB\x06\xbaH\x03\xc8\x01\x01R\x05guest\"\x15\n" +
+	"\x13UpdateGuestResponse\"?\n" +
+	"\x18ListGuestBookingsRequest\x12#\n" +
+	"\bguest_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aguestId\"R\n" +
+	"\x19ListGuestBookingsResponse\x125\n" +
+	"\bbookings\x18\x01 \x03(\v2\x19.campgroundspb.v1.BookingR\bbookings\"7\n" +
+	"\x16ExportGuestDataRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"8\n" +
+	"\x17ExportGuestDataResponse\x12\x1d\n" +
+	"\n" +
+	"guest_data\x18\x01 \x01(\tR\tguestData\"6\n" +
+	"\x15AnonymizeGuestRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"\x18\n" +
+	"\x16AnonymizeGuestResponse\"\xf9\x01\n" +
+	"\x0eImportCampsite\x12#\n" +
+	"\rcampsite_code\x18\x01 \x01(\tR\fcampsiteCode\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12%\n" +
+	"\x0edrinking_water\x18\x03 \x01(\bR\rdrinkingWater\x12\x1c\n" +
+	"\trestrooms\x18\x04 \x01(\bR\trestrooms\x12!\n" +
+	"\fpicnic_table\x18\x05 \x01(\bR\vpicnicTable\x12\x19\n" +
+	"\bfire_pit\x18\x06 \x01(\bR\afirePit\x12#\n" +
+	"\rcampground_id\x18\a \x01(\tR\fcampgroundId\"\x9c\x01\n" +
+	"\x14CampsiteImportResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12#\n" +
+	"\rcampsite_code\x18\x02 \x01(\tR\fcampsiteCode\x12\x1f\n" +
+	"\vcampsite_id\x18\x03 \x01(\tR\n" +
+	"campsiteId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xc8\x02\n" +
 	"\bCampsite\x12)\n" +
 	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"campsiteId\x12,\n" +
@@ -976,7 +5933,14 @@ const file_campgroundspb_v1_api_proto_rawDesc = "" +
 	"\trestrooms\x18\x05 \x01(\bR\trestrooms\x12!\n" +
 	"\fpicnic_table\x18\x06 \x01(\bR\vpicnicTable\x12\x19\n" +
 	"\bfire_pit\x18\a \x01(\bR\afirePit\x12\x16\n" +
-	"\x06active\x18\b \x01(\bR\x06active\"\x8d\x03\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x12#\n" +
+	"\rcampground_id\x18\t \x01(\tR\fcampgroundId\"\x92\x01\n" +
+	"\n" +
+	"Campground\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"\xe4\x05\n" +
 	"\aBooking\x12'\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12)\n" +
@@ -986,18 +5950,174 @@ const file_campgroundspb_v1_api_proto_rawDesc = "" +
 	"\tfull_name\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfullName\x12X\n" +
 	"\n" +
 	"start_date\x18\x05 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
-	"\bend_date\x18\x06 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12\x16\n" +
-	"\x06active\x18\b \x01(\bR\x06active\x12!\n" +
-	"\aversion\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aversion2\xca\x05\n" +
-	"\x12CampgroundsService\x12_\n" +
+	"\bend_date\x18\x06 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x127\n" +
+	"\x06status\x18\x12 \x01(\x0e2\x1f.campgroundspb.v1.BookingStatusR\x06status\x12!\n" +
+	"\aversion\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aversion\x12\x1f\n" +
+	"\x06guests\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06guests\x12\x1f\n" +
+	"\vtotal_price\x18\v \x01(\x03R\n" +
+	"totalPrice\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12%\n" +
+	"\x0edeposit_amount\x18\r \x01(\x03R\rdepositAmount\x12\x1f\n" +
+	"\vpayment_ref\x18\x0e \x01(\tR\n" +
+	"paymentRef\x12%\n" +
+	"\x0erefund_percent\x18\x0f \x01(\x05R\rrefundPercent\x12#\n" +
+	"\rrefund_amount\x18\x10 \x01(\x03R\frefundAmount\x12\x19\n" +
+	"\bgroup_id\x18\x11 \x01(\tR\agroupId\x12\x19\n" +
+	"\bguest_id\x18\x13 \x01(\tR\aguestIdJ\x04\b\b\x10\tR\x06active\"\xe3\x01\n" +
+	"\x05Guest\x12#\n" +
+	"\bguest_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aguestId\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02`\x01R\x05email\x12$\n" +
+	"\tfull_name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\bfullName\x12\x1d\n" +
+	"\x05phone\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x182R\x05phone\x12\"\n" +
+	"\aaddress\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aaddress\x12*\n" +
+	"\vpreferences\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vpreferences\"\xf4\x01\n" +
+	"\tGuestData\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12-\n" +
+	"\x05guest\x18\x02 \x01(\v2\x17.campgroundspb.v1.GuestR\x05guest\x125\n" +
+	"\bbookings\x18\x03 \x03(\v2\x19.campgroundspb.v1.BookingR\bbookings\x12J\n" +
+	"\x10waitlist_entries\x18\x04 \x03(\v2\x1f.campgroundspb.v1.WaitlistEntryR\x0fwaitlistEntries\x12\x1f\n" +
+	"\vexported_at\x18\x05 \x01(\tR\n" +
+	"exportedAt\"b\n" +
+	"\x14GroupBookingCampsite\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12\x1f\n" +
+	"\x06guests\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06guests\"\x82\x03\n" +
+	"\rWaitlistEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12#\n" +
+	"\rcampground_id\x18\x02 \x01(\tR\fcampgroundId\x12\x1f\n" +
+	"\vcampsite_id\x18\x03 \x01(\tR\n" +
+	"campsiteId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x05 \x01(\tR\bfullName\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x16\n" +
+	"\x06guests\x18\b \x01(\x05R\x06guests\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12*\n" +
+	"\x11offer_campsite_id\x18\n" +
+	" \x01(\tR\x0fofferCampsiteId\x12(\n" +
+	"\x10offer_expires_at\x18\v \x01(\tR\x0eofferExpiresAt\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\f \x01(\tR\tbookingId\"\x9e\x01\n" +
+	"\bBlackout\x12\x1f\n" +
+	"\vblackout_id\x18\x01 \x01(\tR\n" +
+	"blackoutId\x12\x1f\n" +
+	"\vcampsite_id\x18\x02 \x01(\tR\n" +
+	"campsiteId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd4\x01\n" +
+	"\x14CalendarSubscription\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x1f\n" +
+	"\vcampsite_id\x18\x02 \x01(\tR\n" +
+	"campsiteId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12$\n" +
+	"\x0elast_synced_at\x18\x05 \x01(\tR\flastSyncedAt\x12&\n" +
+	"\x0flast_sync_error\x18\x06 \x01(\tR\rlastSyncError\"\x8a\x02\n" +
+	"\x10CampgroundSeason\x12-\n" +
+	"\rcampground_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fcampgroundId\x12M\n" +
+	"\bopens_on\x18\x02 \x01(\tB2\xbaH/\xd8\x01\x01r*2(^(0[1-9]|1[0-2])-(0[1-9]|[1-2]\\d|3[01])$R\aopensOn\x12O\n" +
+	"\tcloses_on\x18\x03 \x01(\tB2\xbaH/\xd8\x01\x01r*2(^(0[1-9]|1[0-2])-(0[1-9]|[1-2]\\d|3[01])$R\bclosesOn\x12'\n" +
+	"\x0fclosed_weekdays\x18\x04 \x03(\tR\x0eclosedWeekdays\"\x9b\x03\n" +
+	"\rCampsiteRates\x12)\n" +
+	"\vcampsite_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"campsiteId\x12-\n" +
+	"\bcurrency\x18\x02 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\bcurrency\x12*\n" +
+	"\fnightly_rate\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vnightlyRate\x129\n" +
+	"\x14weekend_nightly_rate\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x12weekendNightlyRate\x120\n" +
+	"\x0fincluded_guests\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0eincludedGuests\x12/\n" +
+	"\x0fextra_guest_fee\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rextraGuestFee\x12,\n" +
+	"\ftax_rate_bps\x18\a \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\n" +
+	"taxRateBps\x128\n" +
+	"\aseasons\x18\b \x03(\v2\x1e.campgroundspb.v1.SeasonalRateR\aseasons\"\xc2\x02\n" +
+	"\fSeasonalRate\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12X\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\tstartDate\x12T\n" +
+	"\bend_date\x18\x03 \x01(\tB9\xbaH6r422^\\d{4}-([0][1-9]|1[0-2])-([0][1-9]|[1-2]\\d|3[01])$R\aendDate\x12*\n" +
+	"\fnightly_rate\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vnightlyRate\x129\n" +
+	"\x14weekend_nightly_rate\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x12weekendNightlyRate\"\xcd\x02\n" +
+	"\x05Quote\x12\x1f\n" +
+	"\vcampsite_id\x18\x01 \x01(\tR\n" +
+	"campsiteId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06guests\x18\x04 \x01(\x05R\x06guests\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x126\n" +
+	"\x06nights\x18\x06 \x03(\v2\x1e.campgroundspb.v1.NightlyPriceR\x06nights\x12'\n" +
+	"\x0fnights_subtotal\x18\a \x01(\x03R\x0enightsSubtotal\x12(\n" +
+	"\x10extra_guest_fees\x18\b \x01(\x03R\x0eextraGuestFees\x12\x14\n" +
+	"\x05taxes\x18\t \x01(\x03R\x05taxes\x12\x14\n" +
+	"\x05total\x18\n" +
+	" \x01(\x03R\x05total\"l\n" +
+	"\fNightlyPrice\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x18\n" +
+	"\aweekend\x18\x03 \x01(\bR\aweekend\x12\x16\n" +
+	"\x06season\x18\x04 \x01(\tR\x06season\"]\n" +
+	"\tDateRange\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06nights\x18\x03 \x01(\x05R\x06nights*\xe2\x01\n" +
+	"\rBookingStatus\x12\x1e\n" +
+	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16BOOKING_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18BOOKING_STATUS_CONFIRMED\x10\x02\x12\x1d\n" +
+	"\x19BOOKING_STATUS_CHECKED_IN\x10\x03\x12\x1e\n" +
+	"\x1aBOOKING_STATUS_CHECKED_OUT\x10\x04\x12\x1c\n" +
+	"\x18BOOKING_STATUS_CANCELLED\x10\x05\x12\x1a\n" +
+	"\x16BOOKING_STATUS_NO_SHOW\x10\x062\x97!\n" +
+	"\x12CampgroundsService\x12e\n" +
+	"\x0eGetCampgrounds\x12'.campgroundspb.v1.GetCampgroundsRequest\x1a(.campgroundspb.v1.GetCampgroundsResponse\"\x00\x12b\n" +
+	"\rGetCampground\x12&.campgroundspb.v1.GetCampgroundRequest\x1a'.campgroundspb.v1.GetCampgroundResponse\"\x00\x12k\n" +
+	"\x10CreateCampground\x12).campgroundspb.v1.CreateCampgroundRequest\x1a*.campgroundspb.v1.CreateCampgroundResponse\"\x00\x12k\n" +
+	"\x10UpdateCampground\x12).campgroundspb.v1.UpdateCampgroundRequest\x1a*.campgroundspb.v1.UpdateCampgroundResponse\"\x00\x12k\n" +
+	"\x10DeleteCampground\x12).campgroundspb.v1.DeleteCampgroundRequest\x1a*.campgroundspb.v1.DeleteCampgroundResponse\"\x00\x12t\n" +
+	"\x13GetCampgroundSeason\x12,.campgroundspb.v1.GetCampgroundSeasonRequest\x1a-.campgroundspb.v1.GetCampgroundSeasonResponse\"\x00\x12t\n" +
+	"\x13SetCampgroundSeason\x12,.campgroundspb.v1.SetCampgroundSeasonRequest\x1a-.campgroundspb.v1.SetCampgroundSeasonResponse\"\x00\x12_\n" +
 	"\fGetCampsites\x12%.campgroundspb.v1.GetCampsitesRequest\x1a&.campgroundspb.v1.GetCampsitesResponse\"\x00\x12e\n" +
-	"\x0eCreateCampsite\x12'.campgroundspb.v1.CreateCampsiteRequest\x1a(.campgroundspb.v1.CreateCampsiteResponse\"\x00\x12Y\n" +
+	"\x0eCreateCampsite\x12'.campgroundspb.v1.CreateCampsiteRequest\x1a(.campgroundspb.v1.CreateCampsiteResponse\"\x00\x12j\n" +
+	"\x0fImportCampsites\x12(.campgroundspb.v1.ImportCampsitesRequest\x1a).campgroundspb.v1.ImportCampsitesResponse\"\x00(\x01\x12k\n" +
+	"\x10GetCampsiteRates\x12).campgroundspb.v1.GetCampsiteRatesRequest\x1a*.campgroundspb.v1.GetCampsiteRatesResponse\"\x00\x12k\n" +
+	"\x10SetCampsiteRates\x12).campgroundspb.v1.SetCampsiteRatesRequest\x1a*.campgroundspb.v1.SetCampsiteRatesResponse\"\x00\x12_\n" +
+	"\fQuoteBooking\x12%.campgroundspb.v1.QuoteBookingRequest\x1a&.campgroundspb.v1.QuoteBookingResponse\"\x00\x12Y\n" +
 	"\n" +
 	"GetBooking\x12#.campgroundspb.v1.GetBookingRequest\x1a$.campgroundspb.v1.GetBookingResponse\"\x00\x12b\n" +
 	"\rCreateBooking\x12&.campgroundspb.v1.CreateBookingRequest\x1a'.campgroundspb.v1.CreateBookingResponse\"\x00\x12b\n" +
 	"\rUpdateBooking\x12&.campgroundspb.v1.UpdateBookingRequest\x1a'.campgroundspb.v1.UpdateBookingResponse\"\x00\x12b\n" +
-	"\rCancelBooking\x12&.campgroundspb.v1.CancelBookingRequest\x1a'.campgroundspb.v1.CancelBookingResponse\"\x00\x12e\n" +
-	"\x0eGetVacantDates\x12'.campgroundspb.v1.GetVacantDatesRequest\x1a(.campgroundspb.v1.GetVacantDatesResponse\"\x00B\xa3\x01\n" +
+	"\rCancelBooking\x12&.campgroundspb.v1.CancelBookingRequest\x1a'.campgroundspb.v1.CancelBookingResponse\"\x00\x12P\n" +
+	"\aCheckIn\x12 .campgroundspb.v1.CheckInRequest\x1a!.campgroundspb.v1.CheckInResponse\"\x00\x12S\n" +
+	"\bCheckOut\x12!.campgroundspb.v1.CheckOutRequest\x1a\".campgroundspb.v1.CheckOutResponse\"\x00\x12Y\n" +
+	"\n" +
+	"MarkNoShow\x12#.campgroundspb.v1.MarkNoShowRequest\x1a$.campgroundspb.v1.MarkNoShowResponse\"\x00\x12h\n" +
+	"\x0fGetGroupBooking\x12(.campgroundspb.v1.GetGroupBookingRequest\x1a).campgroundspb.v1.GetGroupBookingResponse\"\x00\x12q\n" +
+	"\x12CreateGroupBooking\x12+.campgroundspb.v1.CreateGroupBookingRequest\x1a,.campgroundspb.v1.CreateGroupBookingResponse\"\x00\x12q\n" +
+	"\x12UpdateGroupBooking\x12+.campgroundspb.v1.UpdateGroupBookingRequest\x1a,.campgroundspb.v1.UpdateGroupBookingResponse\"\x00\x12q\n" +
+	"\x12CancelGroupBooking\x12+.campgroundspb.v1.CancelGroupBookingRequest\x1a,.campgroundspb.v1.CancelGroupBookingResponse\"\x00\x12e\n" +
+	"\x0eGetVacantDates\x12'.campgroundspb.v1.GetVacantDatesRequest\x1a(.campgroundspb.v1.GetVacantDatesResponse\"\x00\x12_\n" +
+	"\fJoinWaitlist\x12%.campgroundspb.v1.JoinWaitlistRequest\x1a&.campgroundspb.v1.JoinWaitlistResponse\"\x00\x12b\n" +
+	"\rLeaveWaitlist\x12&.campgroundspb.v1.LeaveWaitlistRequest\x1a'.campgroundspb.v1.LeaveWaitlistResponse\"\x00\x12_\n" +
+	"\fListWaitlist\x12%.campgroundspb.v1.ListWaitlistRequest\x1a&.campgroundspb.v1.ListWaitlistResponse\"\x00\x12t\n" +
+	"\x13AcceptWaitlistOffer\x12,.campgroundspb.v1.AcceptWaitlistOfferRequest\x1a-.campgroundspb.v1.AcceptWaitlistOfferResponse\"\x00\x12e\n" +
+	"\x0eCreateBlackout\x12'.campgroundspb.v1.CreateBlackoutRequest\x1a(.campgroundspb.v1.CreateBlackoutResponse\"\x00\x12e\n" +
+	"\x0eDeleteBlackout\x12'.campgroundspb.v1.DeleteBlackoutRequest\x1a(.campgroundspb.v1.DeleteBlackoutResponse\"\x00\x12b\n" +
+	"\rListBlackouts\x12&.campgroundspb.v1.ListBlackoutsRequest\x1a'.campgroundspb.v1.ListBlackoutsResponse\"\x00\x12\x89\x01\n" +
+	"\x1aCreateCalendarSubscription\x123.campgroundspb.v1.CreateCalendarSubscriptionRequest\x1a4.campgroundspb.v1.CreateCalendarSubscriptionResponse\"\x00\x12\x89\x01\n" +
+	"\x1aDeleteCalendarSubscription\x123.campgroundspb.v1.DeleteCalendarSubscriptionRequest\x1a4.campgroundspb.v1.DeleteCalendarSubscriptionResponse\"\x00\x12\x86\x01\n" +
+	"\x19ListCalendarSubscriptions\x122.campgroundspb.v1.ListCalendarSubscriptionsRequest\x1a3.campgroundspb.v1.ListCalendarSubscriptionsResponse\"\x00\x12S\n" +
+	"\bGetGuest\x12!.campgroundspb.v1.GetGuestRequest\x1a\".campgroundspb.v1.GetGuestResponse\"\x00\x12\\\n" +
+	"\vUpdateGuest\x12$.campgroundspb.v1.UpdateGuestRequest\x1a%.campgroundspb.v1.UpdateGuestResponse\"\x00\x12n\n" +
+	"\x11ListGuestBookings\x12*.campgroundspb.v1.ListGuestBookingsRequest\x1a+.campgroundspb.v1.ListGuestBookingsResponse\"\x00\x12h\n" +
+	"\x0fExportGuestData\x12(.campgroundspb.v1.ExportGuestDataRequest\x1a).campgroundspb.v1.ExportGuestDataResponse\"\x00\x12e\n" +
+	"\x0eAnonymizeGuest\x12'.campgroundspb.v1.AnonymizeGuestRequest\x1a(.campgroundspb.v1.AnonymizeGuestResponse\"\x00B\xa3\x01\n" +
 	"\x14com.campgroundspb.v1B\bApiProtoP\x01Z campgroundspb/v1;campgroundspbv1\xa2\x02\x03CXX\xaa\x02\x10Campgroundspb.V1\xca\x02\x10Campgroundspb\\V1\xe2\x02\x1cCampgroundspb\\V1\\GPBMetadata\xea\x02\x11Campgroundspb::V1b\x06proto3"
 
 var (
@@ -1012,48 +6132,222 @@ func file_campgroundspb_v1_api_proto_rawDescGZIP() []byte {
 	return file_campgroundspb_v1_api_proto_rawDescData
 }
 
-var file_campgroundspb_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_campgroundspb_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_campgroundspb_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_campgroundspb_v1_api_proto_goTypes = []any{
-	(*GetCampsitesRequest)(nil),    // 0: campgroundspb.v1.GetCampsitesRequest
-	(*GetCampsitesResponse)(nil),   // 1: campgroundspb.v1.GetCampsitesResponse
-	(*CreateCampsiteRequest)(nil),  // 2: campgroundspb.v1.CreateCampsiteRequest
-	(*CreateCampsiteResponse)(nil), // 3: campgroundspb.v1.CreateCampsiteResponse
-	(*GetBookingRequest)(nil),      // 4: campgroundspb.v1.GetBookingRequest
-	(*GetBookingResponse)(nil),     // 5: campgroundspb.v1.GetBookingResponse
-	(*CreateBookingRequest)(nil),   // 6: campgroundspb.v1.CreateBookingRequest
-	(*CreateBookingResponse)(nil),  // 7: campgroundspb.v1.CreateBookingResponse
-	(*UpdateBookingRequest)(nil),   // 8: campgroundspb.v1.UpdateBookingRequest
-	(*UpdateBookingResponse)(nil),  // 9: campgroundspb.v1.UpdateBookingResponse
-	(*CancelBookingRequest)(nil),   // 10: campgroundspb.v1.CancelBookingRequest
-	(*CancelBookingResponse)(nil),  // 11: campgroundspb.v1.CancelBookingResponse
-	(*GetVacantDatesRequest)(nil),  // 12: campgroundspb.v1.GetVacantDatesRequest
-	(*GetVacantDatesResponse)(nil), // 13: campgroundspb.v1.GetVacantDatesResponse
-	(*Campsite)(nil),               // 14: campgroundspb.v1.Campsite
-	(*Booking)(nil),                // 15: campgroundspb.v1.Booking
+	(BookingStatus)(0),                         // 0: campgroundspb.v1.BookingStatus
+	(*GetCampgroundsRequest)(nil),              // 1: campgroundspb.v1.GetCampgroundsRequest
+	(*GetCampgroundsResponse)(nil),             // 2: campgroundspb.v1.GetCampgroundsResponse
+	(*GetCampgroundRequest)(nil),               // 3: campgroundspb.v1.GetCampgroundRequest
+	(*GetCampgroundResponse)(nil),              // 4: campgroundspb.v1.GetCampgroundResponse
+	(*CreateCampgroundRequest)(nil),            // 5: campgroundspb.v1.CreateCampgroundRequest
+	(*CreateCampgroundResponse)(nil),           // 6: campgroundspb.v1.CreateCampgroundResponse
+	(*UpdateCampgroundRequest)(nil),            // 7: campgroundspb.v1.UpdateCampgroundRequest
+	(*UpdateCampgroundResponse)(nil),           // 8: campgroundspb.v1.UpdateCampgroundResponse
+	(*DeleteCampgroundRequest)(nil),            // 9: campgroundspb.v1.DeleteCampgroundRequest
+	(*DeleteCampgroundResponse)(nil),           // 10: campgroundspb.v1.DeleteCampgroundResponse
+	(*GetCampgroundSeasonRequest)(nil),         // 11: campgroundspb.v1.GetCampgroundSeasonRequest
+	(*GetCampgroundSeasonResponse)(nil),        // 12: campgroundspb.v1.GetCampgroundSeasonResponse
+	(*SetCampgroundSeasonRequest)(nil),         // 13: campgroundspb.v1.SetCampgroundSeasonRequest
+	(*SetCampgroundSeasonResponse)(nil),        // 14: campgroundspb.v1.SetCampgroundSeasonResponse
+	(*GetCampsitesRequest)(nil),                // 15: campgroundspb.v1.GetCampsitesRequest
+	(*GetCampsitesResponse)(nil),               // 16: campgroundspb.v1.GetCampsitesResponse
+	(*CreateCampsiteRequest)(nil),              // 17: campgroundspb.v1.CreateCampsiteRequest
+	(*CreateCampsiteResponse)(nil),             // 18: campgroundspb.v1.CreateCampsiteResponse
+	(*ImportCampsitesRequest)(nil),             // 19: campgroundspb.v1.ImportCampsitesRequest
+	(*ImportCampsitesResponse)(nil),            // 20: campgroundspb.v1.ImportCampsitesResponse
+	(*GetCampsiteRatesRequest)(nil),            // 21: campgroundspb.v1.GetCampsiteRatesRequest
+	(*GetCampsiteRatesResponse)(nil),           // 22: campgroundspb.v1.GetCampsiteRatesResponse
+	(*SetCampsiteRatesRequest)(nil),            // 23: campgroundspb.v1.SetCampsiteRatesRequest
+	(*SetCampsiteRatesResponse)(nil),           // 24: campgroundspb.v1.SetCampsiteRatesResponse
+	(*QuoteBookingRequest)(nil),                // 25: campgroundspb.v1.QuoteBookingRequest
+	(*QuoteBookingResponse)(nil),               // 26: campgroundspb.v1.QuoteBookingResponse
+	(*GetBookingRequest)(nil),                  // 27: campgroundspb.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),                 // 28: campgroundspb.v1.GetBookingResponse
+	(*CreateBookingRequest)(nil),               // 29: campgroundspb.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),              // 30: campgroundspb.v1.CreateBookingResponse
+	(*UpdateBookingRequest)(nil),               // 31: campgroundspb.v1.UpdateBookingRequest
+	(*UpdateBookingResponse)(nil),              // 32: campgroundspb.v1.UpdateBookingResponse
+	(*CancelBookingRequest)(nil),               // 33: campgroundspb.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),              // 34: campgroundspb.v1.CancelBookingResponse
+	(*CheckInRequest)(nil),                     // 35: campgroundspb.v1.CheckInRequest
+	(*CheckInResponse)(nil),                    // 36: campgroundspb.v1.CheckInResponse
+	(*CheckOutRequest)(nil),                    // 37: campgroundspb.v1.CheckOutRequest
+	(*CheckOutResponse)(nil),                   // 38: campgroundspb.v1.CheckOutResponse
+	(*MarkNoShowRequest)(nil),                  // 39: campgroundspb.v1.MarkNoShowRequest
+	(*MarkNoShowResponse)(nil),                 // 40: campgroundspb.v1.MarkNoShowResponse
+	(*GetGroupBookingRequest)(nil),             // 41: campgroundspb.v1.GetGroupBookingRequest
+	(*GetGroupBookingResponse)(nil),            // 42: campgroundspb.v1.GetGroupBookingResponse
+	(*CreateGroupBookingRequest)(nil),          // 43: campgroundspb.v1.CreateGroupBookingRequest
+	(*CreateGroupBookingResponse)(nil),         // 44: campgroundspb.v1.CreateGroupBookingResponse
+	(*UpdateGroupBookingRequest)(nil),          // 45: campgroundspb.v1.UpdateGroupBookingRequest
+	(*UpdateGroupBookingResponse)(nil),         // 46: campgroundspb.v1.UpdateGroupBookingResponse
+	(*CancelGroupBookingRequest)(nil),          // 47: campgroundspb.v1.CancelGroupBookingRequest
+	(*CancelGroupBookingResponse)(nil),         // 48: campgroundspb.v1.CancelGroupBookingResponse
+	(*GetVacantDatesRequest)(nil),              // 49: campgroundspb.v1.GetVacantDatesRequest
+	(*GetVacantDatesResponse)(nil),             // 50: campgroundspb.v1.GetVacantDatesResponse
+	(*JoinWaitlistRequest)(nil),                // 51: campgroundspb.v1.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),               // 52: campgroundspb.v1.JoinWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),               // 53: campgroundspb.v1.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),              // 54: campgroundspb.v1.LeaveWaitlistResponse
+	(*ListWaitlistRequest)(nil),                // 55: campgroundspb.v1.ListWaitlistRequest
+	(*ListWaitlistResponse)(nil),               // 56: campgroundspb.v1.ListWaitlistResponse
+	(*AcceptWaitlistOfferRequest)(nil),         // 57: campgroundspb.v1.AcceptWaitlistOfferRequest
+	(*AcceptWaitlistOfferResponse)(nil),        // 58: campgroundspb.v1.AcceptWaitlistOfferResponse
+	(*CreateBlackoutRequest)(nil),              // 59: campgroundspb.v1.CreateBlackoutRequest
+	(*CreateBlackoutResponse)(nil),             // 60: campgroundspb.v1.CreateBlackoutResponse
+	(*DeleteBlackoutRequest)(nil),              // 61: campgroundspb.v1.DeleteBlackoutRequest
+	(*DeleteBlackoutResponse)(nil),             // 62: campgroundspb.v1.DeleteBlackoutResponse
+	(*ListBlackoutsRequest)(nil),               // 63: campgroundspb.v1.ListBlackoutsRequest
+	(*ListBlackoutsResponse)(nil),              // 64: campgroundspb.v1.ListBlackoutsResponse
+	(*CreateCalendarSubscriptionRequest)(nil),  // 65: campgroundspb.v1.CreateCalendarSubscriptionRequest
+	(*CreateCalendarSubscriptionResponse)(nil), // 66: campgroundspb.v1.CreateCalendarSubscriptionResponse
+	(*DeleteCalendarSubscriptionRequest)(nil),  // 67: campgroundspb.v1.DeleteCalendarSubscriptionRequest
+	(*DeleteCalendarSubscriptionResponse)(nil), // 68: campgroundspb.v1.DeleteCalendarSubscriptionResponse
+	(*ListCalendarSubscriptionsRequest)(nil),   // 69: campgroundspb.v1.ListCalendarSubscriptionsRequest
+	(*ListCalendarSubscriptionsResponse)(nil),  // 70: campgroundspb.v1.ListCalendarSubscriptionsResponse
+	(*GetGuestRequest)(nil),                    // 71: campgroundspb.v1.GetGuestRequest
+	(*GetGuestResponse)(nil),                   // 72: campgroundspb.v1.GetGuestResponse
+	(*UpdateGuestRequest)(nil),                 // 73: campgroundspb.v1.UpdateGuestRequest
+	(*UpdateGuestResponse)(nil),                // 74: campgroundspb.v1.UpdateGuestResponse
+	(*ListGuestBookingsRequest)(nil),           // 75: campgroundspb.v1.ListGuestBookingsRequest
+	(*ListGuestBookingsResponse)(nil),          // 76: campgroundspb.v1.ListGuestBookingsResponse
+	(*ExportGuestDataRequest)(nil),             // 77: campgroundspb.v1.ExportGuestDataRequest
+	(*ExportGuestDataResponse)(nil),            // 78: campgroundspb.v1.ExportGuestDataResponse
+	(*AnonymizeGuestRequest)(nil),              // 79: campgroundspb.v1.AnonymizeGuestRequest
+	(*AnonymizeGuestResponse)(nil),             // 80: campgroundspb.v1.AnonymizeGuestResponse
+	(*ImportCampsite)(nil),                     // 81: campgroundspb.v1.ImportCampsite
+	(*CampsiteImportResult)(nil),               // 82: campgroundspb.v1.CampsiteImportResult
+	(*Campsite)(nil),                           // 83: campgroundspb.v1.Campsite
+	(*Campground)(nil),                         // 84: campgroundspb.v1.Campground
+	(*Booking)(nil),                            // 85: campgroundspb.v1.Booking
+	(*Guest)(nil),                              // 86: campgroundspb.v1.Guest
+	(*GuestData)(nil),                          // 87: campgroundspb.v1.GuestData
+	(*GroupBookingCampsite)(nil),               // 88: campgroundspb.v1.GroupBookingCampsite
+	(*WaitlistEntry)(nil),                      // 89: campgroundspb.v1.WaitlistEntry
+	(*Blackout)(nil),                           // 90: campgroundspb.v1.Blackout
+	(*CalendarSubscription)(nil),               // 91: campgroundspb.v1.CalendarSubscription
+	(*CampgroundSeason)(nil),                   // 92: campgroundspb.v1.CampgroundSeason
+	(*CampsiteRates)(nil),                      // 93: campgroundspb.v1.CampsiteRates
+	(*SeasonalRate)(nil),                       // 94: campgroundspb.v1.SeasonalRate
+	(*Quote)(nil),                              // 95: campgroundspb.v1.Quote
+	(*NightlyPrice)(nil),                       // 96: campgroundspb.v1.NightlyPrice
+	(*DateRange)(nil),                          // 97: campgroundspb.v1.DateRange
 }
 var file_campgroundspb_v1_api_proto_depIdxs = []int32{
-	14, // 0: campgroundspb.v1.GetCampsitesResponse.campsites:type_name -> campgroundspb.v1.Campsite
-	15, // 1: campgroundspb.v1.GetBookingResponse.booking:type_name -> campgroundspb.v1.Booking
-	15, // 2: campgroundspb.v1.UpdateBookingRequest.booking:type_name -> campgroundspb.v1.Booking
-	0,  // 3: campgroundspb.v1.CampgroundsService.GetCampsites:input_type -> campgroundspb.v1.GetCampsitesRequest
-	2,  // 4: campgroundspb.v1.CampgroundsService.CreateCampsite:input_type -> campgroundspb.v1.CreateCampsiteRequest
-	4,  // 5: campgroundspb.v1.CampgroundsService.GetBooking:input_type -> campgroundspb.v1.GetBookingRequest
-	6,  // 6: campgroundspb.v1.CampgroundsService.CreateBooking:input_type -> campgroundspb.v1.CreateBookingRequest
-	8,  // 7: campgroundspb.v1.CampgroundsService.UpdateBooking:input_type -> campgroundspb.v1.UpdateBookingRequest
-	10, // 8: campgroundspb.v1.CampgroundsService.CancelBooking:input_type -> campgroundspb.v1.CancelBookingRequest
-	12, // 9: campgroundspb.v1.CampgroundsService.GetVacantDates:input_type -> campgroundspb.v1.GetVacantDatesRequest
-	1,  // 10: campgroundspb.v1.CampgroundsService.GetCampsites:output_type -> campgroundspb.v1.GetCampsitesResponse
-	3,  // 11: campgroundspb.v1.CampgroundsService.CreateCampsite:output_type -> campgroundspb.v1.CreateCampsiteResponse
-	5,  // 12: campgroundspb.v1.CampgroundsService.GetBooking:output_type -> campgroundspb.v1.GetBookingResponse
-	7,  // 13: campgroundspb.v1.CampgroundsService.CreateBooking:output_type -> campgroundspb.v1.CreateBookingResponse
-	9,  // 14: campgroundspb.v1.CampgroundsService.UpdateBooking:output_type -> campgroundspb.v1.UpdateBookingResponse
-	11, // 15: campgroundspb.v1.CampgroundsService.CancelBooking:output_type -> campgroundspb.v1.CancelBookingResponse
-	13, // 16: campgroundspb.v1.CampgroundsService.GetVacantDates:output_type -> campgroundspb.v1.GetVacantDatesResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	84, // 0: campgroundspb.v1.GetCampgroundsResponse.campgrounds:type_name -> campgroundspb.v1.Campground
+	84, // 1: campgroundspb.v1.GetCampgroundResponse.campground:type_name -> campgroundspb.v1.Campground
+	84, // 2: campgroundspb.v1.UpdateCampgroundRequest.campground:type_name -> campgroundspb.v1.Campground
+	92, // 3: campgroundspb.v1.GetCampgroundSeasonResponse.season:type_name -> campgroundspb.v1.CampgroundSeason
+	92, // 4: campgroundspb.v1.SetCampgroundSeasonRequest.season:type_name -> campgroundspb.v1.CampgroundSeason
+	83, // 5: campgroundspb.v1.GetCampsitesResponse.campsites:type_name -> campgroundspb.v1.Campsite
+	81, // 6: campgroundspb.v1.ImportCampsitesRequest.campsite:type_name -> campgroundspb.v1.ImportCampsite
+	82, // 7: campgroundspb.v1.ImportCampsitesResponse.results:type_name -> campgroundspb.v1.CampsiteImportResult
+	93, // 8: campgroundspb.v1.GetCampsiteRatesResponse.rates:type_name -> campgroundspb.v1.CampsiteRates
+	93, // 9: campgroundspb.v1.SetCampsiteRatesRequest.rates:type_name -> campgroundspb.v1.CampsiteRates
+	95, // 10: campgroundspb.v1.QuoteBookingResponse.quote:type_name -> campgroundspb.v1.Quote
+	85, // 11: campgroundspb.v1.GetBookingResponse.booking:type_name -> campgroundspb.v1.Booking
+	85, // 12: campgroundspb.v1.UpdateBookingRequest.booking:type_name -> campgroundspb.v1.Booking
+	85, // 13: campgroundspb.v1.GetGroupBookingResponse.bookings:type_name -> campgroundspb.v1.Booking
+	88, // 14: campgroundspb.v1.CreateGroupBookingRequest.campsites:type_name -> campgroundspb.v1.GroupBookingCampsite
+	97, // 15: campgroundspb.v1.GetVacantDatesResponse.vacant_ranges:type_name -> campgroundspb.v1.DateRange
+	89, // 16: campgroundspb.v1.ListWaitlistResponse.entries:type_name -> campgroundspb.v1.WaitlistEntry
+	90, // 17: campgroundspb.v1.ListBlackoutsResponse.blackouts:type_name -> campgroundspb.v1.Blackout
+	91, // 18: campgroundspb.v1.ListCalendarSubscriptionsResponse.subscriptions:type_name -> campgroundspb.v1.CalendarSubscription
+	86, // 19: campgroundspb.v1.GetGuestResponse.guest:type_name -> campgroundspb.v1.Guest
+	86, // 20: campgroundspb.v1.UpdateGuestRequest.guest:type_name -> campgroundspb.v1.Guest
+	85, // 21: campgroundspb.v1.ListGuestBookingsResponse.bookings:type_name -> campgroundspb.v1.Booking
+	0,  // 22: campgroundspb.v1.Booking.status:type_name -> campgroundspb.v1.BookingStatus
+	86, // 23: campgroundspb.v1.GuestData.guest:type_name -> campgroundspb.v1.Guest
+	85, // 24: campgroundspb.v1.GuestData.bookings:type_name -> campgroundspb.v1.Booking
+	89, // 25: campgroundspb.v1.GuestData.waitlist_entries:type_name -> campgroundspb.v1.WaitlistEntry
+	94, // 26: campgroundspb.v1.CampsiteRates.seasons:type_name -> campgroundspb.v1.SeasonalRate
+	96, // 27: campgroundspb.v1.Quote.nights:type_name -> campgroundspb.v1.NightlyPrice
+	1,  // 28: campgroundspb.v1.CampgroundsService.GetCampgrounds:input_type -> campgroundspb.v1.GetCampgroundsRequest
+	3,  // 29: campgroundspb.v1.CampgroundsService.GetCampground:input_type -> campgroundspb.v1.GetCampgroundRequest
+	5,  // 30: campgroundspb.v1.CampgroundsService.CreateCampground:input_type -> campgroundspb.v1.CreateCampgroundRequest
+	7,  // 31: campgroundspb.v1.CampgroundsService.UpdateCampground:input_type -> campgroundspb.v1.UpdateCampgroundRequest
+	9,  // 32: campgroundspb.v1.CampgroundsService.DeleteCampground:input_type -> campgroundspb.v1.DeleteCampgroundRequest
+	11, // 33: campgroundspb.v1.CampgroundsService.GetCampgroundSeason:input_type -> campgroundspb.v1.GetCampgroundSeasonRequest
+	13, // 34: campgroundspb.v1.CampgroundsService.SetCampgroundSeason:input_type -> campgroundspb.v1.SetCampgroundSeasonRequest
+	15, // 35: campgroundspb.v1.CampgroundsService.GetCampsites:input_type -> campgroundspb.v1.GetCampsitesRequest
+	17, // 36: campgroundspb.v1.CampgroundsService.CreateCampsite:input_type -> campgroundspb.v1.CreateCampsiteRequest
+	19, // 37: campgroundspb.v1.CampgroundsService.ImportCampsites:input_type -> campgroundspb.v1.ImportCampsitesRequest
+	21, // 38: campgroundspb.v1.CampgroundsService.GetCampsiteRates:input_type -> campgroundspb.v1.GetCampsiteRatesRequest
+	23, // 39: campgroundspb.v1.CampgroundsService.SetCampsiteRates:input_type -> campgroundspb.v1.SetCampsiteRatesRequest
+	25, // 40: campgroundspb.v1.CampgroundsService.QuoteBooking:input_type -> campgroundspb.v1.QuoteBookingRequest
+	27, // 41: campgroundspb.v1.CampgroundsService.GetBooking:input_type -> campgroundspb.v1.GetBookingRequest
+	29, // 42: campgroundspb.v1.CampgroundsService.CreateBooking:input_type -> campgroundspb.v1.CreateBookingRequest
+	31, // 43: campgroundspb.v1.CampgroundsService.UpdateBooking:input_type -> campgroundspb.v1.UpdateBookingRequest
+	33, // 44: campgroundspb.v1.CampgroundsService.CancelBooking:input_type -> campgroundspb.v1.CancelBookingRequest
+	35, // 45: campgroundspb.v1.CampgroundsService.CheckIn:input_type -> campgroundspb.v1.CheckInRequest
+	37, // 46: campgroundspb.v1.CampgroundsService.CheckOut:input_type -> campgroundspb.v1.CheckOutRequest
+	39, // 47: campgroundspb.v1.CampgroundsService.MarkNoShow:input_type -> campgroundspb.v1.MarkNoShowRequest
+	41, // 48: campgroundspb.v1.CampgroundsService.GetGroupBooking:input_type -> campgroundspb.v1.GetGroupBookingRequest
+	43, // 49: campgroundspb.v1.CampgroundsService.CreateGroupBooking:input_type -> campgroundspb.v1.CreateGroupBookingRequest
+	45, // 50: campgroundspb.v1.CampgroundsService.UpdateGroupBooking:input_type -> campgroundspb.v1.UpdateGroupBookingRequest
+	47, // 51: campgroundspb.v1.CampgroundsService.CancelGroupBooking:input_type -> campgroundspb.v1.CancelGroupBookingRequest
+	49, // 52: campgroundspb.v1.CampgroundsService.GetVacantDates:input_type -> campgroundspb.v1.GetVacantDatesRequest
+	51, // 53: campgroundspb.v1.CampgroundsService.JoinWaitlist:input_type -> campgroundspb.v1.JoinWaitlistRequest
+	53, // 54: campgroundspb.v1.CampgroundsService.LeaveWaitlist:input_type -> campgroundspb.v1.LeaveWaitlistRequest
+	55, // 55: campgroundspb.v1.CampgroundsService.ListWaitlist:input_type -> campgroundspb.v1.ListWaitlistRequest
+	57, // 56: campgroundspb.v1.CampgroundsService.AcceptWaitlistOffer:input_type -> campgroundspb.v1.AcceptWaitlistOfferRequest
+	59, // 57: campgroundspb.v1.CampgroundsService.CreateBlackout:input_type -> campgroundspb.v1.CreateBlackoutRequest
+	61, // 58: campgroundspb.v1.CampgroundsService.DeleteBlackout:input_type -> campgroundspb.v1.DeleteBlackoutRequest
+	63, // 59: campgroundspb.v1.CampgroundsService.ListBlackouts:input_type -> campgroundspb.v1.ListBlackoutsRequest
+	65, // 60: campgroundspb.v1.CampgroundsService.CreateCalendarSubscription:input_type -> campgroundspb.v1.CreateCalendarSubscriptionRequest
+	67, // 61: campgroundspb.v1.CampgroundsService.DeleteCalendarSubscription:input_type -> campgroundspb.v1.DeleteCalendarSubscriptionRequest
+	69, // 62: campgroundspb.v1.CampgroundsService.ListCalendarSubscriptions:input_type -> campgroundspb.v1.ListCalendarSubscriptionsRequest
+	71, // 63: campgroundspb.v1.CampgroundsService.GetGuest:input_type -> campgroundspb.v1.GetGuestRequest
+	73, // 64: campgroundspb.v1.CampgroundsService.UpdateGuest:input_type -> campgroundspb.v1.UpdateGuestRequest
+	75, // 65: campgroundspb.v1.CampgroundsService.ListGuestBookings:input_type -> campgroundspb.v1.ListGuestBookingsRequest
+	77, // 66: campgroundspb.v1.CampgroundsService.ExportGuestData:input_type -> campgroundspb.v1.ExportGuestDataRequest
+	79, // 67: campgroundspb.v1.CampgroundsService.AnonymizeGuest:input_type -> campgroundspb.v1.AnonymizeGuestRequest
+	2,  // 68: campgroundspb.v1.CampgroundsService.GetCampgrounds:output_type -> campgroundspb.v1.GetCampgroundsResponse
+	4,  // 69: campgroundspb.v1.CampgroundsService.GetCampground:output_type -> campgroundspb.v1.GetCampgroundResponse
+	6,  // 70: campgroundspb.v1.CampgroundsService.CreateCampground:output_type -> campgroundspb.v1.CreateCampgroundResponse
+	8,  // 71: campgroundspb.v1.CampgroundsService.UpdateCampground:output_type -> campgroundspb.v1.UpdateCampgroundResponse
+	10, // 72: campgroundspb.v1.CampgroundsService.DeleteCampground:output_type -> campgroundspb.v1.DeleteCampgroundResponse
+	12, // 73: campgroundspb.v1.CampgroundsService.GetCampgroundSeason:output_type -> campgroundspb.v1.GetCampgroundSeasonResponse
+	14, // 74: campgroundspb.v1.CampgroundsService.SetCampgroundSeason:output_type -> campgroundspb.v1.SetCampgroundSeasonResponse
+	16, // 75: campgroundspb.v1.CampgroundsService.GetCampsites:output_type -> campgroundspb.v1.GetCampsitesResponse
+	18, // 76: campgroundspb.v1.CampgroundsService.CreateCampsite:output_type -> campgroundspb.v1.CreateCampsiteResponse
+	20, // 77: campgroundspb.v1.CampgroundsService.ImportCampsites:output_type -> campgroundspb.v1.ImportCampsitesResponse
+	22, // 78: campgroundspb.v1.CampgroundsService.GetCampsiteRates:output_type -> campgroundspb.v1.GetCampsiteRatesResponse
+	24, // 79: campgroundspb.v1.CampgroundsService.SetCampsiteRates:output_type -> campgroundspb.v1.SetCampsiteRatesResponse
+	26, // 80: campgroundspb.v1.CampgroundsService.QuoteBooking:output_type -> campgroundspb.v1.QuoteBookingResponse
+	28, // 81: campgroundspb.v1.CampgroundsService.GetBooking:output_type -> campgroundspb.v1.GetBookingResponse
+	30, // 82: campgroundspb.v1.CampgroundsService.CreateBooking:output_type -> campgroundspb.v1.CreateBookingResponse
+	32, // 83: campgroundspb.v1.CampgroundsService.UpdateBooking:output_type -> campgroundspb.v1.UpdateBookingResponse
+	34, // 84: campgroundspb.v1.CampgroundsService.CancelBooking:output_type -> campgroundspb.v1.CancelBookingResponse
+	36, // 85: campgroundspb.v1.CampgroundsService.CheckIn:output_type -> campgroundspb.v1.CheckInResponse
+	38, // 86: campgroundspb.v1.CampgroundsService.CheckOut:output_type -> campgroundspb.v1.CheckOutResponse
+	40, // 87: campgroundspb.v1.CampgroundsService.MarkNoShow:output_type -> campgroundspb.v1.MarkNoShowResponse
+	42, // 88: campgroundspb.v1.CampgroundsService.GetGroupBooking:output_type -> campgroundspb.v1.GetGroupBookingResponse
+	44, // 89: campgroundspb.v1.CampgroundsService.CreateGroupBooking:output_type -> campgroundspb.v1.CreateGroupBookingResponse
+	46, // 90: campgroundspb.v1.CampgroundsService.UpdateGroupBooking:output_type -> campgroundspb.v1.UpdateGroupBookingResponse
+	48, // 91: campgroundspb.v1.CampgroundsService.CancelGroupBooking:output_type -> campgroundspb.v1.CancelGroupBookingResponse
+	50, // 92: campgroundspb.v1.CampgroundsService.GetVacantDates:output_type -> campgroundspb.v1.GetVacantDatesResponse
+	52, // 93: campgroundspb.v1.CampgroundsService.JoinWaitlist:output_type -> campgroundspb.v1.JoinWaitlistResponse
+	54, // 94: campgroundspb.v1.CampgroundsService.LeaveWaitlist:output_type -> campgroundspb.v1.LeaveWaitlistResponse
+	56, // 95: campgroundspb.v1.CampgroundsService.ListWaitlist:output_type -> campgroundspb.v1.ListWaitlistResponse
+	58, // 96: campgroundspb.v1.CampgroundsService.AcceptWaitlistOffer:output_type -> campgroundspb.v1.AcceptWaitlistOfferResponse
+	60, // 97: campgroundspb.v1.CampgroundsService.CreateBlackout:output_type -> campgroundspb.v1.CreateBlackoutResponse
+	62, // 98: campgroundspb.v1.CampgroundsService.DeleteBlackout:output_type -> campgroundspb.v1.DeleteBlackoutResponse
+	64, // 99: campgroundspb.v1.CampgroundsService.ListBlackouts:output_type -> campgroundspb.v1.ListBlackoutsResponse
+	66, // 100: campgroundspb.v1.CampgroundsService.CreateCalendarSubscription:output_type -> campgroundspb.v1.CreateCalendarSubscriptionResponse
+	68, // 101: campgroundspb.v1.CampgroundsService.DeleteCalendarSubscription:output_type -> campgroundspb.v1.DeleteCalendarSubscriptionResponse
+	70, // 102: campgroundspb.v1.CampgroundsService.ListCalendarSubscriptions:output_type -> campgroundspb.v1.ListCalendarSubscriptionsResponse
+	72, // 103: campgroundspb.v1.CampgroundsService.GetGuest:output_type -> campgroundspb.v1.GetGuestResponse
+	74, // 104: campgroundspb.v1.CampgroundsService.UpdateGuest:output_type -> campgroundspb.v1.UpdateGuestResponse
+	76, // 105: campgroundspb.v1.CampgroundsService.ListGuestBookings:output_type -> campgroundspb.v1.ListGuestBookingsResponse
+	78, // 106: campgroundspb.v1.CampgroundsService.ExportGuestData:output_type -> campgroundspb.v1.ExportGuestDataResponse
+	80, // 107: campgroundspb.v1.CampgroundsService.AnonymizeGuest:output_type -> campgroundspb.v1.AnonymizeGuestResponse
+	68, // [68:108] is the sub-list for method output_type
+	28, // [28:68] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_campgroundspb_v1_api_proto_init() }
@@ -1061,18 +6355,28 @@ func file_campgroundspb_v1_api_proto_init() {
 	if File_campgroundspb_v1_api_proto != nil {
 		return
 	}
+	file_campgroundspb_v1_api_proto_msgTypes[18].OneofWrappers = []any{
+		(*ImportCampsitesRequest_Campsite)(nil),
+		(*ImportCampsitesRequest_CsvChunk)(nil),
+		(*ImportCampsitesRequest_JsonChunk)(nil),
+	}
+	file_campgroundspb_v1_api_proto_msgTypes[64].OneofWrappers = []any{
+		(*CreateCalendarSubscriptionRequest_Url)(nil),
+		(*CreateCalendarSubscriptionRequest_Calendar)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_campgroundspb_v1_api_proto_rawDesc), len(file_campgroundspb_v1_api_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_campgroundspb_v1_api_proto_goTypes,
 		DependencyIndexes: file_campgroundspb_v1_api_proto_depIdxs,
+		EnumInfos:         file_campgroundspb_v1_api_proto_enumTypes,
 		MessageInfos:      file_campgroundspb_v1_api_proto_msgTypes,
 	}.Build()
 	File_campgroundspb_v1_api_proto = out.File
@@ -19,26 +19,92 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CampgroundsService_GetCampsites_FullMethodName   = "/campgroundspb.v1.CampgroundsService/GetCampsites"
-	CampgroundsService_CreateCampsite_FullMethodName = "/campgroundspb.v1.CampgroundsService/CreateCampsite"
-	CampgroundsService_GetBooking_FullMethodName     = "/campgroundspb.v1.CampgroundsService/GetBooking"
-	CampgroundsService_CreateBooking_FullMethodName  = "/campgroundspb.v1.CampgroundsService/CreateBooking"
-	CampgroundsService_UpdateBooking_FullMethodName  = "/campgroundspb.v1.CampgroundsService/UpdateBooking"
-	CampgroundsService_CancelBooking_FullMethodName  = "/campgroundspb.v1.CampgroundsService/CancelBooking"
-	CampgroundsService_GetVacantDates_FullMethodName = "/campgroundspb.v1.CampgroundsService/GetVacantDates"
+	CampgroundsService_GetCampgrounds_FullMethodName             = "/campgroundspb.v1.CampgroundsService/GetCampgrounds"
+	CampgroundsService_GetCampground_FullMethodName              = "/campgroundspb.v1.CampgroundsService/GetCampground"
+	CampgroundsService_CreateCampground_FullMethodName           = "/campgroundspb.v1.CampgroundsService/CreateCampground"
+	CampgroundsService_UpdateCampground_FullMethodName           = "/campgroundspb.v1.CampgroundsService/UpdateCampground"
+	CampgroundsService_DeleteCampground_FullMethodName           = "/campgroundspb.v1.CampgroundsService/DeleteCampground"
+	CampgroundsService_GetCampgroundSeason_FullMethodName        = "/campgroundspb.v1.CampgroundsService/GetCampgroundSeason"
+	CampgroundsService_SetCampgroundSeason_FullMethodName        = "/campgroundspb.v1.CampgroundsService/SetCampgroundSeason"
+	CampgroundsService_GetCampsites_FullMethodName               = "/campgroundspb.v1.CampgroundsService/GetCampsites"
+	CampgroundsService_CreateCampsite_FullMethodName             = "/campgroundspb.v1.CampgroundsService/CreateCampsite"
+	CampgroundsService_ImportCampsites_FullMethodName            = "/campgroundspb.v1.CampgroundsService/ImportCampsites"
+	CampgroundsService_GetCampsiteRates_FullMethodName           = "/campgroundspb.v1.CampgroundsService/GetCampsiteRates"
+	CampgroundsService_SetCampsiteRates_FullMethodName           = "/campgroundspb.v1.CampgroundsService/SetCampsiteRates"
+	CampgroundsService_QuoteBooking_FullMethodName               = "/campgroundspb.v1.CampgroundsService/QuoteBooking"
+	CampgroundsService_GetBooking_FullMethodName                 = "/campgroundspb.v1.CampgroundsService/GetBooking"
+	CampgroundsService_CreateBooking_FullMethodName              = "/campgroundspb.v1.CampgroundsService/CreateBooking"
+	CampgroundsService_UpdateBooking_FullMethodName              = "/campgroundspb.v1.CampgroundsService/UpdateBooking"
+	CampgroundsService_CancelBooking_FullMethodName              = "/campgroundspb.v1.CampgroundsService/CancelBooking"
+	CampgroundsService_CheckIn_FullMethodName                    = "/campgroundspb.v1.CampgroundsService/CheckIn"
+	CampgroundsService_CheckOut_FullMethodName                   = "/campgroundspb.v1.CampgroundsService/CheckOut"
+	CampgroundsService_MarkNoShow_FullMethodName                 = "/campgroundspb.v1.CampgroundsService/MarkNoShow"
+	CampgroundsService_GetGroupBooking_FullMethodName            = "/campgroundspb.v1.CampgroundsService/GetGroupBooking"
+	CampgroundsService_CreateGroupBooking_FullMethodName         = "/campgroundspb.v1.CampgroundsService/CreateGroupBooking"
+	CampgroundsService_UpdateGroupBooking_FullMethodName         = "/campgroundspb.v1.CampgroundsService/UpdateGroupBooking"
+	CampgroundsService_CancelGroupBooking_FullMethodName         = "/campgroundspb.v1.CampgroundsService/CancelGroupBooking"
+	CampgroundsService_GetVacantDates_FullMethodName             = "/campgroundspb.v1.CampgroundsService/GetVacantDates"
+	CampgroundsService_JoinWaitlist_FullMethodName               = "/campgroundspb.v1.CampgroundsService/JoinWaitlist"
+	CampgroundsService_LeaveWaitlist_FullMethodName              = "/campgroundspb.v1.CampgroundsService/LeaveWaitlist"
+	CampgroundsService_ListWaitlist_FullMethodName               = "/campgroundspb.v1.CampgroundsService/ListWaitlist"
+	CampgroundsService_AcceptWaitlistOffer_FullMethodName        = "/campgroundspb.v1.CampgroundsService/AcceptWaitlistOffer"
+	CampgroundsService_CreateBlackout_FullMethodName             = "/campgroundspb.v1.CampgroundsService/CreateBlackout"
+	CampgroundsService_DeleteBlackout_FullMethodName             = "/campgroundspb.v1.CampgroundsService/DeleteBlackout"
+	CampgroundsService_ListBlackouts_FullMethodName              = "/campgroundspb.v1.CampgroundsService/ListBlackouts"
+	CampgroundsService_CreateCalendarSubscription_FullMethodName = "/campgroundspb.v1.CampgroundsService/CreateCalendarSubscription"
+	CampgroundsService_DeleteCalendarSubscription_FullMethodName = "/campgroundspb.v1.CampgroundsService/DeleteCalendarSubscription"
+	CampgroundsService_ListCalendarSubscriptions_FullMethodName  = "/campgroundspb.v1.CampgroundsService/ListCalendarSubscriptions"
+	CampgroundsService_GetGuest_FullMethodName                   = "/campgroundspb.v1.CampgroundsService/GetGuest"
+	CampgroundsService_UpdateGuest_FullMethodName                = "/campgroundspb.v1.CampgroundsService/UpdateGuest"
+	CampgroundsService_ListGuestBookings_FullMethodName          = "/campgroundspb.v1.CampgroundsService/ListGuestBookings"
+	CampgroundsService_ExportGuestData_FullMethodName            = "/campgroundspb.v1.CampgroundsService/ExportGuestData"
+	CampgroundsService_AnonymizeGuest_FullMethodName             = "/campgroundspb.v1.CampgroundsService/AnonymizeGuest"
 )
 
 // CampgroundsServiceClient is the client API for CampgroundsService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CampgroundsServiceClient interface {
+	GetCampgrounds(ctx context.Context, in *GetCampgroundsRequest, opts ...grpc.CallOption) (*GetCampgroundsResponse, error)
+	GetCampground(ctx context.Context, in *GetCampgroundRequest, opts ...grpc.CallOption) (*GetCampgroundResponse, error)
+	CreateCampground(ctx context.Context, in *CreateCampgroundRequest, opts ...grpc.CallOption) (*CreateCampgroundResponse, error)
+	UpdateCampground(ctx context.Context, in *UpdateCampgroundRequest, opts ...grpc.CallOption) (*UpdateCampgroundResponse, error)
+	DeleteCampground(ctx context.Context, in *DeleteCampgroundRequest, opts ...grpc.CallOption) (*DeleteCampgroundResponse, error)
+	GetCampgroundSeason(ctx context.Context, in *GetCampgroundSeasonRequest, opts ...grpc.CallOption) (*GetCampgroundSeasonResponse, error)
+	SetCampgroundSeason(ctx context.Context, in *SetCampgroundSeasonRequest, opts ...grpc.CallOption) (*SetCampgroundSeasonResponse, error)
 	GetCampsites(ctx context.Context, in *GetCampsitesRequest, opts ...grpc.CallOption) (*GetCampsitesResponse, error)
 	CreateCampsite(ctx context.Context, in *CreateCampsiteRequest, opts ...grpc.CallOption) (*CreateCampsiteResponse, error)
+	ImportCampsites(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCampsitesRequest, ImportCampsitesResponse], error)
+	GetCampsiteRates(ctx context.Context, in *GetCampsiteRatesRequest, opts ...grpc.CallOption) (*GetCampsiteRatesResponse, error)
+	SetCampsiteRates(ctx context.Context, in *SetCampsiteRatesRequest, opts ...grpc.CallOption) (*SetCampsiteRatesResponse, error)
+	QuoteBooking(ctx context.Context, in *QuoteBookingRequest, opts ...grpc.CallOption) (*QuoteBookingResponse, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingResponse, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error)
+	MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*MarkNoShowResponse, error)
+	GetGroupBooking(ctx context.Context, in *GetGroupBookingRequest, opts ...grpc.CallOption) (*GetGroupBookingResponse, error)
+	CreateGroupBooking(ctx context.Context, in *CreateGroupBookingRequest, opts ...grpc.CallOption) (*CreateGroupBookingResponse, error)
+	UpdateGroupBooking(ctx context.Context, in *UpdateGroupBookingRequest, opts ...grpc.CallOption) (*UpdateGroupBookingResponse, error)
+	CancelGroupBooking(ctx context.Context, in *CancelGroupBookingRequest, opts ...grpc.CallOption) (*CancelGroupBookingResponse, error)
 	GetVacantDates(ctx context.Context, in *GetVacantDatesRequest, opts ...grpc.CallOption) (*GetVacantDatesResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error)
+	AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*AcceptWaitlistOfferResponse, error)
+	CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error)
+	DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error)
+	ListBlackouts(ctx context.Context, in *ListBlackoutsRequest, opts ...grpc.CallOption) (*ListBlackoutsResponse, error)
+	CreateCalendarSubscription(ctx context.Context, in *CreateCalendarSubscriptionRequest, opts ...grpc.CallOption) (*CreateCalendarSubscriptionResponse, error)
+	DeleteCalendarSubscription(ctx context.Context, in *DeleteCalendarSubscriptionRequest, opts ...grpc.CallOption) (*DeleteCalendarSubscriptionResponse, error)
+	ListCalendarSubscriptions(ctx context.Context, in *ListCalendarSubscriptionsRequest, opts ...grpc.CallOption) (*ListCalendarSubscriptionsResponse, error)
+	GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*GetGuestResponse, error)
+	UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*UpdateGuestResponse, error)
+	ListGuestBookings(ctx context.Context, in *ListGuestBookingsRequest, opts ...grpc.CallOption) (*ListGuestBookingsResponse, error)
+	ExportGuestData(ctx context.Context, in *ExportGuestDataRequest, opts ...grpc.CallOption) (*ExportGuestDataResponse, error)
+	AnonymizeGuest(ctx context.Context, in *AnonymizeGuestRequest, opts ...grpc.CallOption) (*AnonymizeGuestResponse, error)
 }
 
 type campgroundsServiceClient struct {
@@ -49,6 +115,76 @@ func NewCampgroundsServiceClient(cc grpc.ClientConnInterface) CampgroundsService
 	return &campgroundsServiceClient{cc}
 }
 
+func (c *campgroundsServiceClient) GetCampgrounds(ctx context.Context, in *GetCampgroundsRequest, opts ...grpc.CallOption) (*GetCampgroundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampgroundsResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetCampgrounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetCampground(ctx context.Context, in *GetCampgroundRequest, opts ...grpc.CallOption) (*GetCampgroundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampgroundResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetCampground_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateCampground(ctx context.Context, in *CreateCampgroundRequest, opts ...grpc.CallOption) (*CreateCampgroundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampgroundResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateCampground_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) UpdateCampground(ctx context.Context, in *UpdateCampgroundRequest, opts ...grpc.CallOption) (*UpdateCampgroundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCampgroundResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_UpdateCampground_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) DeleteCampground(ctx context.Context, in *DeleteCampgroundRequest, opts ...grpc.CallOption) (*DeleteCampgroundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCampgroundResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_DeleteCampground_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetCampgroundSeason(ctx context.Context, in *GetCampgroundSeasonRequest, opts ...grpc.CallOption) (*GetCampgroundSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampgroundSeasonResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetCampgroundSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) SetCampgroundSeason(ctx context.Context, in *SetCampgroundSeasonRequest, opts ...grpc.CallOption) (*SetCampgroundSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCampgroundSeasonResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_SetCampgroundSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *campgroundsServiceClient) GetCampsites(ctx context.Context, in *GetCampsitesRequest, opts ...grpc.CallOption) (*GetCampsitesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCampsitesResponse)
@@ -56,254 +192,1232 @@ func (c *campgroundsServiceClient) GetCampsites(ctx context.Context, in *GetCamp
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateCampsite(ctx context.Context, in *CreateCampsiteRequest, opts ...grpc.CallOption) (*CreateCampsiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampsiteResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateCampsite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ImportCampsites(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCampsitesRequest, ImportCampsitesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CampgroundsService_ServiceDesc.Streams[0], CampgroundsService_ImportCampsites_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportCampsitesRequest, ImportCampsitesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CampgroundsService_ImportCampsitesClient = grpc.ClientStreamingClient[ImportCampsitesRequest, ImportCampsitesResponse]
+
+func (c *campgroundsServiceClient) GetCampsiteRates(ctx context.Context, in *GetCampsiteRatesRequest, opts ...grpc.CallOption) (*GetCampsiteRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampsiteRatesResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetCampsiteRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) SetCampsiteRates(ctx context.Context, in *SetCampsiteRatesRequest, opts ...grpc.CallOption) (*SetCampsiteRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCampsiteRatesResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_SetCampsiteRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) QuoteBooking(ctx context.Context, in *QuoteBookingRequest, opts ...grpc.CallOption) (*QuoteBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_QuoteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_UpdateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOutResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CheckOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*MarkNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNoShowResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_MarkNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetGroupBooking(ctx context.Context, in *GetGroupBookingRequest, opts ...grpc.CallOption) (*GetGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateGroupBooking(ctx context.Context, in *CreateGroupBookingRequest, opts ...grpc.CallOption) (*CreateGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) UpdateGroupBooking(ctx context.Context, in *UpdateGroupBookingRequest, opts ...grpc.CallOption) (*UpdateGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_UpdateGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CancelGroupBooking(ctx context.Context, in *CancelGroupBookingRequest, opts ...grpc.CallOption) (*CancelGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGroupBookingResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CancelGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetVacantDates(ctx context.Context, in *GetVacantDatesRequest, opts ...grpc.CallOption) (*GetVacantDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVacantDatesResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetVacantDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWaitlistResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_ListWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*AcceptWaitlistOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptWaitlistOfferResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_AcceptWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBlackoutResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBlackoutResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_DeleteBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ListBlackouts(ctx context.Context, in *ListBlackoutsRequest, opts ...grpc.CallOption) (*ListBlackoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlackoutsResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_ListBlackouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) CreateCalendarSubscription(ctx context.Context, in *CreateCalendarSubscriptionRequest, opts ...grpc.CallOption) (*CreateCalendarSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalendarSubscriptionResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_CreateCalendarSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) DeleteCalendarSubscription(ctx context.Context, in *DeleteCalendarSubscriptionRequest, opts ...grpc.CallOption) (*DeleteCalendarSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCalendarSubscriptionResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_DeleteCalendarSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ListCalendarSubscriptions(ctx context.Context, in *ListCalendarSubscriptionsRequest, opts ...grpc.CallOption) (*ListCalendarSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_ListCalendarSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*GetGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuestResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_GetGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*UpdateGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGuestResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_UpdateGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ListGuestBookings(ctx context.Context, in *ListGuestBookingsRequest, opts ...grpc.CallOption) (*ListGuestBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuestBookingsResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_ListGuestBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) ExportGuestData(ctx context.Context, in *ExportGuestDataRequest, opts ...grpc.CallOption) (*ExportGuestDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGuestDataResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_ExportGuestData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campgroundsServiceClient) AnonymizeGuest(ctx context.Context, in *AnonymizeGuestRequest, opts ...grpc.CallOption) (*AnonymizeGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeGuestResponse)
+	err := c.cc.Invoke(ctx, CampgroundsService_AnonymizeGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CampgroundsServiceServer is the server API for CampgroundsService service.
+// All implementations must embed UnimplementedCampgroundsServiceServer
+// for forward compatibility.
+type CampgroundsServiceServer interface {
+	GetCampgrounds(context.Context, *GetCampgroundsRequest) (*GetCampgroundsResponse, error)
+	GetCampground(context.Context, *GetCampgroundRequest) (*GetCampgroundResponse, error)
+	CreateCampground(context.Context, *CreateCampgroundRequest) (*CreateCampgroundResponse, error)
+	UpdateCampground(context.Context, *UpdateCampgroundRequest) (*UpdateCampgroundResponse, error)
+	DeleteCampground(context.Context, *DeleteCampgroundRequest) (*DeleteCampgroundResponse, error)
+	GetCampgroundSeason(context.Context, *GetCampgroundSeasonRequest) (*GetCampgroundSeasonResponse, error)
+	SetCampgroundSeason(context.Context, *SetCampgroundSeasonRequest) (*SetCampgroundSeasonResponse, error)
+	GetCampsites(context.Context, *GetCampsitesRequest) (*GetCampsitesResponse, error)
+	CreateCampsite(context.Context, *CreateCampsiteRequest) (*CreateCampsiteResponse, error)
+	ImportCampsites(grpc.ClientStreamingServer[ImportCampsitesRequest, ImportCampsitesResponse]) error
+	GetCampsiteRates(context.Context, *GetCampsiteRatesRequest) (*GetCampsiteRatesResponse, error)
+	SetCampsiteRates(context.Context, *SetCampsiteRatesRequest) (*SetCampsiteRatesResponse, error)
+	QuoteBooking(context.Context, *QuoteBookingRequest) (*QuoteBookingResponse, error)
+	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
+	UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingResponse, error)
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error)
+	MarkNoShow(context.Context, *MarkNoShowRequest) (*MarkNoShowResponse, error)
+	GetGroupBooking(context.Context, *GetGroupBookingRequest) (*GetGroupBookingResponse, error)
+	CreateGroupBooking(context.Context, *CreateGroupBookingRequest) (*CreateGroupBookingResponse, error)
+	UpdateGroupBooking(context.Context, *UpdateGroupBookingRequest) (*UpdateGroupBookingResponse, error)
+	CancelGroupBooking(context.Context, *CancelGroupBookingRequest) (*CancelGroupBookingResponse, error)
+	GetVacantDates(context.Context, *GetVacantDatesRequest) (*GetVacantDatesResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error)
+	AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error)
+	CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error)
+	DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error)
+	ListBlackouts(context.Context, *ListBlackoutsRequest) (*ListBlackoutsResponse, error)
+	CreateCalendarSubscription(context.Context, *CreateCalendarSubscriptionRequest) (*CreateCalendarSubscriptionResponse, error)
+	DeleteCalendarSubscription(context.Context, *DeleteCalendarSubscriptionRequest) (*DeleteCalendarSubscriptionResponse, error)
+	ListCalendarSubscriptions(context.Context, *ListCalendarSubscriptionsRequest) (*ListCalendarSubscriptionsResponse, error)
+	GetGuest(context.Context, *GetGuestRequest) (*GetGuestResponse, error)
+	UpdateGuest(context.Context, *UpdateGuestRequest) (*UpdateGuestResponse, error)
+	ListGuestBookings(context.Context, *ListGuestBookingsRequest) (*ListGuestBookingsResponse, error)
+	ExportGuestData(context.Context, *ExportGuestDataRequest) (*ExportGuestDataResponse, error)
+	AnonymizeGuest(context.Context, *AnonymizeGuestRequest) (*AnonymizeGuestResponse, error)
+	mustEmbedUnimplementedCampgroundsServiceServer()
+}
+
+// UnimplementedCampgroundsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCampgroundsServiceServer struct{}
+
+func (UnimplementedCampgroundsServiceServer) GetCampgrounds(context.Context, *GetCampgroundsRequest) (*GetCampgroundsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampgrounds not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetCampground(context.Context, *GetCampgroundRequest) (*GetCampgroundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampground not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateCampground(context.Context, *CreateCampgroundRequest) (*CreateCampgroundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampground not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) UpdateCampground(context.Context, *UpdateCampgroundRequest) (*UpdateCampgroundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCampground not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) DeleteCampground(context.Context, *DeleteCampgroundRequest) (*DeleteCampgroundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCampground not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetCampgroundSeason(context.Context, *GetCampgroundSeasonRequest) (*GetCampgroundSeasonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampgroundSeason not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) SetCampgroundSeason(context.Context, *SetCampgroundSeasonRequest) (*SetCampgroundSeasonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCampgroundSeason not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetCampsites(context.Context, *GetCampsitesRequest) (*GetCampsitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampsites not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateCampsite(context.Context, *CreateCampsiteRequest) (*CreateCampsiteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampsite not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ImportCampsites(grpc.ClientStreamingServer[ImportCampsitesRequest, ImportCampsitesResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportCampsites not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetCampsiteRates(context.Context, *GetCampsiteRatesRequest) (*GetCampsiteRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampsiteRates not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) SetCampsiteRates(context.Context, *SetCampsiteRatesRequest) (*SetCampsiteRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCampsiteRates not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) QuoteBooking(context.Context, *QuoteBookingRequest) (*QuoteBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckOut not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) MarkNoShow(context.Context, *MarkNoShowRequest) (*MarkNoShowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetGroupBooking(context.Context, *GetGroupBookingRequest) (*GetGroupBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateGroupBooking(context.Context, *CreateGroupBookingRequest) (*CreateGroupBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroupBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) UpdateGroupBooking(context.Context, *UpdateGroupBookingRequest) (*UpdateGroupBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGroupBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CancelGroupBooking(context.Context, *CancelGroupBookingRequest) (*CancelGroupBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelGroupBooking not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetVacantDates(context.Context, *GetVacantDatesRequest) (*GetVacantDatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVacantDates not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWaitlist not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptWaitlistOffer not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBlackout not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBlackout not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ListBlackouts(context.Context, *ListBlackoutsRequest) (*ListBlackoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlackouts not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) CreateCalendarSubscription(context.Context, *CreateCalendarSubscriptionRequest) (*CreateCalendarSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCalendarSubscription not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) DeleteCalendarSubscription(context.Context, *DeleteCalendarSubscriptionRequest) (*DeleteCalendarSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCalendarSubscription not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ListCalendarSubscriptions(context.Context, *ListCalendarSubscriptionsRequest) (*ListCalendarSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCalendarSubscriptions not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) GetGuest(context.Context, *GetGuestRequest) (*GetGuestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGuest not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) UpdateGuest(context.Context, *UpdateGuestRequest) (*UpdateGuestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGuest not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ListGuestBookings(context.Context, *ListGuestBookingsRequest) (*ListGuestBookingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGuestBookings not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) ExportGuestData(context.Context, *ExportGuestDataRequest) (*ExportGuestDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportGuestData not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) AnonymizeGuest(context.Context, *AnonymizeGuestRequest) (*AnonymizeGuestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnonymizeGuest not implemented")
+}
+func (UnimplementedCampgroundsServiceServer) mustEmbedUnimplementedCampgroundsServiceServer() {}
+func (UnimplementedCampgroundsServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeCampgroundsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CampgroundsServiceServer will
+// result in compilation errors.
+type UnsafeCampgroundsServiceServer interface {
+	mustEmbedUnimplementedCampgroundsServiceServer()
+}
+
+func RegisterCampgroundsServiceServer(s grpc.ServiceRegistrar, srv CampgroundsServiceServer) {
+	// If the following call panics, it indicates UnimplementedCampgroundsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CampgroundsService_ServiceDesc, srv)
+}
+
+func _CampgroundsService_GetCampgrounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampgroundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetCampgrounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetCampgrounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetCampgrounds(ctx, req.(*GetCampgroundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetCampground_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampgroundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetCampground(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetCampground_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetCampground(ctx, req.(*GetCampgroundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CreateCampground_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampgroundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateCampground(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateCampground_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateCampground(ctx, req.(*CreateCampgroundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_UpdateCampground_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampgroundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).UpdateCampground(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_UpdateCampground_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).UpdateCampground(ctx, req.(*UpdateCampgroundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_DeleteCampground_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCampgroundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).DeleteCampground(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_DeleteCampground_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).DeleteCampground(ctx, req.(*DeleteCampgroundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetCampgroundSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampgroundSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetCampgroundSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetCampgroundSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetCampgroundSeason(ctx, req.(*GetCampgroundSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_SetCampgroundSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCampgroundSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).SetCampgroundSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_SetCampgroundSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).SetCampgroundSeason(ctx, req.(*SetCampgroundSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetCampsites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampsitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetCampsites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetCampsites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetCampsites(ctx, req.(*GetCampsitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CreateCampsite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampsiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateCampsite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateCampsite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateCampsite(ctx, req.(*CreateCampsiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_ImportCampsites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CampgroundsServiceServer).ImportCampsites(&grpc.GenericServerStream[ImportCampsitesRequest, ImportCampsitesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CampgroundsService_ImportCampsitesServer = grpc.ClientStreamingServer[ImportCampsitesRequest, ImportCampsitesResponse]
+
+func _CampgroundsService_GetCampsiteRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampsiteRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetCampsiteRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetCampsiteRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetCampsiteRates(ctx, req.(*GetCampsiteRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_SetCampsiteRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCampsiteRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).SetCampsiteRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_SetCampsiteRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).SetCampsiteRates(ctx, req.(*SetCampsiteRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_QuoteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).QuoteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_QuoteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).QuoteBooking(ctx, req.(*QuoteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_UpdateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).UpdateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_UpdateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).UpdateBooking(ctx, req.(*UpdateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CheckOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CheckOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CheckOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CheckOut(ctx, req.(*CheckOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_MarkNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).MarkNoShow(ctx, req.(*MarkNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetGroupBooking(ctx, req.(*GetGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CreateGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateGroupBooking(ctx, req.(*CreateGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_UpdateGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).UpdateGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_UpdateGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).UpdateGroupBooking(ctx, req.(*UpdateGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_CancelGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CancelGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CancelGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CancelGroupBooking(ctx, req.(*CancelGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_GetVacantDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacantDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).GetVacantDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_GetVacantDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).GetVacantDates(ctx, req.(*GetVacantDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampgroundsService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) CreateCampsite(ctx context.Context, in *CreateCampsiteRequest, opts ...grpc.CallOption) (*CreateCampsiteResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateCampsiteResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_CreateCampsite_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_ListWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).ListWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_ListWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).ListWaitlist(ctx, req.(*ListWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetBookingResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_GetBooking_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_AcceptWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptWaitlistOfferRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).AcceptWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_AcceptWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).AcceptWaitlistOffer(ctx, req.(*AcceptWaitlistOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateBookingResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_CreateBooking_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_CreateBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlackoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateBlackout(ctx, req.(*CreateBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateBookingResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_UpdateBooking_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_DeleteBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlackoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).DeleteBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_DeleteBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).DeleteBlackout(ctx, req.(*DeleteBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CancelBookingResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_CancelBooking_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_ListBlackouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlackoutsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).ListBlackouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_ListBlackouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).ListBlackouts(ctx, req.(*ListBlackoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *campgroundsServiceClient) GetVacantDates(ctx context.Context, in *GetVacantDatesRequest, opts ...grpc.CallOption) (*GetVacantDatesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVacantDatesResponse)
-	err := c.cc.Invoke(ctx, CampgroundsService_GetVacantDates_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CampgroundsService_CreateCalendarSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarSubscriptionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// CampgroundsServiceServer is the server API for CampgroundsService service.
-// All implementations must embed UnimplementedCampgroundsServiceServer
-// for forward compatibility.
-type CampgroundsServiceServer interface {
-	GetCampsites(context.Context, *GetCampsitesRequest) (*GetCampsitesResponse, error)
-	CreateCampsite(context.Context, *CreateCampsiteRequest) (*CreateCampsiteResponse, error)
-	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
-	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
-	UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingResponse, error)
-	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
-	GetVacantDates(context.Context, *GetVacantDatesRequest) (*GetVacantDatesResponse, error)
-	mustEmbedUnimplementedCampgroundsServiceServer()
-}
-
-// UnimplementedCampgroundsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCampgroundsServiceServer struct{}
-
-func (UnimplementedCampgroundsServiceServer) GetCampsites(context.Context, *GetCampsitesRequest) (*GetCampsitesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCampsites not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) CreateCampsite(context.Context, *CreateCampsiteRequest) (*CreateCampsiteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCampsite not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBooking not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBooking not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateBooking not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CancelBooking not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) GetVacantDates(context.Context, *GetVacantDatesRequest) (*GetVacantDatesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetVacantDates not implemented")
-}
-func (UnimplementedCampgroundsServiceServer) mustEmbedUnimplementedCampgroundsServiceServer() {}
-func (UnimplementedCampgroundsServiceServer) testEmbeddedByValue()                            {}
-
-// UnsafeCampgroundsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CampgroundsServiceServer will
-// result in compilation errors.
-type UnsafeCampgroundsServiceServer interface {
-	mustEmbedUnimplementedCampgroundsServiceServer()
-}
-
-func RegisterCampgroundsServiceServer(s grpc.ServiceRegistrar, srv CampgroundsServiceServer) {
-	// If the following call panics, it indicates UnimplementedCampgroundsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+	if interceptor == nil {
+		return srv.(CampgroundsServiceServer).CreateCalendarSubscription(ctx, in)
 	}
-	s.RegisterService(&CampgroundsService_ServiceDesc, srv)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampgroundsService_CreateCalendarSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampgroundsServiceServer).CreateCalendarSubscription(ctx, req.(*CreateCalendarSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_GetCampsites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCampsitesRequest)
+func _CampgroundsService_DeleteCalendarSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).GetCampsites(ctx, in)
+		return srv.(CampgroundsServiceServer).DeleteCalendarSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_GetCampsites_FullMethodName,
+		FullMethod: CampgroundsService_DeleteCalendarSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).GetCampsites(ctx, req.(*GetCampsitesRequest))
+		return srv.(CampgroundsServiceServer).DeleteCalendarSubscription(ctx, req.(*DeleteCalendarSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_CreateCampsite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCampsiteRequest)
+func _CampgroundsService_ListCalendarSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).CreateCampsite(ctx, in)
+		return srv.(CampgroundsServiceServer).ListCalendarSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_CreateCampsite_FullMethodName,
+		FullMethod: CampgroundsService_ListCalendarSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).CreateCampsite(ctx, req.(*CreateCampsiteRequest))
+		return srv.(CampgroundsServiceServer).ListCalendarSubscriptions(ctx, req.(*ListCalendarSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookingRequest)
+func _CampgroundsService_GetGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).GetBooking(ctx, in)
+		return srv.(CampgroundsServiceServer).GetGuest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_GetBooking_FullMethodName,
+		FullMethod: CampgroundsService_GetGuest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+		return srv.(CampgroundsServiceServer).GetGuest(ctx, req.(*GetGuestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBookingRequest)
+func _CampgroundsService_UpdateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).CreateBooking(ctx, in)
+		return srv.(CampgroundsServiceServer).UpdateGuest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_CreateBooking_FullMethodName,
+		FullMethod: CampgroundsService_UpdateGuest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+		return srv.(CampgroundsServiceServer).UpdateGuest(ctx, req.(*UpdateGuestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_UpdateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBookingRequest)
+func _CampgroundsService_ListGuestBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuestBookingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).UpdateBooking(ctx, in)
+		return srv.(CampgroundsServiceServer).ListGuestBookings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_UpdateBooking_FullMethodName,
+		FullMethod: CampgroundsService_ListGuestBookings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).UpdateBooking(ctx, req.(*UpdateBookingRequest))
+		return srv.(CampgroundsServiceServer).ListGuestBookings(ctx, req.(*ListGuestBookingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBookingRequest)
+func _CampgroundsService_ExportGuestData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGuestDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).CancelBooking(ctx, in)
+		return srv.(CampgroundsServiceServer).ExportGuestData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_CancelBooking_FullMethodName,
+		FullMethod: CampgroundsService_ExportGuestData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+		return srv.(CampgroundsServiceServer).ExportGuestData(ctx, req.(*ExportGuestDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CampgroundsService_GetVacantDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVacantDatesRequest)
+func _CampgroundsService_AnonymizeGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeGuestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CampgroundsServiceServer).GetVacantDates(ctx, in)
+		return srv.(CampgroundsServiceServer).AnonymizeGuest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CampgroundsService_GetVacantDates_FullMethodName,
+		FullMethod: CampgroundsService_AnonymizeGuest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CampgroundsServiceServer).GetVacantDates(ctx, req.(*GetVacantDatesRequest))
+		return srv.(CampgroundsServiceServer).AnonymizeGuest(ctx, req.(*AnonymizeGuestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -315,6 +1429,34 @@ var CampgroundsService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "campgroundspb.v1.CampgroundsService",
 	HandlerType: (*CampgroundsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCampgrounds",
+			Handler:    _CampgroundsService_GetCampgrounds_Handler,
+		},
+		{
+			MethodName: "GetCampground",
+			Handler:    _CampgroundsService_GetCampground_Handler,
+		},
+		{
+			MethodName: "CreateCampground",
+			Handler:    _CampgroundsService_CreateCampground_Handler,
+		},
+		{
+			MethodName: "UpdateCampground",
+			Handler:    _CampgroundsService_UpdateCampground_Handler,
+		},
+		{
+			MethodName: "DeleteCampground",
+			Handler:    _CampgroundsService_DeleteCampground_Handler,
+		},
+		{
+			MethodName: "GetCampgroundSeason",
+			Handler:    _CampgroundsService_GetCampgroundSeason_Handler,
+		},
+		{
+			MethodName: "SetCampgroundSeason",
+			Handler:    _CampgroundsService_SetCampgroundSeason_Handler,
+		},
 		{
 			MethodName: "GetCampsites",
 			Handler:    _CampgroundsService_GetCampsites_Handler,
@@ -323,6 +1465,18 @@ var CampgroundsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCampsite",
 			Handler:    _CampgroundsService_CreateCampsite_Handler,
 		},
+		{
+			MethodName: "GetCampsiteRates",
+			Handler:    _CampgroundsService_GetCampsiteRates_Handler,
+		},
+		{
+			MethodName: "SetCampsiteRates",
+			Handler:    _CampgroundsService_SetCampsiteRates_Handler,
+		},
+		{
+			MethodName: "QuoteBooking",
+			Handler:    _CampgroundsService_QuoteBooking_Handler,
+		},
 		{
 			MethodName: "GetBooking",
 			Handler:    _CampgroundsService_GetBooking_Handler,
@@ -339,11 +1493,105 @@ var CampgroundsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _CampgroundsService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _CampgroundsService_CheckIn_Handler,
+		},
+		{
+			MethodName: "CheckOut",
+			Handler:    _CampgroundsService_CheckOut_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _CampgroundsService_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "GetGroupBooking",
+			Handler:    _CampgroundsService_GetGroupBooking_Handler,
+		},
+		{
+			MethodName: "CreateGroupBooking",
+			Handler:    _CampgroundsService_CreateGroupBooking_Handler,
+		},
+		{
+			MethodName: "UpdateGroupBooking",
+			Handler:    _CampgroundsService_UpdateGroupBooking_Handler,
+		},
+		{
+			MethodName: "CancelGroupBooking",
+			Handler:    _CampgroundsService_CancelGroupBooking_Handler,
+		},
 		{
 			MethodName: "GetVacantDates",
 			Handler:    _CampgroundsService_GetVacantDates_Handler,
 		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _CampgroundsService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _CampgroundsService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ListWaitlist",
+			Handler:    _CampgroundsService_ListWaitlist_Handler,
+		},
+		{
+			MethodName: "AcceptWaitlistOffer",
+			Handler:    _CampgroundsService_AcceptWaitlistOffer_Handler,
+		},
+		{
+			MethodName: "CreateBlackout",
+			Handler:    _CampgroundsService_CreateBlackout_Handler,
+		},
+		{
+			MethodName: "DeleteBlackout",
+			Handler:    _CampgroundsService_DeleteBlackout_Handler,
+		},
+		{
+			MethodName: "ListBlackouts",
+			Handler:    _CampgroundsService_ListBlackouts_Handler,
+		},
+		{
+			MethodName: "CreateCalendarSubscription",
+			Handler:    _CampgroundsService_CreateCalendarSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteCalendarSubscription",
+			Handler:    _CampgroundsService_DeleteCalendarSubscription_Handler,
+		},
+		{
+			MethodName: "ListCalendarSubscriptions",
+			Handler:    _CampgroundsService_ListCalendarSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetGuest",
+			Handler:    _CampgroundsService_GetGuest_Handler,
+		},
+		{
+			MethodName: "UpdateGuest",
+			Handler:    _CampgroundsService_UpdateGuest_Handler,
+		},
+		{
+			MethodName: "ListGuestBookings",
+			Handler:    _CampgroundsService_ListGuestBookings_Handler,
+		},
+		{
+			MethodName: "ExportGuestData",
+			Handler:    _CampgroundsService_ExportGuestData_Handler,
+		},
+		{
+			MethodName: "AnonymizeGuest",
+			Handler:    _CampgroundsService_AnonymizeGuest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCampsites",
+			Handler:       _CampgroundsService_ImportCampsites_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "campgroundspb/v1/api.proto",
 }
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+)
+
+var bookingHeader = []string{
+	"BOOKING_ID", "CAMPSITE_ID", "EMAIL", "FULL_NAME", "START_DATE", "END_DATE", "GUESTS", "STATUS",
+	"VERSION",
+}
+
+var bookingsCreate = command{
+	usage: "--campsite-id ID --email EMAIL --full-name NAME --start-date DATE --end-date DATE [flags]",
+	brief: "Create a booking",
+	flags: func(fs *flag.FlagSet) action {
+		req := &api.CreateBookingRequest{}
+		fs.StringVar(&req.CampsiteId, "campsite-id", "", "identifier of the campsite to book")
+		fs.StringVar(&req.Email, "email", "", "email of the person booking")
+		fs.StringVar(&req.FullName, "full-name", "", "full name of the person booking")
+		fs.StringVar(&req.StartDate, "start-date", "", "start date of the booking (YYYY-MM-DD)")
+		fs.StringVar(&req.EndDate, "end-date", "", "end date of the booking (YYYY-MM-DD)")
+		guests := fs.Int("guests", 0, "number of guests, defaults to 1")
+		fs.StringVar(&req.PaymentMethod, "payment-method", "",
+			"payment method token, required if the campsite has rates")
+
+		return func(ctx context.Context, c *cli, _ []string) error {
+			err := c.required("campsite-id", "email", "full-name", "start-date", "end-date")
+			if err != nil {
+				return err
+			}
+			req.Guests = int32(*guests)
+			resp, err := c.client.CreateBooking(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.print(resp, table{
+				header: []string{"BOOKING_ID"},
+				rows:   [][]string{{resp.BookingId}},
+			})
+		}
+	},
+}
+
+var bookingsGet = command{
+	usage: "BOOKING_ID",
+	brief: "Get a booking",
+	args:  1,
+	flags: func(_ *flag.FlagSet) action {
+		return func(ctx context.Context, c *cli, args []string) error {
+			resp, err := c.client.GetBooking(ctx, &api.GetBookingRequest{BookingId: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.print(resp.Booking, bookingsTable(resp.Booking))
+		}
+	},
+}
+
+var bookingsUpdate = command{
+	usage: "BOOKING_ID [flags]",
+	brief: "Update the fields of a booking given as flags, and print the updated booking",
+	args:  1,
+	flags: func(fs *flag.FlagSet) action {
+		campsiteID := fs.String("campsite-id", "", "identifier of the campsite to move to")
+		email := fs.String("email", "", "email of the person booking")
+		fullName := fs.String("full-name", "", "full name of the person booking")
+		startDate := fs.String("start-date", "", "start date of the booking (YYYY-MM-DD)")
+		endDate := fs.String("end-date", "", "end date of the booking (YYYY-MM-DD)")
+		guests := fs.Int("guests", 0, "number of guests")
+
+		return func(ctx context.Context, c *cli, args []string) error {
+			// the booking is read first to update it at its current version
+			resp, err := c.client.GetBooking(ctx, &api.GetBookingRequest{BookingId: args[0]})
+			if err != nil {
+				return err
+			}
+			booking := resp.Booking
+			if c.isSet("campsite-id") {
+				booking.CampsiteId = *campsiteID
+			}
+			if c.isSet("email") {
+				booking.Email = *email
+			}
+			if c.isSet("full-name") {
+				booking.FullName = *fullName
+			}
+			if c.isSet("start-date") {
+				booking.StartDate = *startDate
+			}
+			if c.isSet("end-date") {
+				booking.EndDate = *endDate
+			}
+			if c.isSet("guests") {
+				booking.Guests = int32(*guests)
+			}
+			if _, err = c.client.UpdateBooking(ctx, &api.UpdateBookingRequest{
+				Booking: booking,
+			}); err != nil {
+				return err
+			}
+
+			resp, err = c.client.GetBooking(ctx, &api.GetBookingRequest{BookingId: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.print(resp.Booking, bookingsTable(resp.Booking))
+		}
+	},
+}
+
+var bookingsCancel = command{
+	usage: "BOOKING_ID",
+	brief: "Cancel a booking and refund its deposit per the cancellation policy",
+	args:  1,
+	flags: func(_ *flag.FlagSet) action {
+		return func(ctx context.Context, c *cli, args []string) error {
+			resp, err := c.client.CancelBooking(ctx, &api.CancelBookingRequest{BookingId: args[0]})
+			if err != nil {
+				return err
+			}
+			return c.print(resp, table{
+				header: []string{"BOOKING_ID", "REFUND_PERCENT", "REFUND_AMOUNT", "CURRENCY"},
+				rows: [][]string{{
+					args[0], itoa(resp.RefundPercent), itoa(resp.RefundAmount), resp.Currency,
+				}},
+			})
+		}
+	},
+}
+
+var bookingsList = command{
+	usage: "--guest-id ID",
+	brief: "List the bookings of a guest",
+	flags: func(fs *flag.FlagSet) action {
+		guestID := fs.String("guest-id", "", "identifier of the guest")
+
+		return func(ctx context.Context, c *cli, _ []string) error {
+			if err := c.required("guest-id"); err != nil {
+				return err
+			}
+			resp, err := c.client.ListGuestBookings(ctx, &api.ListGuestBookingsRequest{
+				GuestId: *guestID,
+			})
+			if err != nil {
+				return err
+			}
+			return c.print(resp, bookingsTable(resp.Bookings...))
+		}
+	},
+}
+
+func bookingsTable(bookings ...*api.Booking) table {
+	t := table{header: bookingHeader}
+	for _, b := range bookings {
+		t.rows = append(t.rows, []string{
+			b.BookingId, b.CampsiteId, b.Email, b.FullName, b.StartDate, b.EndDate, itoa(b.Guests),
+			strings.TrimPrefix(b.Status.String(), "BOOKING_STATUS_"), itoa(b.Version),
+		})
+	}
+	return t
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+)
+
+var campsiteHeader = []string{
+	"CAMPSITE_ID", "CAMPSITE_CODE", "CAPACITY", "DRINKING_WATER", "RESTROOMS", "PICNIC_TABLE",
+	"FIRE_PIT", "ACTIVE", "CAMPGROUND_ID",
+}
+
+var campsitesList = command{
+	usage: "[--campground-id ID]",
+	brief: "List campsites, optionally of a campground",
+	flags: func(fs *flag.FlagSet) action {
+		campgroundID := fs.String("campground-id", "", "identifier of the campground")
+
+		return func(ctx context.Context, c *cli, _ []string) error {
+			resp, err := c.client.GetCampsites(ctx, &api.GetCampsitesRequest{
+				CampgroundId: *campgroundID,
+			})
+			if err != nil {
+				return err
+			}
+			return c.print(resp, campsitesTable(resp.Campsites...))
+		}
+	},
+}
+
+var campsitesCreate = command{
+	usage: "--code CODE --capacity N [flags]",
+	brief: "Create a campsite",
+	flags: func(fs *flag.FlagSet) action {
+		req := &api.CreateCampsiteRequest{}
+		fs.StringVar(&req.CampsiteCode, "code", "", "unique code of the campsite")
+		capacity := fs.Int("capacity", 0, "maximum number of people the campsite can accommodate")
+		fs.BoolVar(&req.DrinkingWater, "drinking-water", false, "campsite has drinking water")
+		fs.BoolVar(&req.Restrooms, "restrooms", false, "campsite has restrooms")
+		fs.BoolVar(&req.PicnicTable, "picnic-table", false, "campsite has a picnic table")
+		fs.BoolVar(&req.FirePit, "fire-pit", false, "campsite has a fire pit")
+		fs.StringVar(&req.CampgroundId, "campground-id", "",
+			"identifier of the campground the campsite belongs to")
+
+		return func(ctx context.Context, c *cli, _ []string) error {
+			if err := c.required("code", "capacity"); err != nil {
+				return err
+			}
+			req.Capacity = int32(*capacity)
+			resp, err := c.client.CreateCampsite(ctx, req)
+			if err != nil {
+				return err
+			}
+			return c.print(resp, table{
+				header: []string{"CAMPSITE_ID"},
+				rows:   [][]string{{resp.CampsiteId}},
+			})
+		}
+	},
+}
+
+var campsitesGet = command{
+	usage: "CAMPSITE_ID",
+	brief: "Get a campsite",
+	args:  1,
+	flags: func(_ *flag.FlagSet) action {
+		return func(ctx context.Context, c *cli, args []string) error {
+			// the API has no lookup of a single campsite, all are fetched
+			resp, err := c.client.GetCampsites(ctx, &api.GetCampsitesRequest{})
+			if err != nil {
+				return err
+			}
+			for _, campsite := range resp.Campsites {
+				if campsite.CampsiteId == args[0] {
+					return c.print(campsite, campsitesTable(campsite))
+				}
+			}
+			return fmt.Errorf("campsite %s not found", args[0])
+		}
+	},
+}
+
+func campsitesTable(campsites ...*api.Campsite) table {
+	t := table{header: campsiteHeader}
+	for _, c := range campsites {
+		t.rows = append(t.rows, []string{
+			c.CampsiteId, c.CampsiteCode, itoa(c.Capacity), btoa(c.DrinkingWater), btoa(c.Restrooms),
+			btoa(c.PicnicTable), btoa(c.FirePit), btoa(c.Active), c.CampgroundId,
+		})
+	}
+	return t
+}
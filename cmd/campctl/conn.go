@@ -0,0 +1,121 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+// globalFlags are the connection and output flags of every command, the
+// address defaults to the CAMPCTL_ADDR environment variable if set.
+type globalFlags struct {
+	addr               string
+	timeout            time.Duration
+	output             string
+	tls                bool
+	caCert             string
+	cert               string
+	key                string
+	serverName         string
+	insecureSkipVerify bool
+}
+
+func newGlobalFlags() *globalFlags {
+	addr := os.Getenv("CAMPCTL_ADDR")
+	if addr == "" {
+		addr = "localhost:8085"
+	}
+	return &globalFlags{addr: addr, timeout: 10 * time.Second, output: outputTable}
+}
+
+// register registers the flags with their current values as defaults, so
+// that flags parsed before a subcommand are kept if not repeated after it.
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.addr, "addr", g.addr, "address of the Campgrounds API")
+	fs.DurationVar(&g.timeout, "timeout", g.timeout, "timeout of the command")
+	fs.StringVar(&g.output, "o", g.output, "output format, one of table, json or csv")
+	fs.StringVar(&g.output, "output", g.output, "output format, one of table, json or csv")
+	fs.BoolVar(&g.tls, "tls", g.tls, "connect over TLS, implied by the other TLS flags")
+	fs.StringVar(&g.caCert, "ca-cert", g.caCert,
+		"PEM file of the CA certificates to verify the server with, system pool if empty")
+	fs.StringVar(&g.cert, "cert", g.cert, "PEM file of the client certificate for mutual TLS")
+	fs.StringVar(&g.key, "key", g.key, "PEM file of the client private key for mutual TLS")
+	fs.StringVar(&g.serverName, "server-name", g.serverName,
+		"server name to verify the server certificate against, host of --addr if empty")
+	fs.BoolVar(&g.insecureSkipVerify, "insecure-skip-verify", g.insecureSkipVerify,
+		"skip the verification of the server certificate")
+}
+
+func (g *globalFlags) validate() error {
+	switch g.output {
+	case outputTable, outputJSON, outputCSV:
+	default:
+		return fmt.Errorf("unsupported output format %q", g.output)
+	}
+	if (g.cert == "") != (g.key == "") {
+		return fmt.Errorf("--cert and --key must be set together")
+	}
+	return nil
+}
+
+func (g *globalFlags) useTLS() bool {
+	return g.tls || g.caCert != "" || g.cert != "" || g.serverName != "" || g.insecureSkipVerify
+}
+
+func (g *globalFlags) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         g.serverName,
+		InsecureSkipVerify: g.insecureSkipVerify,
+	}
+	if g.caCert != "" {
+		pem, err := os.ReadFile(g.caCert)
+		if err != nil {
+			return nil, fmt.Errorf("read CA certificates: %w", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no CA certificates found in %s", g.caCert)
+		}
+	}
+	if g.cert != "" {
+		cert, err := tls.LoadX509KeyPair(g.cert, g.key)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// dial connects to the API in plaintext, or over TLS if any TLS flag is set.
+func dial(g *globalFlags) (api.CampgroundsServiceClient, io.Closer, error) {
+	creds := insecure.NewCredentials()
+	if g.useTLS() {
+		cfg, err := g.tlsConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		creds = credentials.NewTLS(cfg)
+	}
+	conn, err := grpc.NewClient(g.addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to %s: %w", g.addr, err)
+	}
+	return api.NewCampgroundsServiceClient(conn), conn, nil
+}
@@ -0,0 +1,194 @@
+// Command campctl is a command-line admin client for the Campgrounds API.
+//
+// Usage:
+//
+//	campctl [flags] <command> [subcommand] [arguments] [flags]
+//
+// The connection and output flags are accepted both before the command and
+// after the subcommand, e.g. `campctl --addr campgrounds:8085 campsites list -o json`.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// command is a leaf of the command tree, e.g. `campsites list`.
+	command struct {
+		usage string
+		brief string
+		// args is the number of positional arguments of the command.
+		args int
+		// flags registers the flags of the command and returns its action.
+		flags func(fs *flag.FlagSet) action
+	}
+
+	// action runs a command once its flags and arguments have been parsed.
+	action func(ctx context.Context, c *cli, args []string) error
+
+	// clientFactory connects to the API, the returned closer releases the
+	// connection.
+	clientFactory func(g *globalFlags) (api.CampgroundsServiceClient, io.Closer, error)
+
+	// cli is the state shared by the commands of an invocation.
+	cli struct {
+		global *globalFlags
+		client api.CampgroundsServiceClient
+		out    io.Writer
+		// fs is the flag set of the command run.
+		fs *flag.FlagSet
+	}
+)
+
+// commands maps a command and its subcommand to the command run, a command
+// without subcommands is keyed by its name alone.
+var commands = map[string]command{
+	"campsites list":   campsitesList,
+	"campsites create": campsitesCreate,
+	"campsites get":    campsitesGet,
+	"bookings create":  bookingsCreate,
+	"bookings get":     bookingsGet,
+	"bookings update":  bookingsUpdate,
+	"bookings cancel":  bookingsCancel,
+	"bookings list":    bookingsList,
+	"vacancy":          vacancy,
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdout, os.Stderr, dial)
+	switch {
+	case err == nil:
+	case err == flag.ErrHelp:
+		os.Exit(2)
+	default:
+		if s, ok := status.FromError(err); ok {
+			_, _ = fmt.Fprintf(os.Stderr, "campctl: %s: %s\n", s.Code(), s.Message())
+		} else {
+			_, _ = fmt.Fprintf(os.Stderr, "campctl: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer, newClient clientFactory) error {
+	global := newGlobalFlags()
+	fs := flag.NewFlagSet("campctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	global.register(fs)
+	fs.Usage = func() { printUsage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	name := args[0]
+	cmd, ok := commands[name]
+	if !ok && len(args) > 1 {
+		name = args[0] + " " + args[1]
+		cmd, ok = commands[name]
+	}
+	if !ok {
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", strings.Join(args[:min(len(args), 2)], " "))
+	}
+	args = args[len(strings.Fields(name)):]
+
+	// global flags registered after the top-level parse default to its values
+	fs = flag.NewFlagSet("campctl "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	global.register(fs)
+	act := cmd.flags(fs)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "Usage: campctl %s %s\n\n%s.\n\nFlags:\n",
+			name, cmd.usage, cmd.brief)
+		fs.PrintDefaults()
+	}
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != cmd.args {
+		fs.Usage()
+		return fmt.Errorf("%s: expected %d argument(s), got %d", name, cmd.args, len(positional))
+	}
+	if err = global.validate(); err != nil {
+		return err
+	}
+
+	client, closer, err := newClient(global)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = closer.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), global.timeout)
+	defer cancel()
+	return act(ctx, &cli{global: global, client: client, out: stdout, fs: fs}, positional)
+}
+
+// parse parses flags which may be interleaved with positional arguments, and
+// returns the positional arguments.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// required checks that the named flags of the command were set.
+func (c *cli) required(names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if !c.isSet(name) {
+			missing = append(missing, "--"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required flag(s) %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// isSet reports whether the named flag of the command was set.
+func (c *cli) isSet(name string) bool {
+	set := false
+	c.fs.Visit(func(f *flag.Flag) { set = set || f.Name == name })
+	return set
+}
+
+func printUsage(fs *flag.FlagSet) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := fs.Output()
+	_, _ = fmt.Fprint(w, "Usage: campctl [flags] <command> [subcommand] [arguments] [flags]\n\n")
+	_, _ = fmt.Fprint(w, "Commands:\n")
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "  %-18s %s\n", name, commands[name].brief)
+	}
+	_, _ = fmt.Fprint(w, "\nFlags:\n")
+	fs.PrintDefaults()
+}
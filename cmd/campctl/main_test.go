@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	campsiteID = "07df7f35-9c7a-4b10-a702-66844a7ec08c"
+	bookingID  = "692abbc0-5457-4f2b-8a6e-061ba2e5dd90"
+)
+
+// stubClient answers the RPCs used by the commands from fixed data, and
+// records the requests sent.
+type stubClient struct {
+	api.CampgroundsServiceClient
+	campsites []*api.Campsite
+	booking   *api.Booking
+	requests  []any
+}
+
+func (s *stubClient) GetCampsites(
+	_ context.Context, req *api.GetCampsitesRequest, _ ...grpc.CallOption,
+) (*api.GetCampsitesResponse, error) {
+	s.requests = append(s.requests, req)
+	return &api.GetCampsitesResponse{Campsites: s.campsites}, nil
+}
+
+func (s *stubClient) CreateCampsite(
+	_ context.Context, req *api.CreateCampsiteRequest, _ ...grpc.CallOption,
+) (*api.CreateCampsiteResponse, error) {
+	s.requests = append(s.requests, req)
+	return &api.CreateCampsiteResponse{CampsiteId: campsiteID}, nil
+}
+
+func (s *stubClient) GetBooking(
+	_ context.Context, req *api.GetBookingRequest, _ ...grpc.CallOption,
+) (*api.GetBookingResponse, error) {
+	s.requests = append(s.requests, req)
+	if s.booking == nil || req.BookingId != s.booking.BookingId {
+		return nil, status.Errorf(codes.NotFound, "booking not found")
+	}
+	return &api.GetBookingResponse{Booking: s.booking}, nil
+}
+
+func (s *stubClient) UpdateBooking(
+	_ context.Context, req *api.UpdateBookingRequest, _ ...grpc.CallOption,
+) (*api.UpdateBookingResponse, error) {
+	s.requests = append(s.requests, req)
+	s.booking = req.Booking
+	s.booking.Version++
+	return &api.UpdateBookingResponse{}, nil
+}
+
+func (s *stubClient) GetVacantDates(
+	_ context.Context, req *api.GetVacantDatesRequest, _ ...grpc.CallOption,
+) (*api.GetVacantDatesResponse, error) {
+	s.requests = append(s.requests, req)
+	return &api.GetVacantDatesResponse{
+		VacantDates: []string{"2026-11-02", "2026-11-03"},
+		VacantRanges: []*api.DateRange{
+			{StartDate: "2026-11-02", EndDate: "2026-11-04", Nights: 2},
+		},
+	}, nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+func TestRun(t *testing.T) {
+	campsite := &api.Campsite{
+		CampsiteId:   campsiteID,
+		CampsiteCode: "A01",
+		Capacity:     4,
+		FirePit:      true,
+		Active:       true,
+	}
+	booking := func() *api.Booking {
+		return &api.Booking{
+			BookingId:  bookingID,
+			CampsiteId: campsiteID,
+			Email:      "john.smith@example.com",
+			FullName:   "John Smith",
+			StartDate:  "2026-11-02",
+			EndDate:    "2026-11-05",
+			Guests:     2,
+			Status:     api.BookingStatus_BOOKING_STATUS_CONFIRMED,
+			Version:    1,
+		}
+	}
+
+	tests := map[string]struct {
+		args         []string
+		want         string
+		wantRequests []any
+		wantErr      string
+	}{
+		"Success_CampsitesList_Table": {
+			args: []string{"campsites", "list"},
+			want: "CAMPSITE_ID                           CAMPSITE_CODE  CAPACITY  DRINKING_WATER  " +
+				"RESTROOMS  PICNIC_TABLE  FIRE_PIT  ACTIVE  CAMPGROUND_ID\n" +
+				campsiteID + "  A01            4         false           " +
+				"false      false         true      true    \n",
+			wantRequests: []any{&api.GetCampsitesRequest{}},
+		},
+		"Success_CampsitesList_CSVAfterSubcommand": {
+			args: []string{"campsites", "list", "--campground-id", "c1", "-o", "csv"},
+			want: "CAMPSITE_ID,CAMPSITE_CODE,CAPACITY,DRINKING_WATER,RESTROOMS,PICNIC_TABLE," +
+				"FIRE_PIT,ACTIVE,CAMPGROUND_ID\n" +
+				campsiteID + ",A01,4,false,false,false,true,true,\n",
+			wantRequests: []any{&api.GetCampsitesRequest{CampgroundId: "c1"}},
+		},
+		"Success_CampsitesCreate": {
+			args: []string{"campsites", "create", "--code", "A01", "--capacity", "4", "--fire-pit"},
+			want: "CAMPSITE_ID\n" + campsiteID + "\n",
+			wantRequests: []any{&api.CreateCampsiteRequest{
+				CampsiteCode: "A01", Capacity: 4, FirePit: true,
+			}},
+		},
+		"Success_CampsitesGet_JSON": {
+			args: []string{"-o", "json", "campsites", "get", campsiteID},
+			want: "{\n" +
+				"  \"campsiteId\": \"" + campsiteID + "\",\n" +
+				"  \"campsiteCode\": \"A01\",\n" +
+				"  \"capacity\": 4,\n" +
+				"  \"firePit\": true,\n" +
+				"  \"active\": true\n" +
+				"}\n",
+			wantRequests: []any{&api.GetCampsitesRequest{}},
+		},
+		"Success_BookingsUpdate": {
+			args: []string{
+				"bookings",
+				"update",
+				bookingID,
+				"--end-date",
+				"2026-11-06",
+				"-o",
+				"csv",
+			},
+			want: "BOOKING_ID,CAMPSITE_ID,EMAIL,FULL_NAME,START_DATE,END_DATE,GUESTS,STATUS," +
+				"VERSION\n" +
+				bookingID + "," + campsiteID + ",john.smith@example.com,John Smith,2026-11-02," +
+				"2026-11-06,2,CONFIRMED,2\n",
+			wantRequests: []any{
+				&api.GetBookingRequest{BookingId: bookingID},
+				&api.UpdateBookingRequest{Booking: func() *api.Booking {
+					b := booking()
+					b.EndDate, b.Version = "2026-11-06", 2
+					return b
+				}()},
+				&api.GetBookingRequest{BookingId: bookingID},
+			},
+		},
+		"Success_Vacancy": {
+			args: []string{
+				"vacancy", campsiteID, "--start-date", "2026-11-01", "--end-date", "2026-11-30",
+			},
+			want: "START_DATE  END_DATE    NIGHTS\n2026-11-02  2026-11-04  2\n",
+			wantRequests: []any{&api.GetVacantDatesRequest{
+				CampsiteId: campsiteID, StartDate: "2026-11-01", EndDate: "2026-11-30",
+			}},
+		},
+		"Error_CampsitesGet_NotFound": {
+			args:         []string{"campsites", "get", bookingID},
+			wantRequests: []any{&api.GetCampsitesRequest{}},
+			wantErr:      "campsite " + bookingID + " not found",
+		},
+		"Error_BookingsGet_NotFound": {
+			args:         []string{"bookings", "get", campsiteID},
+			wantRequests: []any{&api.GetBookingRequest{BookingId: campsiteID}},
+			wantErr:      "rpc error: code = NotFound desc = booking not found",
+		},
+		"Error_BookingsCreate_MissingFlags": {
+			args: []string{
+				"bookings",
+				"create",
+				"--campsite-id",
+				campsiteID,
+				"--email",
+				"a@b.c",
+			},
+			wantErr: "missing required flag(s) --full-name, --start-date, --end-date",
+		},
+		"Error_BookingsGet_MissingArgument": {
+			args:    []string{"bookings", "get"},
+			wantErr: "bookings get: expected 1 argument(s), got 0",
+		},
+		"Error_UnknownCommand": {
+			args:    []string{"guests", "get"},
+			wantErr: `unknown command "guests get"`,
+		},
+		"Error_UnsupportedOutput": {
+			args:    []string{"campsites", "list", "-o", "yaml"},
+			wantErr: `unsupported output format "yaml"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			client := &stubClient{campsites: []*api.Campsite{campsite}, booking: booking()}
+			newClient := func(_ *globalFlags) (api.CampgroundsServiceClient, io.Closer, error) {
+				return client, nopCloser{}, nil
+			}
+			var stdout bytes.Buffer
+			// when
+			err := run(tc.args, &stdout, io.Discard, newClient)
+			// then
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, stdout.String())
+			}
+			assert.Equal(t, tc.wantRequests, client.requests)
+		})
+	}
+}
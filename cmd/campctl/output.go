@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// table is the tabular rendering of a response, used by the table and CSV
+// output formats.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// print writes a response in the output format: JSON is the protojson
+// encoding of the message, table and CSV render the table.
+func (c *cli) print(msg proto.Message, t table) error {
+	switch c.global.output {
+	case outputJSON:
+		b, err := protojson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("marshal response: %w", err)
+		}
+		// protojson output is deliberately unstable, it is indented anew
+		var buf bytes.Buffer
+		if err = json.Indent(&buf, b, "", "  "); err != nil {
+			return fmt.Errorf("indent response: %w", err)
+		}
+		_, err = fmt.Fprintln(c.out, buf.String())
+		return err
+	case outputCSV:
+		w := csv.NewWriter(c.out)
+		_ = w.Write(t.header)
+		_ = w.WriteAll(t.rows)
+		return w.Error()
+	default:
+		w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+func itoa[T ~int32 | ~int64](n T) string {
+	return strconv.FormatInt(int64(n), 10)
+}
+
+func btoa(b bool) string {
+	return strconv.FormatBool(b)
+}
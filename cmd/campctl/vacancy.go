@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+)
+
+var vacancy = command{
+	usage: "CAMPSITE_ID [--start-date DATE] [--end-date DATE] [--min-nights N]",
+	brief: "List the vacant date ranges of a campsite, within a month from today by default",
+	args:  1,
+	flags: func(fs *flag.FlagSet) action {
+		today := time.Now()
+		req := &api.GetVacantDatesRequest{}
+		fs.StringVar(&req.StartDate, "start-date", today.Format(time.DateOnly),
+			"start date of the period (YYYY-MM-DD)")
+		fs.StringVar(&req.EndDate, "end-date", today.AddDate(0, 1, 0).Format(time.DateOnly),
+			"end date of the period (YYYY-MM-DD)")
+		minNights := fs.Int("min-nights", 0, "minimum number of nights of a vacant range")
+		fs.StringVar(&req.CampgroundId, "campground-id", "",
+			"identifier of the campground the campsite must belong to")
+
+		return func(ctx context.Context, c *cli, args []string) error {
+			req.CampsiteId = args[0]
+			req.MinNights = int32(*minNights)
+			resp, err := c.client.GetVacantDates(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			t := table{header: []string{"START_DATE", "END_DATE", "NIGHTS"}}
+			for _, r := range resp.VacantRanges {
+				t.rows = append(t.rows, []string{r.StartDate, r.EndDate, itoa(r.Nights)})
+			}
+			return c.print(resp, t)
+		}
+	},
+}
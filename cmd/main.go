@@ -1,30 +1,62 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"flag"
+	"fmt"
 	"log/slog"
 	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/igor-baiborodine/campsite-booking-go/db/migrations"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/config"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/service"
 	_ "github.com/jackc/pgx/v4/stdlib"
 )
 
+const usage = `Usage:
+  app [serve] [--no-migrate]  serve the API, migrating the database first unless --no-migrate is set
+  app migrate up              apply the pending migrations
+  app migrate down            roll back the most recently applied migration
+  app migrate status          print the state of every migration
+  app migrate version         print the schema version of the database`
+
 func main() {
-	if err := run(); err != nil {
+	if err := run(os.Args[1:]); err != nil {
 		slog.Error("campgrounds exited abnormally", slog.Any("error", err))
 		os.Exit(1)
 	}
 }
 
-func run() (err error) {
-	var cfg config.AppConfig
-	cfg, err = config.InitConfig()
-	if err != nil {
+func run(args []string) error {
+	name := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	switch name {
+	case "serve":
+		return serve(args)
+	case "migrate":
+		return migrate(args)
+	default:
+		_, _ = fmt.Fprintln(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
+
+func serve(args []string) (err error) {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.Usage = func() { _, _ = fmt.Fprintln(fs.Output(), usage) }
+	noMigrate := fs.Bool("no-migrate", false, "")
+	if err = fs.Parse(args); err != nil {
 		return err
 	}
-	s, err := service.New(cfg)
+
+	s, err := newService()
 	if err != nil {
 		return err
 	}
@@ -35,11 +67,23 @@ func run() (err error) {
 			return
 		}
 	}(s.DB())
-	if err = s.MigrateDB(migrations.FS); err != nil {
-		slog.Error("failed to migrate DB", "error", err)
+	if replica := s.ReplicaDB(); replica != nil {
+		defer func() { _ = replica.Close() }()
+	}
+	migrator, err := s.Migrator(migrations.FS)
+	if err != nil {
+		return err
+	}
+	if !*noMigrate {
+		if err = migrateUp(context.Background(), migrator); err != nil {
+			slog.Error("failed to migrate DB", "error", err)
+			return err
+		}
+	}
+	if err = migrator.CheckVersion(context.Background()); err != nil {
+		slog.Error("refusing to serve with an outdated schema", "error", err)
 		return err
 	}
-	slog.Info("migrated DB")
 
 	if err = s.Startup(); err != nil {
 		return err
@@ -47,7 +91,95 @@ func run() (err error) {
 	slog.Info("✅ campgrounds app stared")
 	defer slog.Info("🚫 campgrounds app stopped")
 
-	s.Waiter().Add(s.WaitForRPC)
+	s.Waiter().
+		Add(
+			s.WaitForRPC, s.WaitForHTTP, s.WaitForAdmin, s.WaitForProbes,
+			s.WaitForReplicaCheck, s.WaitForCalendarSync, s.WaitForRetention,
+			s.WaitForWaitlistExpiry,
+		)
 
 	return s.Waiter().Wait()
 }
+
+func migrate(args []string) (err error) {
+	if len(args) != 1 {
+		_, _ = fmt.Fprintln(os.Stderr, usage)
+		return fmt.Errorf("migrate: expected one of up, down, status or version")
+	}
+	s, err := newService()
+	if err != nil {
+		return err
+	}
+	defer func(db *sql.DB) {
+		if err = db.Close(); err != nil {
+			return
+		}
+	}(s.DB())
+	migrator, err := s.Migrator(migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return migrateUp(ctx, migrator)
+	case "down":
+		result, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("rolled back DB migration",
+			"version", result.Source.Version, "duration", result.Duration)
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED_AT\tSOURCE")
+		for _, st := range statuses {
+			appliedAt := ""
+			if !st.AppliedAt.IsZero() {
+				appliedAt = st.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+				st.Source.Version, strings.ToUpper(string(st.State)), appliedAt, st.Source.Path)
+		}
+		return w.Flush()
+	case "version":
+		version, expected, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Printf("version: %d, expected: %d\n", version, expected)
+		return err
+	default:
+		_, _ = fmt.Fprintln(os.Stderr, usage)
+		return fmt.Errorf("migrate: unknown subcommand %q", args[0])
+	}
+}
+
+func newService() (*service.Service, error) {
+	cfg, err := config.InitConfig()
+	if err != nil {
+		return nil, err
+	}
+	return service.New(cfg)
+}
+
+// migrateUp applies the pending migrations, waiting for a replica migrating
+// concurrently to finish first.
+func migrateUp(ctx context.Context, migrator *postgres.Migrator) error {
+	results, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		slog.Info("applied DB migration",
+			"version", result.Source.Version, "duration", result.Duration)
+	}
+	slog.Info("migrated DB", "applied", len(results))
+	return nil
+}
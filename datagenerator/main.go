@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"log"
 	"os"
 	"strconv"
@@ -10,6 +13,7 @@ import (
 	"github.com/go-faker/faker/v4"
 	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -41,7 +45,11 @@ func main() {
 	}
 	log.Printf("server address: %s, campsites count: %d", addr, campsitesCount)
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := transportCredentials()
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("failed to connect to grpc server at %s: %v", addr, err)
 	}
@@ -59,6 +67,32 @@ func main() {
 	log.Printf("created total %d bookings", count)
 }
 
+// transportCredentials returns TLS credentials if TLS_CA_CERT is set, with the
+// client certificate of TLS_CERT and TLS_KEY for mutual TLS if set too, and
+// plaintext credentials otherwise.
+func transportCredentials() (credentials.TransportCredentials, error) {
+	caCert, ok := os.LookupEnv("TLS_CA_CERT")
+	if !ok {
+		return insecure.NewCredentials(), nil
+	}
+	pem, err := os.ReadFile(caCert)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: x509.NewCertPool()}
+	if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificates found in %s", caCert)
+	}
+	if cert, ok := os.LookupEnv("TLS_CERT"); ok {
+		pair, err := tls.LoadX509KeyPair(cert, os.Getenv("TLS_KEY"))
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{pair}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
 func createCampsites(c api.CampgroundsServiceClient, count int) (campsiteIDs []string) {
 	for i := 0; i < count; i++ {
 		response, err := c.CreateCampsite(context.Background(), newCreateCampsiteRequest())
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE campgrounds
+(
+    id             bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    campground_id  varchar(255)                            NOT NULL,
+    name           varchar(255)                            NOT NULL,
+    description    text                                    NOT NULL DEFAULT '',
+    active         boolean                                 NOT NULL,
+    created_at     timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_campgrounds PRIMARY KEY (id)
+);
+
+CREATE TRIGGER campgrounds_update_moddatetime_trigger
+    BEFORE UPDATE ON campgrounds
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_campgrounds_campground_id ON campgrounds (campground_id);
+CREATE UNIQUE INDEX unique_campgrounds_name ON campgrounds (name);
+
+ALTER TABLE campsites ADD COLUMN campground_id varchar(255);
+ALTER TABLE campsites ADD CONSTRAINT fk_campsites_campground_id_campgrounds
+    FOREIGN KEY (campground_id) REFERENCES campgrounds (campground_id);
+
+CREATE INDEX idx_campsites_campground_id ON campsites (campground_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_campsites_campground_id;
+ALTER TABLE campsites DROP CONSTRAINT IF EXISTS fk_campsites_campground_id_campgrounds;
+ALTER TABLE campsites DROP COLUMN IF EXISTS campground_id;
+DROP TABLE IF EXISTS campgrounds;
@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE campsite_rates
+(
+    id                   bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    campsite_id          varchar(255)                            NOT NULL,
+    currency             varchar(3)                              NOT NULL,
+    nightly_rate         bigint                                  NOT NULL,
+    weekend_nightly_rate bigint                                  NOT NULL DEFAULT 0,
+    included_guests      int                                     NOT NULL DEFAULT 1,
+    extra_guest_fee      bigint                                  NOT NULL DEFAULT 0,
+    tax_rate_bps         int                                     NOT NULL DEFAULT 0,
+    created_at           timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at           timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_campsite_rates PRIMARY KEY (id),
+    CONSTRAINT fk_campsite_rates_campsite_id_campsites FOREIGN KEY (campsite_id) REFERENCES campsites (campsite_id)
+);
+
+CREATE TRIGGER campsite_rates_update_moddatetime_trigger
+    BEFORE UPDATE ON campsite_rates
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_campsite_rates_campsite_id ON campsite_rates (campsite_id);
+
+CREATE TABLE campsite_seasonal_rates
+(
+    id                   bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    campsite_id          varchar(255)                            NOT NULL,
+    name                 varchar(50)                             NOT NULL,
+    start_date           date                                    NOT NULL,
+    end_date             date                                    NOT NULL,
+    nightly_rate         bigint                                  NOT NULL,
+    weekend_nightly_rate bigint                                  NOT NULL DEFAULT 0,
+    created_at           timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_campsite_seasonal_rates PRIMARY KEY (id),
+    CONSTRAINT fk_campsite_seasonal_rates_campsite_id_campsite_rates FOREIGN KEY (campsite_id) REFERENCES campsite_rates (campsite_id) ON DELETE CASCADE,
+    CONSTRAINT chk_campsite_seasonal_rates_dates CHECK (start_date < end_date)
+);
+
+CREATE INDEX idx_campsite_seasonal_rates_campsite_id ON campsite_seasonal_rates (campsite_id);
+
+ALTER TABLE bookings ADD COLUMN guests int NOT NULL DEFAULT 1;
+ALTER TABLE bookings ADD COLUMN total_price bigint NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN currency varchar(3) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE bookings DROP COLUMN IF EXISTS currency;
+ALTER TABLE bookings DROP COLUMN IF EXISTS total_price;
+ALTER TABLE bookings DROP COLUMN IF EXISTS guests;
+DROP TABLE IF EXISTS campsite_seasonal_rates;
+DROP TABLE IF EXISTS campsite_rates;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE bookings ADD COLUMN deposit_amount bigint NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN payment_ref varchar(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE bookings DROP COLUMN IF EXISTS payment_ref;
+ALTER TABLE bookings DROP COLUMN IF EXISTS deposit_amount;
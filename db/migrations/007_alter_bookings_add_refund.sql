@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE bookings ADD COLUMN refund_percent int NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN refund_amount bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE bookings DROP COLUMN IF EXISTS refund_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS refund_percent;
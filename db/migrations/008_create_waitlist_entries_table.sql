@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE waitlist_entries
+(
+    id                bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    entry_id          varchar(255)                            NOT NULL,
+    campground_id     varchar(255)                            NOT NULL,
+    campsite_id       varchar(255)                            NOT NULL DEFAULT '',
+    email             varchar(255)                            NOT NULL,
+    full_name         varchar(255)                            NOT NULL,
+    start_date        date                                    NOT NULL,
+    end_date          date                                    NOT NULL,
+    guests            int                                     NOT NULL DEFAULT 1,
+    status            varchar(20)                             NOT NULL,
+    offer_campsite_id varchar(255)                            NOT NULL DEFAULT '',
+    offer_expires_at  timestamptz,
+    booking_id        varchar(255)                            NOT NULL DEFAULT '',
+    created_at        timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_waitlist_entries PRIMARY KEY (id),
+    CONSTRAINT fk_waitlist_entries_campground_id_campgrounds FOREIGN KEY (campground_id) REFERENCES campgrounds (campground_id) ON DELETE CASCADE,
+    CONSTRAINT chk_waitlist_entries_dates CHECK (start_date < end_date)
+);
+
+CREATE TRIGGER waitlist_entries_update_moddatetime_trigger
+    BEFORE UPDATE ON waitlist_entries
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_waitlist_entries_entry_id ON waitlist_entries (entry_id);
+CREATE INDEX idx_waitlist_entries_campground_id_status ON waitlist_entries (campground_id, status);
+
+-- +goose Down
+DROP TABLE IF EXISTS waitlist_entries;
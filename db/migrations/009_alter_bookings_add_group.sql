@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE bookings ADD COLUMN group_id varchar(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_bookings_group_id ON bookings (group_id) WHERE group_id <> '';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_group_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS group_id;
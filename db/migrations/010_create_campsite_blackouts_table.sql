@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE campsite_blackouts
+(
+    id          bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    blackout_id varchar(255)                            NOT NULL,
+    campsite_id varchar(255)                            NOT NULL,
+    start_date  date                                    NOT NULL,
+    end_date    date                                    NOT NULL,
+    reason      varchar(255)                            NOT NULL,
+    created_at  timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_campsite_blackouts PRIMARY KEY (id),
+    CONSTRAINT fk_campsite_blackouts_campsite_id_campsites FOREIGN KEY (campsite_id) REFERENCES campsites (campsite_id),
+    CONSTRAINT chk_campsite_blackouts_dates CHECK (start_date < end_date)
+);
+
+CREATE TRIGGER campsite_blackouts_update_moddatetime_trigger
+    BEFORE UPDATE ON campsite_blackouts
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_campsite_blackouts_blackout_id ON campsite_blackouts (blackout_id);
+CREATE INDEX idx_campsite_blackouts_campsite_id_dates ON campsite_blackouts (campsite_id, start_date, end_date);
+
+-- +goose Down
+DROP TABLE IF EXISTS campsite_blackouts;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE campground_seasons
+(
+    id              bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    campground_id   varchar(255)                            NOT NULL,
+    opens_on        varchar(5)                              NOT NULL DEFAULT '',
+    closes_on       varchar(5)                              NOT NULL DEFAULT '',
+    closed_weekdays varchar(100)                            NOT NULL DEFAULT '',
+    created_at      timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_campground_seasons PRIMARY KEY (id),
+    CONSTRAINT fk_campground_seasons_campground_id_campgrounds FOREIGN KEY (campground_id) REFERENCES campgrounds (campground_id) ON DELETE CASCADE,
+    CONSTRAINT chk_campground_seasons_opens_on_closes_on CHECK ((opens_on = '') = (closes_on = ''))
+);
+
+CREATE TRIGGER campground_seasons_update_moddatetime_trigger
+    BEFORE UPDATE ON campground_seasons
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_campground_seasons_campground_id ON campground_seasons (campground_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS campground_seasons;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE bookings ADD COLUMN status varchar(20) NOT NULL DEFAULT 'CONFIRMED';
+UPDATE bookings SET status = 'CANCELLED' WHERE active = FALSE;
+ALTER TABLE bookings ADD CONSTRAINT chk_bookings_status
+    CHECK (status IN ('PENDING', 'CONFIRMED', 'CHECKED_IN', 'CHECKED_OUT', 'CANCELLED', 'NO_SHOW'));
+ALTER TABLE bookings DROP COLUMN active;
+
+CREATE INDEX idx_bookings_campsite_id_status ON bookings (campsite_id, status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_campsite_id_status;
+
+ALTER TABLE bookings ADD COLUMN active boolean NOT NULL DEFAULT TRUE;
+UPDATE bookings SET active = status IN ('PENDING', 'CONFIRMED', 'CHECKED_IN');
+ALTER TABLE bookings ALTER COLUMN active DROP DEFAULT;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS chk_bookings_status;
+ALTER TABLE bookings DROP COLUMN IF EXISTS status;
@@ -0,0 +1,42 @@
+-- +goose Up
+CREATE TABLE guests
+(
+    id          bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    guest_id    varchar(255)                            NOT NULL,
+    email       varchar(255)                            NOT NULL,
+    full_name   varchar(255)                            NOT NULL,
+    phone       varchar(50)                             NOT NULL DEFAULT '',
+    address     varchar(255)                            NOT NULL DEFAULT '',
+    preferences varchar(1000)                           NOT NULL DEFAULT '',
+    created_at  timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_guests PRIMARY KEY (id)
+);
+
+CREATE TRIGGER guests_update_moddatetime_trigger
+    BEFORE UPDATE ON guests
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_guests_guest_id ON guests (guest_id);
+CREATE UNIQUE INDEX unique_guests_email ON guests (email);
+
+ALTER TABLE bookings ADD COLUMN guest_id varchar(255) NOT NULL DEFAULT '';
+
+-- existing bookings are matched to guests by email, the latest full name wins
+INSERT INTO guests (guest_id, email, full_name)
+SELECT DISTINCT ON (lower(email)) gen_random_uuid()::text, lower(email), full_name
+FROM bookings
+ORDER BY lower(email), created_at DESC;
+
+UPDATE bookings b
+SET guest_id = g.guest_id
+FROM guests g
+WHERE g.email = lower(b.email);
+
+CREATE INDEX idx_bookings_guest_id ON bookings (guest_id) WHERE guest_id <> '';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_guest_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS guest_id;
+DROP TABLE IF EXISTS guests;
@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE calendar_subscriptions
+(
+    id              bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    subscription_id varchar(255)                            NOT NULL,
+    campsite_id     varchar(255)                            NOT NULL,
+    name            varchar(255)                            NOT NULL,
+    url             varchar(2048)                           NOT NULL DEFAULT '',
+    last_synced_at  timestamptz,
+    last_sync_error varchar(1000)                           NOT NULL DEFAULT '',
+    created_at      timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      timestamptz                             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_calendar_subscriptions PRIMARY KEY (id),
+    CONSTRAINT fk_calendar_subscriptions_campsite_id_campsites FOREIGN KEY (campsite_id) REFERENCES campsites (campsite_id)
+);
+
+CREATE TRIGGER calendar_subscriptions_update_moddatetime_trigger
+    BEFORE UPDATE ON calendar_subscriptions
+    FOR EACH ROW
+    EXECUTE PROCEDURE moddatetime (updated_at);
+
+CREATE UNIQUE INDEX unique_calendar_subscriptions_subscription_id ON calendar_subscriptions (subscription_id);
+CREATE INDEX idx_calendar_subscriptions_campsite_id ON calendar_subscriptions (campsite_id);
+
+CREATE TABLE external_blocks
+(
+    id              bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    subscription_id varchar(255)                            NOT NULL,
+    campsite_id     varchar(255)                            NOT NULL,
+    uid             varchar(1000)                           NOT NULL,
+    start_date      date                                    NOT NULL,
+    end_date        date                                    NOT NULL,
+    summary         varchar(1000)                           NOT NULL DEFAULT '',
+    CONSTRAINT pk_external_blocks PRIMARY KEY (id),
+    CONSTRAINT fk_external_blocks_subscription_id_calendar_subscriptions FOREIGN KEY (subscription_id)
+        REFERENCES calendar_subscriptions (subscription_id) ON DELETE CASCADE,
+    CONSTRAINT chk_external_blocks_dates CHECK (start_date < end_date)
+);
+
+CREATE INDEX idx_external_blocks_subscription_id ON external_blocks (subscription_id);
+CREATE INDEX idx_external_blocks_campsite_id_dates ON external_blocks (campsite_id, start_date, end_date);
+
+-- +goose Down
+DROP TABLE IF EXISTS external_blocks;
+DROP TABLE IF EXISTS calendar_subscriptions;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- the bookings and waitlist entries of a person are looked up by email to
+-- export or anonymize their data, anonymized bookings have an empty email
+CREATE INDEX idx_bookings_lower_email ON bookings (lower(email)) WHERE email <> '';
+CREATE INDEX idx_waitlist_entries_lower_email ON waitlist_entries (lower(email));
+-- bookings still holding personal data, by end of stay for the retention job
+CREATE INDEX idx_bookings_end_date_not_anonymized ON bookings (end_date) WHERE email <> '';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_end_date_not_anonymized;
+DROP INDEX IF EXISTS idx_waitlist_entries_lower_email;
+DROP INDEX IF EXISTS idx_bookings_lower_email;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- unexpired offers hold their campsite, they are looked up by campsite on
+-- every booking and by expiry for the expiry job
+CREATE INDEX idx_waitlist_entries_offer_campsite_id ON waitlist_entries (offer_campsite_id) WHERE status = 'OFFERED';
+CREATE INDEX idx_waitlist_entries_offer_expires_at ON waitlist_entries (offer_expires_at) WHERE status = 'OFFERED';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_waitlist_entries_offer_expires_at;
+DROP INDEX IF EXISTS idx_waitlist_entries_offer_campsite_id;
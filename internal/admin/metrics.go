@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+)
+
+const (
+	gauge   = "gauge"
+	counter = "counter"
+)
+
+// Metrics writes the runtime, database pool and build metrics in the text
+// exposition format of Prometheus.
+func (s server) Metrics(w http.ResponseWriter, _ *http.Request) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	db := s.db.Stats()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeMetric(
+		w, "campgrounds_build_info", gauge, "Build information of the app.", 1,
+		"version", s.buildInfo.Version,
+		"revision", s.buildInfo.Revision,
+		"go_version", s.buildInfo.GoVersion,
+	)
+	writeMetric(w, "process_start_time_seconds", gauge,
+		"Start time of the process since unix epoch in seconds.",
+		float64(s.startedAt.UnixNano())/1e9)
+	writeMetric(w, "go_goroutines", gauge, "Number of goroutines that currently exist.",
+		float64(runtime.NumGoroutine()))
+	writeMetric(w, "go_memstats_heap_alloc_bytes", gauge,
+		"Number of heap bytes allocated and still in use.", float64(mem.HeapAlloc))
+	writeMetric(w, "go_memstats_sys_bytes", gauge,
+		"Number of bytes obtained from system.", float64(mem.Sys))
+	writeMetric(w, "go_gc_cycles_total", counter,
+		"Number of completed GC cycles.", float64(mem.NumGC))
+	writeMetric(w, "campgrounds_db_max_open_connections", gauge,
+		"Maximum number of open connections to the database.", float64(db.MaxOpenConnections))
+	writeMetric(w, "campgrounds_db_open_connections", gauge,
+		"Number of established connections to the database.", float64(db.OpenConnections))
+	writeMetric(w, "campgrounds_db_in_use_connections", gauge,
+		"Number of connections currently in use.", float64(db.InUse))
+	writeMetric(w, "campgrounds_db_idle_connections", gauge,
+		"Number of idle connections.", float64(db.Idle))
+	writeMetric(w, "campgrounds_db_wait_count_total", counter,
+		"Total number of connections waited for.", float64(db.WaitCount))
+	writeMetric(w, "campgrounds_db_wait_duration_seconds_total", counter,
+		"Total time blocked waiting for a new connection.", db.WaitDuration.Seconds())
+}
+
+// writeMetric writes a metric with a single sample, labels are given as
+// name and value pairs.
+func writeMetric(w io.Writer, name, kind, help string, value float64, labels ...string) {
+	var sample strings.Builder
+	sample.WriteString(name)
+	if len(labels) > 0 {
+		sample.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				sample.WriteByte(',')
+			}
+			_, _ = fmt.Fprintf(&sample, "%s=%q", labels[i], labels[i+1])
+		}
+		sample.WriteByte('}')
+	}
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %v\n",
+		name, help, name, kind, sample.String(), value)
+}
@@ -0,0 +1,106 @@
+// Package admin serves the operational endpoints of the app: health checks,
+// apart from the others as they are to be reached by the probes, and metrics,
+// build info, log level and, optionally, pprof profiles.
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"runtime/debug"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
+)
+
+// readinessTimeout bounds the checks of the readiness endpoint.
+const readinessTimeout = 2 * time.Second
+
+type (
+	// BuildInfo describes the binary of the app, as embedded by the Go toolchain.
+	BuildInfo struct {
+		GoVersion string `json:"goVersion"`
+		Version   string `json:"version"`
+		Revision  string `json:"revision,omitempty"`
+		Time      string `json:"revisionTime,omitempty"`
+		Modified  bool   `json:"modified"`
+	}
+
+	server struct {
+		db        *sql.DB
+		buildInfo BuildInfo
+		startedAt time.Time
+	}
+)
+
+// RegisterProbes registers the liveness and readiness endpoints, which change
+// nothing and reveal nothing but whether the database can be reached.
+func RegisterProbes(db *sql.DB, mux *http.ServeMux) {
+	s := server{db: db}
+	mux.HandleFunc("GET /livez", s.Liveness)
+	mux.HandleFunc("GET /readyz", s.Readiness)
+}
+
+// RegisterServer registers the admin endpoints; the pprof endpoints expose
+// the internals of the process and are registered only if enabled.
+func RegisterServer(db *sql.DB, level *slog.LevelVar, enablePprof bool, mux *http.ServeMux) {
+	s := server{db: db, buildInfo: ReadBuildInfo(), startedAt: time.Now()}
+	mux.HandleFunc("GET /metrics", s.Metrics)
+	mux.HandleFunc("GET /buildinfo", s.BuildInfo)
+	logger.RegisterLevelHandler(level, mux)
+
+	if enablePprof {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+}
+
+// Liveness reports that the process is up, regardless of its dependencies.
+func (s server) Liveness(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// Readiness reports whether the app can serve requests, i.e. whether the
+// database is reachable.
+func (s server) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+	if err := s.db.PingContext(ctx); err != nil {
+		slog.WarnContext(ctx, "readiness check failed", slog.Any("error", err))
+		http.Error(w, "db: unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// BuildInfo writes the build info of the running binary as JSON.
+func (s server) BuildInfo(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.buildInfo)
+}
+
+// ReadBuildInfo returns the build info of the running binary.
+func ReadBuildInfo() BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return BuildInfo{Version: "unknown"}
+	}
+	b := BuildInfo{GoVersion: info.GoVersion, Version: info.Main.Version}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			b.Revision = setting.Value
+		case "vcs.time":
+			b.Time = setting.Value
+		case "vcs.modified":
+			b.Modified = setting.Value == "true"
+		}
+	}
+	return b
+}
@@ -0,0 +1,147 @@
+package admin
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmin_RegisterServer(t *testing.T) {
+	tests := map[string]struct {
+		path       string
+		pprof      bool
+		wantStatus int
+		wantBody   []string
+	}{
+		"Liveness_NotServed": {
+			path:       "/livez",
+			wantStatus: http.StatusNotFound,
+		},
+		"Metrics": {
+			path:       "/metrics",
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"# TYPE campgrounds_build_info gauge",
+				"campgrounds_build_info{version=",
+				"go_goroutines ",
+				"campgrounds_db_open_connections ",
+			},
+		},
+		"BuildInfo": {
+			path:       "/buildinfo",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{`"goVersion":"go`},
+		},
+		"LogLevel": {
+			path:       "/admin/log-level",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{`{"level":"INFO"}`},
+		},
+		"Pprof_Enabled": {
+			path:       "/debug/pprof/",
+			pprof:      true,
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"goroutine"},
+		},
+		"Pprof_Disabled": {
+			path:       "/debug/pprof/",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, _, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			mux := http.NewServeMux()
+			RegisterServer(db, new(slog.LevelVar), tc.pprof, mux)
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+			// when
+			mux.ServeHTTP(rec, req)
+			// then
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			for _, want := range tc.wantBody {
+				assert.Contains(t, rec.Body.String(), want)
+			}
+		})
+	}
+}
+
+func TestAdmin_RegisterProbes(t *testing.T) {
+	tests := map[string]struct {
+		path       string
+		pingErr    error
+		wantStatus int
+		wantBody   []string
+	}{
+		"Liveness": {
+			path:       "/livez",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"ok"},
+		},
+		"Readiness": {
+			path:       "/readyz",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"ok"},
+		},
+		"Readiness_DBUnavailable": {
+			path:       "/readyz",
+			pingErr:    errors.New("connection refused"),
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   []string{"db: unavailable"},
+		},
+		"Metrics_NotServed": {
+			path:       "/metrics",
+			wantStatus: http.StatusNotFound,
+		},
+		"LogLevel_NotServed": {
+			path:       "/admin/log-level",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+			mock.ExpectPing().WillReturnError(tc.pingErr)
+
+			mux := http.NewServeMux()
+			RegisterProbes(db, mux)
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+			// when
+			mux.ServeHTTP(rec, req)
+			// then
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			for _, want := range tc.wantBody {
+				assert.Contains(t, rec.Body.String(), want)
+			}
+		})
+	}
+}
+
+func TestAdmin_writeMetric(t *testing.T) {
+	// given
+	var got strings.Builder
+	// when
+	writeMetric(&got, "up", gauge, "Whether the app is up.", 1, "env", "dev", "zone", "a")
+	// then
+	assert.Equal(t,
+		"# HELP up Whether the app is up.\n# TYPE up gauge\nup{env=\"dev\",zone=\"a\"} 1\n",
+		got.String())
+}
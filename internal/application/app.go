@@ -11,54 +11,330 @@ import (
 
 type (
 	App interface {
+		CreateCampground(ctx context.Context, cmd command.CreateCampground) error
+		UpdateCampground(ctx context.Context, cmd command.UpdateCampground) error
+		DeleteCampground(ctx context.Context, cmd command.DeleteCampground) error
+		SetCampgroundSeason(ctx context.Context, cmd command.SetCampgroundSeason) error
 		CreateCampsite(ctx context.Context, cmd command.CreateCampsite) error
+		ImportCampsites(ctx context.Context, cmd command.ImportCampsites) error
+		SetCampsiteRates(ctx context.Context, cmd command.SetCampsiteRates) error
+		CreateBlackout(ctx context.Context, cmd command.CreateBlackout) error
+		DeleteBlackout(ctx context.Context, cmd command.DeleteBlackout) error
+		CreateCalendarSubscription(
+			ctx context.Context,
+			cmd command.CreateCalendarSubscription,
+		) error
+		DeleteCalendarSubscription(
+			ctx context.Context,
+			cmd command.DeleteCalendarSubscription,
+		) error
+		SyncCalendarSubscriptions(ctx context.Context, cmd command.SyncCalendarSubscriptions) error
 		CreateBooking(ctx context.Context, cmd command.CreateBooking) error
 		UpdateBooking(ctx context.Context, cmd command.UpdateBooking) error
 		CancelBooking(ctx context.Context, cmd command.CancelBooking) error
+		CheckIn(ctx context.Context, cmd command.CheckIn) error
+		CheckOut(ctx context.Context, cmd command.CheckOut) error
+		MarkNoShow(ctx context.Context, cmd command.MarkNoShow) error
+		CreateGroupBooking(ctx context.Context, cmd command.CreateGroupBooking) error
+		UpdateGroupBooking(ctx context.Context, cmd command.UpdateGroupBooking) error
+		CancelGroupBooking(ctx context.Context, cmd command.CancelGroupBooking) error
+		JoinWaitlist(ctx context.Context, cmd command.JoinWaitlist) error
+		LeaveWaitlist(ctx context.Context, cmd command.LeaveWaitlist) error
+		AcceptWaitlistOffer(ctx context.Context, cmd command.AcceptWaitlistOffer) error
+		ExpireWaitlistOffers(ctx context.Context, cmd command.ExpireWaitlistOffers) error
+		UpdateGuest(ctx context.Context, cmd command.UpdateGuest) error
+		AnonymizeGuest(ctx context.Context, cmd command.AnonymizeGuest) error
+		ApplyRetentionPolicy(ctx context.Context, cmd command.ApplyRetentionPolicy) error
+		GetCampground(ctx context.Context, qry query.GetCampground) (*domain.Campground, error)
+		GetCampgrounds(
+			ctx context.Context,
+			qry query.GetCampgrounds,
+		) ([]*domain.Campground, error)
+		GetCampgroundSeason(
+			ctx context.Context,
+			qry query.GetCampgroundSeason,
+		) (*domain.CampgroundSeason, error)
 		GetCampsites(ctx context.Context, qry query.GetCampsites) ([]*domain.Campsite, error)
+		GetCampsiteRates(
+			ctx context.Context,
+			qry query.GetCampsiteRates,
+		) (*domain.CampsiteRates, error)
+		ListBlackouts(
+			ctx context.Context,
+			qry query.ListBlackouts,
+		) ([]*domain.CampsiteBlackout, error)
+		ListCalendarSubscriptions(
+			ctx context.Context,
+			qry query.ListCalendarSubscriptions,
+		) ([]*domain.CalendarSubscription, error)
+		GetCampsiteCalendar(
+			ctx context.Context,
+			qry query.GetCampsiteCalendar,
+		) (*domain.CampsiteCalendar, error)
 		GetBooking(ctx context.Context, qry query.GetBooking) (*domain.Booking, error)
-		GetVacantDates(ctx context.Context, qry query.GetVacantDates) ([]string, error)
+		GetGroupBooking(ctx context.Context, qry query.GetGroupBooking) ([]*domain.Booking, error)
+		QuoteBooking(ctx context.Context, qry query.QuoteBooking) (*domain.Quote, error)
+		GetVacantDates(ctx context.Context, qry query.GetVacantDates) (*domain.Vacancy, error)
+		ListWaitlist(
+			ctx context.Context,
+			qry query.ListWaitlist,
+		) ([]*domain.WaitlistEntry, error)
+		GetGuest(ctx context.Context, qry query.GetGuest) (*domain.Guest, error)
+		ListGuestBookings(
+			ctx context.Context,
+			qry query.ListGuestBookings,
+		) ([]*domain.Booking, error)
+		ExportGuestData(ctx context.Context, qry query.ExportGuestData) (*domain.GuestData, error)
 	}
 
 	commands struct {
+		command.CreateCampgroundHandler
+		command.UpdateCampgroundHandler
+		command.DeleteCampgroundHandler
+		command.SetCampgroundSeasonHandler
 		command.CreateCampsiteHandler
+		command.ImportCampsitesHandler
+		command.SetCampsiteRatesHandler
+		command.CreateBlackoutHandler
+		command.DeleteBlackoutHandler
+		command.CreateCalendarSubscriptionHandler
+		command.DeleteCalendarSubscriptionHandler
+		command.SyncCalendarSubscriptionsHandler
 		command.CreateBookingHandler
 		command.UpdateBookingHandler
 		command.CancelBookingHandler
+		command.CheckInHandler
+		command.CheckOutHandler
+		command.MarkNoShowHandler
+		command.CreateGroupBookingHandler
+		command.UpdateGroupBookingHandler
+		command.CancelGroupBookingHandler
+		command.JoinWaitlistHandler
+		command.LeaveWaitlistHandler
+		command.AcceptWaitlistOfferHandler
+		command.ExpireWaitlistOffersHandler
+		command.UpdateGuestHandler
+		command.AnonymizeGuestHandler
+		command.ApplyRetentionPolicyHandler
 	}
 
 	queries struct {
+		query.GetCampgroundHandler
+		query.GetCampgroundsHandler
+		query.GetCampgroundSeasonHandler
 		query.GetCampsitesHandler
+		query.GetCampsiteRatesHandler
+		query.ListBlackoutsHandler
+		query.ListCalendarSubscriptionsHandler
+		query.GetCampsiteCalendarHandler
 		query.GetBookingHandler
+		query.GetGroupBookingHandler
+		query.QuoteBookingHandler
 		query.GetVacantDatesHandler
+		query.ListWaitlistHandler
+		query.GetGuestHandler
+		query.ListGuestBookingsHandler
+		query.ExportGuestDataHandler
 	}
 
+	// CampgroundsApp runs the commands with read-your-writes, as they check
+	// what they read, e.g. the vacancy of a campsite, before writing.
 	CampgroundsApp struct {
 		commands
 		queries
 	}
 )
 
-var bookingValidators = []domain.BookingValidator{
-	validator.BookingStartDateBeforeEndDate{},
-	validator.BookingAllowedStartDate{},
-	validator.BookingMaximumStay{},
+func bookingValidators(
+	campsites domain.CampsiteRepository,
+	seasons domain.CampgroundSeasonRepository,
+	seasonPolicy domain.SeasonPolicy,
+) []domain.BookingValidator {
+	return []domain.BookingValidator{
+		validator.BookingStartDateBeforeEndDate{},
+		validator.BookingAllowedStartDate{},
+		validator.BookingMaximumStay{},
+		validator.BookingWithinSeason{
+			Campsites: campsites,
+			Seasons:   seasons,
+			Policy:    seasonPolicy,
+		},
+	}
+}
+
+func (a CampgroundsApp) CreateCampground(
+	ctx context.Context,
+	cmd command.CreateCampground,
+) error {
+	return a.CreateCampgroundHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) UpdateCampground(
+	ctx context.Context,
+	cmd command.UpdateCampground,
+) error {
+	return a.UpdateCampgroundHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) DeleteCampground(
+	ctx context.Context,
+	cmd command.DeleteCampground,
+) error {
+	return a.DeleteCampgroundHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) SetCampgroundSeason(
+	ctx context.Context,
+	cmd command.SetCampgroundSeason,
+) error {
+	return a.SetCampgroundSeasonHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
 }
 
 func (a CampgroundsApp) CreateCampsite(ctx context.Context, cmd command.CreateCampsite) error {
-	return a.CreateCampsiteHandler.Handle(ctx, cmd)
+	return a.CreateCampsiteHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) ImportCampsites(ctx context.Context, cmd command.ImportCampsites) error {
+	return a.ImportCampsitesHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) SetCampsiteRates(
+	ctx context.Context,
+	cmd command.SetCampsiteRates,
+) error {
+	return a.SetCampsiteRatesHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CreateBlackout(ctx context.Context, cmd command.CreateBlackout) error {
+	return a.CreateBlackoutHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) DeleteBlackout(ctx context.Context, cmd command.DeleteBlackout) error {
+	return a.DeleteBlackoutHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CreateCalendarSubscription(
+	ctx context.Context,
+	cmd command.CreateCalendarSubscription,
+) error {
+	return a.CreateCalendarSubscriptionHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) DeleteCalendarSubscription(
+	ctx context.Context,
+	cmd command.DeleteCalendarSubscription,
+) error {
+	return a.DeleteCalendarSubscriptionHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) SyncCalendarSubscriptions(
+	ctx context.Context,
+	cmd command.SyncCalendarSubscriptions,
+) error {
+	return a.SyncCalendarSubscriptionsHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
 }
 
 func (a CampgroundsApp) CreateBooking(ctx context.Context, cmd command.CreateBooking) error {
-	return a.CreateBookingHandler.Handle(ctx, cmd)
+	return a.CreateBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
 }
 
 func (a CampgroundsApp) UpdateBooking(ctx context.Context, cmd command.UpdateBooking) error {
-	return a.UpdateBookingHandler.Handle(ctx, cmd)
+	return a.UpdateBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
 }
 
 func (a CampgroundsApp) CancelBooking(ctx context.Context, cmd command.CancelBooking) error {
-	return a.CancelBookingHandler.Handle(ctx, cmd)
+	return a.CancelBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CheckIn(ctx context.Context, cmd command.CheckIn) error {
+	return a.CheckInHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CheckOut(ctx context.Context, cmd command.CheckOut) error {
+	return a.CheckOutHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) MarkNoShow(ctx context.Context, cmd command.MarkNoShow) error {
+	return a.MarkNoShowHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CreateGroupBooking(
+	ctx context.Context,
+	cmd command.CreateGroupBooking,
+) error {
+	return a.CreateGroupBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) UpdateGroupBooking(
+	ctx context.Context,
+	cmd command.UpdateGroupBooking,
+) error {
+	return a.UpdateGroupBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) CancelGroupBooking(
+	ctx context.Context,
+	cmd command.CancelGroupBooking,
+) error {
+	return a.CancelGroupBookingHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) JoinWaitlist(ctx context.Context, cmd command.JoinWaitlist) error {
+	return a.JoinWaitlistHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) LeaveWaitlist(ctx context.Context, cmd command.LeaveWaitlist) error {
+	return a.LeaveWaitlistHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) AcceptWaitlistOffer(
+	ctx context.Context,
+	cmd command.AcceptWaitlistOffer,
+) error {
+	return a.AcceptWaitlistOfferHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) ExpireWaitlistOffers(
+	ctx context.Context,
+	cmd command.ExpireWaitlistOffers,
+) error {
+	return a.ExpireWaitlistOffersHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) UpdateGuest(ctx context.Context, cmd command.UpdateGuest) error {
+	return a.UpdateGuestHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) AnonymizeGuest(ctx context.Context, cmd command.AnonymizeGuest) error {
+	return a.AnonymizeGuestHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) ApplyRetentionPolicy(
+	ctx context.Context,
+	cmd command.ApplyRetentionPolicy,
+) error {
+	return a.ApplyRetentionPolicyHandler.Handle(domain.WithReadYourWrites(ctx), cmd)
+}
+
+func (a CampgroundsApp) GetCampground(
+	ctx context.Context,
+	qry query.GetCampground,
+) (*domain.Campground, error) {
+	return a.GetCampgroundHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) GetCampgrounds(
+	ctx context.Context,
+	qry query.GetCampgrounds,
+) ([]*domain.Campground, error) {
+	return a.GetCampgroundsHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) GetCampgroundSeason(
+	ctx context.Context,
+	qry query.GetCampgroundSeason,
+) (*domain.CampgroundSeason, error) {
+	return a.GetCampgroundSeasonHandler.Handle(ctx, qry)
 }
 
 func (a CampgroundsApp) GetCampsites(
@@ -68,6 +344,34 @@ func (a CampgroundsApp) GetCampsites(
 	return a.GetCampsitesHandler.Handle(ctx, qry)
 }
 
+func (a CampgroundsApp) GetCampsiteRates(
+	ctx context.Context,
+	qry query.GetCampsiteRates,
+) (*domain.CampsiteRates, error) {
+	return a.GetCampsiteRatesHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) ListBlackouts(
+	ctx context.Context,
+	qry query.ListBlackouts,
+) ([]*domain.CampsiteBlackout, error) {
+	return a.ListBlackoutsHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) ListCalendarSubscriptions(
+	ctx context.Context,
+	qry query.ListCalendarSubscriptions,
+) ([]*domain.CalendarSubscription, error) {
+	return a.ListCalendarSubscriptionsHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) GetCampsiteCalendar(
+	ctx context.Context,
+	qry query.GetCampsiteCalendar,
+) (*domain.CampsiteCalendar, error) {
+	return a.GetCampsiteCalendarHandler.Handle(ctx, qry)
+}
+
 func (a CampgroundsApp) GetBooking(
 	ctx context.Context,
 	qry query.GetBooking,
@@ -75,27 +379,161 @@ func (a CampgroundsApp) GetBooking(
 	return a.GetBookingHandler.Handle(ctx, qry)
 }
 
+func (a CampgroundsApp) GetGroupBooking(
+	ctx context.Context,
+	qry query.GetGroupBooking,
+) ([]*domain.Booking, error) {
+	return a.GetGroupBookingHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) QuoteBooking(
+	ctx context.Context,
+	qry query.QuoteBooking,
+) (*domain.Quote, error) {
+	return a.QuoteBookingHandler.Handle(ctx, qry)
+}
+
 func (a CampgroundsApp) GetVacantDates(
 	ctx context.Context,
 	qry query.GetVacantDates,
-) ([]string, error) {
+) (*domain.Vacancy, error) {
 	return a.GetVacantDatesHandler.Handle(ctx, qry)
 }
 
+func (a CampgroundsApp) ListWaitlist(
+	ctx context.Context,
+	qry query.ListWaitlist,
+) ([]*domain.WaitlistEntry, error) {
+	return a.ListWaitlistHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) GetGuest(ctx context.Context, qry query.GetGuest) (*domain.Guest, error) {
+	return a.GetGuestHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) ListGuestBookings(
+	ctx context.Context,
+	qry query.ListGuestBookings,
+) ([]*domain.Booking, error) {
+	return a.ListGuestBookingsHandler.Handle(ctx, qry)
+}
+
+func (a CampgroundsApp) ExportGuestData(
+	ctx context.Context,
+	qry query.ExportGuestData,
+) (*domain.GuestData, error) {
+	return a.ExportGuestDataHandler.Handle(ctx, qry)
+}
+
 var _ App = (*CampgroundsApp)(nil)
 
-func New(campsites domain.CampsiteRepository, bookings domain.BookingRepository) *CampgroundsApp {
+func New(
+	campgrounds domain.CampgroundRepository,
+	campsites domain.CampsiteRepository,
+	bookings domain.BookingRepository,
+	guests domain.GuestRepository,
+	rates domain.CampsiteRatesRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+	seasons domain.CampgroundSeasonRepository,
+	waitlist domain.WaitlistRepository,
+	subscriptions domain.CalendarSubscriptionRepository,
+	payments domain.PaymentGateway,
+	calendars domain.ExternalCalendarReader,
+	deposit domain.DepositPolicy,
+	cancellation domain.CancellationPolicy,
+	waitlistPolicy domain.WaitlistPolicy,
+	seasonPolicy domain.SeasonPolicy,
+	retention domain.RetentionPolicy,
+) *CampgroundsApp {
+	validators := bookingValidators(campsites, seasons, seasonPolicy)
+	createBooking := command.NewCreateBookingHandler(
+		bookings, guests, rates, payments, deposit, validators,
+	)
+	offers := command.NewWaitlistOffers(
+		campsites, bookings, blackouts, subscriptions, waitlist, validators, waitlistPolicy,
+	)
 	return &CampgroundsApp{
 		commands: commands{
-			CreateCampsiteHandler: command.NewCreateCampsiteHandler(campsites),
-			CreateBookingHandler:  command.NewCreateBookingHandler(bookings, bookingValidators),
-			UpdateBookingHandler:  command.NewUpdateBookingHandler(bookings, bookingValidators),
-			CancelBookingHandler:  command.NewCancelBookingHandler(bookings),
+			CreateCampgroundHandler: command.NewCreateCampgroundHandler(campgrounds),
+			UpdateCampgroundHandler: command.NewUpdateCampgroundHandler(campgrounds),
+			DeleteCampgroundHandler: command.NewDeleteCampgroundHandler(campgrounds),
+			SetCampgroundSeasonHandler: command.NewSetCampgroundSeasonHandler(
+				campgrounds, seasons,
+			),
+			CreateCampsiteHandler:   command.NewCreateCampsiteHandler(campgrounds, campsites),
+			ImportCampsitesHandler:  command.NewImportCampsitesHandler(campgrounds, campsites),
+			SetCampsiteRatesHandler: command.NewSetCampsiteRatesHandler(campsites, rates),
+			CreateBlackoutHandler:   command.NewCreateBlackoutHandler(campsites, blackouts),
+			DeleteBlackoutHandler:   command.NewDeleteBlackoutHandler(blackouts, offers),
+			CreateCalendarSubscriptionHandler: command.NewCreateCalendarSubscriptionHandler(
+				campsites, subscriptions, calendars,
+			),
+			DeleteCalendarSubscriptionHandler: command.NewDeleteCalendarSubscriptionHandler(
+				subscriptions,
+			),
+			SyncCalendarSubscriptionsHandler: command.NewSyncCalendarSubscriptionsHandler(
+				subscriptions, calendars,
+			),
+			CreateBookingHandler: createBooking,
+			UpdateBookingHandler: command.NewUpdateBookingHandler(
+				bookings, rates, offers, validators,
+			),
+			CancelBookingHandler: command.NewCancelBookingHandler(
+				bookings, payments, cancellation, offers,
+			),
+			CheckInHandler:    command.NewCheckInHandler(bookings),
+			CheckOutHandler:   command.NewCheckOutHandler(bookings),
+			MarkNoShowHandler: command.NewMarkNoShowHandler(bookings),
+			CreateGroupBookingHandler: command.NewCreateGroupBookingHandler(
+				bookings, guests, rates, payments, deposit, validators,
+			),
+			UpdateGroupBookingHandler: command.NewUpdateGroupBookingHandler(
+				bookings, rates, offers, validators,
+			),
+			CancelGroupBookingHandler: command.NewCancelGroupBookingHandler(
+				bookings, payments, cancellation, offers,
+			),
+			JoinWaitlistHandler: command.NewJoinWaitlistHandler(
+				campgrounds, campsites, waitlist, validators,
+			),
+			LeaveWaitlistHandler: command.NewLeaveWaitlistHandler(waitlist, offers),
+			AcceptWaitlistOfferHandler: command.NewAcceptWaitlistOfferHandler(
+				waitlist, offers, createBooking,
+			),
+			ExpireWaitlistOffersHandler: command.NewExpireWaitlistOffersHandler(
+				waitlist, offers,
+			),
+			UpdateGuestHandler:    command.NewUpdateGuestHandler(guests),
+			AnonymizeGuestHandler: command.NewAnonymizeGuestHandler(guests),
+			ApplyRetentionPolicyHandler: command.NewApplyRetentionPolicyHandler(
+				guests, retention,
+			),
 		},
 		queries: queries{
-			GetCampsitesHandler:   query.NewGetCampsitesHandler(campsites),
-			GetBookingHandler:     query.NewGetBookingHandler(bookings),
-			GetVacantDatesHandler: query.NewGetVacantDatesHandler(bookings),
+			GetCampgroundHandler:  query.NewGetCampgroundHandler(campgrounds),
+			GetCampgroundsHandler: query.NewGetCampgroundsHandler(campgrounds),
+			GetCampgroundSeasonHandler: query.NewGetCampgroundSeasonHandler(
+				campgrounds, seasons, seasonPolicy,
+			),
+			GetCampsitesHandler:     query.NewGetCampsitesHandler(campsites),
+			GetCampsiteRatesHandler: query.NewGetCampsiteRatesHandler(rates),
+			ListBlackoutsHandler:    query.NewListBlackoutsHandler(campsites, blackouts),
+			ListCalendarSubscriptionsHandler: query.NewListCalendarSubscriptionsHandler(
+				campsites, subscriptions,
+			),
+			GetCampsiteCalendarHandler: query.NewGetCampsiteCalendarHandler(
+				campsites, bookings, blackouts,
+			),
+			GetBookingHandler:      query.NewGetBookingHandler(bookings),
+			GetGroupBookingHandler: query.NewGetGroupBookingHandler(bookings),
+			QuoteBookingHandler:    query.NewQuoteBookingHandler(rates, validators),
+			GetVacantDatesHandler: query.NewGetVacantDatesHandler(
+				campsites, bookings, blackouts, seasons, subscriptions, waitlist, seasonPolicy,
+			),
+			ListWaitlistHandler:      query.NewListWaitlistHandler(waitlist),
+			GetGuestHandler:          query.NewGetGuestHandler(guests),
+			ListGuestBookingsHandler: query.NewListGuestBookingsHandler(guests, bookings),
+			ExportGuestDataHandler:   query.NewExportGuestDataHandler(guests),
 		},
 	}
 }
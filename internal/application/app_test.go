@@ -1,25 +1,116 @@
 package application
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/command"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/query"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestApp_New(t *testing.T) {
 	// given
+	campgroundRepository := domain.NewMockCampgroundRepository(t)
 	campsiteRepository := domain.NewMockCampsiteRepository(t)
 	bookingRepository := domain.NewMockBookingRepository(t)
+	guestRepository := domain.NewMockGuestRepository(t)
+	campsiteRatesRepository := domain.NewMockCampsiteRatesRepository(t)
+	campsiteBlackoutRepository := domain.NewMockCampsiteBlackoutRepository(t)
+	campgroundSeasonRepository := domain.NewMockCampgroundSeasonRepository(t)
+	waitlistRepository := domain.NewMockWaitlistRepository(t)
+	calendarSubscriptionRepository := domain.NewMockCalendarSubscriptionRepository(t)
+	paymentGateway := domain.NewMockPaymentGateway(t)
+	externalCalendarReader := domain.NewMockExternalCalendarReader(t)
 	// when
-	got := New(campsiteRepository, bookingRepository)
+	got := New(
+		campgroundRepository,
+		campsiteRepository,
+		bookingRepository,
+		guestRepository,
+		campsiteRatesRepository,
+		campsiteBlackoutRepository,
+		campgroundSeasonRepository,
+		waitlistRepository,
+		calendarSubscriptionRepository,
+		paymentGateway,
+		externalCalendarReader,
+		domain.DepositPolicy{Percent: 30},
+		domain.NewCancellationPolicy(map[int]int32{8: 100, 0: 50}),
+		domain.WaitlistPolicy{OfferTTL: time.Hour},
+		domain.SeasonPolicy{},
+		domain.RetentionPolicy{Days: 1095},
+	)
 	// then
 	assert.NotNil(t, got)
+	assert.NotNil(t, got.CreateCampgroundHandler)
+	assert.NotNil(t, got.UpdateCampgroundHandler)
+	assert.NotNil(t, got.DeleteCampgroundHandler)
+	assert.NotNil(t, got.SetCampgroundSeasonHandler)
 	assert.NotNil(t, got.CreateCampsiteHandler)
+	assert.NotNil(t, got.ImportCampsitesHandler)
+	assert.NotNil(t, got.SetCampsiteRatesHandler)
+	assert.NotNil(t, got.CreateBlackoutHandler)
+	assert.NotNil(t, got.DeleteBlackoutHandler)
+	assert.NotNil(t, got.CreateCalendarSubscriptionHandler)
+	assert.NotNil(t, got.DeleteCalendarSubscriptionHandler)
+	assert.NotNil(t, got.SyncCalendarSubscriptionsHandler)
 	assert.NotNil(t, got.CreateBookingHandler)
 	assert.NotNil(t, got.UpdateBookingHandler)
 	assert.NotNil(t, got.CancelBookingHandler)
+	assert.NotNil(t, got.CheckInHandler)
+	assert.NotNil(t, got.CheckOutHandler)
+	assert.NotNil(t, got.MarkNoShowHandler)
+	assert.NotNil(t, got.CreateGroupBookingHandler)
+	assert.NotNil(t, got.UpdateGroupBookingHandler)
+	assert.NotNil(t, got.CancelGroupBookingHandler)
+	assert.NotNil(t, got.JoinWaitlistHandler)
+	assert.NotNil(t, got.LeaveWaitlistHandler)
+	assert.NotNil(t, got.AcceptWaitlistOfferHandler)
+	assert.NotNil(t, got.ExpireWaitlistOffersHandler)
+	assert.NotNil(t, got.UpdateGuestHandler)
+	assert.NotNil(t, got.AnonymizeGuestHandler)
+	assert.NotNil(t, got.ApplyRetentionPolicyHandler)
+	assert.NotNil(t, got.GetCampgroundHandler)
+	assert.NotNil(t, got.GetCampgroundsHandler)
+	assert.NotNil(t, got.GetCampgroundSeasonHandler)
 	assert.NotNil(t, got.GetCampsitesHandler)
+	assert.NotNil(t, got.GetCampsiteRatesHandler)
+	assert.NotNil(t, got.ListBlackoutsHandler)
+	assert.NotNil(t, got.ListCalendarSubscriptionsHandler)
+	assert.NotNil(t, got.GetCampsiteCalendarHandler)
+	assert.NotNil(t, got.GetGuestHandler)
+	assert.NotNil(t, got.ListGuestBookingsHandler)
+	assert.NotNil(t, got.ExportGuestDataHandler)
 	assert.NotNil(t, got.GetBookingHandler)
+	assert.NotNil(t, got.GetGroupBookingHandler)
+	assert.NotNil(t, got.QuoteBookingHandler)
 	assert.NotNil(t, got.GetVacantDatesHandler)
+	assert.NotNil(t, got.ListWaitlistHandler)
+}
+
+func TestApp_ReadYourWrites(t *testing.T) {
+	// given
+	createBooking := command.NewMockCreateBookingHandler(t)
+	createBooking.
+		On("Handle", mock.MatchedBy(domain.ReadYourWrites), command.CreateBooking{}).
+		Return(nil)
+	getBooking := query.NewMockGetBookingHandler(t)
+	getBooking.
+		On("Handle", context.TODO(), query.GetBooking{}).
+		Return(&domain.Booking{}, nil)
+	app := CampgroundsApp{
+		commands: commands{CreateBookingHandler: createBooking},
+		queries:  queries{GetBookingHandler: getBooking},
+	}
+	// when
+	errCmd := app.CreateBooking(context.TODO(), command.CreateBooking{})
+	_, errQry := app.GetBooking(context.TODO(), query.GetBooking{})
+	// then
+	assert.NoError(t, errCmd)
+	assert.NoError(t, errQry)
+	mock.AssertExpectationsForObjects(t, createBooking, getBooking)
 }
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	AcceptWaitlistOffer struct {
+		EntryID   string
+		BookingID string
+		// Payment method token charged for the deposit of a priced booking.
+		PaymentMethod string
+		// Identifier of the guest created if no guest with the email exists.
+		GuestID string
+	}
+
+	// AcceptWaitlistOfferHandler is a logging decorator for the acceptWaitlistOfferHandler struct.
+	AcceptWaitlistOfferHandler handler.Command[AcceptWaitlistOffer]
+
+	acceptWaitlistOfferHandler struct {
+		waitlist      domain.WaitlistRepository
+		offers        WaitlistOffers
+		createBooking CreateBookingHandler
+	}
+)
+
+func NewAcceptWaitlistOfferHandler(
+	waitlist domain.WaitlistRepository,
+	offers WaitlistOffers,
+	createBooking CreateBookingHandler,
+) AcceptWaitlistOfferHandler {
+	return decorator.ApplyCommandDecorator[AcceptWaitlistOffer](acceptWaitlistOfferHandler{
+		waitlist:      waitlist,
+		offers:        offers,
+		createBooking: createBooking,
+	})
+}
+
+func (h acceptWaitlistOfferHandler) Handle(ctx context.Context, cmd AcceptWaitlistOffer) error {
+	entry, err := h.waitlist.Find(ctx, cmd.EntryID)
+	if err != nil {
+		return err
+	}
+	if entry.Status != domain.WaitlistStatusOffered {
+		return domain.ErrWaitlistOfferNotFound{EntryID: cmd.EntryID}
+	}
+	// the offer may expire before the expiry job runs
+	if entry.OfferExpired(time.Now()) {
+		if err = h.offers.expire(ctx, entry); err != nil {
+			return err
+		}
+		return domain.ErrWaitlistOfferExpired{EntryID: cmd.EntryID}
+	}
+
+	err = h.createBooking.Handle(ctx, CreateBooking{
+		BookingID:     cmd.BookingID,
+		CampsiteID:    entry.OfferCampsiteID,
+		Email:         entry.Email,
+		FullName:      entry.FullName,
+		StartDate:     entry.StartDate.Format(time.DateOnly),
+		EndDate:       entry.EndDate.Format(time.DateOnly),
+		Guests:        entry.Guests,
+		PaymentMethod: cmd.PaymentMethod,
+		GuestID:       cmd.GuestID,
+	})
+	if err != nil {
+		return err
+	}
+	entry.Status = domain.WaitlistStatusAccepted
+	entry.BookingID = cmd.BookingID
+	return h.waitlist.Update(ctx, entry)
+}
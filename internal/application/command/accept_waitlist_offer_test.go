@@ -0,0 +1,174 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAcceptWaitlistOfferHandler(t *testing.T) {
+	type mocks struct {
+		campsites     *domain.MockCampsiteRepository
+		bookings      *domain.MockBookingRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+		createBooking *MockCreateBookingHandler
+	}
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+	policy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	bookingID := uuid.New().String()
+
+	waitingEntry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	offeredEntry := *waitingEntry
+	offeredEntry.Offer(campsite.CampsiteID, time.Now(), policy)
+	expiredEntry := *waitingEntry
+	expiredEntry.Offer(campsite.CampsiteID, time.Now().Add(-2*policy.OfferTTL), policy)
+	nextEntry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+
+	createBooking := CreateBooking{
+		BookingID:     bookingID,
+		CampsiteID:    campsite.CampsiteID,
+		Email:         waitingEntry.Email,
+		FullName:      waitingEntry.FullName,
+		StartDate:     waitingEntry.StartDate.Format(time.DateOnly),
+		EndDate:       waitingEntry.EndDate.Format(time.DateOnly),
+		Guests:        waitingEntry.Guests,
+		PaymentMethod: "pm_card_visa",
+	}
+	cmd := AcceptWaitlistOffer{
+		EntryID:       waitingEntry.EntryID,
+		BookingID:     bookingID,
+		PaymentMethod: createBooking.PaymentMethod,
+	}
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: waitingEntry.StartDate,
+		EndDate:   waitingEntry.EndDate,
+	}
+
+	tests := map[string]struct {
+		cmd     AcceptWaitlistOffer
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				entry := offeredEntry
+				f.waitlist.
+					On("Find", context.TODO(), waitingEntry.EntryID).
+					Return(&entry, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.Status == domain.WaitlistStatusAccepted && e.BookingID == bookingID
+					})).
+					Return(nil)
+				f.createBooking.
+					On("Handle", context.TODO(), createBooking).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_WaitlistOfferNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), waitingEntry.EntryID).
+					Return(waitingEntry, nil)
+			},
+			wantErr: domain.ErrWaitlistOfferNotFound{EntryID: waitingEntry.EntryID},
+		},
+		"Error_WaitlistOfferExpired": {
+			cmd: cmd,
+			on: func(f mocks) {
+				entry := expiredEntry
+				f.waitlist.
+					On("Find", context.TODO(), waitingEntry.EntryID).
+					Return(&entry, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.EntryID == waitingEntry.EntryID &&
+							e.Status == domain.WaitlistStatusExpired
+					})).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						waitingEntry.StartDate, waitingEntry.EndDate).
+					Return([]*domain.WaitlistEntry{nextEntry}, nil).
+					On("FindOffered", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.EntryID == nextEntry.EntryID &&
+							e.Status == domain.WaitlistStatusOffered
+					})).
+					Return(nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: domain.ErrWaitlistOfferExpired{EntryID: waitingEntry.EntryID},
+		},
+		"Error_CreateBooking_BookingDatesNotAvailable": {
+			cmd: cmd,
+			on: func(f mocks) {
+				entry := offeredEntry
+				f.waitlist.
+					On("Find", context.TODO(), waitingEntry.EntryID).
+					Return(&entry, nil)
+				f.createBooking.
+					On("Handle", context.TODO(), createBooking).
+					Return(errBookingDatesNotAvailable)
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites:     domain.NewMockCampsiteRepository(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+				createBooking: NewMockCreateBookingHandler(t),
+			}
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil, policy,
+			)
+			h := NewAcceptWaitlistOfferHandler(m.waitlist, offers, m.createBooking)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"AcceptWaitlistOfferHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, m.createBooking)
+		})
+	}
+}
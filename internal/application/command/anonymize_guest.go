@@ -0,0 +1,35 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	AnonymizeGuest struct {
+		Email string
+	}
+
+	// AnonymizeGuestHandler is a logging decorator for the anonymizeGuestHandler struct.
+	AnonymizeGuestHandler handler.Command[AnonymizeGuest]
+
+	anonymizeGuestHandler struct {
+		guests domain.GuestRepository
+	}
+)
+
+func NewAnonymizeGuestHandler(guests domain.GuestRepository) AnonymizeGuestHandler {
+	return decorator.ApplyCommandDecorator[AnonymizeGuest](
+		anonymizeGuestHandler{guests: guests},
+	)
+}
+
+// Handle erases the personal data held about the person with the email, on
+// their request; it succeeds if none is held, e.g. when the request is
+// retried.
+func (h anonymizeGuestHandler) Handle(ctx context.Context, cmd AnonymizeGuest) error {
+	return h.guests.Anonymize(ctx, domain.NormalizeEmail(cmd.Email))
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAnonymizeGuestHandler(t *testing.T) {
+	type mocks struct {
+		guests *domain.MockGuestRepository
+	}
+	cmd := AnonymizeGuest{Email: " Jane.Doe@Example.com "}
+	email := "jane.doe@example.com"
+
+	tests := map[string]struct {
+		cmd     AnonymizeGuest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.guests.
+					On("Anonymize", context.TODO(), email).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_GuestHasActiveBookings": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.guests.
+					On("Anonymize", context.TODO(), email).
+					Return(domain.ErrGuestHasActiveBookings{})
+			},
+			wantErr: domain.ErrGuestHasActiveBookings{},
+		},
+		"Error_Anonymize": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.guests.
+					On("Anonymize", context.TODO(), email).
+					Return(bootstrap.ErrExec)
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests: domain.NewMockGuestRepository(t),
+			}
+			h := NewAnonymizeGuestHandler(m.guests)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"AnonymizeGuestHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests)
+		})
+	}
+}
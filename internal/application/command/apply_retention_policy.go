@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ApplyRetentionPolicy struct{}
+
+	// ApplyRetentionPolicyHandler is a logging decorator for the applyRetentionPolicyHandler struct.
+	ApplyRetentionPolicyHandler handler.Command[ApplyRetentionPolicy]
+
+	applyRetentionPolicyHandler struct {
+		guests domain.GuestRepository
+		policy domain.RetentionPolicy
+	}
+)
+
+func NewApplyRetentionPolicyHandler(
+	guests domain.GuestRepository,
+	policy domain.RetentionPolicy,
+) ApplyRetentionPolicyHandler {
+	return decorator.ApplyCommandDecorator[ApplyRetentionPolicy](
+		applyRetentionPolicyHandler{guests: guests, policy: policy},
+	)
+}
+
+// Handle anonymizes the bookings whose stay ended more than the retention
+// period ago, which are kept for occupancy statistics; nothing is done if
+// the policy is disabled.
+func (h applyRetentionPolicyHandler) Handle(ctx context.Context, _ ApplyRetentionPolicy) error {
+	if !h.policy.Enabled() {
+		return nil
+	}
+	anonymized, err := h.guests.AnonymizeEndedBefore(ctx, h.policy.Cutoff(time.Now()))
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "anonymized bookings past retention period",
+		slog.Int64("bookings", anonymized))
+	return nil
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyRetentionPolicyHandler(t *testing.T) {
+	type mocks struct {
+		guests *domain.MockGuestRepository
+	}
+	policy := domain.RetentionPolicy{Days: 365}
+	cutoff := func(date time.Time) bool {
+		return date.Equal(policy.Cutoff(time.Now()))
+	}
+
+	tests := map[string]struct {
+		policy  domain.RetentionPolicy
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			policy: policy,
+			on: func(f mocks) {
+				f.guests.
+					On("AnonymizeEndedBefore", context.TODO(), mock.MatchedBy(cutoff)).
+					Return(int64(2), nil)
+			},
+			wantErr: nil,
+		},
+		"Success_Disabled": {
+			policy:  domain.RetentionPolicy{},
+			wantErr: nil,
+		},
+		"Error_AnonymizeEndedBefore": {
+			policy: policy,
+			on: func(f mocks) {
+				f.guests.
+					On("AnonymizeEndedBefore", context.TODO(), mock.MatchedBy(cutoff)).
+					Return(int64(0), bootstrap.ErrExec)
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests: domain.NewMockGuestRepository(t),
+			}
+			h := NewApplyRetentionPolicyHandler(m.guests, tc.policy)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), ApplyRetentionPolicy{})
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"ApplyRetentionPolicyHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests)
+		})
+	}
+}
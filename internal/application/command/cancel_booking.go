@@ -2,6 +2,8 @@ package command
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
@@ -18,11 +20,24 @@ type (
 
 	cancelBookingHandler struct {
 		bookings domain.BookingRepository
+		payments domain.PaymentGateway
+		policy   domain.CancellationPolicy
+		offers   WaitlistOffers
 	}
 )
 
-func NewCancelBookingHandler(bookings domain.BookingRepository) CancelBookingHandler {
-	return decorator.ApplyCommandDecorator[CancelBooking](cancelBookingHandler{bookings: bookings})
+func NewCancelBookingHandler(
+	bookings domain.BookingRepository,
+	payments domain.PaymentGateway,
+	policy domain.CancellationPolicy,
+	offers WaitlistOffers,
+) CancelBookingHandler {
+	return decorator.ApplyCommandDecorator[CancelBooking](cancelBookingHandler{
+		bookings: bookings,
+		payments: payments,
+		policy:   policy,
+		offers:   offers,
+	})
 }
 
 func (h cancelBookingHandler) Handle(ctx context.Context, cmd CancelBooking) error {
@@ -30,10 +45,43 @@ func (h cancelBookingHandler) Handle(ctx context.Context, cmd CancelBooking) err
 	if err != nil {
 		return err
 	}
-	if !booking.Active {
+	if booking.Status == domain.BookingStatusCancelled {
 		return domain.ErrBookingAlreadyCancelled{BookingID: cmd.BookingID}
 	}
-	booking.Active = false
+	if err = booking.TransitionTo(domain.BookingStatusCancelled); err != nil {
+		return err
+	}
+	refund, err := h.policy.Evaluate(booking, time.Now())
+	if err != nil {
+		return err
+	}
+	// the campsite is released before any refund, so that a refunded booking
+	// never still holds it
+	if err = h.bookings.Update(ctx, booking); err != nil {
+		return err
+	}
+	h.offers.offerVacatedDates(ctx, booking.CampsiteID, booking.StartDate, booking.EndDate)
 
-	return h.bookings.Update(ctx, booking)
+	if booking.PaymentRef == "" || refund.Amount == 0 {
+		return nil
+	}
+	_, err = h.payments.Refund(ctx, domain.RefundRequest{
+		IdempotencyKey: "refund-" + booking.BookingID,
+		PaymentRef:     booking.PaymentRef,
+		Amount:         refund.Amount,
+	})
+	if err != nil {
+		// the booking stays cancelled without a refund recorded, for staff to
+		// refund it
+		slog.ErrorContext(ctx, "failed to refund cancelled booking",
+			"booking_id", booking.BookingID, "payment_ref", booking.PaymentRef, "error", err)
+		return err
+	}
+	booking.RefundPercent = refund.Percent
+	booking.RefundAmount = refund.Amount
+	if err = h.bookings.Update(ctx, booking); err != nil {
+		slog.ErrorContext(ctx, "failed to record refund of cancelled booking",
+			"booking_id", booking.BookingID, "error", err)
+	}
+	return nil
 }
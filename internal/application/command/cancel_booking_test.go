@@ -3,26 +3,79 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stackus/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestCancelBookingHandler(t *testing.T) {
 	type mocks struct {
-		bookings *domain.MockBookingRepository
+		bookings      *domain.MockBookingRepository
+		campsites     *domain.MockCampsiteRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+		payments      *domain.MockPaymentGateway
 	}
 	campsiteID := uuid.New().String()
 	booking, err := bootstrap.NewBooking(campsiteID)
 	if err != nil {
-		t.Fatalf("create active booking error: %v", err)
+		t.Fatalf("create confirmed booking error: %v", err)
 	}
 	booking.ID = 0
-	booking.Active = true
+	booking.Status = domain.BookingStatusConfirmed
 	errBookingAlreadyCancelled := domain.ErrBookingAlreadyCancelled{BookingID: booking.BookingID}
+	paidBooking := *booking
+	paidBooking.TotalPrice = 17515
+	paidBooking.Currency = "USD"
+	paidBooking.DepositAmount = 5255
+	paidBooking.PaymentRef = "pi_123"
+	refundRequest := domain.RefundRequest{
+		IdempotencyKey: "refund-" + paidBooking.BookingID,
+		PaymentRef:     paidBooking.PaymentRef,
+		Amount:         2628, // 50% within 7 days
+	}
+	earlyPaidBooking := paidBooking
+	earlyPaidBooking.StartDate = paidBooking.StartDate.AddDate(0, 0, 9)
+	earlyPaidBooking.EndDate = paidBooking.EndDate.AddDate(0, 0, 9)
+	earlyRefundRequest := refundRequest
+	earlyRefundRequest.Amount = 5255 // 100% more than 7 days out
+	inStayPaidBooking := paidBooking
+	inStayPaidBooking.StartDate = paidBooking.StartDate.AddDate(0, 0, -2)
+	pastBooking := *booking
+	pastBooking.StartDate = booking.StartDate.AddDate(0, 0, -4)
+	pastBooking.EndDate = booking.EndDate.AddDate(0, 0, -4)
+	errPaymentUnavailable := errors.ErrUnavailable.Msg("payment api unavailable")
+	policy := domain.NewCancellationPolicy(map[int]int32{8: 100, 0: 50})
+	waitlistPolicy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	campsite := &domain.Campsite{
+		CampsiteID:   campsiteID,
+		CampgroundID: uuid.New().String(),
+		Capacity:     4,
+	}
+	entry := &domain.WaitlistEntry{
+		EntryID:      uuid.New().String(),
+		CampgroundID: campsite.CampgroundID,
+		StartDate:    booking.StartDate,
+		EndDate:      booking.EndDate,
+		Guests:       2,
+		Status:       domain.WaitlistStatusWaiting,
+	}
+	offeredEntry := mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.EntryID == entry.EntryID && e.Status == domain.WaitlistStatusOffered &&
+			e.OfferCampsiteID == campsiteID && e.OfferExpiresAt.After(time.Now())
+	})
+	cancelledWithRefund := func(percent int32, amount int64) any {
+		return mock.MatchedBy(func(b *domain.Booking) bool {
+			return b.Status == domain.BookingStatusCancelled && b.RefundPercent == percent &&
+				b.RefundAmount == amount
+		})
+	}
 
 	tests := map[string]struct {
 		cmd     CancelBooking
@@ -32,19 +85,201 @@ func TestCancelBookingHandler(t *testing.T) {
 		"Success": {
 			cmd: CancelBooking{BookingID: booking.BookingID},
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil).
 					On("Update", context.TODO(), booking).
 					Return(nil)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						booking.StartDate, booking.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("FindOffered", context.TODO(), campsiteID, entry.StartDate, entry.EndDate).
+					Return(nil, nil).
+					On("Update", context.TODO(), offeredEntry).
+					Return(nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.bookings.
+					On(
+						"FindForDateRange",
+						context.TODO(),
+						campsiteID,
+						entry.StartDate,
+						entry.EndDate,
+					).
+					Return(nil, nil)
+				f.blackouts.
+					On(
+						"FindForDateRange",
+						context.TODO(),
+						campsiteID,
+						entry.StartDate,
+						entry.EndDate,
+					).
+					Return(nil, nil)
 			},
 			wantErr: nil,
 		},
+		"Success_RefundsDeposit_WithinSevenDays": {
+			cmd: CancelBooking{BookingID: paidBooking.BookingID},
+			on: func(f mocks) {
+				paidBooking.Status = domain.BookingStatusConfirmed
+				paidBooking.RefundPercent, paidBooking.RefundAmount = 0, 0
+				f.bookings.
+					On("Find", context.TODO(), paidBooking.BookingID).
+					Return(&paidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(nil).
+					Once().
+					On("Update", context.TODO(), cancelledWithRefund(50, 2628)).
+					Return(nil).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), refundRequest).
+					Return(&domain.Refund{Reference: "re_123", Amount: 2628}, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsiteID, "", paidBooking.StartDate,
+						paidBooking.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_RefundsDeposit_MoreThanSevenDaysOut": {
+			cmd: CancelBooking{BookingID: earlyPaidBooking.BookingID},
+			on: func(f mocks) {
+				earlyPaidBooking.Status = domain.BookingStatusConfirmed
+				f.bookings.
+					On("Find", context.TODO(), earlyPaidBooking.BookingID).
+					Return(&earlyPaidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(nil).
+					Once().
+					On("Update", context.TODO(), cancelledWithRefund(100, 5255)).
+					Return(nil).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), earlyRefundRequest).
+					Return(&domain.Refund{Reference: "re_123", Amount: 5255}, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsiteID, "", earlyPaidBooking.StartDate,
+						earlyPaidBooking.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NoRefund_AfterStartDate": {
+			cmd: CancelBooking{BookingID: inStayPaidBooking.BookingID},
+			on: func(f mocks) {
+				inStayPaidBooking.Status = domain.BookingStatusConfirmed
+				f.bookings.
+					On("Find", context.TODO(), inStayPaidBooking.BookingID).
+					Return(&inStayPaidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(nil)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsiteID, "", inStayPaidBooking.StartDate,
+						inStayPaidBooking.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CancellationNotAllowed_PastStay": {
+			cmd: CancelBooking{BookingID: pastBooking.BookingID},
+			on: func(f mocks) {
+				pastBooking.Status = domain.BookingStatusConfirmed
+				f.bookings.
+					On("Find", context.TODO(), pastBooking.BookingID).
+					Return(&pastBooking, nil)
+			},
+			wantErr: domain.ErrCancellationNotAllowed{
+				BookingID: pastBooking.BookingID,
+				Reason:    "stay has already ended",
+			},
+		},
+		"Success_RefundNotRecorded": {
+			cmd: CancelBooking{BookingID: paidBooking.BookingID},
+			on: func(f mocks) {
+				paidBooking.Status = domain.BookingStatusConfirmed
+				paidBooking.RefundPercent, paidBooking.RefundAmount = 0, 0
+				f.bookings.
+					On("Find", context.TODO(), paidBooking.BookingID).
+					Return(&paidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(nil).
+					Once().
+					On("Update", context.TODO(), cancelledWithRefund(50, 2628)).
+					Return(domain.ErrBookingConcurrentUpdate{}).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), refundRequest).
+					Return(&domain.Refund{Reference: "re_123", Amount: 2628}, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsiteID, "", paidBooking.StartDate,
+						paidBooking.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Error_Refund_StaysCancelled": {
+			cmd: CancelBooking{BookingID: paidBooking.BookingID},
+			on: func(f mocks) {
+				paidBooking.Status = domain.BookingStatusConfirmed
+				paidBooking.RefundPercent, paidBooking.RefundAmount = 0, 0
+				f.bookings.
+					On("Find", context.TODO(), paidBooking.BookingID).
+					Return(&paidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(nil).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), refundRequest).
+					Return(nil, errPaymentUnavailable)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsiteID, "", paidBooking.StartDate,
+						paidBooking.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: errPaymentUnavailable,
+		},
+		"Error_Update_ConcurrentUpdate_NotRefunded": {
+			cmd: CancelBooking{BookingID: paidBooking.BookingID},
+			on: func(f mocks) {
+				paidBooking.Status = domain.BookingStatusConfirmed
+				paidBooking.RefundPercent, paidBooking.RefundAmount = 0, 0
+				f.bookings.
+					On("Find", context.TODO(), paidBooking.BookingID).
+					Return(&paidBooking, nil).
+					On("Update", context.TODO(), cancelledWithRefund(0, 0)).
+					Return(domain.ErrBookingConcurrentUpdate{})
+			},
+			wantErr: domain.ErrBookingConcurrentUpdate{},
+		},
 		"Error_Find_BeginTx": {
 			cmd: CancelBooking{BookingID: booking.BookingID},
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(nil, bootstrap.ErrBeginTx)
@@ -54,7 +289,7 @@ func TestCancelBookingHandler(t *testing.T) {
 		"Error_BookingAlreadyCancelled": {
 			cmd: CancelBooking{BookingID: booking.BookingID},
 			on: func(f mocks) {
-				booking.Active = false
+				booking.Status = domain.BookingStatusCancelled
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil)
@@ -64,7 +299,7 @@ func TestCancelBookingHandler(t *testing.T) {
 		"Error_Update_CommitTx": {
 			cmd: CancelBooking{BookingID: booking.BookingID},
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil).
@@ -79,9 +314,18 @@ func TestCancelBookingHandler(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// given
 			m := mocks{
-				bookings: domain.NewMockBookingRepository(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				campsites:     domain.NewMockCampsiteRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+				payments:      domain.NewMockPaymentGateway(t),
 			}
-			h := NewCancelBookingHandler(m.bookings)
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				waitlistPolicy,
+			)
+			h := NewCancelBookingHandler(m.bookings, m.payments, policy, offers)
 			if tc.on != nil {
 				tc.on(m)
 			}
@@ -90,7 +334,15 @@ func TestCancelBookingHandler(t *testing.T) {
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"CancelBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
-			mock.AssertExpectationsForObjects(t, m.bookings)
+			mock.AssertExpectationsForObjects(
+				t,
+				m.bookings,
+				m.campsites,
+				m.blackouts,
+				m.subscriptions,
+				m.waitlist,
+				m.payments,
+			)
 		})
 	}
 }
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CancelGroupBooking struct {
+		GroupID string
+	}
+
+	// CancelGroupBookingHandler is a logging decorator for the cancelGroupBookingHandler struct.
+	CancelGroupBookingHandler handler.Command[CancelGroupBooking]
+
+	cancelGroupBookingHandler struct {
+		bookings domain.BookingRepository
+		payments domain.PaymentGateway
+		policy   domain.CancellationPolicy
+		offers   WaitlistOffers
+	}
+)
+
+func NewCancelGroupBookingHandler(
+	bookings domain.BookingRepository,
+	payments domain.PaymentGateway,
+	policy domain.CancellationPolicy,
+	offers WaitlistOffers,
+) CancelGroupBookingHandler {
+	return decorator.ApplyCommandDecorator[CancelGroupBooking](cancelGroupBookingHandler{
+		bookings: bookings,
+		payments: payments,
+		policy:   policy,
+		offers:   offers,
+	})
+}
+
+// Handle cancels all bookings of the group the transition rules allow to
+// cancel, i.e. pending or confirmed, other bookings are left as they are.
+func (h cancelGroupBookingHandler) Handle(ctx context.Context, cmd CancelGroupBooking) error {
+	bookings, err := h.bookings.FindByGroupID(ctx, cmd.GroupID)
+	if err != nil {
+		return err
+	}
+	active := cancelBookings(bookings)
+	if len(active) == 0 {
+		return domain.ErrGroupBookingAlreadyCancelled{GroupID: cmd.GroupID}
+	}
+
+	now := time.Now()
+	refunds := make([]domain.CancellationRefund, len(active))
+	for i, booking := range active {
+		if refunds[i], err = h.policy.Evaluate(booking, now); err != nil {
+			return err
+		}
+	}
+	// the campsites are released before any refund, so that a refunded booking
+	// never still holds its campsite
+	if err = h.bookings.UpdateGroup(ctx, active); err != nil {
+		return err
+	}
+	for _, booking := range active {
+		h.offers.offerVacatedDates(ctx, booking.CampsiteID, booking.StartDate, booking.EndDate)
+	}
+
+	var result *multierror.Error
+	var refunded bool
+	for i, booking := range active {
+		if booking.PaymentRef == "" || refunds[i].Amount == 0 {
+			continue
+		}
+		_, err = h.payments.Refund(ctx, domain.RefundRequest{
+			IdempotencyKey: "refund-" + booking.BookingID,
+			PaymentRef:     booking.PaymentRef,
+			Amount:         refunds[i].Amount,
+		})
+		if err != nil {
+			// the booking stays cancelled without a refund recorded, for staff
+			// to refund it
+			slog.ErrorContext(ctx, "failed to refund cancelled booking",
+				"booking_id", booking.BookingID, "payment_ref", booking.PaymentRef, "error", err)
+			result = multierror.Append(result, err)
+			continue
+		}
+		booking.RefundPercent = refunds[i].Percent
+		booking.RefundAmount = refunds[i].Amount
+		refunded = true
+	}
+	if refunded {
+		if err = h.bookings.UpdateGroup(ctx, active); err != nil {
+			slog.ErrorContext(ctx, "failed to record refunds of cancelled group booking",
+				"group_id", cmd.GroupID, "error", err)
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// cancelBookings transitions the bookings that can be cancelled and returns
+// them, the bookings the transition rules do not allow to cancel, i.e. for
+// which ErrBookingStatusTransition is returned, are left as they are.
+func cancelBookings(bookings []*domain.Booking) []*domain.Booking {
+	var cancelled []*domain.Booking
+	for _, booking := range bookings {
+		if err := booking.TransitionTo(domain.BookingStatusCancelled); err != nil {
+			continue
+		}
+		cancelled = append(cancelled, booking)
+	}
+	return cancelled
+}
+
+func modifiableBookings(bookings []*domain.Booking) []*domain.Booking {
+	var active []*domain.Booking
+	for _, booking := range bookings {
+		if booking.Modifiable() {
+			active = append(active, booking)
+		}
+	}
+	return active
+}
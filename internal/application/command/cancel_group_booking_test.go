@@ -0,0 +1,235 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCancelGroupBookingHandler(t *testing.T) {
+	type mocks struct {
+		bookings      *domain.MockBookingRepository
+		campsites     *domain.MockCampsiteRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+		payments      *domain.MockPaymentGateway
+	}
+	groupID := uuid.New().String()
+	policy := domain.NewCancellationPolicy(map[int]int32{8: 100, 0: 50})
+	waitlistPolicy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	errGroupBookingNotFound := domain.ErrGroupBookingNotFound{GroupID: groupID}
+	errGroupBookingAlreadyCancelled := domain.ErrGroupBookingAlreadyCancelled{GroupID: groupID}
+
+	newGroup := func() []*domain.Booking {
+		var bookings []*domain.Booking
+		for i := 0; i < 2; i++ {
+			booking, err := bootstrap.NewBooking(uuid.New().String())
+			if err != nil {
+				t.Fatalf("create booking error: %v", err)
+			}
+			booking.GroupID = groupID
+			bookings = append(bookings, booking)
+		}
+		bookings[0].DepositAmount = 5255
+		bookings[0].PaymentRef = "pi_123"
+		return bookings
+	}
+	// the refund of the first booking is recorded once it is refunded
+	cancelledGroup := func(refunded bool) any {
+		return mock.MatchedBy(func(bookings []*domain.Booking) bool {
+			percent, amount := int32(0), int64(0)
+			if refunded {
+				percent, amount = 50, 2628
+			}
+			return len(bookings) == 2 && bookings[0].Status == domain.BookingStatusCancelled &&
+				bookings[1].Status == domain.BookingStatusCancelled &&
+				bookings[0].RefundPercent == percent &&
+				bookings[0].RefundAmount == amount &&
+				bookings[1].RefundAmount == 0
+		})
+	}
+	refundRequest := func(bookings []*domain.Booking) domain.RefundRequest {
+		return domain.RefundRequest{
+			IdempotencyKey: "refund-" + bookings[0].BookingID,
+			PaymentRef:     "pi_123",
+			Amount:         2628, // 50% within 7 days
+		}
+	}
+	// campsites of the group are not part of a campground, no waitlist entry to
+	// offer to
+	onOffers := func(f mocks, bookings []*domain.Booking) {
+		for _, b := range bookings {
+			f.campsites.
+				On("Find", context.TODO(), b.CampsiteID).
+				Return(&domain.Campsite{CampsiteID: b.CampsiteID}, nil)
+			f.waitlist.
+				On("FindWaiting", context.TODO(), b.CampsiteID, "", b.StartDate, b.EndDate).
+				Return(nil, nil)
+		}
+	}
+
+	tests := map[string]struct {
+		cmd     CancelGroupBooking
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), cancelledGroup(false)).
+					Return(nil).
+					Once().
+					On("UpdateGroup", context.TODO(), cancelledGroup(true)).
+					Return(nil).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), refundRequest(bookings)).
+					Return(&domain.Refund{Reference: "re_123", Amount: 2628}, nil)
+				onOffers(f, bookings)
+			},
+			wantErr: nil,
+		},
+		"Error_Refund_StaysCancelled": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), cancelledGroup(false)).
+					Return(nil).
+					Once()
+				f.payments.
+					On("Refund", context.TODO(), refundRequest(bookings)).
+					Return(nil, bootstrap.ErrQuery)
+				onOffers(f, bookings)
+			},
+			wantErr: multierror.Append(nil, bootstrap.ErrQuery),
+		},
+		"Error_UpdateGroup_ConcurrentUpdate_NotRefunded": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), cancelledGroup(false)).
+					Return(domain.ErrBookingConcurrentUpdate{})
+			},
+			wantErr: domain.ErrBookingConcurrentUpdate{},
+		},
+		"Success_SkipsCheckedOut": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				bookings[0].PaymentRef = ""
+				bookings[1].Status = domain.BookingStatusCheckedOut
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), mock.MatchedBy(func(b []*domain.Booking) bool {
+						return len(b) == 1 && b[0] == bookings[0] &&
+							b[0].Status == domain.BookingStatusCancelled &&
+							bookings[1].Status == domain.BookingStatusCheckedOut
+					})).
+					Return(nil)
+				onOffers(f, bookings[:1])
+			},
+			wantErr: nil,
+		},
+		"Error_GroupBookingNotFound": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(nil, errGroupBookingNotFound)
+			},
+			wantErr: errGroupBookingNotFound,
+		},
+		"Error_GroupBookingAlreadyCancelled": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				bookings[0].Status = domain.BookingStatusCancelled
+				bookings[1].Status = domain.BookingStatusCancelled
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil)
+			},
+			wantErr: errGroupBookingAlreadyCancelled,
+		},
+		"Error_GroupBookingAlreadyCancelled_CheckedOutAndNoShow": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				bookings[0].Status = domain.BookingStatusCheckedOut
+				bookings[1].Status = domain.BookingStatusNoShow
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil)
+			},
+			wantErr: errGroupBookingAlreadyCancelled,
+		},
+		"Error_UpdateGroup_CommitTx": {
+			cmd: CancelGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				bookings := newGroup()
+				bookings[0].PaymentRef = ""
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), mock.Anything).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				bookings:      domain.NewMockBookingRepository(t),
+				campsites:     domain.NewMockCampsiteRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+				payments:      domain.NewMockPaymentGateway(t),
+			}
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				waitlistPolicy,
+			)
+			h := NewCancelGroupBookingHandler(m.bookings, m.payments, policy, offers)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"CancelGroupBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(
+				t,
+				m.bookings,
+				m.campsites,
+				m.blackouts,
+				m.subscriptions,
+				m.waitlist,
+				m.payments,
+			)
+		})
+	}
+}
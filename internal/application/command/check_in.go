@@ -0,0 +1,44 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CheckIn struct {
+		BookingID string
+	}
+
+	// CheckInHandler is a logging decorator for the checkInHandler struct.
+	CheckInHandler handler.Command[CheckIn]
+
+	checkInHandler struct {
+		bookings domain.BookingRepository
+	}
+)
+
+func NewCheckInHandler(bookings domain.BookingRepository) CheckInHandler {
+	return decorator.ApplyCommandDecorator[CheckIn](checkInHandler{
+		bookings: bookings,
+	})
+}
+
+// Handle checks in the guest of a confirmed booking, not before its start date.
+func (h checkInHandler) Handle(ctx context.Context, cmd CheckIn) error {
+	booking, err := h.bookings.Find(ctx, cmd.BookingID)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(booking.StartDate) {
+		return domain.ErrBookingNotStarted{BookingID: cmd.BookingID, StartDate: booking.StartDate}
+	}
+	if err = booking.TransitionTo(domain.BookingStatusCheckedIn); err != nil {
+		return err
+	}
+	return h.bookings.Update(ctx, booking)
+}
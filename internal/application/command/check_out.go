@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CheckOut struct {
+		BookingID string
+	}
+
+	// CheckOutHandler is a logging decorator for the checkOutHandler struct.
+	CheckOutHandler handler.Command[CheckOut]
+
+	checkOutHandler struct {
+		bookings domain.BookingRepository
+	}
+)
+
+func NewCheckOutHandler(bookings domain.BookingRepository) CheckOutHandler {
+	return decorator.ApplyCommandDecorator[CheckOut](checkOutHandler{
+		bookings: bookings,
+	})
+}
+
+// Handle checks out the guest of a checked-in booking, the campsite is vacated
+// for the remaining nights of an early check-out.
+func (h checkOutHandler) Handle(ctx context.Context, cmd CheckOut) error {
+	booking, err := h.bookings.Find(ctx, cmd.BookingID)
+	if err != nil {
+		return err
+	}
+	if err = booking.TransitionTo(domain.BookingStatusCheckedOut); err != nil {
+		return err
+	}
+	return h.bookings.Update(ctx, booking)
+}
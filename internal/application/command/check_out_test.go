@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckOutHandler(t *testing.T) {
+	type mocks struct {
+		bookings *domain.MockBookingRepository
+	}
+	booking, err := bootstrap.NewBookingWithAddDays(uuid.New().String(), -1, 1)
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.Status = domain.BookingStatusCheckedIn
+	withStatus := func(status domain.BookingStatus) any {
+		return mock.MatchedBy(func(b *domain.Booking) bool {
+			return b.BookingID == booking.BookingID && b.Status == status
+		})
+	}
+	errBookingStatusTransition := domain.ErrBookingStatusTransition{
+		BookingID: booking.BookingID,
+		From:      domain.BookingStatusConfirmed,
+		To:        domain.BookingStatusCheckedOut,
+	}
+
+	tests := map[string]struct {
+		cmd     CheckOut
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: CheckOut{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil).
+					On("Update", context.TODO(), withStatus(domain.BookingStatusCheckedOut)).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_BookingStatusTransition": {
+			cmd: CheckOut{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				b.Status = domain.BookingStatusConfirmed
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil)
+			},
+			wantErr: errBookingStatusTransition,
+		},
+		"Error_Find_BeginTx": {
+			cmd: CheckOut{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Update_CommitTx": {
+			cmd: CheckOut{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil).
+					On("Update", context.TODO(), withStatus(domain.BookingStatusCheckedOut)).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{bookings: domain.NewMockBookingRepository(t)}
+			h := NewCheckOutHandler(m.bookings)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"CheckOutHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.bookings)
+		})
+	}
+}
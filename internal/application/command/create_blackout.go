@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CreateBlackout struct {
+		BlackoutID string
+		CampsiteID string
+		StartDate  string
+		EndDate    string
+		Reason     string
+	}
+
+	// CreateBlackoutHandler is a logging decorator for the createBlackoutHandler struct.
+	CreateBlackoutHandler handler.Command[CreateBlackout]
+
+	createBlackoutHandler struct {
+		campsites domain.CampsiteRepository
+		blackouts domain.CampsiteBlackoutRepository
+	}
+)
+
+func NewCreateBlackoutHandler(
+	campsites domain.CampsiteRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+) CreateBlackoutHandler {
+	return decorator.ApplyCommandDecorator[CreateBlackout](
+		createBlackoutHandler{campsites: campsites, blackouts: blackouts},
+	)
+}
+
+// Handle closes the campsite for new bookings, bookings already made for the
+// blacked out dates are left for the operator to move or cancel.
+func (h createBlackoutHandler) Handle(ctx context.Context, cmd CreateBlackout) error {
+	if _, err := h.campsites.Find(ctx, cmd.CampsiteID); err != nil {
+		return err
+	}
+
+	startDate, err := domain.ParseDate("start_date", cmd.StartDate)
+	if err != nil {
+		return err
+	}
+	endDate, err := domain.ParseDate("end_date", cmd.EndDate)
+	if err != nil {
+		return err
+	}
+	blackout := &domain.CampsiteBlackout{
+		BlackoutID: cmd.BlackoutID,
+		CampsiteID: cmd.CampsiteID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Reason:     cmd.Reason,
+	}
+
+	if err = blackout.Validate(); err != nil {
+		return err
+	}
+	return h.blackouts.Insert(ctx, blackout)
+}
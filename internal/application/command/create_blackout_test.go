@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateBlackoutHandler(t *testing.T) {
+	type mocks struct {
+		campsites *domain.MockCampsiteRepository
+		blackouts *domain.MockCampsiteBlackoutRepository
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	blackout.ID = 0
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+
+	cmd := CreateBlackout{
+		BlackoutID: blackout.BlackoutID,
+		CampsiteID: campsiteID,
+		StartDate:  blackout.StartDate.Format(time.DateOnly),
+		EndDate:    blackout.EndDate.Format(time.DateOnly),
+		Reason:     blackout.Reason,
+	}
+	invalidDatesCmd := cmd
+	invalidDatesCmd.StartDate, invalidDatesCmd.EndDate = cmd.EndDate, cmd.StartDate
+
+	tests := map[string]struct {
+		cmd     CreateBlackout
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.blackouts.
+					On("Insert", context.TODO(), blackout).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_CampsiteBlackoutValidation": {
+			cmd: invalidDatesCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: domain.ErrCampsiteBlackoutValidation{
+				Reason: "start_date must be before end_date",
+			},
+		},
+		"Error_Insert_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.blackouts.
+					On("Insert", context.TODO(), blackout).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites: domain.NewMockCampsiteRepository(t),
+				blackouts: domain.NewMockCampsiteBlackoutRepository(t),
+			}
+			h := NewCreateBlackoutHandler(m.campsites, m.blackouts)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"CreateBlackoutHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.blackouts)
+		})
+	}
+}
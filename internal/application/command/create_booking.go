@@ -2,12 +2,14 @@ package command
 
 import (
 	"context"
-	"time"
+	"fmt"
+	"log/slog"
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
 )
 
 type (
@@ -18,6 +20,11 @@ type (
 		FullName   string
 		StartDate  string
 		EndDate    string
+		Guests     int32
+		// Payment method token charged for the deposit of a priced booking.
+		PaymentMethod string
+		// Identifier of the guest created if no guest with the email exists.
+		GuestID string
 	}
 
 	// CreateBookingHandler is a logging decorator for the createBookingHandler struct.
@@ -25,16 +32,28 @@ type (
 
 	createBookingHandler struct {
 		bookings   domain.BookingRepository
+		guests     domain.GuestRepository
+		rates      domain.CampsiteRatesRepository
+		payments   domain.PaymentGateway
+		deposit    domain.DepositPolicy
 		validators []domain.BookingValidator
 	}
 )
 
 func NewCreateBookingHandler(
 	bookings domain.BookingRepository,
+	guests domain.GuestRepository,
+	rates domain.CampsiteRatesRepository,
+	payments domain.PaymentGateway,
+	deposit domain.DepositPolicy,
 	validators []domain.BookingValidator,
 ) CreateBookingHandler {
 	return decorator.ApplyCommandDecorator[CreateBooking](createBookingHandler{
 		bookings:   bookings,
+		guests:     guests,
+		rates:      rates,
+		payments:   payments,
+		deposit:    deposit,
 		validators: validators,
 	})
 }
@@ -45,24 +64,117 @@ func (h createBookingHandler) Handle(ctx context.Context, cmd CreateBooking) err
 		CampsiteID: cmd.CampsiteID,
 		Email:      cmd.Email,
 		FullName:   cmd.FullName,
+		Guests:     max(cmd.Guests, 1),
 	}
-	startDate, err := time.Parse(time.DateOnly, cmd.StartDate)
+	startDate, err := domain.ParseDate("start_date", cmd.StartDate)
 	if err != nil {
 		return err
 	}
 	booking.StartDate = startDate
 
-	endDate, err := time.Parse(time.DateOnly, cmd.EndDate)
+	endDate, err := domain.ParseDate("end_date", cmd.EndDate)
 	if err != nil {
 		return err
 	}
 	booking.EndDate = endDate
-	booking.Active = true
+	booking.Status = domain.BookingStatusConfirmed
 	booking.Version = 1
 
-	err = validator.Apply(h.validators, booking)
+	err = validator.Apply(ctx, h.validators, booking)
 	if err != nil {
 		return err
 	}
-	return h.bookings.Insert(ctx, booking)
+	if err = priceBooking(ctx, h.rates, booking); err != nil {
+		return err
+	}
+	if booking.GuestID, err = matchGuest(ctx, h.guests, cmd.GuestID, cmd.Email,
+		cmd.FullName); err != nil {
+		return err
+	}
+	if err = h.authorizeDeposit(ctx, booking, cmd.PaymentMethod); err != nil {
+		return err
+	}
+	return settleDeposit(
+		ctx,
+		h.payments,
+		booking.PaymentRef,
+		[]*domain.Booking{booking},
+		func(ctx context.Context) error { return h.bookings.Insert(ctx, booking) },
+		func(ctx context.Context) error { return h.bookings.Update(ctx, booking) },
+		slog.String("booking_id", booking.BookingID),
+	)
+}
+
+// authorizeDeposit holds the deposit of a priced booking on the payment method,
+// nothing is charged for bookings with zero deposit.
+func (h createBookingHandler) authorizeDeposit(
+	ctx context.Context,
+	booking *domain.Booking,
+	paymentMethod string,
+) error {
+	deposit := h.deposit.Amount(booking.TotalPrice)
+	if deposit == 0 {
+		return nil
+	}
+	if paymentMethod == "" {
+		return domain.ErrPaymentMethodRequired{}
+	}
+
+	payment, err := h.payments.Authorize(ctx, domain.PaymentRequest{
+		IdempotencyKey: booking.BookingID,
+		PaymentMethod:  paymentMethod,
+		Description:    fmt.Sprintf("Deposit for booking %s", booking.BookingID),
+		Amount:         deposit,
+		Currency:       booking.Currency,
+	})
+	if err != nil {
+		return err
+	}
+	booking.DepositAmount = payment.Amount
+	booking.PaymentRef = payment.Reference
+	return nil
+}
+
+// matchGuest returns the identifier of the guest with the email, a new guest
+// is created with the given identifier if there is none.
+func matchGuest(
+	ctx context.Context,
+	guests domain.GuestRepository,
+	guestID string,
+	email string,
+	fullName string,
+) (string, error) {
+	guest, err := guests.FindOrInsert(ctx, &domain.Guest{
+		GuestID:  guestID,
+		Email:    domain.NormalizeEmail(email),
+		FullName: fullName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return guest.GuestID, nil
+}
+
+// priceBooking snapshots the total price of the booking from the current rates
+// of its campsite; the booking is left unpriced if the campsite has no rates.
+func priceBooking(
+	ctx context.Context,
+	rates domain.CampsiteRatesRepository,
+	booking *domain.Booking,
+) error {
+	campsiteRates, err := rates.Find(ctx, booking.CampsiteID)
+	if err != nil {
+		var notFound domain.ErrCampsiteRatesNotFound
+		if errors.As(err, &notFound) {
+			booking.TotalPrice = 0
+			booking.Currency = ""
+			return nil
+		}
+		return err
+	}
+
+	quote := campsiteRates.Quote(booking.StartDate, booking.EndDate, booking.Guests)
+	booking.TotalPrice = quote.Total
+	booking.Currency = quote.Currency
+	return nil
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
-	"github.com/stackus/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -18,6 +17,9 @@ import (
 func TestCreateBookingHandler(t *testing.T) {
 	type mocks struct {
 		bookings  *domain.MockBookingRepository
+		guests    *domain.MockGuestRepository
+		rates     *domain.MockCampsiteRatesRepository
+		payments  *domain.MockPaymentGateway
 		validator *domain.MockBookingValidator
 	}
 	campsiteID := uuid.New().String()
@@ -26,22 +28,66 @@ func TestCreateBookingHandler(t *testing.T) {
 		t.Fatalf("create booking error: %v", err)
 	}
 	booking.ID = 0
-	booking.Active = true
+	booking.Status = domain.BookingStatusConfirmed
 	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
 		StartDate: booking.StartDate,
 		EndDate:   booking.EndDate,
 	}
 	errBookingAllowedStartDate := validator.ErrBookingAllowedStartDate{}
 	monthOutOfRangeDate := "2024-99-01"
+	dayOutOfRangeDate := "2025-02-30"
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}
+	rates := bootstrap.NewCampsiteRates(campsiteID)
+	newGuest := &domain.Guest{
+		GuestID:  uuid.New().String(),
+		Email:    domain.NormalizeEmail(booking.Email),
+		FullName: booking.FullName,
+	}
+	guest := &domain.Guest{
+		GuestID:  uuid.New().String(),
+		Email:    newGuest.Email,
+		FullName: newGuest.FullName,
+	}
+	guestBooking := *booking
+	guestBooking.GuestID = guest.GuestID
+	pricedBooking := guestBooking
+	pricedBooking.TotalPrice = rates.Quote(booking.StartDate, booking.EndDate, booking.Guests).Total
+	pricedBooking.Currency = rates.Currency
+
+	depositPolicy := domain.DepositPolicy{Percent: 30}
+	paymentRequest := domain.PaymentRequest{
+		IdempotencyKey: booking.BookingID,
+		PaymentMethod:  "pm_card_visa",
+		Description:    "Deposit for booking " + booking.BookingID,
+		Amount:         depositPolicy.Amount(pricedBooking.TotalPrice),
+		Currency:       pricedBooking.Currency,
+	}
+	payment := &domain.Payment{
+		Reference: "pi_123",
+		Amount:    paymentRequest.Amount,
+		Currency:  paymentRequest.Currency,
+	}
+	paidBooking := pricedBooking
+	paidBooking.DepositAmount = payment.Amount
+	paidBooking.PaymentRef = payment.Reference
+	pendingPaidBooking := paidBooking
+	pendingPaidBooking.Status = domain.BookingStatusPending
+	cancelledPaidBooking := paidBooking
+	cancelledPaidBooking.Status = domain.BookingStatusCancelled
+	errPaymentDeclined := domain.ErrPaymentDeclined{Reason: "card declined"}
 
 	cmd := CreateBooking{
-		BookingID:  booking.BookingID,
-		CampsiteID: booking.CampsiteID,
-		Email:      booking.Email,
-		FullName:   booking.FullName,
-		StartDate:  booking.StartDate.Format(time.DateOnly),
-		EndDate:    booking.EndDate.Format(time.DateOnly),
+		BookingID:     booking.BookingID,
+		CampsiteID:    booking.CampsiteID,
+		GuestID:       newGuest.GuestID,
+		Email:         booking.Email,
+		FullName:      booking.FullName,
+		StartDate:     booking.StartDate.Format(time.DateOnly),
+		EndDate:       booking.EndDate.Format(time.DateOnly),
+		PaymentMethod: paymentRequest.PaymentMethod,
 	}
+	cmdWithoutPaymentMethod := cmd
+	cmdWithoutPaymentMethod.PaymentMethod = ""
 
 	tests := map[string]struct {
 		cmd     CreateBooking
@@ -52,14 +98,155 @@ func TestCreateBookingHandler(t *testing.T) {
 			cmd: cmd,
 			on: func(f mocks) {
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Capture", context.TODO(), payment.Reference).
 					Return(nil)
 				f.bookings.
-					On("Insert", context.TODO(), booking).
+					On("Insert", context.TODO(), &pendingPaidBooking).
+					Return(nil).
+					On("Update", context.TODO(), &paidBooking).
 					Return(nil)
 			},
 			wantErr: nil,
 		},
+		"Success_CampsiteWithoutRates": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+				f.bookings.
+					On("Insert", context.TODO(), &guestBooking).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_PaymentMethodRequired": {
+			cmd: cmdWithoutPaymentMethod,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+			},
+			wantErr: domain.ErrPaymentMethodRequired{},
+		},
+		"Error_PaymentDeclined": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(nil, errPaymentDeclined)
+			},
+			wantErr: errPaymentDeclined,
+		},
+		"Error_Insert_VoidsDeposit": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Void", context.TODO(), payment.Reference).
+					Return(nil)
+				f.bookings.
+					On("Insert", context.TODO(), &pendingPaidBooking).
+					Return(errBookingDatesNotAvailable)
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
+		"Error_Capture_CancelsBooking": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Capture", context.TODO(), payment.Reference).
+					Return(errPaymentDeclined).
+					On("Void", context.TODO(), payment.Reference).
+					Return(nil)
+				f.bookings.
+					On("Insert", context.TODO(), &pendingPaidBooking).
+					Return(nil).
+					On("Update", context.TODO(), &cancelledPaidBooking).
+					Return(nil)
+			},
+			wantErr: errPaymentDeclined,
+		},
+		"Error_FindOrInsertGuest": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_FindRates": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
 		"Error_ParseStartDate": {
 			cmd: CreateBooking{
 				BookingID:  cmd.BookingID,
@@ -70,7 +257,7 @@ func TestCreateBookingHandler(t *testing.T) {
 				EndDate:    cmd.EndDate,
 			},
 			on:      nil,
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "start_date", Value: monthOutOfRangeDate},
 		},
 		"Error_ParseEndDate": {
 			cmd: CreateBooking{
@@ -82,13 +269,25 @@ func TestCreateBookingHandler(t *testing.T) {
 				EndDate:    monthOutOfRangeDate,
 			},
 			on:      nil,
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "end_date", Value: monthOutOfRangeDate},
+		},
+		"Error_ParseEndDate_DayOutOfRange": {
+			cmd: CreateBooking{
+				BookingID:  cmd.BookingID,
+				CampsiteID: cmd.CampsiteID,
+				Email:      cmd.Email,
+				FullName:   cmd.FullName,
+				StartDate:  cmd.StartDate,
+				EndDate:    dayOutOfRangeDate,
+			},
+			on:      nil,
+			wantErr: domain.ErrInvalidDate{FieldName: "end_date", Value: dayOutOfRangeDate},
 		},
 		"Error_Validate_BookingAllowedStartDate": {
 			cmd: cmd,
 			on: func(f mocks) {
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
 					Return(errBookingAllowedStartDate)
 			},
 			wantErr: domain.ErrBookingValidation{
@@ -99,10 +298,16 @@ func TestCreateBookingHandler(t *testing.T) {
 			cmd: cmd,
 			on: func(f mocks) {
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
 					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+				f.guests.
+					On("FindOrInsert", context.TODO(), newGuest).
+					Return(guest, nil)
 				f.bookings.
-					On("Insert", context.TODO(), booking).
+					On("Insert", context.TODO(), &guestBooking).
 					Return(errBookingDatesNotAvailable)
 			},
 			wantErr: errBookingDatesNotAvailable,
@@ -114,11 +319,21 @@ func TestCreateBookingHandler(t *testing.T) {
 			// given
 			m := mocks{
 				bookings:  domain.NewMockBookingRepository(t),
+				guests:    domain.NewMockGuestRepository(t),
+				rates:     domain.NewMockCampsiteRatesRepository(t),
+				payments:  domain.NewMockPaymentGateway(t),
 				validator: domain.NewMockBookingValidator(t),
 			}
 			var validators []domain.BookingValidator
 			validators = append(validators, m.validator)
-			h := NewCreateBookingHandler(m.bookings, validators)
+			h := NewCreateBookingHandler(
+				m.bookings,
+				m.guests,
+				m.rates,
+				m.payments,
+				depositPolicy,
+				validators,
+			)
 
 			if tc.on != nil {
 				tc.on(m)
@@ -126,14 +341,8 @@ func TestCreateBookingHandler(t *testing.T) {
 			// when
 			err := h.Handle(context.TODO(), tc.cmd)
 			// then
-			defer mock.AssertExpectationsForObjects(t, m.bookings)
+			defer mock.AssertExpectationsForObjects(t, m.bookings, m.guests, m.rates, m.payments)
 
-			var parseErr *time.ParseError
-			if errors.As(err, &parseErr) {
-				assert.Equal(t, monthOutOfRangeDate, parseErr.Value,
-					"CreateBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
-				return
-			}
 			assert.Equal(t, tc.wantErr, err,
 				"CreateBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
 		})
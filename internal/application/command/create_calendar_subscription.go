@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	// CreateCalendarSubscription subscribes a campsite to the calendar published
+	// at URL, or imports the uploaded Calendar once; exactly one is set.
+	CreateCalendarSubscription struct {
+		SubscriptionID string
+		CampsiteID     string
+		Name           string
+		URL            string
+		Calendar       []byte
+	}
+
+	// CreateCalendarSubscriptionHandler is a logging decorator for the createCalendarSubscriptionHandler struct.
+	CreateCalendarSubscriptionHandler handler.Command[CreateCalendarSubscription]
+
+	createCalendarSubscriptionHandler struct {
+		campsites     domain.CampsiteRepository
+		subscriptions domain.CalendarSubscriptionRepository
+		reader        domain.ExternalCalendarReader
+	}
+)
+
+func NewCreateCalendarSubscriptionHandler(
+	campsites domain.CampsiteRepository,
+	subscriptions domain.CalendarSubscriptionRepository,
+	reader domain.ExternalCalendarReader,
+) CreateCalendarSubscriptionHandler {
+	return decorator.ApplyCommandDecorator[CreateCalendarSubscription](
+		createCalendarSubscriptionHandler{
+			campsites:     campsites,
+			subscriptions: subscriptions,
+			reader:        reader,
+		},
+	)
+}
+
+// Handle blocks the dates of an uploaded calendar right away, the dates of a
+// subscribed URL are blocked by the next sync.
+func (h createCalendarSubscriptionHandler) Handle(
+	ctx context.Context,
+	cmd CreateCalendarSubscription,
+) error {
+	if _, err := h.campsites.Find(ctx, cmd.CampsiteID); err != nil {
+		return err
+	}
+
+	subscription := &domain.CalendarSubscription{
+		SubscriptionID: cmd.SubscriptionID,
+		CampsiteID:     cmd.CampsiteID,
+		Name:           cmd.Name,
+		URL:            cmd.URL,
+	}
+	if (cmd.URL == "") == (len(cmd.Calendar) == 0) {
+		return domain.ErrCalendarSubscriptionValidation{Reason: "either url or calendar required"}
+	}
+	if err := subscription.Validate(); err != nil {
+		return err
+	}
+
+	var blocks []*domain.ExternalBlock
+	if len(cmd.Calendar) > 0 {
+		var err error
+		if blocks, err = h.reader.Read(cmd.Calendar); err != nil {
+			return domain.ErrCalendarSubscriptionValidation{Reason: err.Error()}
+		}
+		for _, block := range blocks {
+			block.SubscriptionID = subscription.SubscriptionID
+			block.CampsiteID = subscription.CampsiteID
+		}
+		subscription.LastSyncedAt = time.Now().UTC()
+	}
+	return h.subscriptions.Insert(ctx, subscription, blocks)
+}
@@ -0,0 +1,171 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCalendarSubscriptionHandler(t *testing.T) {
+	type mocks struct {
+		campsites     *domain.MockCampsiteRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		reader        *domain.MockExternalCalendarReader
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	subscription := bootstrap.NewCalendarSubscription(campsiteID)
+	block := bootstrap.NewExternalBlock(subscription)
+	calendar := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+	errParse := errors.ErrInvalidArgument.Msg("parse calendar: missing END:VCALENDAR")
+
+	urlCmd := CreateCalendarSubscription{
+		SubscriptionID: subscription.SubscriptionID,
+		CampsiteID:     campsiteID,
+		Name:           subscription.Name,
+		URL:            subscription.URL,
+	}
+	calendarCmd := urlCmd
+	calendarCmd.URL, calendarCmd.Calendar = "", calendar
+	bothCmd := urlCmd
+	bothCmd.Calendar = calendar
+	invalidURLCmd := urlCmd
+	invalidURLCmd.URL = "ftp://example.com/a01.ics"
+
+	isSubscription := func(url string, synced bool) any {
+		return mock.MatchedBy(func(s *domain.CalendarSubscription) bool {
+			return s.SubscriptionID == subscription.SubscriptionID && s.CampsiteID == campsiteID &&
+				s.URL == url && s.LastSyncedAt.IsZero() != synced
+		})
+	}
+
+	tests := map[string]struct {
+		cmd     CreateCalendarSubscription
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success_URL": {
+			cmd: urlCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.subscriptions.
+					On("Insert", context.TODO(), isSubscription(subscription.URL, false),
+						[]*domain.ExternalBlock(nil)).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Success_Calendar": {
+			cmd: calendarCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.reader.
+					On("Read", calendar).
+					Return([]*domain.ExternalBlock{{
+						UID:       block.UID,
+						StartDate: block.StartDate,
+						EndDate:   block.EndDate,
+						Summary:   block.Summary,
+					}}, nil)
+				f.subscriptions.
+					On("Insert", context.TODO(), isSubscription("", true),
+						[]*domain.ExternalBlock{block}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			cmd: urlCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_CalendarSubscriptionValidation_BothSources": {
+			cmd: bothCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: domain.ErrCalendarSubscriptionValidation{
+				Reason: "either url or calendar required",
+			},
+		},
+		"Error_CalendarSubscriptionValidation_URLScheme": {
+			cmd: invalidURLCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: domain.ErrCalendarSubscriptionValidation{
+				Reason: "unsupported url scheme ftp",
+			},
+		},
+		"Error_CalendarSubscriptionValidation_Calendar": {
+			cmd: calendarCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.reader.
+					On("Read", calendar).
+					Return(nil, errParse)
+			},
+			wantErr: domain.ErrCalendarSubscriptionValidation{Reason: errParse.Error()},
+		},
+		"Error_Insert_CommitTx": {
+			cmd: urlCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.subscriptions.
+					On("Insert", context.TODO(), mock.Anything, mock.Anything).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites:     domain.NewMockCampsiteRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				reader:        domain.NewMockExternalCalendarReader(t),
+			}
+			h := NewCreateCalendarSubscriptionHandler(m.campsites, m.subscriptions, m.reader)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(
+				t,
+				tc.wantErr,
+				err,
+				"CreateCalendarSubscriptionHandler.Handle() error = %v, wantErr %v",
+				err,
+				tc.wantErr,
+			)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.subscriptions, m.reader)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CreateCampground struct {
+		CampgroundID string
+		Name         string
+		Description  string
+	}
+
+	// CreateCampgroundHandler is a logging decorator for the createCampgroundHandler struct.
+	CreateCampgroundHandler handler.Command[CreateCampground]
+
+	createCampgroundHandler struct {
+		campgrounds domain.CampgroundRepository
+	}
+)
+
+func NewCreateCampgroundHandler(campgrounds domain.CampgroundRepository) CreateCampgroundHandler {
+	return decorator.ApplyCommandDecorator[CreateCampground](
+		createCampgroundHandler{campgrounds: campgrounds},
+	)
+}
+
+func (h createCampgroundHandler) Handle(ctx context.Context, cmd CreateCampground) error {
+	campground := domain.Campground{
+		CampgroundID: cmd.CampgroundID,
+		Name:         cmd.Name,
+		Description:  cmd.Description,
+		Active:       true,
+	}
+	return h.campgrounds.Insert(ctx, &campground)
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCampgroundHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	campground.ID = 0
+
+	cmd := CreateCampground{
+		CampgroundID: campground.CampgroundID,
+		Name:         campground.Name,
+		Description:  campground.Description,
+	}
+
+	tests := map[string]struct {
+		cmd     CreateCampground
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Insert", context.TODO(), campground).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Insert", context.TODO(), campground).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+			}
+			h := NewCreateCampgroundHandler(m.campgrounds)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"CreateCampgroundHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds)
+		})
+	}
+}
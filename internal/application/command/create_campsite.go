@@ -11,6 +11,7 @@ import (
 type (
 	CreateCampsite struct {
 		CampsiteID    string
+		CampgroundID  string
 		CampsiteCode  string
 		Capacity      int32
 		DrinkingWater bool
@@ -23,19 +24,29 @@ type (
 	CreateCampsiteHandler handler.Command[CreateCampsite]
 
 	createCampsiteHandler struct {
-		campsites domain.CampsiteRepository
+		campgrounds domain.CampgroundRepository
+		campsites   domain.CampsiteRepository
 	}
 )
 
-func NewCreateCampsiteHandler(campsites domain.CampsiteRepository) CreateCampsiteHandler {
+func NewCreateCampsiteHandler(
+	campgrounds domain.CampgroundRepository,
+	campsites domain.CampsiteRepository,
+) CreateCampsiteHandler {
 	return decorator.ApplyCommandDecorator[CreateCampsite](
-		createCampsiteHandler{campsites: campsites},
+		createCampsiteHandler{campgrounds: campgrounds, campsites: campsites},
 	)
 }
 
 func (h createCampsiteHandler) Handle(ctx context.Context, cmd CreateCampsite) error {
+	if cmd.CampgroundID != "" {
+		if _, err := h.campgrounds.Find(ctx, cmd.CampgroundID); err != nil {
+			return err
+		}
+	}
 	campsite := domain.Campsite{
 		CampsiteID:    cmd.CampsiteID,
+		CampgroundID:  cmd.CampgroundID,
 		CampsiteCode:  cmd.CampsiteCode,
 		Capacity:      cmd.Capacity,
 		DrinkingWater: cmd.DrinkingWater,
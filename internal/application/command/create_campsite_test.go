@@ -12,7 +12,8 @@ import (
 
 func TestCreateCampsiteHandler(t *testing.T) {
 	type mocks struct {
-		campsites *domain.MockCampsiteRepository
+		campgrounds *domain.MockCampgroundRepository
+		campsites   *domain.MockCampsiteRepository
 	}
 	campsite, err := bootstrap.NewCampsite()
 	if err != nil {
@@ -30,6 +31,15 @@ func TestCreateCampsiteHandler(t *testing.T) {
 		PicnicTable:   campsite.PicnicTable,
 		FirePit:       campsite.FirePit,
 	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	campgroundCampsite := *campsite
+	campgroundCampsite.CampgroundID = campground.CampgroundID
+	campgroundCmd := cmd
+	campgroundCmd.CampgroundID = campground.CampgroundID
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
 
 	tests := map[string]struct {
 		cmd     CreateCampsite
@@ -54,15 +64,37 @@ func TestCreateCampsiteHandler(t *testing.T) {
 			},
 			wantErr: bootstrap.ErrCommitTx,
 		},
+		"Success_WithCampground": {
+			cmd: campgroundCmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Insert", context.TODO(), &campgroundCampsite).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampgroundNotFound": {
+			cmd: campgroundCmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			wantErr: errCampgroundNotFound,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// given
 			m := mocks{
-				campsites: domain.NewMockCampsiteRepository(t),
+				campgrounds: domain.NewMockCampgroundRepository(t),
+				campsites:   domain.NewMockCampsiteRepository(t),
 			}
-			h := NewCreateCampsiteHandler(m.campsites)
+			h := NewCreateCampsiteHandler(m.campgrounds, m.campsites)
 			if tc.on != nil {
 				tc.on(m)
 			}
@@ -71,7 +103,7 @@ func TestCreateCampsiteHandler(t *testing.T) {
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"CreateCampsiteHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
-			mock.AssertExpectationsForObjects(t, m.campsites)
+			mock.AssertExpectationsForObjects(t, m.campgrounds, m.campsites)
 		})
 	}
 }
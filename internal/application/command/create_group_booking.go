@@ -0,0 +1,180 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	CreateGroupBooking struct {
+		GroupID   string
+		Campsites []GroupBookingCampsite
+		Email     string
+		FullName  string
+		StartDate string
+		EndDate   string
+		// Payment method token charged for the deposit of all priced bookings of group.
+		PaymentMethod string
+		// Identifier of the guest created if no guest with the email exists.
+		GuestID string
+	}
+
+	GroupBookingCampsite struct {
+		BookingID  string
+		CampsiteID string
+		Guests     int32
+	}
+
+	// CreateGroupBookingHandler is a logging decorator for the createGroupBookingHandler struct.
+	CreateGroupBookingHandler handler.Command[CreateGroupBooking]
+
+	createGroupBookingHandler struct {
+		bookings   domain.BookingRepository
+		guests     domain.GuestRepository
+		rates      domain.CampsiteRatesRepository
+		payments   domain.PaymentGateway
+		deposit    domain.DepositPolicy
+		validators []domain.BookingValidator
+	}
+)
+
+func NewCreateGroupBookingHandler(
+	bookings domain.BookingRepository,
+	guests domain.GuestRepository,
+	rates domain.CampsiteRatesRepository,
+	payments domain.PaymentGateway,
+	deposit domain.DepositPolicy,
+	validators []domain.BookingValidator,
+) CreateGroupBookingHandler {
+	return decorator.ApplyCommandDecorator[CreateGroupBooking](createGroupBookingHandler{
+		bookings:   bookings,
+		guests:     guests,
+		rates:      rates,
+		payments:   payments,
+		deposit:    deposit,
+		validators: validators,
+	})
+}
+
+func (h createGroupBookingHandler) Handle(ctx context.Context, cmd CreateGroupBooking) error {
+	startDate, err := domain.ParseDate("start_date", cmd.StartDate)
+	if err != nil {
+		return err
+	}
+	endDate, err := domain.ParseDate("end_date", cmd.EndDate)
+	if err != nil {
+		return err
+	}
+	if len(cmd.Campsites) == 0 {
+		return domain.ErrGroupBookingValidation{Reason: "at least one campsite required"}
+	}
+
+	bookings := make([]*domain.Booking, 0, len(cmd.Campsites))
+	campsiteIDs := make(map[string]bool, len(cmd.Campsites))
+	for _, c := range cmd.Campsites {
+		if campsiteIDs[c.CampsiteID] {
+			return domain.ErrGroupBookingValidation{
+				Reason: fmt.Sprintf("campsite %s booked more than once", c.CampsiteID),
+			}
+		}
+		campsiteIDs[c.CampsiteID] = true
+
+		booking := &domain.Booking{
+			BookingID:  c.BookingID,
+			CampsiteID: c.CampsiteID,
+			Email:      cmd.Email,
+			FullName:   cmd.FullName,
+			StartDate:  startDate,
+			EndDate:    endDate,
+			Guests:     max(c.Guests, 1),
+			GroupID:    cmd.GroupID,
+			Status:     domain.BookingStatusConfirmed,
+			Version:    1,
+		}
+		if err = validator.Apply(ctx, h.validators, booking); err != nil {
+			return err
+		}
+		if err = priceBooking(ctx, h.rates, booking); err != nil {
+			return err
+		}
+		bookings = append(bookings, booking)
+	}
+
+	guestID, err := matchGuest(ctx, h.guests, cmd.GuestID, cmd.Email, cmd.FullName)
+	if err != nil {
+		return err
+	}
+	for _, booking := range bookings {
+		booking.GuestID = guestID
+	}
+
+	paymentRef, err := h.authorizeDeposit(ctx, cmd.GroupID, bookings, cmd.PaymentMethod)
+	if err != nil {
+		return err
+	}
+	return settleDeposit(
+		ctx,
+		h.payments,
+		paymentRef,
+		bookings,
+		func(ctx context.Context) error { return h.bookings.InsertGroup(ctx, bookings) },
+		func(ctx context.Context) error { return h.bookings.UpdateGroup(ctx, bookings) },
+		slog.String("group_id", cmd.GroupID),
+	)
+}
+
+// authorizeDeposit holds the deposits of all priced bookings of the group in a
+// single payment, every booking records its own share of the deposit; the
+// payment reference is empty if nothing was authorized.
+func (h createGroupBookingHandler) authorizeDeposit(
+	ctx context.Context,
+	groupID string,
+	bookings []*domain.Booking,
+	paymentMethod string,
+) (string, error) {
+	var deposit int64
+	var currency string
+	for _, booking := range bookings {
+		amount := h.deposit.Amount(booking.TotalPrice)
+		if amount == 0 {
+			continue
+		}
+		if currency != "" && currency != booking.Currency {
+			return "", domain.ErrGroupBookingValidation{
+				Reason: "campsites must be priced in the same currency",
+			}
+		}
+		currency = booking.Currency
+		deposit += amount
+	}
+	if deposit == 0 {
+		return "", nil
+	}
+	if paymentMethod == "" {
+		return "", domain.ErrPaymentMethodRequired{}
+	}
+
+	payment, err := h.payments.Authorize(ctx, domain.PaymentRequest{
+		IdempotencyKey: groupID,
+		PaymentMethod:  paymentMethod,
+		Description:    fmt.Sprintf("Deposit for group booking %s", groupID),
+		Amount:         deposit,
+		Currency:       currency,
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, booking := range bookings {
+		if amount := h.deposit.Amount(booking.TotalPrice); amount > 0 {
+			booking.DepositAmount = amount
+			booking.PaymentRef = payment.Reference
+		}
+	}
+	return payment.Reference, nil
+}
@@ -0,0 +1,206 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateGroupBookingHandler(t *testing.T) {
+	type mocks struct {
+		bookings  *domain.MockBookingRepository
+		guests    *domain.MockGuestRepository
+		rates     *domain.MockCampsiteRatesRepository
+		payments  *domain.MockPaymentGateway
+		validator *domain.MockBookingValidator
+	}
+	groupID := uuid.New().String()
+	campsiteIDs := []string{uuid.New().String(), uuid.New().String()}
+	booking, err := bootstrap.NewBooking(campsiteIDs[0])
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	rates := bootstrap.NewCampsiteRates(campsiteIDs[0])
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteIDs[1]}
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+	}
+	errPaymentDeclined := domain.ErrPaymentDeclined{Reason: "card declined"}
+
+	depositPolicy := domain.DepositPolicy{Percent: 30}
+	deposit := depositPolicy.Amount(
+		rates.Quote(booking.StartDate, booking.EndDate, 1).Total,
+	)
+	paymentRequest := domain.PaymentRequest{
+		IdempotencyKey: groupID,
+		PaymentMethod:  "pm_card_visa",
+		Description:    "Deposit for group booking " + groupID,
+		Amount:         deposit,
+		Currency:       rates.Currency,
+	}
+	payment := &domain.Payment{Reference: "pi_123", Amount: deposit, Currency: rates.Currency}
+	newGuest := &domain.Guest{
+		GuestID:  uuid.New().String(),
+		Email:    domain.NormalizeEmail(booking.Email),
+		FullName: booking.FullName,
+	}
+
+	cmd := CreateGroupBooking{
+		GroupID: groupID,
+		Campsites: []GroupBookingCampsite{
+			{BookingID: uuid.New().String(), CampsiteID: campsiteIDs[0]},
+			{BookingID: uuid.New().String(), CampsiteID: campsiteIDs[1]},
+		},
+		GuestID:       newGuest.GuestID,
+		Email:         booking.Email,
+		FullName:      booking.FullName,
+		StartDate:     booking.StartDate.Format(time.DateOnly),
+		EndDate:       booking.EndDate.Format(time.DateOnly),
+		PaymentMethod: paymentRequest.PaymentMethod,
+	}
+	cmdRepeatedCampsite := cmd
+	cmdRepeatedCampsite.Campsites = []GroupBookingCampsite{
+		{BookingID: uuid.New().String(), CampsiteID: campsiteIDs[0]},
+		{BookingID: uuid.New().String(), CampsiteID: campsiteIDs[0]},
+	}
+
+	groupBookings := func(status domain.BookingStatus) any {
+		return mock.MatchedBy(func(bookings []*domain.Booking) bool {
+			return len(bookings) == 2 &&
+				bookings[0].GroupID == groupID && bookings[1].GroupID == groupID &&
+				bookings[0].GuestID == newGuest.GuestID && bookings[1].GuestID == newGuest.GuestID &&
+				bookings[0].DepositAmount == deposit && bookings[0].PaymentRef == payment.Reference &&
+				bookings[1].DepositAmount == 0 && bookings[1].PaymentRef == "" &&
+				bookings[0].Status == status && bookings[1].Status == status
+		})
+	}
+	onPriced := func(f mocks) {
+		f.validator.
+			On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+			Return(nil)
+		f.rates.
+			On("Find", context.TODO(), campsiteIDs[0]).
+			Return(rates, nil).
+			On("Find", context.TODO(), campsiteIDs[1]).
+			Return(nil, errCampsiteRatesNotFound)
+		f.guests.
+			On("FindOrInsert", context.TODO(), newGuest).
+			Return(newGuest, nil)
+	}
+
+	tests := map[string]struct {
+		cmd     CreateGroupBooking
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				onPriced(f)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Capture", context.TODO(), payment.Reference).
+					Return(nil)
+				f.bookings.
+					On("InsertGroup", context.TODO(), groupBookings(domain.BookingStatusPending)).
+					Return(nil).
+					On("UpdateGroup", context.TODO(), groupBookings(domain.BookingStatusConfirmed)).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_GroupBookingValidation_RepeatedCampsite": {
+			cmd: cmdRepeatedCampsite,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteIDs[0]).
+					Return(rates, nil)
+			},
+			wantErr: domain.ErrGroupBookingValidation{
+				Reason: "campsite " + campsiteIDs[0] + " booked more than once",
+			},
+		},
+		"Error_PaymentDeclined": {
+			cmd: cmd,
+			on: func(f mocks) {
+				onPriced(f)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(nil, errPaymentDeclined)
+			},
+			wantErr: errPaymentDeclined,
+		},
+		"Error_InsertGroup_BookingDatesNotAvailable_DepositVoided": {
+			cmd: cmd,
+			on: func(f mocks) {
+				onPriced(f)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Void", context.TODO(), payment.Reference).
+					Return(nil)
+				f.bookings.
+					On("InsertGroup", context.TODO(), groupBookings(domain.BookingStatusPending)).
+					Return(errBookingDatesNotAvailable)
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
+		"Error_Capture_BookingsCancelled": {
+			cmd: cmd,
+			on: func(f mocks) {
+				onPriced(f)
+				f.payments.
+					On("Authorize", context.TODO(), paymentRequest).
+					Return(payment, nil).
+					On("Capture", context.TODO(), payment.Reference).
+					Return(errors.ErrUnavailable).
+					On("Void", context.TODO(), payment.Reference).
+					Return(nil)
+				f.bookings.
+					On("InsertGroup", context.TODO(), mock.Anything).
+					Return(nil).
+					On("UpdateGroup", context.TODO(), groupBookings(domain.BookingStatusCancelled)).
+					Return(nil)
+			},
+			wantErr: errors.ErrUnavailable,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				bookings:  domain.NewMockBookingRepository(t),
+				guests:    domain.NewMockGuestRepository(t),
+				rates:     domain.NewMockCampsiteRatesRepository(t),
+				payments:  domain.NewMockPaymentGateway(t),
+				validator: domain.NewMockBookingValidator(t),
+			}
+			validators := []domain.BookingValidator{m.validator}
+			h := NewCreateGroupBookingHandler(
+				m.bookings, m.guests, m.rates, m.payments, depositPolicy, validators,
+			)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"CreateGroupBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.bookings, m.guests, m.rates, m.payments)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	DeleteBlackout struct {
+		BlackoutID string
+	}
+
+	// DeleteBlackoutHandler is a logging decorator for the deleteBlackoutHandler struct.
+	DeleteBlackoutHandler handler.Command[DeleteBlackout]
+
+	deleteBlackoutHandler struct {
+		blackouts domain.CampsiteBlackoutRepository
+		offers    WaitlistOffers
+	}
+)
+
+func NewDeleteBlackoutHandler(
+	blackouts domain.CampsiteBlackoutRepository,
+	offers WaitlistOffers,
+) DeleteBlackoutHandler {
+	return decorator.ApplyCommandDecorator[DeleteBlackout](deleteBlackoutHandler{
+		blackouts: blackouts,
+		offers:    offers,
+	})
+}
+
+func (h deleteBlackoutHandler) Handle(ctx context.Context, cmd DeleteBlackout) error {
+	blackout, err := h.blackouts.Find(ctx, cmd.BlackoutID)
+	if err != nil {
+		return err
+	}
+	if err = h.blackouts.Delete(ctx, cmd.BlackoutID); err != nil {
+		return err
+	}
+	// reopened dates are vacated like dates of a cancelled booking
+	h.offers.offerVacatedDates(ctx, blackout.CampsiteID, blackout.StartDate, blackout.EndDate)
+	return nil
+}
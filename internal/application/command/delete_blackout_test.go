@@ -0,0 +1,127 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteBlackoutHandler(t *testing.T) {
+	type mocks struct {
+		campsites     *domain.MockCampsiteRepository
+		bookings      *domain.MockBookingRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+	}
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+
+	blackout := bootstrap.NewCampsiteBlackout(campsite.CampsiteID)
+	entry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	errCampsiteBlackoutNotFound := domain.ErrCampsiteBlackoutNotFound{
+		BlackoutID: blackout.BlackoutID,
+	}
+
+	tests := map[string]struct {
+		cmd     DeleteBlackout
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success_OfferReopenedDates": {
+			cmd: DeleteBlackout{BlackoutID: blackout.BlackoutID},
+			on: func(f mocks) {
+				f.blackouts.
+					On("Find", context.TODO(), blackout.BlackoutID).
+					Return(blackout, nil).
+					On("Delete", context.TODO(), blackout.BlackoutID).
+					Return(nil).
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						blackout.StartDate, blackout.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("FindOffered", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.EntryID == entry.EntryID &&
+							e.Status == domain.WaitlistStatusOffered
+					})).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteBlackoutNotFound": {
+			cmd: DeleteBlackout{BlackoutID: blackout.BlackoutID},
+			on: func(f mocks) {
+				f.blackouts.
+					On("Find", context.TODO(), blackout.BlackoutID).
+					Return(nil, errCampsiteBlackoutNotFound)
+			},
+			wantErr: errCampsiteBlackoutNotFound,
+		},
+		"Error_Delete_CommitTx": {
+			cmd: DeleteBlackout{BlackoutID: blackout.BlackoutID},
+			on: func(f mocks) {
+				f.blackouts.
+					On("Find", context.TODO(), blackout.BlackoutID).
+					Return(blackout, nil).
+					On("Delete", context.TODO(), blackout.BlackoutID).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites:     domain.NewMockCampsiteRepository(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+			}
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				domain.WaitlistPolicy{OfferTTL: time.Hour},
+			)
+			h := NewDeleteBlackoutHandler(m.blackouts, offers)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"DeleteBlackoutHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist)
+		})
+	}
+}
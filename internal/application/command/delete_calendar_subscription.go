@@ -0,0 +1,38 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	DeleteCalendarSubscription struct {
+		SubscriptionID string
+	}
+
+	// DeleteCalendarSubscriptionHandler is a logging decorator for the deleteCalendarSubscriptionHandler struct.
+	DeleteCalendarSubscriptionHandler handler.Command[DeleteCalendarSubscription]
+
+	deleteCalendarSubscriptionHandler struct {
+		subscriptions domain.CalendarSubscriptionRepository
+	}
+)
+
+func NewDeleteCalendarSubscriptionHandler(
+	subscriptions domain.CalendarSubscriptionRepository,
+) DeleteCalendarSubscriptionHandler {
+	return decorator.ApplyCommandDecorator[DeleteCalendarSubscription](
+		deleteCalendarSubscriptionHandler{subscriptions: subscriptions},
+	)
+}
+
+// Handle deletes the subscription along with the dates it blocks.
+func (h deleteCalendarSubscriptionHandler) Handle(
+	ctx context.Context,
+	cmd DeleteCalendarSubscription,
+) error {
+	return h.subscriptions.Delete(ctx, cmd.SubscriptionID)
+}
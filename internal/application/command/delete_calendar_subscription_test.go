@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteCalendarSubscriptionHandler(t *testing.T) {
+	type mocks struct {
+		subscriptions *domain.MockCalendarSubscriptionRepository
+	}
+	subscriptionID := uuid.New().String()
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscriptionID,
+	}
+
+	tests := map[string]struct {
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("Delete", context.TODO(), subscriptionID).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CalendarSubscriptionNotFound": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("Delete", context.TODO(), subscriptionID).
+					Return(errCalendarSubscriptionNotFound)
+			},
+			wantErr: errCalendarSubscriptionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{subscriptions: domain.NewMockCalendarSubscriptionRepository(t)}
+			h := NewDeleteCalendarSubscriptionHandler(m.subscriptions)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(
+				context.TODO(), DeleteCalendarSubscription{SubscriptionID: subscriptionID},
+			)
+			// then
+			assert.Equal(
+				t,
+				tc.wantErr,
+				err,
+				"DeleteCalendarSubscriptionHandler.Handle() error = %v, wantErr %v",
+				err,
+				tc.wantErr,
+			)
+			mock.AssertExpectationsForObjects(t, m.subscriptions)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	DeleteCampground struct {
+		CampgroundID string
+	}
+
+	// DeleteCampgroundHandler is a logging decorator for the deleteCampgroundHandler struct.
+	DeleteCampgroundHandler handler.Command[DeleteCampground]
+
+	deleteCampgroundHandler struct {
+		campgrounds domain.CampgroundRepository
+	}
+)
+
+func NewDeleteCampgroundHandler(campgrounds domain.CampgroundRepository) DeleteCampgroundHandler {
+	return decorator.ApplyCommandDecorator[DeleteCampground](
+		deleteCampgroundHandler{campgrounds: campgrounds},
+	)
+}
+
+func (h deleteCampgroundHandler) Handle(ctx context.Context, cmd DeleteCampground) error {
+	return h.campgrounds.Delete(ctx, cmd.CampgroundID)
+}
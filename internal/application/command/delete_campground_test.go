@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteCampgroundHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+	}
+	campgroundID := uuid.New().String()
+	errCampgroundInUse := domain.ErrCampgroundInUse{CampgroundID: campgroundID}
+
+	tests := map[string]struct {
+		cmd     DeleteCampground
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: DeleteCampground{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Delete", context.TODO(), campgroundID).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampgroundInUse": {
+			cmd: DeleteCampground{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Delete", context.TODO(), campgroundID).
+					Return(errCampgroundInUse)
+			},
+			wantErr: errCampgroundInUse,
+		},
+		"Error_CommitTx": {
+			cmd: DeleteCampground{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Delete", context.TODO(), campgroundID).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+			}
+			h := NewDeleteCampgroundHandler(m.campgrounds)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"DeleteCampgroundHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ExpireWaitlistOffers struct{}
+
+	// ExpireWaitlistOffersHandler is a logging decorator for the expireWaitlistOffersHandler struct.
+	ExpireWaitlistOffersHandler handler.Command[ExpireWaitlistOffers]
+
+	expireWaitlistOffersHandler struct {
+		waitlist domain.WaitlistRepository
+		offers   WaitlistOffers
+	}
+)
+
+func NewExpireWaitlistOffersHandler(
+	waitlist domain.WaitlistRepository,
+	offers WaitlistOffers,
+) ExpireWaitlistOffersHandler {
+	return decorator.ApplyCommandDecorator[ExpireWaitlistOffers](
+		expireWaitlistOffersHandler{waitlist: waitlist, offers: offers},
+	)
+}
+
+// Handle expires the offers not accepted in time and offers their dates to the
+// next matching entries of the waitlist. An offer that cannot be expired is
+// retried on the next run instead of failing the expiry of the others.
+func (h expireWaitlistOffersHandler) Handle(ctx context.Context, _ ExpireWaitlistOffers) error {
+	entries, err := h.waitlist.FindExpiredOffers(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, entry := range entries {
+		if err = h.offers.expire(ctx, entry); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	if len(entries) > 0 {
+		slog.InfoContext(ctx, "expired waitlist offers", slog.Int("offers", len(entries)))
+	}
+	return result.ErrorOrNil()
+}
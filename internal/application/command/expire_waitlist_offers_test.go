@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpireWaitlistOffersHandler(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+
+	expiredOffer := func() *domain.WaitlistEntry {
+		entry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+		entry.Offer(campsite.CampsiteID, time.Now().Add(-2*time.Hour),
+			domain.WaitlistPolicy{OfferTTL: time.Hour})
+		return entry
+	}
+	entry := expiredOffer()
+	otherEntry := expiredOffer()
+	expired := func(e *domain.WaitlistEntry) any {
+		return mock.MatchedBy(func(u *domain.WaitlistEntry) bool {
+			return u.EntryID == e.EntryID && u.Status == domain.WaitlistStatusExpired
+		})
+	}
+
+	tests := map[string]struct {
+		on      func(f waitlistOffersMocks)
+		wantErr error
+	}{
+		"Success": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindExpiredOffers", context.TODO(), mock.AnythingOfType("time.Time")).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("Update", context.TODO(), expired(entry)).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NoExpiredOffers": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindExpiredOffers", context.TODO(), mock.AnythingOfType("time.Time")).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FindExpiredOffers": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindExpiredOffers", context.TODO(), mock.AnythingOfType("time.Time")).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_Update_OthersExpired": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindExpiredOffers", context.TODO(), mock.AnythingOfType("time.Time")).
+					Return([]*domain.WaitlistEntry{entry, otherEntry}, nil).
+					On("Update", context.TODO(), expired(entry)).
+					Return(bootstrap.ErrCommitTx).
+					On("Update", context.TODO(), expired(otherEntry)).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						otherEntry.StartDate, otherEntry.EndDate).
+					Return(nil, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: multierror.Append(nil, bootstrap.ErrCommitTx),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m, offers := newWaitlistOffersMocks(t)
+			h := NewExpireWaitlistOffersHandler(m.waitlist, offers)
+			tc.on(m)
+			// when
+			err := h.Handle(context.TODO(), ExpireWaitlistOffers{})
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"ExpireWaitlistOffersHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, m.validator)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+)
+
+const (
+	campsiteColumnCampsiteCode  = "campsite_code"
+	campsiteColumnCapacity      = "capacity"
+	campsiteColumnDrinkingWater = "drinking_water"
+	campsiteColumnRestrooms     = "restrooms"
+	campsiteColumnPicnicTable   = "picnic_table"
+	campsiteColumnFirePit       = "fire_pit"
+	campsiteColumnCampgroundID  = "campground_id"
+	campsiteColumnActive        = "active"
+)
+
+type (
+	ImportCampsites struct {
+		Mode string
+		// Rows to import, the outcome of each row is set on it. A row that could
+		// not be parsed is failed beforehand.
+		Rows []*domain.CampsiteImportRow
+	}
+
+	// ImportCampsitesHandler is a logging decorator for the importCampsitesHandler struct.
+	ImportCampsitesHandler handler.Command[ImportCampsites]
+
+	importCampsitesHandler struct {
+		campgrounds domain.CampgroundRepository
+		campsites   domain.CampsiteRepository
+	}
+)
+
+func NewImportCampsitesHandler(
+	campgrounds domain.CampgroundRepository,
+	campsites domain.CampsiteRepository,
+) ImportCampsitesHandler {
+	return decorator.ApplyCommandDecorator[ImportCampsites](
+		importCampsitesHandler{campgrounds: campgrounds, campsites: campsites},
+	)
+}
+
+// Handle validates every row and upserts the campsites of the valid ones by
+// their code, the outcome of each row is set on the rows of the command.
+func (h importCampsitesHandler) Handle(ctx context.Context, cmd ImportCampsites) error {
+	mode := domain.CampsiteImportMode(strings.ToUpper(cmd.Mode))
+	switch mode {
+	case "":
+		mode = domain.CampsiteImportModeAllOrNothing
+	case domain.CampsiteImportModeAllOrNothing, domain.CampsiteImportModeBestEffort:
+	default:
+		return domain.ErrCampsiteImportValidation{Reason: "unknown mode " + cmd.Mode}
+	}
+	if len(cmd.Rows) == 0 {
+		return domain.ErrCampsiteImportValidation{Reason: "no campsites to import"}
+	}
+
+	var valid []*domain.CampsiteImportRow
+	codes := make(map[string]int)
+	campgrounds := make(map[string]bool)
+	for i, row := range cmd.Rows {
+		row.Row = i + 1
+		if row.Status == domain.CampsiteImportStatusFailed {
+			continue
+		}
+
+		reason, err := h.validateRow(ctx, row.Campsite, codes, campgrounds)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			row.Fail(reason)
+			continue
+		}
+		codes[row.Campsite.CampsiteCode] = row.Row
+		valid = append(valid, row)
+	}
+
+	if len(valid) < len(cmd.Rows) && mode == domain.CampsiteImportModeAllOrNothing {
+		domain.SkipCampsiteImportRows(cmd.Rows)
+		return nil
+	}
+	if len(valid) > 0 {
+		return h.campsites.Upsert(ctx, valid, mode)
+	}
+	return nil
+}
+
+// validateRow returns the reason the campsite of a row is invalid, codes and
+// campgrounds hold the codes of the previous valid rows and the campgrounds
+// already looked up.
+func (h importCampsitesHandler) validateRow(
+	ctx context.Context,
+	r *domain.Campsite,
+	codes map[string]int,
+	campgrounds map[string]bool,
+) (string, error) {
+	switch {
+	case strings.TrimSpace(r.CampsiteCode) == "":
+		return campsiteColumnCampsiteCode + " must not be empty", nil
+	case r.Capacity <= 0:
+		return campsiteColumnCapacity + " must be greater than 0", nil
+	}
+	if row, ok := codes[r.CampsiteCode]; ok {
+		return fmt.Sprintf("%s %s repeats row %d",
+			campsiteColumnCampsiteCode, r.CampsiteCode, row), nil
+	}
+	if r.CampgroundID == "" {
+		return "", nil
+	}
+
+	found, ok := campgrounds[r.CampgroundID]
+	if !ok {
+		_, err := h.campgrounds.Find(ctx, r.CampgroundID)
+		var notFound domain.ErrCampgroundNotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return "", err
+		}
+		found = err == nil
+		campgrounds[r.CampgroundID] = found
+	}
+	if !found {
+		return domain.ErrCampgroundNotFound{CampgroundID: r.CampgroundID}.Error(), nil
+	}
+	return "", nil
+}
+
+// ParseCampsitesCSV reads the rows of a CSV document with a header row naming
+// the columns, campsite_code and capacity are required. A malformed row is
+// returned failed.
+func ParseCampsitesCSV(r io.Reader) ([]*domain.CampsiteImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, domain.ErrCampsiteImportValidation{Reason: "missing CSV header"}
+		}
+		return nil, domain.ErrCampsiteImportValidation{Reason: err.Error()}
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case campsiteColumnCampsiteCode, campsiteColumnCapacity, campsiteColumnDrinkingWater,
+			campsiteColumnRestrooms, campsiteColumnPicnicTable, campsiteColumnFirePit,
+			campsiteColumnCampgroundID, campsiteColumnActive:
+		default:
+			return nil, domain.ErrCampsiteImportValidation{Reason: "unknown CSV column " + name}
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{campsiteColumnCampsiteCode, campsiteColumnCapacity} {
+		if _, ok := columns[name]; !ok {
+			return nil, domain.ErrCampsiteImportValidation{Reason: "missing CSV column " + name}
+		}
+	}
+
+	var rows []*domain.CampsiteImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if errors.Is(err, csv.ErrFieldCount) {
+				row := newCampsiteImportRow()
+				row.Fail(err.Error())
+				rows = append(rows, row)
+				continue
+			}
+			return nil, domain.ErrCampsiteImportValidation{Reason: err.Error()}
+		}
+		rows = append(rows, parseCampsiteRecord(columns, record))
+	}
+	return rows, nil
+}
+
+func parseCampsiteRecord(columns map[string]int, record []string) *domain.CampsiteImportRow {
+	value := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	row := newCampsiteImportRow()
+	c := row.Campsite
+	c.CampsiteCode = value(campsiteColumnCampsiteCode)
+	c.CampgroundID = value(campsiteColumnCampgroundID)
+
+	capacity, err := strconv.ParseInt(value(campsiteColumnCapacity), 10, 32)
+	if err != nil {
+		row.Fail("invalid " + campsiteColumnCapacity + " " + value(campsiteColumnCapacity))
+		return row
+	}
+	c.Capacity = int32(capacity)
+
+	for _, column := range []struct {
+		name  string
+		field *bool
+	}{
+		{campsiteColumnDrinkingWater, &c.DrinkingWater},
+		{campsiteColumnRestrooms, &c.Restrooms},
+		{campsiteColumnPicnicTable, &c.PicnicTable},
+		{campsiteColumnFirePit, &c.FirePit},
+		{campsiteColumnActive, &c.Active},
+	} {
+		if value(column.name) == "" {
+			continue
+		}
+		v, err := strconv.ParseBool(value(column.name))
+		if err != nil {
+			row.Fail("invalid " + column.name + " " + value(column.name))
+			return row
+		}
+		*column.field = v
+	}
+	row.ActiveImported = value(campsiteColumnActive) != ""
+	return row
+}
+
+// ParseCampsitesJSON reads the rows of a JSON array of campsite objects keyed
+// like the CSV columns. A malformed object is returned failed.
+func ParseCampsitesJSON(r io.Reader) ([]*domain.CampsiteImportRow, error) {
+	var objects []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return nil, domain.ErrCampsiteImportValidation{Reason: "invalid JSON array: " + err.Error()}
+	}
+
+	rows := make([]*domain.CampsiteImportRow, 0, len(objects))
+	for _, object := range objects {
+		var campsite struct {
+			CampsiteCode  string `json:"campsite_code"`
+			Capacity      int32  `json:"capacity"`
+			DrinkingWater bool   `json:"drinking_water"`
+			Restrooms     bool   `json:"restrooms"`
+			PicnicTable   bool   `json:"picnic_table"`
+			FirePit       bool   `json:"fire_pit"`
+			CampgroundID  string `json:"campground_id"`
+			Active        *bool  `json:"active"`
+		}
+		row := newCampsiteImportRow()
+		rows = append(rows, row)
+		decoder := json.NewDecoder(bytes.NewReader(object))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&campsite); err != nil {
+			row.Fail(err.Error())
+			continue
+		}
+		row.Campsite.CampgroundID = campsite.CampgroundID
+		row.Campsite.CampsiteCode = campsite.CampsiteCode
+		row.Campsite.Capacity = campsite.Capacity
+		row.Campsite.DrinkingWater = campsite.DrinkingWater
+		row.Campsite.Restrooms = campsite.Restrooms
+		row.Campsite.PicnicTable = campsite.PicnicTable
+		row.Campsite.FirePit = campsite.FirePit
+		if campsite.Active != nil {
+			row.Campsite.Active = *campsite.Active
+			row.ActiveImported = true
+		}
+	}
+	return rows, nil
+}
+
+// newCampsiteImportRow returns a row of a campsite created active unless its
+// active flag is imported.
+func newCampsiteImportRow() *domain.CampsiteImportRow {
+	return &domain.CampsiteImportRow{Campsite: &domain.Campsite{Active: true}}
+}
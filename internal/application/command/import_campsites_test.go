@@ -0,0 +1,341 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImportCampsitesHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+		campsites   *domain.MockCampsiteRepository
+	}
+	campgroundID := uuid.New().String()
+	unknownCampgroundID := uuid.New().String()
+	campground := &domain.Campground{CampgroundID: campgroundID}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: unknownCampgroundID}
+
+	row := func(campgroundID string, code string, capacity int32) *domain.CampsiteImportRow {
+		return &domain.CampsiteImportRow{Campsite: &domain.Campsite{
+			CampsiteID:   uuid.New().String(),
+			CampgroundID: campgroundID,
+			CampsiteCode: code,
+			Capacity:     capacity,
+			Active:       true,
+		}}
+	}
+	// the handler sets the outcomes on the rows, every import gets its own
+	validRows := func() []*domain.CampsiteImportRow {
+		return []*domain.CampsiteImportRow{row("", "A01", 4), row(campgroundID, "A02", 6)}
+	}
+	invalidRows := func() []*domain.CampsiteImportRow {
+		unparsed := row("", "", 0)
+		unparsed.Fail("invalid capacity four")
+		return append([]*domain.CampsiteImportRow{
+			row("", "", 4),
+			row("", "A03", 0),
+			row("", "A01", 2),
+			unparsed,
+			row(unknownCampgroundID, "A04", 2),
+		}, validRows()...)
+	}
+	upserted := func(codes ...string) any {
+		return mock.MatchedBy(func(rows []*domain.CampsiteImportRow) bool {
+			if len(rows) != len(codes) {
+				return false
+			}
+			for i, row := range rows {
+				if row.Campsite.CampsiteCode != codes[i] || !row.Campsite.Active {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	setStatus := func(status domain.CampsiteImportStatus) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			for _, row := range args.Get(1).([]*domain.CampsiteImportRow) {
+				row.Status = status
+			}
+		}
+	}
+
+	tests := map[string]struct {
+		mode       string
+		rows       func() []*domain.CampsiteImportRow
+		on         func(f mocks)
+		wantStatus []domain.CampsiteImportStatus
+		wantError  []string
+		wantErr    error
+	}{
+		"Success_AllOrNothing": {
+			rows: validRows,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Upsert", context.TODO(), upserted("A01", "A02"),
+						domain.CampsiteImportModeAllOrNothing).
+					Run(setStatus(domain.CampsiteImportStatusCreated)).
+					Return(nil)
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusCreated,
+				domain.CampsiteImportStatusCreated,
+			},
+			wantError: []string{"", ""},
+			wantErr:   nil,
+		},
+		"Success_BestEffort_InvalidRowsFailed": {
+			mode: "best_effort",
+			rows: invalidRows,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), unknownCampgroundID).
+					Return(nil, errCampgroundNotFound).
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Upsert", context.TODO(), upserted("A01", "A02"),
+						domain.CampsiteImportModeBestEffort).
+					Run(setStatus(domain.CampsiteImportStatusUpdated)).
+					Return(nil)
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusUpdated,
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusUpdated,
+			},
+			wantError: []string{
+				"campsite_code must not be empty",
+				"capacity must be greater than 0",
+				"",
+				"invalid capacity four",
+				errCampgroundNotFound.Error(),
+				"campsite_code A01 repeats row 3",
+				"",
+			},
+			wantErr: nil,
+		},
+		"Success_AllOrNothing_InvalidRowSkipsOthers": {
+			mode: string(domain.CampsiteImportModeAllOrNothing),
+			rows: func() []*domain.CampsiteImportRow {
+				return append(validRows(), row("", "A01", 4))
+			},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusSkipped,
+				domain.CampsiteImportStatusSkipped,
+				domain.CampsiteImportStatusFailed,
+			},
+			wantError: []string{"", "", "campsite_code A01 repeats row 1"},
+			wantErr:   nil,
+		},
+		"Error_CampsiteImportValidation_UnknownMode": {
+			mode:    "SOME",
+			rows:    validRows,
+			on:      nil,
+			wantErr: domain.ErrCampsiteImportValidation{Reason: "unknown mode SOME"},
+		},
+		"Error_CampsiteImportValidation_NoRows": {
+			rows:    func() []*domain.CampsiteImportRow { return nil },
+			on:      nil,
+			wantErr: domain.ErrCampsiteImportValidation{Reason: "no campsites to import"},
+		},
+		"Error_FindCampground": {
+			rows: validRows,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_Upsert": {
+			rows: validRows,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Upsert", context.TODO(), upserted("A01", "A02"),
+						domain.CampsiteImportModeAllOrNothing).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+				campsites:   domain.NewMockCampsiteRepository(t),
+			}
+			h := NewImportCampsitesHandler(m.campgrounds, m.campsites)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			rows := tc.rows()
+			// when
+			err := h.Handle(context.TODO(), ImportCampsites{Mode: tc.mode, Rows: rows})
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"ImportCampsitesHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			if tc.wantErr == nil && assert.Len(t, rows, len(tc.wantStatus)) {
+				for i, row := range rows {
+					assert.Equal(t, i+1, row.Row)
+					assert.Equal(t, tc.wantStatus[i], row.Status, "row %d status", row.Row)
+					assert.Equal(t, tc.wantError[i], row.Error, "row %d error", row.Row)
+				}
+			}
+			mock.AssertExpectationsForObjects(t, m.campgrounds, m.campsites)
+		})
+	}
+}
+
+func TestParseCampsitesCSV(t *testing.T) {
+	tests := map[string]struct {
+		document string
+		want     []*domain.CampsiteImportRow
+		wantErr  error
+	}{
+		"Success": {
+			document: "campsite_code, capacity, fire_pit, Drinking_Water, campground_id\n" +
+				"A01, 4, true, 0, \n" +
+				"A02,six,,,\n" +
+				"A03,2,yes,,\n" +
+				"A04,2\n",
+			want: []*domain.CampsiteImportRow{
+				{Campsite: &domain.Campsite{
+					CampsiteCode: "A01", Capacity: 4, FirePit: true, Active: true,
+				}},
+				failedImportRow(domain.Campsite{CampsiteCode: "A02"}, "invalid capacity six"),
+				failedImportRow(domain.Campsite{CampsiteCode: "A03", Capacity: 2},
+					"invalid fire_pit yes"),
+				failedImportRow(domain.Campsite{}, "record on line 5: wrong number of fields"),
+			},
+			wantErr: nil,
+		},
+		"Success_Active": {
+			document: "campsite_code,capacity,active\n" +
+				"A01,4,false\n" +
+				"A02,4,\n" +
+				"A03,4,no\n",
+			want: []*domain.CampsiteImportRow{
+				{
+					Campsite:       &domain.Campsite{CampsiteCode: "A01", Capacity: 4},
+					ActiveImported: true,
+				},
+				{Campsite: &domain.Campsite{CampsiteCode: "A02", Capacity: 4, Active: true}},
+				failedImportRow(domain.Campsite{CampsiteCode: "A03", Capacity: 4},
+					"invalid active no"),
+			},
+			wantErr: nil,
+		},
+		"Error_MissingHeader": {
+			document: "",
+			want:     nil,
+			wantErr:  domain.ErrCampsiteImportValidation{Reason: "missing CSV header"},
+		},
+		"Error_MissingColumn": {
+			document: "campsite_code\nA01\n",
+			want:     nil,
+			wantErr:  domain.ErrCampsiteImportValidation{Reason: "missing CSV column capacity"},
+		},
+		"Error_UnknownColumn": {
+			document: "campsite_code,capacity,shower\nA01,4,true\n",
+			want:     nil,
+			wantErr:  domain.ErrCampsiteImportValidation{Reason: "unknown CSV column shower"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := ParseCampsitesCSV(strings.NewReader(tc.document))
+			// then
+			assert.Equal(t, tc.want, got,
+				"ParseCampsitesCSV() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ParseCampsitesCSV() error = %v, wantErr %v", err, tc.wantErr)
+		})
+	}
+}
+
+func TestParseCampsitesJSON(t *testing.T) {
+	tests := map[string]struct {
+		document string
+		want     []*domain.CampsiteImportRow
+		wantErr  bool
+	}{
+		"Success": {
+			document: `[
+				{"campsite_code": "A01", "capacity": 4, "restrooms": true},
+				{"campsite_code": "A02", "capacity": "six"},
+				{"campsite_code": "A03", "capacity": 2, "shower": true},
+				{"campsite_code": "A04", "capacity": 2, "active": false}
+			]`,
+			want: []*domain.CampsiteImportRow{
+				{Campsite: &domain.Campsite{
+					CampsiteCode: "A01", Capacity: 4, Restrooms: true, Active: true,
+				}},
+				failedImportRow(domain.Campsite{},
+					"json: cannot unmarshal string into Go struct field .capacity of type int32"),
+				failedImportRow(domain.Campsite{}, `json: unknown field "shower"`),
+				{
+					Campsite:       &domain.Campsite{CampsiteCode: "A04", Capacity: 2},
+					ActiveImported: true,
+				},
+			},
+			wantErr: false,
+		},
+		"Error_InvalidArray": {
+			document: `{"campsite_code": "A01"}`,
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := ParseCampsitesJSON(strings.NewReader(tc.document))
+			// then
+			assert.Equal(t, tc.want, got,
+				"ParseCampsitesJSON() got = %v, want %v", got, tc.want)
+			if tc.wantErr {
+				assert.IsType(t, domain.ErrCampsiteImportValidation{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// failedImportRow returns the row of the active campsite failed to parse.
+func failedImportRow(c domain.Campsite, reason string) *domain.CampsiteImportRow {
+	c.Active = true
+	return &domain.CampsiteImportRow{
+		Campsite: &c,
+		Status:   domain.CampsiteImportStatusFailed,
+		Error:    reason,
+	}
+}
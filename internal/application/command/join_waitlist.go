@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	JoinWaitlist struct {
+		EntryID      string
+		CampgroundID string
+		// Campsite wanted, any campsite of campground with enough capacity if empty.
+		CampsiteID string
+		Email      string
+		FullName   string
+		StartDate  string
+		EndDate    string
+		Guests     int32
+	}
+
+	// JoinWaitlistHandler is a logging decorator for the joinWaitlistHandler struct.
+	JoinWaitlistHandler handler.Command[JoinWaitlist]
+
+	joinWaitlistHandler struct {
+		campgrounds domain.CampgroundRepository
+		campsites   domain.CampsiteRepository
+		waitlist    domain.WaitlistRepository
+		validators  []domain.BookingValidator
+	}
+)
+
+func NewJoinWaitlistHandler(
+	campgrounds domain.CampgroundRepository,
+	campsites domain.CampsiteRepository,
+	waitlist domain.WaitlistRepository,
+	validators []domain.BookingValidator,
+) JoinWaitlistHandler {
+	return decorator.ApplyCommandDecorator[JoinWaitlist](joinWaitlistHandler{
+		campgrounds: campgrounds,
+		campsites:   campsites,
+		waitlist:    waitlist,
+		validators:  validators,
+	})
+}
+
+func (h joinWaitlistHandler) Handle(ctx context.Context, cmd JoinWaitlist) error {
+	entry := &domain.WaitlistEntry{
+		EntryID:      cmd.EntryID,
+		CampgroundID: cmd.CampgroundID,
+		CampsiteID:   cmd.CampsiteID,
+		Email:        cmd.Email,
+		FullName:     cmd.FullName,
+		Guests:       max(cmd.Guests, 1),
+		Status:       domain.WaitlistStatusWaiting,
+	}
+	startDate, err := domain.ParseDate("start_date", cmd.StartDate)
+	if err != nil {
+		return err
+	}
+	entry.StartDate = startDate
+
+	endDate, err := domain.ParseDate("end_date", cmd.EndDate)
+	if err != nil {
+		return err
+	}
+	entry.EndDate = endDate
+
+	// entry must be bookable once offered
+	err = validator.Apply(ctx, h.validators, &domain.Booking{
+		CampsiteID: entry.CampsiteID,
+		Email:      entry.Email,
+		FullName:   entry.FullName,
+		StartDate:  entry.StartDate,
+		EndDate:    entry.EndDate,
+		Guests:     entry.Guests,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err = h.campgrounds.Find(ctx, cmd.CampgroundID); err != nil {
+		return err
+	}
+	if cmd.CampsiteID != "" {
+		var campsite *domain.Campsite
+		campsite, err = h.campsites.Find(ctx, cmd.CampsiteID)
+		if err != nil {
+			return err
+		}
+		if campsite.CampgroundID != cmd.CampgroundID {
+			return domain.ErrCampsiteNotFound{CampsiteID: cmd.CampsiteID}
+		}
+	}
+	return h.waitlist.Insert(ctx, entry)
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJoinWaitlistHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+		campsites   *domain.MockCampsiteRepository
+		waitlist    *domain.MockWaitlistRepository
+		validator   *domain.MockBookingValidator
+	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = campground.CampgroundID
+	otherCampsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	entry := bootstrap.NewWaitlistEntry(campground.CampgroundID)
+	entry.ID = 0
+	entry.CreatedAt = time.Time{}
+
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: otherCampsite.CampsiteID}
+	errBookingMaximumStay := validator.ErrBookingMaximumStay{}
+	monthOutOfRangeDate := "2024-99-01"
+
+	cmd := JoinWaitlist{
+		EntryID:      entry.EntryID,
+		CampgroundID: entry.CampgroundID,
+		Email:        entry.Email,
+		FullName:     entry.FullName,
+		StartDate:    entry.StartDate.Format(time.DateOnly),
+		EndDate:      entry.EndDate.Format(time.DateOnly),
+		Guests:       entry.Guests,
+	}
+	cmdWithCampsite := cmd
+	cmdWithCampsite.CampsiteID = campsite.CampsiteID
+	entryWithCampsite := *entry
+	entryWithCampsite.CampsiteID = campsite.CampsiteID
+	cmdWithOtherCampsite := cmd
+	cmdWithOtherCampsite.CampsiteID = otherCampsite.CampsiteID
+
+	tests := map[string]struct {
+		cmd     JoinWaitlist
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+				f.waitlist.
+					On("Insert", context.TODO(), entry).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Success_Campsite": {
+			cmd: cmdWithCampsite,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.waitlist.
+					On("Insert", context.TODO(), &entryWithCampsite).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_ParseStartDate": {
+			cmd: JoinWaitlist{
+				CampgroundID: cmd.CampgroundID,
+				StartDate:    monthOutOfRangeDate,
+				EndDate:      cmd.EndDate,
+			},
+			on:      nil,
+			wantErr: domain.ErrInvalidDate{FieldName: "start_date", Value: monthOutOfRangeDate},
+		},
+		"Error_Validate_BookingMaximumStay": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(errBookingMaximumStay)
+			},
+			wantErr: domain.ErrBookingValidation{
+				MultiErr: multierror.Append(errBookingMaximumStay),
+			},
+		},
+		"Error_CampgroundNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_CampsiteNotFound_OtherCampground": {
+			cmd: cmdWithOtherCampsite,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+				f.campsites.
+					On("Find", context.TODO(), otherCampsite.CampsiteID).
+					Return(otherCampsite, nil)
+			},
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_Insert_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.validator.
+					On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+					Return(nil)
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+				f.waitlist.
+					On("Insert", context.TODO(), entry).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+				campsites:   domain.NewMockCampsiteRepository(t),
+				waitlist:    domain.NewMockWaitlistRepository(t),
+				validator:   domain.NewMockBookingValidator(t),
+			}
+			validators := []domain.BookingValidator{m.validator}
+			h := NewJoinWaitlistHandler(m.campgrounds, m.campsites, m.waitlist, validators)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.campgrounds, m.campsites, m.waitlist)
+
+			assert.Equal(t, tc.wantErr, err,
+				"JoinWaitlistHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	LeaveWaitlist struct {
+		EntryID string
+	}
+
+	// LeaveWaitlistHandler is a logging decorator for the leaveWaitlistHandler struct.
+	LeaveWaitlistHandler handler.Command[LeaveWaitlist]
+
+	leaveWaitlistHandler struct {
+		waitlist domain.WaitlistRepository
+		offers   WaitlistOffers
+	}
+)
+
+func NewLeaveWaitlistHandler(
+	waitlist domain.WaitlistRepository,
+	offers WaitlistOffers,
+) LeaveWaitlistHandler {
+	return decorator.ApplyCommandDecorator[LeaveWaitlist](leaveWaitlistHandler{
+		waitlist: waitlist,
+		offers:   offers,
+	})
+}
+
+func (h leaveWaitlistHandler) Handle(ctx context.Context, cmd LeaveWaitlist) error {
+	entry, err := h.waitlist.Find(ctx, cmd.EntryID)
+	if err != nil {
+		return err
+	}
+	if err = h.waitlist.Delete(ctx, cmd.EntryID); err != nil {
+		return err
+	}
+	if entry.Status == domain.WaitlistStatusOffered {
+		// pass declined offer on to the next entry waiting
+		h.offers.offerVacatedDates(ctx, entry.OfferCampsiteID, entry.StartDate, entry.EndDate)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaveWaitlistHandler(t *testing.T) {
+	type mocks struct {
+		campsites     *domain.MockCampsiteRepository
+		bookings      *domain.MockBookingRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+	}
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+
+	entry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	offeredEntry := *entry
+	offeredEntry.Offer(campsite.CampsiteID, time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	nextEntry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	errWaitlistEntryNotFound := domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}
+
+	tests := map[string]struct {
+		cmd     LeaveWaitlist
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: LeaveWaitlist{EntryID: entry.EntryID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), entry.EntryID).
+					Return(entry, nil).
+					On("Delete", context.TODO(), entry.EntryID).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Success_OfferPassedOn": {
+			cmd: LeaveWaitlist{EntryID: entry.EntryID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), entry.EntryID).
+					Return(&offeredEntry, nil).
+					On("Delete", context.TODO(), entry.EntryID).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{nextEntry}, nil).
+					On("FindOffered", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.EntryID == nextEntry.EntryID &&
+							e.Status == domain.WaitlistStatusOffered
+					})).
+					Return(nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_OfferNotPassedOn_CampsiteBlackedOut": {
+			cmd: LeaveWaitlist{EntryID: entry.EntryID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), entry.EntryID).
+					Return(&offeredEntry, nil).
+					On("Delete", context.TODO(), entry.EntryID).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{nextEntry}, nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						nextEntry.StartDate, nextEntry.EndDate).
+					Return([]*domain.CampsiteBlackout{
+						bootstrap.NewCampsiteBlackout(campsite.CampsiteID),
+					}, nil)
+			},
+			wantErr: nil,
+		},
+		"Error_WaitlistEntryNotFound": {
+			cmd: LeaveWaitlist{EntryID: entry.EntryID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), entry.EntryID).
+					Return(nil, errWaitlistEntryNotFound)
+			},
+			wantErr: errWaitlistEntryNotFound,
+		},
+		"Error_Delete_CommitTx": {
+			cmd: LeaveWaitlist{EntryID: entry.EntryID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("Find", context.TODO(), entry.EntryID).
+					Return(entry, nil).
+					On("Delete", context.TODO(), entry.EntryID).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites:     domain.NewMockCampsiteRepository(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+			}
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				domain.WaitlistPolicy{OfferTTL: time.Hour},
+			)
+			h := NewLeaveWaitlistHandler(m.waitlist, offers)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"LeaveWaitlistHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	MarkNoShow struct {
+		BookingID string
+	}
+
+	// MarkNoShowHandler is a logging decorator for the markNoShowHandler struct.
+	MarkNoShowHandler handler.Command[MarkNoShow]
+
+	markNoShowHandler struct {
+		bookings domain.BookingRepository
+	}
+)
+
+func NewMarkNoShowHandler(bookings domain.BookingRepository) MarkNoShowHandler {
+	return decorator.ApplyCommandDecorator[MarkNoShow](markNoShowHandler{
+		bookings: bookings,
+	})
+}
+
+// Handle marks a confirmed booking whose guest did not arrive as no-show, not
+// before its start date; the campsite is vacated for all its nights.
+func (h markNoShowHandler) Handle(ctx context.Context, cmd MarkNoShow) error {
+	booking, err := h.bookings.Find(ctx, cmd.BookingID)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(booking.StartDate) {
+		return domain.ErrBookingNotStarted{BookingID: cmd.BookingID, StartDate: booking.StartDate}
+	}
+	if err = booking.TransitionTo(domain.BookingStatusNoShow); err != nil {
+		return err
+	}
+	return h.bookings.Update(ctx, booking)
+}
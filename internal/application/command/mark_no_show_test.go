@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarkNoShowHandler(t *testing.T) {
+	type mocks struct {
+		bookings *domain.MockBookingRepository
+	}
+	booking, err := bootstrap.NewBookingWithAddDays(uuid.New().String(), 0, 2)
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.Status = domain.BookingStatusConfirmed
+	withStatus := func(status domain.BookingStatus) any {
+		return mock.MatchedBy(func(b *domain.Booking) bool {
+			return b.BookingID == booking.BookingID && b.Status == status
+		})
+	}
+	errBookingNotStarted := domain.ErrBookingNotStarted{
+		BookingID: booking.BookingID,
+		StartDate: booking.StartDate.AddDate(0, 0, 1),
+	}
+	errBookingStatusTransition := domain.ErrBookingStatusTransition{
+		BookingID: booking.BookingID,
+		From:      domain.BookingStatusCheckedIn,
+		To:        domain.BookingStatusNoShow,
+	}
+
+	tests := map[string]struct {
+		cmd     MarkNoShow
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: MarkNoShow{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil).
+					On("Update", context.TODO(), withStatus(domain.BookingStatusNoShow)).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_BookingNotStarted": {
+			cmd: MarkNoShow{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				b.StartDate = b.StartDate.AddDate(0, 0, 1)
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil)
+			},
+			wantErr: errBookingNotStarted,
+		},
+		"Error_BookingStatusTransition": {
+			cmd: MarkNoShow{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				b.Status = domain.BookingStatusCheckedIn
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil)
+			},
+			wantErr: errBookingStatusTransition,
+		},
+		"Error_Update_CommitTx": {
+			cmd: MarkNoShow{BookingID: booking.BookingID},
+			on: func(f mocks) {
+				b := *booking
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(&b, nil).
+					On("Update", context.TODO(), withStatus(domain.BookingStatusNoShow)).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{bookings: domain.NewMockBookingRepository(t)}
+			h := NewMarkNoShowHandler(m.bookings)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"MarkNoShowHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.bookings)
+		})
+	}
+}
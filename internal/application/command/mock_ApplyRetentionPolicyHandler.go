@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package command
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockApplyRetentionPolicyHandler creates a new instance of MockApplyRetentionPolicyHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockApplyRetentionPolicyHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockApplyRetentionPolicyHandler {
+	mock := &MockApplyRetentionPolicyHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockApplyRetentionPolicyHandler is an autogenerated mock type for the ApplyRetentionPolicyHandler type
+type MockApplyRetentionPolicyHandler struct {
+	mock.Mock
+}
+
+type MockApplyRetentionPolicyHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockApplyRetentionPolicyHandler) EXPECT() *MockApplyRetentionPolicyHandler_Expecter {
+	return &MockApplyRetentionPolicyHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockApplyRetentionPolicyHandler
+func (_mock *MockApplyRetentionPolicyHandler) Handle(ctx context.Context, cmd ApplyRetentionPolicy) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ApplyRetentionPolicy) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApplyRetentionPolicyHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockApplyRetentionPolicyHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd ApplyRetentionPolicy
+func (_e *MockApplyRetentionPolicyHandler_Expecter) Handle(ctx any, cmd any) *MockApplyRetentionPolicyHandler_Handle_Call {
+	return &MockApplyRetentionPolicyHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, cmd)}
+}
+
+func (_c *MockApplyRetentionPolicyHandler_Handle_Call) Run(run func(ctx context.Context, cmd ApplyRetentionPolicy)) *MockApplyRetentionPolicyHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ApplyRetentionPolicy
+		if args[1] != nil {
+			arg1 = args[1].(ApplyRetentionPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApplyRetentionPolicyHandler_Handle_Call) Return(err error) *MockApplyRetentionPolicyHandler_Handle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApplyRetentionPolicyHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, cmd ApplyRetentionPolicy) error) *MockApplyRetentionPolicyHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
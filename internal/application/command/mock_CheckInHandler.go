@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package command
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCheckInHandler creates a new instance of MockCheckInHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCheckInHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCheckInHandler {
+	mock := &MockCheckInHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCheckInHandler is an autogenerated mock type for the CheckInHandler type
+type MockCheckInHandler struct {
+	mock.Mock
+}
+
+type MockCheckInHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCheckInHandler) EXPECT() *MockCheckInHandler_Expecter {
+	return &MockCheckInHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockCheckInHandler
+func (_mock *MockCheckInHandler) Handle(ctx context.Context, cmd CheckIn) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CheckIn) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCheckInHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockCheckInHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd CheckIn
+func (_e *MockCheckInHandler_Expecter) Handle(ctx any, cmd any) *MockCheckInHandler_Handle_Call {
+	return &MockCheckInHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, cmd)}
+}
+
+func (_c *MockCheckInHandler_Handle_Call) Run(run func(ctx context.Context, cmd CheckIn)) *MockCheckInHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CheckIn
+		if args[1] != nil {
+			arg1 = args[1].(CheckIn)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCheckInHandler_Handle_Call) Return(err error) *MockCheckInHandler_Handle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCheckInHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, cmd CheckIn) error) *MockCheckInHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
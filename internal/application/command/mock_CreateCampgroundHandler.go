@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package command
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCreateCampgroundHandler creates a new instance of MockCreateCampgroundHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateCampgroundHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateCampgroundHandler {
+	mock := &MockCreateCampgroundHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateCampgroundHandler is an autogenerated mock type for the CreateCampgroundHandler type
+type MockCreateCampgroundHandler struct {
+	mock.Mock
+}
+
+type MockCreateCampgroundHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateCampgroundHandler) EXPECT() *MockCreateCampgroundHandler_Expecter {
+	return &MockCreateCampgroundHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockCreateCampgroundHandler
+func (_mock *MockCreateCampgroundHandler) Handle(ctx context.Context, cmd CreateCampground) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateCampground) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCreateCampgroundHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockCreateCampgroundHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd CreateCampground
+func (_e *MockCreateCampgroundHandler_Expecter) Handle(ctx any, cmd any) *MockCreateCampgroundHandler_Handle_Call {
+	return &MockCreateCampgroundHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, cmd)}
+}
+
+func (_c *MockCreateCampgroundHandler_Handle_Call) Run(run func(ctx context.Context, cmd CreateCampground)) *MockCreateCampgroundHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateCampground
+		if args[1] != nil {
+			arg1 = args[1].(CreateCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreateCampgroundHandler_Handle_Call) Return(err error) *MockCreateCampgroundHandler_Handle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCreateCampgroundHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, cmd CreateCampground) error) *MockCreateCampgroundHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package command
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLeaveWaitlistHandler creates a new instance of MockLeaveWaitlistHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLeaveWaitlistHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLeaveWaitlistHandler {
+	mock := &MockLeaveWaitlistHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLeaveWaitlistHandler is an autogenerated mock type for the LeaveWaitlistHandler type
+type MockLeaveWaitlistHandler struct {
+	mock.Mock
+}
+
+type MockLeaveWaitlistHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaveWaitlistHandler) EXPECT() *MockLeaveWaitlistHandler_Expecter {
+	return &MockLeaveWaitlistHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockLeaveWaitlistHandler
+func (_mock *MockLeaveWaitlistHandler) Handle(ctx context.Context, cmd LeaveWaitlist) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, LeaveWaitlist) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLeaveWaitlistHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockLeaveWaitlistHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd LeaveWaitlist
+func (_e *MockLeaveWaitlistHandler_Expecter) Handle(ctx any, cmd any) *MockLeaveWaitlistHandler_Handle_Call {
+	return &MockLeaveWaitlistHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, cmd)}
+}
+
+func (_c *MockLeaveWaitlistHandler_Handle_Call) Run(run func(ctx context.Context, cmd LeaveWaitlist)) *MockLeaveWaitlistHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 LeaveWaitlist
+		if args[1] != nil {
+			arg1 = args[1].(LeaveWaitlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaveWaitlistHandler_Handle_Call) Return(err error) *MockLeaveWaitlistHandler_Handle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLeaveWaitlistHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, cmd LeaveWaitlist) error) *MockLeaveWaitlistHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package command
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockUpdateCampgroundHandler creates a new instance of MockUpdateCampgroundHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateCampgroundHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateCampgroundHandler {
+	mock := &MockUpdateCampgroundHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdateCampgroundHandler is an autogenerated mock type for the UpdateCampgroundHandler type
+type MockUpdateCampgroundHandler struct {
+	mock.Mock
+}
+
+type MockUpdateCampgroundHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateCampgroundHandler) EXPECT() *MockUpdateCampgroundHandler_Expecter {
+	return &MockUpdateCampgroundHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockUpdateCampgroundHandler
+func (_mock *MockUpdateCampgroundHandler) Handle(ctx context.Context, cmd UpdateCampground) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateCampground) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUpdateCampgroundHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockUpdateCampgroundHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd UpdateCampground
+func (_e *MockUpdateCampgroundHandler_Expecter) Handle(ctx any, cmd any) *MockUpdateCampgroundHandler_Handle_Call {
+	return &MockUpdateCampgroundHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, cmd)}
+}
+
+func (_c *MockUpdateCampgroundHandler_Handle_Call) Run(run func(ctx context.Context, cmd UpdateCampground)) *MockUpdateCampgroundHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 UpdateCampground
+		if args[1] != nil {
+			arg1 = args[1].(UpdateCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpdateCampgroundHandler_Handle_Call) Return(err error) *MockUpdateCampgroundHandler_Handle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUpdateCampgroundHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, cmd UpdateCampground) error) *MockUpdateCampgroundHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
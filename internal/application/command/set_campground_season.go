@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	SetCampgroundSeason struct {
+		CampgroundID   string
+		OpensOn        string
+		ClosesOn       string
+		ClosedWeekdays []string
+	}
+
+	// SetCampgroundSeasonHandler is a logging decorator for the setCampgroundSeasonHandler struct.
+	SetCampgroundSeasonHandler handler.Command[SetCampgroundSeason]
+
+	setCampgroundSeasonHandler struct {
+		campgrounds domain.CampgroundRepository
+		seasons     domain.CampgroundSeasonRepository
+	}
+)
+
+func NewSetCampgroundSeasonHandler(
+	campgrounds domain.CampgroundRepository,
+	seasons domain.CampgroundSeasonRepository,
+) SetCampgroundSeasonHandler {
+	return decorator.ApplyCommandDecorator[SetCampgroundSeason](
+		setCampgroundSeasonHandler{campgrounds: campgrounds, seasons: seasons},
+	)
+}
+
+// Handle replaces the season of the campground, bookings already made for
+// nights the campground is now closed are left for the operator to cancel.
+func (h setCampgroundSeasonHandler) Handle(ctx context.Context, cmd SetCampgroundSeason) error {
+	if _, err := h.campgrounds.Find(ctx, cmd.CampgroundID); err != nil {
+		return err
+	}
+
+	closedWeekdays, err := domain.ParseWeekdays(cmd.ClosedWeekdays)
+	if err != nil {
+		return err
+	}
+	season := &domain.CampgroundSeason{
+		CampgroundID:   cmd.CampgroundID,
+		OpensOn:        cmd.OpensOn,
+		ClosesOn:       cmd.ClosesOn,
+		ClosedWeekdays: closedWeekdays,
+	}
+
+	if err = season.Validate(); err != nil {
+		return err
+	}
+	return h.seasons.Upsert(ctx, season)
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetCampgroundSeasonHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+		seasons     *domain.MockCampgroundSeasonRepository
+	}
+	campgroundID := uuid.New().String()
+	campground := &domain.Campground{CampgroundID: campgroundID}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campgroundID}
+
+	cmd := SetCampgroundSeason{
+		CampgroundID:   campgroundID,
+		OpensOn:        "05-01",
+		ClosesOn:       "10-31",
+		ClosedWeekdays: []string{"TUESDAY", "monday"},
+	}
+	season := &domain.CampgroundSeason{
+		CampgroundID:   campgroundID,
+		OpensOn:        "05-01",
+		ClosesOn:       "10-31",
+		ClosedWeekdays: []time.Weekday{time.Monday, time.Tuesday},
+	}
+	invalidWeekdayCmd := cmd
+	invalidWeekdayCmd.ClosedWeekdays = []string{"MONDAYS"}
+	missingClosesOnCmd := cmd
+	missingClosesOnCmd.ClosesOn = ""
+
+	tests := map[string]struct {
+		cmd     SetCampgroundSeason
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.seasons.
+					On("Upsert", context.TODO(), season).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampgroundNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_CampgroundSeasonValidation_InvalidWeekday": {
+			cmd: invalidWeekdayCmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+			},
+			wantErr: domain.ErrCampgroundSeasonValidation{Reason: "invalid weekday MONDAYS"},
+		},
+		"Error_CampgroundSeasonValidation_MissingClosesOn": {
+			cmd: missingClosesOnCmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+			},
+			wantErr: domain.ErrCampgroundSeasonValidation{
+				Reason: "opens_on and closes_on must both be set",
+			},
+		},
+		"Error_Upsert_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.seasons.
+					On("Upsert", context.TODO(), season).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+				seasons:     domain.NewMockCampgroundSeasonRepository(t),
+			}
+			h := NewSetCampgroundSeasonHandler(m.campgrounds, m.seasons)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"SetCampgroundSeasonHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds, m.seasons)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	SetCampsiteRates struct {
+		CampsiteID         string
+		Currency           string
+		NightlyRate        int64
+		WeekendNightlyRate int64
+		IncludedGuests     int32
+		ExtraGuestFee      int64
+		TaxRateBps         int32
+		Seasons            []SeasonalRate
+	}
+
+	SeasonalRate struct {
+		Name               string
+		StartDate          string
+		EndDate            string
+		NightlyRate        int64
+		WeekendNightlyRate int64
+	}
+
+	// SetCampsiteRatesHandler is a logging decorator for the setCampsiteRatesHandler struct.
+	SetCampsiteRatesHandler handler.Command[SetCampsiteRates]
+
+	setCampsiteRatesHandler struct {
+		campsites domain.CampsiteRepository
+		rates     domain.CampsiteRatesRepository
+	}
+)
+
+func NewSetCampsiteRatesHandler(
+	campsites domain.CampsiteRepository,
+	rates domain.CampsiteRatesRepository,
+) SetCampsiteRatesHandler {
+	return decorator.ApplyCommandDecorator[SetCampsiteRates](
+		setCampsiteRatesHandler{campsites: campsites, rates: rates},
+	)
+}
+
+func (h setCampsiteRatesHandler) Handle(ctx context.Context, cmd SetCampsiteRates) error {
+	if _, err := h.campsites.Find(ctx, cmd.CampsiteID); err != nil {
+		return err
+	}
+
+	rates := &domain.CampsiteRates{
+		CampsiteID:         cmd.CampsiteID,
+		Currency:           cmd.Currency,
+		NightlyRate:        cmd.NightlyRate,
+		WeekendNightlyRate: cmd.WeekendNightlyRate,
+		IncludedGuests:     max(cmd.IncludedGuests, 1),
+		ExtraGuestFee:      cmd.ExtraGuestFee,
+		TaxRateBps:         cmd.TaxRateBps,
+	}
+	for i, s := range cmd.Seasons {
+		startDate, err := domain.ParseDate(fmt.Sprintf("seasons[%d].start_date", i), s.StartDate)
+		if err != nil {
+			return err
+		}
+		endDate, err := domain.ParseDate(fmt.Sprintf("seasons[%d].end_date", i), s.EndDate)
+		if err != nil {
+			return err
+		}
+		rates.Seasons = append(rates.Seasons, domain.SeasonalRate{
+			Name:               s.Name,
+			StartDate:          startDate,
+			EndDate:            endDate,
+			NightlyRate:        s.NightlyRate,
+			WeekendNightlyRate: s.WeekendNightlyRate,
+		})
+	}
+
+	if err := rates.Validate(); err != nil {
+		return err
+	}
+	return h.rates.Upsert(ctx, rates)
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetCampsiteRatesHandler(t *testing.T) {
+	type mocks struct {
+		campsites *domain.MockCampsiteRepository
+		rates     *domain.MockCampsiteRatesRepository
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	rates := bootstrap.NewCampsiteRates(campsiteID)
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+
+	cmd := SetCampsiteRates{
+		CampsiteID:         rates.CampsiteID,
+		Currency:           rates.Currency,
+		NightlyRate:        rates.NightlyRate,
+		WeekendNightlyRate: rates.WeekendNightlyRate,
+		IncludedGuests:     rates.IncludedGuests,
+		ExtraGuestFee:      rates.ExtraGuestFee,
+		TaxRateBps:         rates.TaxRateBps,
+		Seasons: []SeasonalRate{
+			{
+				Name:        rates.Seasons[0].Name,
+				StartDate:   rates.Seasons[0].StartDate.Format(time.DateOnly),
+				EndDate:     rates.Seasons[0].EndDate.Format(time.DateOnly),
+				NightlyRate: rates.Seasons[0].NightlyRate,
+			},
+		},
+	}
+	overlappingCmd := cmd
+	overlappingCmd.Seasons = []SeasonalRate{
+		{Name: "summer", StartDate: "2006-06-01", EndDate: "2006-09-01", NightlyRate: 6000},
+		{Name: "august", StartDate: "2006-08-01", EndDate: "2006-08-15", NightlyRate: 7000},
+	}
+	invalidDateCmd := cmd
+	invalidDateCmd.Seasons = []SeasonalRate{
+		{Name: "summer", StartDate: "2006-06-01", EndDate: "2006-09-01", NightlyRate: 6000},
+		{Name: "winter", StartDate: "2006-12-01", EndDate: "2007-02-30", NightlyRate: 5000},
+	}
+
+	tests := map[string]struct {
+		cmd     SetCampsiteRates
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.rates.
+					On("Upsert", context.TODO(), rates).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_OverlappingSeasons": {
+			cmd: overlappingCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: domain.ErrCampsiteRatesValidation{
+				Reason: "season august: overlaps season summer",
+			},
+		},
+		"Error_ParseSeasonEndDate": {
+			cmd: invalidDateCmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+			},
+			wantErr: domain.ErrInvalidDate{FieldName: "seasons[1].end_date", Value: "2007-02-30"},
+		},
+		"Error_Upsert_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.rates.
+					On("Upsert", context.TODO(), rates).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites: domain.NewMockCampsiteRepository(t),
+				rates:     domain.NewMockCampsiteRatesRepository(t),
+			}
+			h := NewSetCampsiteRatesHandler(m.campsites, m.rates)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"SetCampsiteRatesHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.rates)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+// settleDeposit stores the new bookings and charges their deposit authorized
+// under paymentRef, it is shared by the handlers creating bookings. The
+// bookings are stored as pending until the deposit is captured, and cancelled
+// with the deposit voided if it cannot be, so that no pending booking is left
+// behind for a deposit that was not charged. The bookings are stored as they
+// are if paymentRef is empty, i.e. no deposit was authorized.
+func settleDeposit(
+	ctx context.Context,
+	payments domain.PaymentGateway,
+	paymentRef string,
+	bookings []*domain.Booking,
+	insert func(ctx context.Context) error,
+	update func(ctx context.Context) error,
+	subject slog.Attr,
+) error {
+	if paymentRef != "" {
+		setStatus(bookings, domain.BookingStatusPending)
+	}
+	if err := insert(ctx); err != nil {
+		voidDeposit(ctx, payments, paymentRef, subject)
+		return err
+	}
+	if paymentRef == "" {
+		return nil
+	}
+	if err := payments.Capture(ctx, paymentRef); err != nil {
+		setStatus(bookings, domain.BookingStatusCancelled)
+		if uerr := update(ctx); uerr != nil {
+			slog.ErrorContext(ctx, "failed to cancel bookings with uncaptured deposit",
+				subject, "error", uerr)
+		}
+		voidDeposit(ctx, payments, paymentRef, subject)
+		return err
+	}
+	// deposit is charged, the bookings stay pending for staff to confirm if
+	// this update fails
+	setStatus(bookings, domain.BookingStatusConfirmed)
+	if err := update(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to confirm bookings with captured deposit",
+			subject, "error", err)
+	}
+	return nil
+}
+
+func voidDeposit(
+	ctx context.Context,
+	payments domain.PaymentGateway,
+	paymentRef string,
+	subject slog.Attr,
+) {
+	if paymentRef == "" {
+		return
+	}
+	if err := payments.Void(ctx, paymentRef); err != nil {
+		slog.ErrorContext(ctx, "failed to void deposit", subject,
+			"payment_ref", paymentRef, "error", err)
+	}
+}
+
+func setStatus(bookings []*domain.Booking, status domain.BookingStatus) {
+	for _, booking := range bookings {
+		booking.Status = status
+	}
+}
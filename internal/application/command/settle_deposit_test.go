@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSettleDeposit(t *testing.T) {
+	paymentRef := "pi_123"
+
+	tests := map[string]struct {
+		paymentRef   string
+		insertErr    error
+		updateErr    error
+		on           func(payments *domain.MockPaymentGateway)
+		wantInserted domain.BookingStatus
+		wantUpdated  []domain.BookingStatus
+		wantErr      error
+	}{
+		"Success_NoDeposit": {
+			paymentRef:   "",
+			wantInserted: domain.BookingStatusConfirmed,
+			wantErr:      nil,
+		},
+		"Success_Captured": {
+			paymentRef: paymentRef,
+			on: func(payments *domain.MockPaymentGateway) {
+				payments.On("Capture", context.TODO(), paymentRef).Return(nil)
+			},
+			wantInserted: domain.BookingStatusPending,
+			wantUpdated:  []domain.BookingStatus{domain.BookingStatusConfirmed},
+			wantErr:      nil,
+		},
+		"Success_Captured_ConfirmFailed": {
+			paymentRef: paymentRef,
+			updateErr:  bootstrap.ErrCommitTx,
+			on: func(payments *domain.MockPaymentGateway) {
+				payments.On("Capture", context.TODO(), paymentRef).Return(nil)
+			},
+			wantInserted: domain.BookingStatusPending,
+			wantUpdated:  []domain.BookingStatus{domain.BookingStatusConfirmed},
+			wantErr:      nil,
+		},
+		"Error_Insert_Voided": {
+			paymentRef: paymentRef,
+			insertErr:  bootstrap.ErrCommitTx,
+			on: func(payments *domain.MockPaymentGateway) {
+				payments.On("Void", context.TODO(), paymentRef).Return(nil)
+			},
+			wantInserted: domain.BookingStatusPending,
+			wantErr:      bootstrap.ErrCommitTx,
+		},
+		"Error_Capture_CancelledAndVoided": {
+			paymentRef: paymentRef,
+			on: func(payments *domain.MockPaymentGateway) {
+				payments.
+					On("Capture", context.TODO(), paymentRef).
+					Return(domain.ErrPaymentDeclined{Reason: "card declined"}).
+					On("Void", context.TODO(), paymentRef).
+					Return(nil)
+			},
+			wantInserted: domain.BookingStatusPending,
+			wantUpdated:  []domain.BookingStatus{domain.BookingStatusCancelled},
+			wantErr:      domain.ErrPaymentDeclined{Reason: "card declined"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			payments := domain.NewMockPaymentGateway(t)
+			if tc.on != nil {
+				tc.on(payments)
+			}
+			booking := &domain.Booking{Status: domain.BookingStatusConfirmed}
+			var inserted domain.BookingStatus
+			var updated []domain.BookingStatus
+			insert := func(context.Context) error {
+				inserted = booking.Status
+				return tc.insertErr
+			}
+			update := func(context.Context) error {
+				updated = append(updated, booking.Status)
+				return tc.updateErr
+			}
+			// when
+			err := settleDeposit(context.TODO(), payments, tc.paymentRef,
+				[]*domain.Booking{booking}, insert, update, slog.String("booking_id", "1"))
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"settleDeposit() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, tc.wantInserted, inserted)
+			assert.Equal(t, tc.wantUpdated, updated)
+			mock.AssertExpectationsForObjects(t, payments)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	SyncCalendarSubscriptions struct{}
+
+	// SyncCalendarSubscriptionsHandler is a logging decorator for the syncCalendarSubscriptionsHandler struct.
+	SyncCalendarSubscriptionsHandler handler.Command[SyncCalendarSubscriptions]
+
+	syncCalendarSubscriptionsHandler struct {
+		subscriptions domain.CalendarSubscriptionRepository
+		reader        domain.ExternalCalendarReader
+	}
+)
+
+func NewSyncCalendarSubscriptionsHandler(
+	subscriptions domain.CalendarSubscriptionRepository,
+	reader domain.ExternalCalendarReader,
+) SyncCalendarSubscriptionsHandler {
+	return decorator.ApplyCommandDecorator[SyncCalendarSubscriptions](
+		syncCalendarSubscriptionsHandler{subscriptions: subscriptions, reader: reader},
+	)
+}
+
+// Handle replaces the blocks of each subscribed URL with the events it
+// currently publishes. A calendar that cannot be fetched or parsed keeps its
+// previous blocks, the failure is recorded on the subscription instead of
+// failing the sync of the others.
+func (h syncCalendarSubscriptionsHandler) Handle(
+	ctx context.Context,
+	_ SyncCalendarSubscriptions,
+) error {
+	subscriptions, err := h.subscriptions.FindAllWithURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, subscription := range subscriptions {
+		if err = h.sync(ctx, subscription); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func (h syncCalendarSubscriptionsHandler) sync(
+	ctx context.Context,
+	subscription *domain.CalendarSubscription,
+) error {
+	blocks, err := h.reader.Fetch(ctx, subscription.URL)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to fetch external calendar",
+			slog.String("subscription_id", subscription.SubscriptionID),
+			slog.String("url", subscription.URL),
+			slog.Any("error", err))
+		return h.subscriptions.UpdateSyncError(ctx, subscription.SubscriptionID, err.Error())
+	}
+
+	for _, block := range blocks {
+		block.SubscriptionID = subscription.SubscriptionID
+		block.CampsiteID = subscription.CampsiteID
+	}
+	return h.subscriptions.ReplaceBlocks(
+		ctx, subscription.SubscriptionID, blocks, time.Now().UTC(),
+	)
+}
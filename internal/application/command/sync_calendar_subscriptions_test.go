@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncCalendarSubscriptionsHandler(t *testing.T) {
+	type mocks struct {
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		reader        *domain.MockExternalCalendarReader
+	}
+	subscription := bootstrap.NewCalendarSubscription(uuid.New().String())
+	other := bootstrap.NewCalendarSubscription(uuid.New().String())
+	other.URL = "https://example.com/calendars/b02.ics"
+	block := bootstrap.NewExternalBlock(subscription)
+	errFetch := errors.ErrUnavailable.Msg("unexpected status 404 Not Found")
+
+	fetched := func() []*domain.ExternalBlock {
+		return []*domain.ExternalBlock{{
+			UID:       block.UID,
+			StartDate: block.StartDate,
+			EndDate:   block.EndDate,
+			Summary:   block.Summary,
+		}}
+	}
+
+	tests := map[string]struct {
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("FindAllWithURL", context.TODO()).
+					Return([]*domain.CalendarSubscription{subscription}, nil)
+				f.reader.
+					On("Fetch", context.TODO(), subscription.URL).
+					Return(fetched(), nil)
+				f.subscriptions.
+					On("ReplaceBlocks", context.TODO(), subscription.SubscriptionID,
+						[]*domain.ExternalBlock{block}, mock.AnythingOfType("time.Time")).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Success_FetchFailureRecorded": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("FindAllWithURL", context.TODO()).
+					Return([]*domain.CalendarSubscription{other, subscription}, nil)
+				f.reader.
+					On("Fetch", context.TODO(), other.URL).
+					Return(nil, errFetch)
+				f.subscriptions.
+					On("UpdateSyncError", context.TODO(), other.SubscriptionID, errFetch.Error()).
+					Return(nil)
+				f.reader.
+					On("Fetch", context.TODO(), subscription.URL).
+					Return(fetched(), nil)
+				f.subscriptions.
+					On("ReplaceBlocks", context.TODO(), subscription.SubscriptionID,
+						[]*domain.ExternalBlock{block}, mock.AnythingOfType("time.Time")).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FindAllWithURL": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("FindAllWithURL", context.TODO()).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_ReplaceBlocks": {
+			on: func(f mocks) {
+				f.subscriptions.
+					On("FindAllWithURL", context.TODO()).
+					Return([]*domain.CalendarSubscription{subscription}, nil)
+				f.reader.
+					On("Fetch", context.TODO(), subscription.URL).
+					Return(fetched(), nil)
+				f.subscriptions.
+					On("ReplaceBlocks", context.TODO(), subscription.SubscriptionID,
+						[]*domain.ExternalBlock{block}, mock.AnythingOfType("time.Time")).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				reader:        domain.NewMockExternalCalendarReader(t),
+			}
+			h := NewSyncCalendarSubscriptionsHandler(m.subscriptions, m.reader)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), SyncCalendarSubscriptions{})
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"SyncCalendarSubscriptionsHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.subscriptions, m.reader)
+		})
+	}
+}
@@ -2,7 +2,6 @@ package command
 
 import (
 	"context"
-	"time"
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
@@ -18,6 +17,7 @@ type (
 		FullName   string
 		StartDate  string
 		EndDate    string
+		Guests     int32
 		Version    int64
 	}
 
@@ -26,16 +26,22 @@ type (
 
 	updateBookingHandler struct {
 		bookings   domain.BookingRepository
+		rates      domain.CampsiteRatesRepository
+		offers     WaitlistOffers
 		validators []domain.BookingValidator
 	}
 )
 
 func NewUpdateBookingHandler(
 	bookings domain.BookingRepository,
+	rates domain.CampsiteRatesRepository,
+	offers WaitlistOffers,
 	validators []domain.BookingValidator,
 ) UpdateBookingHandler {
 	return decorator.ApplyCommandDecorator[UpdateBooking](updateBookingHandler{
 		bookings:   bookings,
+		rates:      rates,
+		offers:     offers,
 		validators: validators,
 	})
 }
@@ -45,9 +51,13 @@ func (h updateBookingHandler) Handle(ctx context.Context, cmd UpdateBooking) err
 	if err != nil {
 		return err
 	}
-	if !booking.Active {
+	if booking.Status == domain.BookingStatusCancelled {
 		return domain.ErrBookingAlreadyCancelled{BookingID: cmd.BookingID}
 	}
+	if !booking.Modifiable() {
+		return domain.ErrBookingNotModifiable{BookingID: cmd.BookingID, Status: booking.Status}
+	}
+	vacated := *booking
 
 	if cmd.CampsiteID != "" {
 		booking.CampsiteID = cmd.CampsiteID
@@ -58,24 +68,37 @@ func (h updateBookingHandler) Handle(ctx context.Context, cmd UpdateBooking) err
 	if cmd.FullName != "" {
 		booking.FullName = cmd.FullName
 	}
+	if cmd.Guests > 0 {
+		booking.Guests = cmd.Guests
+	}
 
 	if cmd.StartDate != "" && cmd.EndDate != "" {
-		startDate, perr := time.Parse(time.DateOnly, cmd.StartDate)
+		startDate, perr := domain.ParseDate("start_date", cmd.StartDate)
 		if perr != nil {
 			return perr
 		}
 		booking.StartDate = startDate
 
-		endDate, perr := time.Parse(time.DateOnly, cmd.EndDate)
+		endDate, perr := domain.ParseDate("end_date", cmd.EndDate)
 		if perr != nil {
 			return perr
 		}
 		booking.EndDate = endDate
 	}
 
-	err = validator.Apply(h.validators, booking)
+	err = validator.Apply(ctx, h.validators, booking)
 	if err != nil {
 		return err
 	}
-	return h.bookings.Update(ctx, booking)
+	if err = priceBooking(ctx, h.rates, booking); err != nil {
+		return err
+	}
+	if err = h.bookings.Update(ctx, booking); err != nil {
+		return err
+	}
+	if vacated.CampsiteID != booking.CampsiteID || !vacated.StartDate.Equal(booking.StartDate) ||
+		!vacated.EndDate.Equal(booking.EndDate) {
+		h.offers.offerVacatedDates(ctx, vacated.CampsiteID, vacated.StartDate, vacated.EndDate)
+	}
+	return nil
 }
@@ -10,15 +10,19 @@ import (
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
-	"github.com/stackus/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestUpdateBookingHandler(t *testing.T) {
 	type mocks struct {
-		bookings  *domain.MockBookingRepository
-		validator *domain.MockBookingValidator
+		bookings      *domain.MockBookingRepository
+		campsites     *domain.MockCampsiteRepository
+		rates         *domain.MockCampsiteRatesRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+		validator     *domain.MockBookingValidator
 	}
 	campsiteID := uuid.New().String()
 	booking, err := bootstrap.NewBooking(campsiteID)
@@ -26,11 +30,30 @@ func TestUpdateBookingHandler(t *testing.T) {
 		t.Fatalf("create booking error: %v", err)
 	}
 	booking.ID = 0
-	booking.Active = true
+	booking.Status = domain.BookingStatusConfirmed
 
 	errBookingAlreadyCancelled := domain.ErrBookingAlreadyCancelled{BookingID: booking.BookingID}
+	errBookingNotModifiable := domain.ErrBookingNotModifiable{
+		BookingID: booking.BookingID,
+		Status:    domain.BookingStatusCheckedIn,
+	}
 	errBookingMaximumStay := validator.ErrBookingMaximumStay{}
 	monthOutOfRangeDate := "2024-99-01"
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}
+	rates := bootstrap.NewCampsiteRates(campsiteID)
+	waitlistPolicy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	campsite := &domain.Campsite{
+		CampsiteID: campsiteID, CampgroundID: uuid.New().String(), Capacity: 4,
+	}
+	entry := &domain.WaitlistEntry{
+		EntryID:      uuid.New().String(),
+		CampgroundID: campsite.CampgroundID,
+		StartDate:    booking.StartDate,
+		EndDate:      booking.EndDate,
+		Guests:       2,
+		Status:       domain.WaitlistStatusWaiting,
+	}
+	oldStartDate, oldEndDate := booking.StartDate, booking.EndDate
 
 	cmd := UpdateBooking{
 		BookingID:  booking.BookingID,
@@ -49,14 +72,97 @@ func TestUpdateBookingHandler(t *testing.T) {
 		"Success": {
 			cmd: cmd,
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil).
 					On("Update", context.TODO(), booking).
 					Return(nil)
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+			},
+			wantErr: nil,
+		},
+		"Success_Repriced": {
+			cmd: UpdateBooking{
+				BookingID: cmd.BookingID,
+				StartDate: cmd.StartDate,
+				EndDate:   cmd.EndDate,
+				Guests:    4,
+			},
+			on: func(f mocks) {
+				booking.Status = domain.BookingStatusConfirmed
+				wantTotal := rates.Quote(booking.StartDate, booking.EndDate, 4).Total
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(booking, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(b *domain.Booking) bool {
+						return b.Guests == 4 && b.TotalPrice == wantTotal &&
+							b.Currency == rates.Currency
+					})).
+					Return(nil)
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_DatesChanged_OfferVacatedDates": {
+			cmd: UpdateBooking{
+				BookingID: cmd.BookingID,
+				StartDate: oldStartDate.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:   oldEndDate.AddDate(0, 0, 1).Format(time.DateOnly),
+			},
+			on: func(f mocks) {
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(booking, nil).
+					On("Update", context.TODO(), booking).
+					Return(nil).
+					On("FindForDateRange", context.TODO(), campsiteID, oldStartDate, oldEndDate).
+					Return(nil, nil)
+				f.validator.
+					On("Validate", context.TODO(), booking).
+					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsiteID, oldStartDate, oldEndDate).
+					Return(nil, nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsiteID,
+						oldStartDate, oldEndDate).
+					Return(nil, nil)
+				f.waitlist.
+					On(
+						"FindWaiting",
+						context.TODO(),
+						campsiteID,
+						campsite.CampgroundID,
+						oldStartDate,
+						oldEndDate,
+					).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("FindOffered", context.TODO(), campsiteID, oldStartDate, oldEndDate).
+					Return(nil, nil).
+					On("Update", context.TODO(), mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+						return e.Status == domain.WaitlistStatusOffered &&
+							e.OfferCampsiteID == campsiteID
+					})).
 					Return(nil)
 			},
 			wantErr: nil,
@@ -64,7 +170,8 @@ func TestUpdateBookingHandler(t *testing.T) {
 		"Error_Find_BeginTx": {
 			cmd: cmd,
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(nil, bootstrap.ErrBeginTx)
@@ -74,13 +181,25 @@ func TestUpdateBookingHandler(t *testing.T) {
 		"Error_BookingAlreadyCancelled": {
 			cmd: cmd,
 			on: func(f mocks) {
-				booking.Active = false
+				booking.Status = domain.BookingStatusCancelled
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil)
 			},
 			wantErr: errBookingAlreadyCancelled,
 		},
+		"Error_BookingNotModifiable": {
+			cmd: cmd,
+			on: func(f mocks) {
+				booking.Status = domain.BookingStatusCheckedIn
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
+				f.bookings.
+					On("Find", context.TODO(), booking.BookingID).
+					Return(booking, nil)
+			},
+			wantErr: errBookingNotModifiable,
+		},
 		"Error_ParseStartDate": {
 			cmd: UpdateBooking{
 				BookingID:  cmd.BookingID,
@@ -91,12 +210,13 @@ func TestUpdateBookingHandler(t *testing.T) {
 				EndDate:    cmd.EndDate,
 			},
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil)
 			},
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "start_date", Value: monthOutOfRangeDate},
 		},
 		"Error_ParseEndDate": {
 			cmd: UpdateBooking{
@@ -108,22 +228,24 @@ func TestUpdateBookingHandler(t *testing.T) {
 				EndDate:    monthOutOfRangeDate,
 			},
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil)
 			},
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "end_date", Value: monthOutOfRangeDate},
 		},
 		"Error_Validate_BookingMaximumStay": {
 			cmd: cmd,
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil)
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
 					Return(errBookingMaximumStay)
 			},
 			wantErr: domain.ErrBookingValidation{
@@ -133,15 +255,19 @@ func TestUpdateBookingHandler(t *testing.T) {
 		"Error_Update_CommitTx": {
 			cmd: cmd,
 			on: func(f mocks) {
-				booking.Active = true
+				booking.Status = domain.BookingStatusConfirmed
+				booking.StartDate, booking.EndDate = oldStartDate, oldEndDate
 				f.bookings.
 					On("Find", context.TODO(), booking.BookingID).
 					Return(booking, nil).
 					On("Update", context.TODO(), booking).
 					Return(bootstrap.ErrCommitTx)
 				f.validator.
-					On("Validate", booking).
+					On("Validate", context.TODO(), booking).
 					Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
 			},
 			wantErr: bootstrap.ErrCommitTx,
 		},
@@ -151,12 +277,21 @@ func TestUpdateBookingHandler(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// given
 			m := mocks{
-				bookings:  domain.NewMockBookingRepository(t),
-				validator: domain.NewMockBookingValidator(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				campsites:     domain.NewMockCampsiteRepository(t),
+				rates:         domain.NewMockCampsiteRatesRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+				validator:     domain.NewMockBookingValidator(t),
 			}
 			var validators []domain.BookingValidator
 			validators = append(validators, m.validator)
-			h := NewUpdateBookingHandler(m.bookings, validators)
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				waitlistPolicy,
+			)
+			h := NewUpdateBookingHandler(m.bookings, m.rates, offers, validators)
 
 			if tc.on != nil {
 				tc.on(m)
@@ -164,14 +299,16 @@ func TestUpdateBookingHandler(t *testing.T) {
 			// when
 			err := h.Handle(context.TODO(), tc.cmd)
 			// then
-			defer mock.AssertExpectationsForObjects(t, m.bookings)
+			defer mock.AssertExpectationsForObjects(
+				t,
+				m.bookings,
+				m.rates,
+				m.campsites,
+				m.blackouts,
+				m.subscriptions,
+				m.waitlist,
+			)
 
-			var parseErr *time.ParseError
-			if errors.As(err, &parseErr) {
-				assert.Equal(t, monthOutOfRangeDate, parseErr.Value,
-					"UpdateBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
-				return
-			}
 			assert.Equal(t, tc.wantErr, err,
 				"UpdateBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
 		})
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	UpdateCampground struct {
+		CampgroundID string
+		Name         string
+		Description  string
+		Active       bool
+	}
+
+	// UpdateCampgroundHandler is a logging decorator for the updateCampgroundHandler struct.
+	UpdateCampgroundHandler handler.Command[UpdateCampground]
+
+	updateCampgroundHandler struct {
+		campgrounds domain.CampgroundRepository
+	}
+)
+
+func NewUpdateCampgroundHandler(campgrounds domain.CampgroundRepository) UpdateCampgroundHandler {
+	return decorator.ApplyCommandDecorator[UpdateCampground](
+		updateCampgroundHandler{campgrounds: campgrounds},
+	)
+}
+
+func (h updateCampgroundHandler) Handle(ctx context.Context, cmd UpdateCampground) error {
+	campground, err := h.campgrounds.Find(ctx, cmd.CampgroundID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Name != "" {
+		campground.Name = cmd.Name
+	}
+	if cmd.Description != "" {
+		campground.Description = cmd.Description
+	}
+	campground.Active = cmd.Active
+	return h.campgrounds.Update(ctx, campground)
+}
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateCampgroundHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	updated := *campground
+	updated.Name = "updated-name"
+	updated.Active = false
+
+	cmd := UpdateCampground{
+		CampgroundID: campground.CampgroundID,
+		Name:         updated.Name,
+		Active:       updated.Active,
+	}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		cmd     UpdateCampground
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				found := *campground
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(&found, nil).
+					On("Update", context.TODO(), &updated).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_CampgroundNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_Update_CommitTx": {
+			cmd: cmd,
+			on: func(f mocks) {
+				found := *campground
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(&found, nil).
+					On("Update", context.TODO(), &updated).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+			}
+			h := NewUpdateCampgroundHandler(m.campgrounds)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"UpdateCampgroundHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	UpdateGroupBooking struct {
+		GroupID   string
+		Email     string
+		FullName  string
+		StartDate string
+		EndDate   string
+	}
+
+	// UpdateGroupBookingHandler is a logging decorator for the updateGroupBookingHandler struct.
+	UpdateGroupBookingHandler handler.Command[UpdateGroupBooking]
+
+	updateGroupBookingHandler struct {
+		bookings   domain.BookingRepository
+		rates      domain.CampsiteRatesRepository
+		offers     WaitlistOffers
+		validators []domain.BookingValidator
+	}
+)
+
+func NewUpdateGroupBookingHandler(
+	bookings domain.BookingRepository,
+	rates domain.CampsiteRatesRepository,
+	offers WaitlistOffers,
+	validators []domain.BookingValidator,
+) UpdateGroupBookingHandler {
+	return decorator.ApplyCommandDecorator[UpdateGroupBooking](updateGroupBookingHandler{
+		bookings:   bookings,
+		rates:      rates,
+		offers:     offers,
+		validators: validators,
+	})
+}
+
+// Handle applies the change to all bookings of the group not yet checked in,
+// cancelled or marked as no-show, either all of them are updated or none is.
+func (h updateGroupBookingHandler) Handle(ctx context.Context, cmd UpdateGroupBooking) error {
+	bookings, err := h.bookings.FindByGroupID(ctx, cmd.GroupID)
+	if err != nil {
+		return err
+	}
+	active := modifiableBookings(bookings)
+	if len(active) == 0 {
+		return domain.ErrGroupBookingAlreadyCancelled{GroupID: cmd.GroupID}
+	}
+
+	var startDate, endDate time.Time
+	updateDates := cmd.StartDate != "" && cmd.EndDate != ""
+	if updateDates {
+		if startDate, err = domain.ParseDate("start_date", cmd.StartDate); err != nil {
+			return err
+		}
+		if endDate, err = domain.ParseDate("end_date", cmd.EndDate); err != nil {
+			return err
+		}
+	}
+
+	var vacated []domain.Booking
+	for _, booking := range active {
+		if updateDates &&
+			(!startDate.Equal(booking.StartDate) || !endDate.Equal(booking.EndDate)) {
+			vacated = append(vacated, *booking)
+			booking.StartDate = startDate
+			booking.EndDate = endDate
+		}
+		if cmd.Email != "" {
+			booking.Email = cmd.Email
+		}
+		if cmd.FullName != "" {
+			booking.FullName = cmd.FullName
+		}
+
+		if err = validator.Apply(ctx, h.validators, booking); err != nil {
+			return err
+		}
+		if err = priceBooking(ctx, h.rates, booking); err != nil {
+			return err
+		}
+	}
+
+	if err = h.bookings.UpdateGroup(ctx, active); err != nil {
+		return err
+	}
+	for _, booking := range vacated {
+		h.offers.offerVacatedDates(ctx, booking.CampsiteID, booking.StartDate, booking.EndDate)
+	}
+	return nil
+}
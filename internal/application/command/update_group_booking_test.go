@@ -0,0 +1,180 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateGroupBookingHandler(t *testing.T) {
+	type mocks struct {
+		bookings      *domain.MockBookingRepository
+		campsites     *domain.MockCampsiteRepository
+		rates         *domain.MockCampsiteRatesRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
+		validator     *domain.MockBookingValidator
+	}
+	groupID := uuid.New().String()
+	waitlistPolicy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	errGroupBookingAlreadyCancelled := domain.ErrGroupBookingAlreadyCancelled{GroupID: groupID}
+
+	newGroup := func() []*domain.Booking {
+		var bookings []*domain.Booking
+		for i := 0; i < 2; i++ {
+			booking, err := bootstrap.NewBooking(uuid.New().String())
+			if err != nil {
+				t.Fatalf("create booking error: %v", err)
+			}
+			booking.GroupID = groupID
+			bookings = append(bookings, booking)
+		}
+		return bookings
+	}
+	group := newGroup()
+	newStartDate := group[0].StartDate.AddDate(0, 0, 1)
+	newEndDate := group[0].EndDate.AddDate(0, 0, 1)
+	onUnpriced := func(f mocks, bookings []*domain.Booking) {
+		f.validator.
+			On("Validate", context.TODO(), mock.AnythingOfType("*domain.Booking")).
+			Return(nil)
+		for _, b := range bookings {
+			f.rates.
+				On("Find", context.TODO(), b.CampsiteID).
+				Return(nil, domain.ErrCampsiteRatesNotFound{CampsiteID: b.CampsiteID})
+		}
+	}
+
+	tests := map[string]struct {
+		cmd     UpdateGroupBooking
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success_FullName": {
+			cmd: UpdateGroupBooking{GroupID: groupID, FullName: "Jane Doe"},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), mock.MatchedBy(func(b []*domain.Booking) bool {
+						return len(b) == 2 && b[0].FullName == "Jane Doe" &&
+							b[1].FullName == "Jane Doe"
+					})).
+					Return(nil)
+				onUnpriced(f, bookings)
+			},
+			wantErr: nil,
+		},
+		"Success_DatesChanged_OfferVacatedDates": {
+			cmd: UpdateGroupBooking{
+				GroupID:   groupID,
+				StartDate: newStartDate.Format(time.DateOnly),
+				EndDate:   newEndDate.Format(time.DateOnly),
+			},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), mock.MatchedBy(func(b []*domain.Booking) bool {
+						return len(b) == 2 &&
+							b[0].StartDate.Equal(newStartDate) && b[1].EndDate.Equal(newEndDate)
+					})).
+					Return(nil)
+				onUnpriced(f, bookings)
+				for _, b := range bookings {
+					// campsites not part of a campground, no waitlist entry to offer to
+					f.campsites.
+						On("Find", context.TODO(), b.CampsiteID).
+						Return(&domain.Campsite{CampsiteID: b.CampsiteID}, nil)
+					f.waitlist.
+						On("FindWaiting", context.TODO(), b.CampsiteID, "",
+							mock.Anything, mock.Anything).
+						Return(nil, nil)
+				}
+			},
+			wantErr: nil,
+		},
+		"Error_GroupBookingAlreadyCancelled": {
+			cmd: UpdateGroupBooking{GroupID: groupID, FullName: "Jane Doe"},
+			on: func(f mocks) {
+				bookings := newGroup()
+				bookings[0].Status = domain.BookingStatusCancelled
+				bookings[1].Status = domain.BookingStatusCancelled
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil)
+			},
+			wantErr: errGroupBookingAlreadyCancelled,
+		},
+		"Error_UpdateGroup_BookingDatesNotAvailable": {
+			cmd: UpdateGroupBooking{
+				GroupID:   groupID,
+				StartDate: newStartDate.Format(time.DateOnly),
+				EndDate:   newEndDate.Format(time.DateOnly),
+			},
+			on: func(f mocks) {
+				bookings := newGroup()
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(bookings, nil).
+					On("UpdateGroup", context.TODO(), mock.Anything).
+					Return(domain.ErrBookingDatesNotAvailable{
+						StartDate: newStartDate,
+						EndDate:   newEndDate,
+					})
+				onUnpriced(f, bookings)
+			},
+			wantErr: domain.ErrBookingDatesNotAvailable{
+				StartDate: newStartDate,
+				EndDate:   newEndDate,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				bookings:      domain.NewMockBookingRepository(t),
+				campsites:     domain.NewMockCampsiteRepository(t),
+				rates:         domain.NewMockCampsiteRatesRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
+				validator:     domain.NewMockBookingValidator(t),
+			}
+			validators := []domain.BookingValidator{m.validator}
+			offers := NewWaitlistOffers(
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, nil,
+				waitlistPolicy,
+			)
+			h := NewUpdateGroupBookingHandler(m.bookings, m.rates, offers, validators)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"UpdateGroupBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(
+				t,
+				m.bookings,
+				m.campsites,
+				m.rates,
+				m.blackouts,
+				m.subscriptions,
+				m.waitlist,
+			)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	UpdateGuest struct {
+		GuestID     string
+		Email       string
+		FullName    string
+		Phone       string
+		Address     string
+		Preferences string
+	}
+
+	// UpdateGuestHandler is a logging decorator for the updateGuestHandler struct.
+	UpdateGuestHandler handler.Command[UpdateGuest]
+
+	updateGuestHandler struct {
+		guests domain.GuestRepository
+	}
+)
+
+func NewUpdateGuestHandler(guests domain.GuestRepository) UpdateGuestHandler {
+	return decorator.ApplyCommandDecorator[UpdateGuest](
+		updateGuestHandler{guests: guests},
+	)
+}
+
+// Handle updates the contact details of the guest left unchanged if empty, the
+// email and full name also apply to the upcoming bookings of the guest.
+func (h updateGuestHandler) Handle(ctx context.Context, cmd UpdateGuest) error {
+	guest, err := h.guests.Find(ctx, cmd.GuestID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Email != "" {
+		guest.Email = domain.NormalizeEmail(cmd.Email)
+	}
+	if cmd.FullName != "" {
+		guest.FullName = cmd.FullName
+	}
+	if cmd.Phone != "" {
+		guest.Phone = cmd.Phone
+	}
+	if cmd.Address != "" {
+		guest.Address = cmd.Address
+	}
+	if cmd.Preferences != "" {
+		guest.Preferences = cmd.Preferences
+	}
+	return h.guests.Update(ctx, guest)
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateGuestHandler(t *testing.T) {
+	type mocks struct {
+		guests *domain.MockGuestRepository
+	}
+	guest := bootstrap.NewGuest()
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+	errGuestEmailInUse := domain.ErrGuestEmailInUse{}
+
+	cmd := UpdateGuest{
+		GuestID:  guest.GuestID,
+		Email:    " Jane.Doe@Example.com ",
+		FullName: "Jane Doe",
+		Phone:    "+1 555 0100",
+	}
+	updatedGuest := *guest
+	updatedGuest.Email = "jane.doe@example.com"
+	updatedGuest.FullName = "Jane Doe"
+	updatedGuest.Phone = "+1 555 0100"
+
+	tests := map[string]struct {
+		cmd     UpdateGuest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			cmd: cmd,
+			on: func(f mocks) {
+				found := *guest
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(&found, nil).
+					On("Update", context.TODO(), &updatedGuest).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Success_EmptyFieldsUnchanged": {
+			cmd: UpdateGuest{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				found := *guest
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(&found, nil).
+					On("Update", context.TODO(), guest).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_GuestNotFound": {
+			cmd: cmd,
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(nil, errGuestNotFound)
+			},
+			wantErr: errGuestNotFound,
+		},
+		"Error_GuestEmailInUse": {
+			cmd: cmd,
+			on: func(f mocks) {
+				found := *guest
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(&found, nil).
+					On("Update", context.TODO(), &updatedGuest).
+					Return(errGuestEmailInUse)
+			},
+			wantErr: errGuestEmailInUse,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests: domain.NewMockGuestRepository(t),
+			}
+			h := NewUpdateGuestHandler(m.guests)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := h.Handle(context.TODO(), tc.cmd)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"UpdateGuestHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests)
+		})
+	}
+}
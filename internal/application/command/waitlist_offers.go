@@ -0,0 +1,161 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+)
+
+// WaitlistOffers offers dates vacated at a campsite to the first matching
+// entry of the waitlist for which the campsite could be booked, it is shared
+// by the handlers of the commands vacating dates.
+type WaitlistOffers struct {
+	campsites     domain.CampsiteRepository
+	bookings      domain.BookingRepository
+	blackouts     domain.CampsiteBlackoutRepository
+	subscriptions domain.CalendarSubscriptionRepository
+	waitlist      domain.WaitlistRepository
+	validators    []domain.BookingValidator
+	policy        domain.WaitlistPolicy
+}
+
+// NewWaitlistOffers returns the offers of the waitlist, validated with the
+// validators of the bookings the offers are accepted as.
+func NewWaitlistOffers(
+	campsites domain.CampsiteRepository,
+	bookings domain.BookingRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+	subscriptions domain.CalendarSubscriptionRepository,
+	waitlist domain.WaitlistRepository,
+	validators []domain.BookingValidator,
+	policy domain.WaitlistPolicy,
+) WaitlistOffers {
+	return WaitlistOffers{
+		campsites:     campsites,
+		bookings:      bookings,
+		blackouts:     blackouts,
+		subscriptions: subscriptions,
+		waitlist:      waitlist,
+		validators:    validators,
+		policy:        policy,
+	}
+}
+
+// offerVacatedDates is called once the change vacating the dates is committed,
+// failures are only logged and left for the next vacated dates to resolve.
+func (o WaitlistOffers) offerVacatedDates(
+	ctx context.Context,
+	campsiteID string,
+	startDate time.Time,
+	endDate time.Time,
+) {
+	if err := o.offer(ctx, campsiteID, startDate, endDate); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to offer vacated dates to waitlist",
+			"campsite_id",
+			campsiteID,
+			"start_date",
+			startDate.Format(time.DateOnly),
+			"end_date",
+			endDate.Format(time.DateOnly),
+			"error",
+			err,
+		)
+	}
+}
+
+// expire marks the expired offer of the entry and offers its dates again.
+func (o WaitlistOffers) expire(ctx context.Context, entry *domain.WaitlistEntry) error {
+	entry.Status = domain.WaitlistStatusExpired
+	if err := o.waitlist.Update(ctx, entry); err != nil {
+		return err
+	}
+	o.offerVacatedDates(ctx, entry.OfferCampsiteID, entry.StartDate, entry.EndDate)
+	return nil
+}
+
+func (o WaitlistOffers) offer(
+	ctx context.Context,
+	campsiteID string,
+	startDate time.Time,
+	endDate time.Time,
+) error {
+	campsite, err := o.campsites.Find(ctx, campsiteID)
+	if err != nil {
+		return err
+	}
+
+	entries, err := o.waitlist.FindWaiting(
+		ctx, campsite.CampsiteID, campsite.CampgroundID, startDate, endDate,
+	)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Matches(campsite) {
+			continue
+		}
+		var bookable bool
+		if bookable, err = o.bookable(ctx, campsiteID, entry); err != nil {
+			return err
+		}
+		if !bookable {
+			continue
+		}
+		entry.Offer(campsiteID, time.Now(), o.policy)
+		return o.waitlist.Update(ctx, entry)
+	}
+	return nil
+}
+
+// bookable reports whether the entry, once offered the campsite, could be
+// accepted as a booking, i.e. its dates are valid and the campsite is neither
+// occupied, closed nor held by another offer.
+func (o WaitlistOffers) bookable(
+	ctx context.Context,
+	campsiteID string,
+	entry *domain.WaitlistEntry,
+) (bool, error) {
+	err := validator.Apply(ctx, o.validators, &domain.Booking{
+		CampsiteID: campsiteID,
+		Email:      entry.Email,
+		FullName:   entry.FullName,
+		StartDate:  entry.StartDate,
+		EndDate:    entry.EndDate,
+		Guests:     entry.Guests,
+	})
+	if err != nil {
+		var violations domain.ErrBookingValidation
+		if errors.As(err, &violations) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	bookings, err := o.bookings.FindForDateRange(ctx, campsiteID, entry.StartDate, entry.EndDate)
+	if err != nil || len(bookings) > 0 {
+		return false, err
+	}
+	blackouts, err := o.blackouts.FindForDateRange(
+		ctx, campsiteID, entry.StartDate, entry.EndDate,
+	)
+	if err != nil || len(blackouts) > 0 {
+		return false, err
+	}
+	blocks, err := o.subscriptions.FindBlocksForDateRange(
+		ctx, campsiteID, entry.StartDate, entry.EndDate,
+	)
+	if err != nil || len(blocks) > 0 {
+		return false, err
+	}
+	offers, err := o.waitlist.FindOffered(ctx, campsiteID, entry.StartDate, entry.EndDate)
+	if err != nil || len(offers) > 0 {
+		return false, err
+	}
+	return true, nil
+}
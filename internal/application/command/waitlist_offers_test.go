@@ -0,0 +1,384 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type waitlistOffersMocks struct {
+	campsites     *domain.MockCampsiteRepository
+	bookings      *domain.MockBookingRepository
+	blackouts     *domain.MockCampsiteBlackoutRepository
+	subscriptions *domain.MockCalendarSubscriptionRepository
+	waitlist      *domain.MockWaitlistRepository
+	validator     *domain.MockBookingValidator
+}
+
+func newWaitlistOffersMocks(t *testing.T) (waitlistOffersMocks, WaitlistOffers) {
+	m := waitlistOffersMocks{
+		campsites:     domain.NewMockCampsiteRepository(t),
+		bookings:      domain.NewMockBookingRepository(t),
+		blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+		subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+		waitlist:      domain.NewMockWaitlistRepository(t),
+		validator:     domain.NewMockBookingValidator(t),
+	}
+	offers := NewWaitlistOffers(
+		m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist,
+		[]domain.BookingValidator{m.validator}, domain.WaitlistPolicy{OfferTTL: time.Hour},
+	)
+	return m, offers
+}
+
+// onVacant expects the checks of the entry at the campsite to find it vacant.
+func (m waitlistOffersMocks) onVacant(campsiteID string, entry *domain.WaitlistEntry) {
+	m.validator.
+		On("Validate", context.TODO(), mock.MatchedBy(func(b *domain.Booking) bool {
+			return b.Email == entry.Email
+		})).
+		Return(nil)
+	m.bookings.
+		On("FindForDateRange", context.TODO(), campsiteID, entry.StartDate, entry.EndDate).
+		Return(nil, nil)
+	m.blackouts.
+		On("FindForDateRange", context.TODO(), campsiteID, entry.StartDate, entry.EndDate).
+		Return(nil, nil)
+	m.subscriptions.
+		On("FindBlocksForDateRange", context.TODO(), campsiteID, entry.StartDate, entry.EndDate).
+		Return(nil, nil)
+	m.waitlist.
+		On("FindOffered", context.TODO(), campsiteID, entry.StartDate, entry.EndDate).
+		Return(nil, nil)
+}
+
+func offeredEntry(entry *domain.WaitlistEntry, campsiteID string) any {
+	return mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.EntryID == entry.EntryID && e.Status == domain.WaitlistStatusOffered &&
+			e.OfferCampsiteID == campsiteID && e.OfferExpiresAt.After(time.Now())
+	})
+}
+
+func TestWaitlistOffers_Offer(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+	standalone, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	standalone.CampgroundID = ""
+
+	entry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	nextEntry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	standaloneEntry := bootstrap.NewWaitlistEntry("")
+	standaloneEntry.CampsiteID = standalone.CampsiteID
+	otherOffer := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	otherOffer.Offer(campsite.CampsiteID, time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	booking, err := bootstrap.NewBooking(campsite.CampsiteID)
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	subscription := bootstrap.NewCalendarSubscription(campsite.CampsiteID)
+
+	tests := map[string]struct {
+		campsite *domain.Campsite
+		on       func(f waitlistOffersMocks)
+		wantErr  error
+	}{
+		"Success": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("Update", context.TODO(), offeredEntry(entry, campsite.CampsiteID)).
+					Return(nil)
+				f.onVacant(campsite.CampsiteID, entry)
+			},
+			wantErr: nil,
+		},
+		"Success_CampsiteWithoutCampground": {
+			campsite: standalone,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), standalone.CampsiteID, "",
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{standaloneEntry}, nil).
+					On("Update", context.TODO(),
+						offeredEntry(standaloneEntry, standalone.CampsiteID)).
+					Return(nil)
+				f.onVacant(standalone.CampsiteID, standaloneEntry)
+			},
+			wantErr: nil,
+		},
+		"Success_NextEntry_Violation": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry, nextEntry}, nil).
+					On("Update", context.TODO(), offeredEntry(nextEntry, campsite.CampsiteID)).
+					Return(nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.MatchedBy(func(b *domain.Booking) bool {
+						return b.Email == entry.Email
+					})).
+					Return(validator.ErrBookingStartDateBeforeEndDate{})
+				f.onVacant(campsite.CampsiteID, nextEntry)
+			},
+			wantErr: nil,
+		},
+		"Success_NotOffered_Booked": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.Booking{booking}, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NotOffered_BlackedOut": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.CampsiteBlackout{
+						bootstrap.NewCampsiteBlackout(campsite.CampsiteID),
+					}, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NotOffered_ExternalBlock": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.ExternalBlock{bootstrap.NewExternalBlock(subscription)}, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NotOffered_AlreadyOffered": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil).
+					On("FindOffered", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{otherOffer}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FindWaiting": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_Validate": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_FindBlocksForDateRange": {
+			campsite: campsite,
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{entry}, nil)
+				f.validator.
+					On("Validate", context.TODO(), mock.Anything).
+					Return(nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, nil)
+				f.subscriptions.
+					On("FindBlocksForDateRange", context.TODO(), campsite.CampsiteID,
+						entry.StartDate, entry.EndDate).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m, offers := newWaitlistOffersMocks(t)
+			m.campsites.
+				On("Find", context.TODO(), tc.campsite.CampsiteID).
+				Return(tc.campsite, nil)
+			tc.on(m)
+			// when
+			err := offers.offer(
+				context.TODO(),
+				tc.campsite.CampsiteID,
+				entry.StartDate,
+				entry.EndDate,
+			)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"WaitlistOffers.offer() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, m.validator)
+		})
+	}
+}
+
+func TestWaitlistOffers_Expire(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.CampgroundID = uuid.New().String()
+	campsite.Capacity = 4
+
+	entry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	nextEntry := bootstrap.NewWaitlistEntry(campsite.CampgroundID)
+	expiredEntry := mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.EntryID == entry.EntryID && e.Status == domain.WaitlistStatusExpired
+	})
+
+	tests := map[string]struct {
+		on      func(f waitlistOffersMocks)
+		wantErr error
+	}{
+		"Success_Reoffered": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("Update", context.TODO(), expiredEntry).
+					Return(nil).
+					On("FindWaiting", context.TODO(), campsite.CampsiteID, campsite.CampgroundID,
+						entry.StartDate, entry.EndDate).
+					Return([]*domain.WaitlistEntry{nextEntry}, nil).
+					On("Update", context.TODO(), offeredEntry(nextEntry, campsite.CampsiteID)).
+					Return(nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(campsite, nil)
+				f.onVacant(campsite.CampsiteID, nextEntry)
+			},
+			wantErr: nil,
+		},
+		"Success_ReofferFailed": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("Update", context.TODO(), expiredEntry).
+					Return(nil)
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: nil,
+		},
+		"Error_Update_CommitTx": {
+			on: func(f waitlistOffersMocks) {
+				f.waitlist.
+					On("Update", context.TODO(), expiredEntry).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m, offers := newWaitlistOffersMocks(t)
+			offered := *entry
+			offered.Offer(campsite.CampsiteID, time.Now().Add(-2*time.Hour),
+				domain.WaitlistPolicy{OfferTTL: time.Hour})
+			tc.on(m)
+			// when
+			err := offers.expire(context.TODO(), &offered)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"WaitlistOffers.expire() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t,
+				m.campsites, m.bookings, m.blackouts, m.subscriptions, m.waitlist, m.validator)
+		})
+	}
+}
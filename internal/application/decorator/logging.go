@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
 )
 
 type loggingCommandHandler[C any] struct {
@@ -21,14 +22,15 @@ func ApplyCommandDecorator[C any](handler handler.Command[C]) handler.Command[C]
 
 func (d loggingCommandHandler[C]) Handle(ctx context.Context, cmd C) (err error) {
 	handlerName := extractHandlerName(cmd)
-	logger := slog.With("command", handlerName, "command_body", fmt.Sprintf("%#v", cmd))
+	l := slog.With("command", handlerName,
+		"command_body", fmt.Sprintf("%#v", logger.Redact(cmd)))
 
-	logger.Debug("executing")
+	l.DebugContext(ctx, "executing")
 	defer func() {
 		if err == nil {
-			logger.Info("executed successfully")
+			l.InfoContext(ctx, "executed successfully")
 		} else {
-			logger.Error("failed to execute", slog.Any("error", err))
+			l.ErrorContext(ctx, "failed to execute", slog.Any("error", err))
 		}
 	}()
 
@@ -47,17 +49,19 @@ func ApplyQueryDecorator[C any, R any](handler handler.Query[C, R]) handler.Quer
 
 func (d loggingQueryHandler[C, R]) Handle(ctx context.Context, cmd C) (result R, err error) {
 	handlerName := extractHandlerName(cmd)
-	logger := slog.With("query", handlerName, "query_body", fmt.Sprintf("%#v", cmd))
+	l := slog.With("query", handlerName,
+		"query_body", fmt.Sprintf("%#v", logger.Redact(cmd)))
 
-	logger.Debug("executing")
+	l.DebugContext(ctx, "executing")
 	defer func() {
 		if err == nil {
-			logger.Info(
+			l.InfoContext(
+				ctx,
 				"executed successfully",
-				slog.Any("result", fmt.Sprintf("%v", result)),
+				slog.Any("result", fmt.Sprintf("%v", logger.Redact(result))),
 			)
 		} else {
-			logger.Error("failed to execute", slog.Any("error", err))
+			l.ErrorContext(ctx, "failed to execute", slog.Any("error", err))
 		}
 	}()
 
@@ -40,16 +40,16 @@ func (_m *MockApp) EXPECT() *MockApp_Expecter {
 	return &MockApp_Expecter{mock: &_m.Mock}
 }
 
-// CancelBooking provides a mock function for the type MockApp
-func (_mock *MockApp) CancelBooking(ctx context.Context, cmd command.CancelBooking) error {
+// AcceptWaitlistOffer provides a mock function for the type MockApp
+func (_mock *MockApp) AcceptWaitlistOffer(ctx context.Context, cmd command.AcceptWaitlistOffer) error {
 	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CancelBooking")
+		panic("no return value specified for AcceptWaitlistOffer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CancelBooking) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.AcceptWaitlistOffer) error); ok {
 		r0 = returnFunc(ctx, cmd)
 	} else {
 		r0 = ret.Error(0)
@@ -57,27 +57,27 @@ func (_mock *MockApp) CancelBooking(ctx context.Context, cmd command.CancelBooki
 	return r0
 }
 
-// MockApp_CancelBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelBooking'
-type MockApp_CancelBooking_Call struct {
+// MockApp_AcceptWaitlistOffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptWaitlistOffer'
+type MockApp_AcceptWaitlistOffer_Call struct {
 	*mock.Call
 }
 
-// CancelBooking is a helper method to define mock.On call
+// AcceptWaitlistOffer is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cmd command.CancelBooking
-func (_e *MockApp_Expecter) CancelBooking(ctx any, cmd any) *MockApp_CancelBooking_Call {
-	return &MockApp_CancelBooking_Call{Call: _e.mock.On("CancelBooking", ctx, cmd)}
+//   - cmd command.AcceptWaitlistOffer
+func (_e *MockApp_Expecter) AcceptWaitlistOffer(ctx any, cmd any) *MockApp_AcceptWaitlistOffer_Call {
+	return &MockApp_AcceptWaitlistOffer_Call{Call: _e.mock.On("AcceptWaitlistOffer", ctx, cmd)}
 }
 
-func (_c *MockApp_CancelBooking_Call) Run(run func(ctx context.Context, cmd command.CancelBooking)) *MockApp_CancelBooking_Call {
+func (_c *MockApp_AcceptWaitlistOffer_Call) Run(run func(ctx context.Context, cmd command.AcceptWaitlistOffer)) *MockApp_AcceptWaitlistOffer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 command.CancelBooking
+		var arg1 command.AcceptWaitlistOffer
 		if args[1] != nil {
-			arg1 = args[1].(command.CancelBooking)
+			arg1 = args[1].(command.AcceptWaitlistOffer)
 		}
 		run(
 			arg0,
@@ -87,26 +87,26 @@ func (_c *MockApp_CancelBooking_Call) Run(run func(ctx context.Context, cmd comm
 	return _c
 }
 
-func (_c *MockApp_CancelBooking_Call) Return(err error) *MockApp_CancelBooking_Call {
+func (_c *MockApp_AcceptWaitlistOffer_Call) Return(err error) *MockApp_AcceptWaitlistOffer_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_CancelBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CancelBooking) error) *MockApp_CancelBooking_Call {
+func (_c *MockApp_AcceptWaitlistOffer_Call) RunAndReturn(run func(ctx context.Context, cmd command.AcceptWaitlistOffer) error) *MockApp_AcceptWaitlistOffer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateBooking provides a mock function for the type MockApp
-func (_mock *MockApp) CreateBooking(ctx context.Context, cmd command.CreateBooking) error {
+// AnonymizeGuest provides a mock function for the type MockApp
+func (_mock *MockApp) AnonymizeGuest(ctx context.Context, cmd command.AnonymizeGuest) error {
 	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBooking")
+		panic("no return value specified for AnonymizeGuest")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateBooking) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.AnonymizeGuest) error); ok {
 		r0 = returnFunc(ctx, cmd)
 	} else {
 		r0 = ret.Error(0)
@@ -114,27 +114,27 @@ func (_mock *MockApp) CreateBooking(ctx context.Context, cmd command.CreateBooki
 	return r0
 }
 
-// MockApp_CreateBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBooking'
-type MockApp_CreateBooking_Call struct {
+// MockApp_AnonymizeGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeGuest'
+type MockApp_AnonymizeGuest_Call struct {
 	*mock.Call
 }
 
-// CreateBooking is a helper method to define mock.On call
+// AnonymizeGuest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cmd command.CreateBooking
-func (_e *MockApp_Expecter) CreateBooking(ctx any, cmd any) *MockApp_CreateBooking_Call {
-	return &MockApp_CreateBooking_Call{Call: _e.mock.On("CreateBooking", ctx, cmd)}
+//   - cmd command.AnonymizeGuest
+func (_e *MockApp_Expecter) AnonymizeGuest(ctx any, cmd any) *MockApp_AnonymizeGuest_Call {
+	return &MockApp_AnonymizeGuest_Call{Call: _e.mock.On("AnonymizeGuest", ctx, cmd)}
 }
 
-func (_c *MockApp_CreateBooking_Call) Run(run func(ctx context.Context, cmd command.CreateBooking)) *MockApp_CreateBooking_Call {
+func (_c *MockApp_AnonymizeGuest_Call) Run(run func(ctx context.Context, cmd command.AnonymizeGuest)) *MockApp_AnonymizeGuest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 command.CreateBooking
+		var arg1 command.AnonymizeGuest
 		if args[1] != nil {
-			arg1 = args[1].(command.CreateBooking)
+			arg1 = args[1].(command.AnonymizeGuest)
 		}
 		run(
 			arg0,
@@ -144,26 +144,26 @@ func (_c *MockApp_CreateBooking_Call) Run(run func(ctx context.Context, cmd comm
 	return _c
 }
 
-func (_c *MockApp_CreateBooking_Call) Return(err error) *MockApp_CreateBooking_Call {
+func (_c *MockApp_AnonymizeGuest_Call) Return(err error) *MockApp_AnonymizeGuest_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_CreateBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateBooking) error) *MockApp_CreateBooking_Call {
+func (_c *MockApp_AnonymizeGuest_Call) RunAndReturn(run func(ctx context.Context, cmd command.AnonymizeGuest) error) *MockApp_AnonymizeGuest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCampsite provides a mock function for the type MockApp
-func (_mock *MockApp) CreateCampsite(ctx context.Context, cmd command.CreateCampsite) error {
+// ApplyRetentionPolicy provides a mock function for the type MockApp
+func (_mock *MockApp) ApplyRetentionPolicy(ctx context.Context, cmd command.ApplyRetentionPolicy) error {
 	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCampsite")
+		panic("no return value specified for ApplyRetentionPolicy")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateCampsite) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.ApplyRetentionPolicy) error); ok {
 		r0 = returnFunc(ctx, cmd)
 	} else {
 		r0 = ret.Error(0)
@@ -171,27 +171,27 @@ func (_mock *MockApp) CreateCampsite(ctx context.Context, cmd command.CreateCamp
 	return r0
 }
 
-// MockApp_CreateCampsite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampsite'
-type MockApp_CreateCampsite_Call struct {
+// MockApp_ApplyRetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyRetentionPolicy'
+type MockApp_ApplyRetentionPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateCampsite is a helper method to define mock.On call
+// ApplyRetentionPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cmd command.CreateCampsite
-func (_e *MockApp_Expecter) CreateCampsite(ctx any, cmd any) *MockApp_CreateCampsite_Call {
-	return &MockApp_CreateCampsite_Call{Call: _e.mock.On("CreateCampsite", ctx, cmd)}
+//   - cmd command.ApplyRetentionPolicy
+func (_e *MockApp_Expecter) ApplyRetentionPolicy(ctx any, cmd any) *MockApp_ApplyRetentionPolicy_Call {
+	return &MockApp_ApplyRetentionPolicy_Call{Call: _e.mock.On("ApplyRetentionPolicy", ctx, cmd)}
 }
 
-func (_c *MockApp_CreateCampsite_Call) Run(run func(ctx context.Context, cmd command.CreateCampsite)) *MockApp_CreateCampsite_Call {
+func (_c *MockApp_ApplyRetentionPolicy_Call) Run(run func(ctx context.Context, cmd command.ApplyRetentionPolicy)) *MockApp_ApplyRetentionPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 command.CreateCampsite
+		var arg1 command.ApplyRetentionPolicy
 		if args[1] != nil {
-			arg1 = args[1].(command.CreateCampsite)
+			arg1 = args[1].(command.ApplyRetentionPolicy)
 		}
 		run(
 			arg0,
@@ -201,65 +201,54 @@ func (_c *MockApp_CreateCampsite_Call) Run(run func(ctx context.Context, cmd com
 	return _c
 }
 
-func (_c *MockApp_CreateCampsite_Call) Return(err error) *MockApp_CreateCampsite_Call {
+func (_c *MockApp_ApplyRetentionPolicy_Call) Return(err error) *MockApp_ApplyRetentionPolicy_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_CreateCampsite_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateCampsite) error) *MockApp_CreateCampsite_Call {
+func (_c *MockApp_ApplyRetentionPolicy_Call) RunAndReturn(run func(ctx context.Context, cmd command.ApplyRetentionPolicy) error) *MockApp_ApplyRetentionPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBooking provides a mock function for the type MockApp
-func (_mock *MockApp) GetBooking(ctx context.Context, qry query.GetBooking) (*domain.Booking, error) {
-	ret := _mock.Called(ctx, qry)
+// CancelBooking provides a mock function for the type MockApp
+func (_mock *MockApp) CancelBooking(ctx context.Context, cmd command.CancelBooking) error {
+	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBooking")
+		panic("no return value specified for CancelBooking")
 	}
 
-	var r0 *domain.Booking
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetBooking) (*domain.Booking, error)); ok {
-		return returnFunc(ctx, qry)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetBooking) *domain.Booking); ok {
-		r0 = returnFunc(ctx, qry)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Booking)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetBooking) error); ok {
-		r1 = returnFunc(ctx, qry)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CancelBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockApp_GetBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBooking'
-type MockApp_GetBooking_Call struct {
+// MockApp_CancelBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelBooking'
+type MockApp_CancelBooking_Call struct {
 	*mock.Call
 }
 
-// GetBooking is a helper method to define mock.On call
+// CancelBooking is a helper method to define mock.On call
 //   - ctx context.Context
-//   - qry query.GetBooking
-func (_e *MockApp_Expecter) GetBooking(ctx any, qry any) *MockApp_GetBooking_Call {
-	return &MockApp_GetBooking_Call{Call: _e.mock.On("GetBooking", ctx, qry)}
+//   - cmd command.CancelBooking
+func (_e *MockApp_Expecter) CancelBooking(ctx any, cmd any) *MockApp_CancelBooking_Call {
+	return &MockApp_CancelBooking_Call{Call: _e.mock.On("CancelBooking", ctx, cmd)}
 }
 
-func (_c *MockApp_GetBooking_Call) Run(run func(ctx context.Context, qry query.GetBooking)) *MockApp_GetBooking_Call {
+func (_c *MockApp_CancelBooking_Call) Run(run func(ctx context.Context, cmd command.CancelBooking)) *MockApp_CancelBooking_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 query.GetBooking
+		var arg1 command.CancelBooking
 		if args[1] != nil {
-			arg1 = args[1].(query.GetBooking)
+			arg1 = args[1].(command.CancelBooking)
 		}
 		run(
 			arg0,
@@ -269,65 +258,54 @@ func (_c *MockApp_GetBooking_Call) Run(run func(ctx context.Context, qry query.G
 	return _c
 }
 
-func (_c *MockApp_GetBooking_Call) Return(booking *domain.Booking, err error) *MockApp_GetBooking_Call {
-	_c.Call.Return(booking, err)
+func (_c *MockApp_CancelBooking_Call) Return(err error) *MockApp_CancelBooking_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_GetBooking_Call) RunAndReturn(run func(ctx context.Context, qry query.GetBooking) (*domain.Booking, error)) *MockApp_GetBooking_Call {
+func (_c *MockApp_CancelBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CancelBooking) error) *MockApp_CancelBooking_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCampsites provides a mock function for the type MockApp
-func (_mock *MockApp) GetCampsites(ctx context.Context, qry query.GetCampsites) ([]*domain.Campsite, error) {
-	ret := _mock.Called(ctx, qry)
+// CancelGroupBooking provides a mock function for the type MockApp
+func (_mock *MockApp) CancelGroupBooking(ctx context.Context, cmd command.CancelGroupBooking) error {
+	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCampsites")
+		panic("no return value specified for CancelGroupBooking")
 	}
 
-	var r0 []*domain.Campsite
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsites) ([]*domain.Campsite, error)); ok {
-		return returnFunc(ctx, qry)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsites) []*domain.Campsite); ok {
-		r0 = returnFunc(ctx, qry)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Campsite)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampsites) error); ok {
-		r1 = returnFunc(ctx, qry)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CancelGroupBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockApp_GetCampsites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampsites'
-type MockApp_GetCampsites_Call struct {
+// MockApp_CancelGroupBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelGroupBooking'
+type MockApp_CancelGroupBooking_Call struct {
 	*mock.Call
 }
 
-// GetCampsites is a helper method to define mock.On call
+// CancelGroupBooking is a helper method to define mock.On call
 //   - ctx context.Context
-//   - qry query.GetCampsites
-func (_e *MockApp_Expecter) GetCampsites(ctx any, qry any) *MockApp_GetCampsites_Call {
-	return &MockApp_GetCampsites_Call{Call: _e.mock.On("GetCampsites", ctx, qry)}
+//   - cmd command.CancelGroupBooking
+func (_e *MockApp_Expecter) CancelGroupBooking(ctx any, cmd any) *MockApp_CancelGroupBooking_Call {
+	return &MockApp_CancelGroupBooking_Call{Call: _e.mock.On("CancelGroupBooking", ctx, cmd)}
 }
 
-func (_c *MockApp_GetCampsites_Call) Run(run func(ctx context.Context, qry query.GetCampsites)) *MockApp_GetCampsites_Call {
+func (_c *MockApp_CancelGroupBooking_Call) Run(run func(ctx context.Context, cmd command.CancelGroupBooking)) *MockApp_CancelGroupBooking_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 query.GetCampsites
+		var arg1 command.CancelGroupBooking
 		if args[1] != nil {
-			arg1 = args[1].(query.GetCampsites)
+			arg1 = args[1].(command.CancelGroupBooking)
 		}
 		run(
 			arg0,
@@ -337,65 +315,111 @@ func (_c *MockApp_GetCampsites_Call) Run(run func(ctx context.Context, qry query
 	return _c
 }
 
-func (_c *MockApp_GetCampsites_Call) Return(campsites []*domain.Campsite, err error) *MockApp_GetCampsites_Call {
-	_c.Call.Return(campsites, err)
+func (_c *MockApp_CancelGroupBooking_Call) Return(err error) *MockApp_CancelGroupBooking_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_GetCampsites_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampsites) ([]*domain.Campsite, error)) *MockApp_GetCampsites_Call {
+func (_c *MockApp_CancelGroupBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CancelGroupBooking) error) *MockApp_CancelGroupBooking_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetVacantDates provides a mock function for the type MockApp
-func (_mock *MockApp) GetVacantDates(ctx context.Context, qry query.GetVacantDates) ([]string, error) {
-	ret := _mock.Called(ctx, qry)
+// CheckIn provides a mock function for the type MockApp
+func (_mock *MockApp) CheckIn(ctx context.Context, cmd command.CheckIn) error {
+	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetVacantDates")
+		panic("no return value specified for CheckIn")
 	}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetVacantDates) ([]string, error)); ok {
-		return returnFunc(ctx, qry)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetVacantDates) []string); ok {
-		r0 = returnFunc(ctx, qry)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CheckIn) error); ok {
+		r0 = returnFunc(ctx, cmd)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIn'
+type MockApp_CheckIn_Call struct {
+	*mock.Call
+}
+
+// CheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CheckIn
+func (_e *MockApp_Expecter) CheckIn(ctx any, cmd any) *MockApp_CheckIn_Call {
+	return &MockApp_CheckIn_Call{Call: _e.mock.On("CheckIn", ctx, cmd)}
+}
+
+func (_c *MockApp_CheckIn_Call) Run(run func(ctx context.Context, cmd command.CheckIn)) *MockApp_CheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CheckIn
+		if args[1] != nil {
+			arg1 = args[1].(command.CheckIn)
 		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CheckIn_Call) Return(err error) *MockApp_CheckIn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CheckIn_Call) RunAndReturn(run func(ctx context.Context, cmd command.CheckIn) error) *MockApp_CheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckOut provides a mock function for the type MockApp
+func (_mock *MockApp) CheckOut(ctx context.Context, cmd command.CheckOut) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckOut")
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetVacantDates) error); ok {
-		r1 = returnFunc(ctx, qry)
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CheckOut) error); ok {
+		r0 = returnFunc(ctx, cmd)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockApp_GetVacantDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVacantDates'
-type MockApp_GetVacantDates_Call struct {
+// MockApp_CheckOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckOut'
+type MockApp_CheckOut_Call struct {
 	*mock.Call
 }
 
-// GetVacantDates is a helper method to define mock.On call
+// CheckOut is a helper method to define mock.On call
 //   - ctx context.Context
-//   - qry query.GetVacantDates
-func (_e *MockApp_Expecter) GetVacantDates(ctx any, qry any) *MockApp_GetVacantDates_Call {
-	return &MockApp_GetVacantDates_Call{Call: _e.mock.On("GetVacantDates", ctx, qry)}
+//   - cmd command.CheckOut
+func (_e *MockApp_Expecter) CheckOut(ctx any, cmd any) *MockApp_CheckOut_Call {
+	return &MockApp_CheckOut_Call{Call: _e.mock.On("CheckOut", ctx, cmd)}
 }
 
-func (_c *MockApp_GetVacantDates_Call) Run(run func(ctx context.Context, qry query.GetVacantDates)) *MockApp_GetVacantDates_Call {
+func (_c *MockApp_CheckOut_Call) Run(run func(ctx context.Context, cmd command.CheckOut)) *MockApp_CheckOut_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 query.GetVacantDates
+		var arg1 command.CheckOut
 		if args[1] != nil {
-			arg1 = args[1].(query.GetVacantDates)
+			arg1 = args[1].(command.CheckOut)
 		}
 		run(
 			arg0,
@@ -405,26 +429,26 @@ func (_c *MockApp_GetVacantDates_Call) Run(run func(ctx context.Context, qry que
 	return _c
 }
 
-func (_c *MockApp_GetVacantDates_Call) Return(strings []string, err error) *MockApp_GetVacantDates_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockApp_CheckOut_Call) Return(err error) *MockApp_CheckOut_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_GetVacantDates_Call) RunAndReturn(run func(ctx context.Context, qry query.GetVacantDates) ([]string, error)) *MockApp_GetVacantDates_Call {
+func (_c *MockApp_CheckOut_Call) RunAndReturn(run func(ctx context.Context, cmd command.CheckOut) error) *MockApp_CheckOut_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateBooking provides a mock function for the type MockApp
-func (_mock *MockApp) UpdateBooking(ctx context.Context, cmd command.UpdateBooking) error {
+// CreateBlackout provides a mock function for the type MockApp
+func (_mock *MockApp) CreateBlackout(ctx context.Context, cmd command.CreateBlackout) error {
 	ret := _mock.Called(ctx, cmd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateBooking")
+		panic("no return value specified for CreateBlackout")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, command.UpdateBooking) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateBlackout) error); ok {
 		r0 = returnFunc(ctx, cmd)
 	} else {
 		r0 = ret.Error(0)
@@ -432,27 +456,27 @@ func (_mock *MockApp) UpdateBooking(ctx context.Context, cmd command.UpdateBooki
 	return r0
 }
 
-// MockApp_UpdateBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBooking'
-type MockApp_UpdateBooking_Call struct {
+// MockApp_CreateBlackout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlackout'
+type MockApp_CreateBlackout_Call struct {
 	*mock.Call
 }
 
-// UpdateBooking is a helper method to define mock.On call
+// CreateBlackout is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cmd command.UpdateBooking
-func (_e *MockApp_Expecter) UpdateBooking(ctx any, cmd any) *MockApp_UpdateBooking_Call {
-	return &MockApp_UpdateBooking_Call{Call: _e.mock.On("UpdateBooking", ctx, cmd)}
+//   - cmd command.CreateBlackout
+func (_e *MockApp_Expecter) CreateBlackout(ctx any, cmd any) *MockApp_CreateBlackout_Call {
+	return &MockApp_CreateBlackout_Call{Call: _e.mock.On("CreateBlackout", ctx, cmd)}
 }
 
-func (_c *MockApp_UpdateBooking_Call) Run(run func(ctx context.Context, cmd command.UpdateBooking)) *MockApp_UpdateBooking_Call {
+func (_c *MockApp_CreateBlackout_Call) Run(run func(ctx context.Context, cmd command.CreateBlackout)) *MockApp_CreateBlackout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 command.UpdateBooking
+		var arg1 command.CreateBlackout
 		if args[1] != nil {
-			arg1 = args[1].(command.UpdateBooking)
+			arg1 = args[1].(command.CreateBlackout)
 		}
 		run(
 			arg0,
@@ -462,12 +486,2240 @@ func (_c *MockApp_UpdateBooking_Call) Run(run func(ctx context.Context, cmd comm
 	return _c
 }
 
-func (_c *MockApp_UpdateBooking_Call) Return(err error) *MockApp_UpdateBooking_Call {
+func (_c *MockApp_CreateBlackout_Call) Return(err error) *MockApp_CreateBlackout_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockApp_UpdateBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.UpdateBooking) error) *MockApp_UpdateBooking_Call {
+func (_c *MockApp_CreateBlackout_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateBlackout) error) *MockApp_CreateBlackout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBooking provides a mock function for the type MockApp
+func (_mock *MockApp) CreateBooking(ctx context.Context, cmd command.CreateBooking) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CreateBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBooking'
+type MockApp_CreateBooking_Call struct {
+	*mock.Call
+}
+
+// CreateBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CreateBooking
+func (_e *MockApp_Expecter) CreateBooking(ctx any, cmd any) *MockApp_CreateBooking_Call {
+	return &MockApp_CreateBooking_Call{Call: _e.mock.On("CreateBooking", ctx, cmd)}
+}
+
+func (_c *MockApp_CreateBooking_Call) Run(run func(ctx context.Context, cmd command.CreateBooking)) *MockApp_CreateBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CreateBooking
+		if args[1] != nil {
+			arg1 = args[1].(command.CreateBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CreateBooking_Call) Return(err error) *MockApp_CreateBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CreateBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateBooking) error) *MockApp_CreateBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCalendarSubscription provides a mock function for the type MockApp
+func (_mock *MockApp) CreateCalendarSubscription(ctx context.Context, cmd command.CreateCalendarSubscription) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendarSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateCalendarSubscription) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CreateCalendarSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCalendarSubscription'
+type MockApp_CreateCalendarSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateCalendarSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CreateCalendarSubscription
+func (_e *MockApp_Expecter) CreateCalendarSubscription(ctx any, cmd any) *MockApp_CreateCalendarSubscription_Call {
+	return &MockApp_CreateCalendarSubscription_Call{Call: _e.mock.On("CreateCalendarSubscription", ctx, cmd)}
+}
+
+func (_c *MockApp_CreateCalendarSubscription_Call) Run(run func(ctx context.Context, cmd command.CreateCalendarSubscription)) *MockApp_CreateCalendarSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CreateCalendarSubscription
+		if args[1] != nil {
+			arg1 = args[1].(command.CreateCalendarSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CreateCalendarSubscription_Call) Return(err error) *MockApp_CreateCalendarSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CreateCalendarSubscription_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateCalendarSubscription) error) *MockApp_CreateCalendarSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCampground provides a mock function for the type MockApp
+func (_mock *MockApp) CreateCampground(ctx context.Context, cmd command.CreateCampground) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampground")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateCampground) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CreateCampground_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampground'
+type MockApp_CreateCampground_Call struct {
+	*mock.Call
+}
+
+// CreateCampground is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CreateCampground
+func (_e *MockApp_Expecter) CreateCampground(ctx any, cmd any) *MockApp_CreateCampground_Call {
+	return &MockApp_CreateCampground_Call{Call: _e.mock.On("CreateCampground", ctx, cmd)}
+}
+
+func (_c *MockApp_CreateCampground_Call) Run(run func(ctx context.Context, cmd command.CreateCampground)) *MockApp_CreateCampground_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CreateCampground
+		if args[1] != nil {
+			arg1 = args[1].(command.CreateCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CreateCampground_Call) Return(err error) *MockApp_CreateCampground_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CreateCampground_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateCampground) error) *MockApp_CreateCampground_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCampsite provides a mock function for the type MockApp
+func (_mock *MockApp) CreateCampsite(ctx context.Context, cmd command.CreateCampsite) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampsite")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateCampsite) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CreateCampsite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampsite'
+type MockApp_CreateCampsite_Call struct {
+	*mock.Call
+}
+
+// CreateCampsite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CreateCampsite
+func (_e *MockApp_Expecter) CreateCampsite(ctx any, cmd any) *MockApp_CreateCampsite_Call {
+	return &MockApp_CreateCampsite_Call{Call: _e.mock.On("CreateCampsite", ctx, cmd)}
+}
+
+func (_c *MockApp_CreateCampsite_Call) Run(run func(ctx context.Context, cmd command.CreateCampsite)) *MockApp_CreateCampsite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CreateCampsite
+		if args[1] != nil {
+			arg1 = args[1].(command.CreateCampsite)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CreateCampsite_Call) Return(err error) *MockApp_CreateCampsite_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CreateCampsite_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateCampsite) error) *MockApp_CreateCampsite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGroupBooking provides a mock function for the type MockApp
+func (_mock *MockApp) CreateGroupBooking(ctx context.Context, cmd command.CreateGroupBooking) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroupBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.CreateGroupBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_CreateGroupBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroupBooking'
+type MockApp_CreateGroupBooking_Call struct {
+	*mock.Call
+}
+
+// CreateGroupBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.CreateGroupBooking
+func (_e *MockApp_Expecter) CreateGroupBooking(ctx any, cmd any) *MockApp_CreateGroupBooking_Call {
+	return &MockApp_CreateGroupBooking_Call{Call: _e.mock.On("CreateGroupBooking", ctx, cmd)}
+}
+
+func (_c *MockApp_CreateGroupBooking_Call) Run(run func(ctx context.Context, cmd command.CreateGroupBooking)) *MockApp_CreateGroupBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.CreateGroupBooking
+		if args[1] != nil {
+			arg1 = args[1].(command.CreateGroupBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_CreateGroupBooking_Call) Return(err error) *MockApp_CreateGroupBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_CreateGroupBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.CreateGroupBooking) error) *MockApp_CreateGroupBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlackout provides a mock function for the type MockApp
+func (_mock *MockApp) DeleteBlackout(ctx context.Context, cmd command.DeleteBlackout) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlackout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.DeleteBlackout) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_DeleteBlackout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlackout'
+type MockApp_DeleteBlackout_Call struct {
+	*mock.Call
+}
+
+// DeleteBlackout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.DeleteBlackout
+func (_e *MockApp_Expecter) DeleteBlackout(ctx any, cmd any) *MockApp_DeleteBlackout_Call {
+	return &MockApp_DeleteBlackout_Call{Call: _e.mock.On("DeleteBlackout", ctx, cmd)}
+}
+
+func (_c *MockApp_DeleteBlackout_Call) Run(run func(ctx context.Context, cmd command.DeleteBlackout)) *MockApp_DeleteBlackout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.DeleteBlackout
+		if args[1] != nil {
+			arg1 = args[1].(command.DeleteBlackout)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_DeleteBlackout_Call) Return(err error) *MockApp_DeleteBlackout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_DeleteBlackout_Call) RunAndReturn(run func(ctx context.Context, cmd command.DeleteBlackout) error) *MockApp_DeleteBlackout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCalendarSubscription provides a mock function for the type MockApp
+func (_mock *MockApp) DeleteCalendarSubscription(ctx context.Context, cmd command.DeleteCalendarSubscription) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendarSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.DeleteCalendarSubscription) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_DeleteCalendarSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCalendarSubscription'
+type MockApp_DeleteCalendarSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteCalendarSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.DeleteCalendarSubscription
+func (_e *MockApp_Expecter) DeleteCalendarSubscription(ctx any, cmd any) *MockApp_DeleteCalendarSubscription_Call {
+	return &MockApp_DeleteCalendarSubscription_Call{Call: _e.mock.On("DeleteCalendarSubscription", ctx, cmd)}
+}
+
+func (_c *MockApp_DeleteCalendarSubscription_Call) Run(run func(ctx context.Context, cmd command.DeleteCalendarSubscription)) *MockApp_DeleteCalendarSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.DeleteCalendarSubscription
+		if args[1] != nil {
+			arg1 = args[1].(command.DeleteCalendarSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_DeleteCalendarSubscription_Call) Return(err error) *MockApp_DeleteCalendarSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_DeleteCalendarSubscription_Call) RunAndReturn(run func(ctx context.Context, cmd command.DeleteCalendarSubscription) error) *MockApp_DeleteCalendarSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCampground provides a mock function for the type MockApp
+func (_mock *MockApp) DeleteCampground(ctx context.Context, cmd command.DeleteCampground) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCampground")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.DeleteCampground) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_DeleteCampground_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCampground'
+type MockApp_DeleteCampground_Call struct {
+	*mock.Call
+}
+
+// DeleteCampground is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.DeleteCampground
+func (_e *MockApp_Expecter) DeleteCampground(ctx any, cmd any) *MockApp_DeleteCampground_Call {
+	return &MockApp_DeleteCampground_Call{Call: _e.mock.On("DeleteCampground", ctx, cmd)}
+}
+
+func (_c *MockApp_DeleteCampground_Call) Run(run func(ctx context.Context, cmd command.DeleteCampground)) *MockApp_DeleteCampground_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.DeleteCampground
+		if args[1] != nil {
+			arg1 = args[1].(command.DeleteCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_DeleteCampground_Call) Return(err error) *MockApp_DeleteCampground_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_DeleteCampground_Call) RunAndReturn(run func(ctx context.Context, cmd command.DeleteCampground) error) *MockApp_DeleteCampground_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireWaitlistOffers provides a mock function for the type MockApp
+func (_mock *MockApp) ExpireWaitlistOffers(ctx context.Context, cmd command.ExpireWaitlistOffers) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireWaitlistOffers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.ExpireWaitlistOffers) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_ExpireWaitlistOffers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireWaitlistOffers'
+type MockApp_ExpireWaitlistOffers_Call struct {
+	*mock.Call
+}
+
+// ExpireWaitlistOffers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.ExpireWaitlistOffers
+func (_e *MockApp_Expecter) ExpireWaitlistOffers(ctx any, cmd any) *MockApp_ExpireWaitlistOffers_Call {
+	return &MockApp_ExpireWaitlistOffers_Call{Call: _e.mock.On("ExpireWaitlistOffers", ctx, cmd)}
+}
+
+func (_c *MockApp_ExpireWaitlistOffers_Call) Run(run func(ctx context.Context, cmd command.ExpireWaitlistOffers)) *MockApp_ExpireWaitlistOffers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.ExpireWaitlistOffers
+		if args[1] != nil {
+			arg1 = args[1].(command.ExpireWaitlistOffers)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ExpireWaitlistOffers_Call) Return(err error) *MockApp_ExpireWaitlistOffers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_ExpireWaitlistOffers_Call) RunAndReturn(run func(ctx context.Context, cmd command.ExpireWaitlistOffers) error) *MockApp_ExpireWaitlistOffers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportGuestData provides a mock function for the type MockApp
+func (_mock *MockApp) ExportGuestData(ctx context.Context, qry query.ExportGuestData) (*domain.GuestData, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportGuestData")
+	}
+
+	var r0 *domain.GuestData
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ExportGuestData) (*domain.GuestData, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ExportGuestData) *domain.GuestData); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.GuestData)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ExportGuestData) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_ExportGuestData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportGuestData'
+type MockApp_ExportGuestData_Call struct {
+	*mock.Call
+}
+
+// ExportGuestData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.ExportGuestData
+func (_e *MockApp_Expecter) ExportGuestData(ctx any, qry any) *MockApp_ExportGuestData_Call {
+	return &MockApp_ExportGuestData_Call{Call: _e.mock.On("ExportGuestData", ctx, qry)}
+}
+
+func (_c *MockApp_ExportGuestData_Call) Run(run func(ctx context.Context, qry query.ExportGuestData)) *MockApp_ExportGuestData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ExportGuestData
+		if args[1] != nil {
+			arg1 = args[1].(query.ExportGuestData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ExportGuestData_Call) Return(guestData *domain.GuestData, err error) *MockApp_ExportGuestData_Call {
+	_c.Call.Return(guestData, err)
+	return _c
+}
+
+func (_c *MockApp_ExportGuestData_Call) RunAndReturn(run func(ctx context.Context, qry query.ExportGuestData) (*domain.GuestData, error)) *MockApp_ExportGuestData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBooking provides a mock function for the type MockApp
+func (_mock *MockApp) GetBooking(ctx context.Context, qry query.GetBooking) (*domain.Booking, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBooking")
+	}
+
+	var r0 *domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetBooking) (*domain.Booking, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetBooking) *domain.Booking); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetBooking) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBooking'
+type MockApp_GetBooking_Call struct {
+	*mock.Call
+}
+
+// GetBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetBooking
+func (_e *MockApp_Expecter) GetBooking(ctx any, qry any) *MockApp_GetBooking_Call {
+	return &MockApp_GetBooking_Call{Call: _e.mock.On("GetBooking", ctx, qry)}
+}
+
+func (_c *MockApp_GetBooking_Call) Run(run func(ctx context.Context, qry query.GetBooking)) *MockApp_GetBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetBooking
+		if args[1] != nil {
+			arg1 = args[1].(query.GetBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetBooking_Call) Return(booking *domain.Booking, err error) *MockApp_GetBooking_Call {
+	_c.Call.Return(booking, err)
+	return _c
+}
+
+func (_c *MockApp_GetBooking_Call) RunAndReturn(run func(ctx context.Context, qry query.GetBooking) (*domain.Booking, error)) *MockApp_GetBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampground provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampground(ctx context.Context, qry query.GetCampground) (*domain.Campground, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampground")
+	}
+
+	var r0 *domain.Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampground) (*domain.Campground, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampground) *domain.Campground); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampground) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampground_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampground'
+type MockApp_GetCampground_Call struct {
+	*mock.Call
+}
+
+// GetCampground is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampground
+func (_e *MockApp_Expecter) GetCampground(ctx any, qry any) *MockApp_GetCampground_Call {
+	return &MockApp_GetCampground_Call{Call: _e.mock.On("GetCampground", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampground_Call) Run(run func(ctx context.Context, qry query.GetCampground)) *MockApp_GetCampground_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampground
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampground_Call) Return(campground *domain.Campground, err error) *MockApp_GetCampground_Call {
+	_c.Call.Return(campground, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampground_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampground) (*domain.Campground, error)) *MockApp_GetCampground_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampgroundSeason provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampgroundSeason(ctx context.Context, qry query.GetCampgroundSeason) (*domain.CampgroundSeason, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampgroundSeason")
+	}
+
+	var r0 *domain.CampgroundSeason
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampgroundSeason) (*domain.CampgroundSeason, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampgroundSeason) *domain.CampgroundSeason); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampgroundSeason)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampgroundSeason) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampgroundSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampgroundSeason'
+type MockApp_GetCampgroundSeason_Call struct {
+	*mock.Call
+}
+
+// GetCampgroundSeason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampgroundSeason
+func (_e *MockApp_Expecter) GetCampgroundSeason(ctx any, qry any) *MockApp_GetCampgroundSeason_Call {
+	return &MockApp_GetCampgroundSeason_Call{Call: _e.mock.On("GetCampgroundSeason", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampgroundSeason_Call) Run(run func(ctx context.Context, qry query.GetCampgroundSeason)) *MockApp_GetCampgroundSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampgroundSeason
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampgroundSeason)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampgroundSeason_Call) Return(campgroundSeason *domain.CampgroundSeason, err error) *MockApp_GetCampgroundSeason_Call {
+	_c.Call.Return(campgroundSeason, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampgroundSeason_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampgroundSeason) (*domain.CampgroundSeason, error)) *MockApp_GetCampgroundSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampgrounds provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampgrounds(ctx context.Context, qry query.GetCampgrounds) ([]*domain.Campground, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampgrounds")
+	}
+
+	var r0 []*domain.Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampgrounds) ([]*domain.Campground, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampgrounds) []*domain.Campground); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampgrounds) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampgrounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampgrounds'
+type MockApp_GetCampgrounds_Call struct {
+	*mock.Call
+}
+
+// GetCampgrounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampgrounds
+func (_e *MockApp_Expecter) GetCampgrounds(ctx any, qry any) *MockApp_GetCampgrounds_Call {
+	return &MockApp_GetCampgrounds_Call{Call: _e.mock.On("GetCampgrounds", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampgrounds_Call) Run(run func(ctx context.Context, qry query.GetCampgrounds)) *MockApp_GetCampgrounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampgrounds
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampgrounds)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampgrounds_Call) Return(campgrounds []*domain.Campground, err error) *MockApp_GetCampgrounds_Call {
+	_c.Call.Return(campgrounds, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampgrounds_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampgrounds) ([]*domain.Campground, error)) *MockApp_GetCampgrounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampsiteCalendar provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampsiteCalendar(ctx context.Context, qry query.GetCampsiteCalendar) (*domain.CampsiteCalendar, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampsiteCalendar")
+	}
+
+	var r0 *domain.CampsiteCalendar
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsiteCalendar) (*domain.CampsiteCalendar, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsiteCalendar) *domain.CampsiteCalendar); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampsiteCalendar)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampsiteCalendar) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampsiteCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampsiteCalendar'
+type MockApp_GetCampsiteCalendar_Call struct {
+	*mock.Call
+}
+
+// GetCampsiteCalendar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampsiteCalendar
+func (_e *MockApp_Expecter) GetCampsiteCalendar(ctx any, qry any) *MockApp_GetCampsiteCalendar_Call {
+	return &MockApp_GetCampsiteCalendar_Call{Call: _e.mock.On("GetCampsiteCalendar", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampsiteCalendar_Call) Run(run func(ctx context.Context, qry query.GetCampsiteCalendar)) *MockApp_GetCampsiteCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampsiteCalendar
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampsiteCalendar)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampsiteCalendar_Call) Return(campsiteCalendar *domain.CampsiteCalendar, err error) *MockApp_GetCampsiteCalendar_Call {
+	_c.Call.Return(campsiteCalendar, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampsiteCalendar_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampsiteCalendar) (*domain.CampsiteCalendar, error)) *MockApp_GetCampsiteCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampsiteRates provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampsiteRates(ctx context.Context, qry query.GetCampsiteRates) (*domain.CampsiteRates, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampsiteRates")
+	}
+
+	var r0 *domain.CampsiteRates
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsiteRates) (*domain.CampsiteRates, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsiteRates) *domain.CampsiteRates); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampsiteRates)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampsiteRates) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampsiteRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampsiteRates'
+type MockApp_GetCampsiteRates_Call struct {
+	*mock.Call
+}
+
+// GetCampsiteRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampsiteRates
+func (_e *MockApp_Expecter) GetCampsiteRates(ctx any, qry any) *MockApp_GetCampsiteRates_Call {
+	return &MockApp_GetCampsiteRates_Call{Call: _e.mock.On("GetCampsiteRates", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampsiteRates_Call) Run(run func(ctx context.Context, qry query.GetCampsiteRates)) *MockApp_GetCampsiteRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampsiteRates
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampsiteRates)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampsiteRates_Call) Return(campsiteRates *domain.CampsiteRates, err error) *MockApp_GetCampsiteRates_Call {
+	_c.Call.Return(campsiteRates, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampsiteRates_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampsiteRates) (*domain.CampsiteRates, error)) *MockApp_GetCampsiteRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampsites provides a mock function for the type MockApp
+func (_mock *MockApp) GetCampsites(ctx context.Context, qry query.GetCampsites) ([]*domain.Campsite, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampsites")
+	}
+
+	var r0 []*domain.Campsite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsites) ([]*domain.Campsite, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetCampsites) []*domain.Campsite); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Campsite)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetCampsites) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetCampsites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampsites'
+type MockApp_GetCampsites_Call struct {
+	*mock.Call
+}
+
+// GetCampsites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetCampsites
+func (_e *MockApp_Expecter) GetCampsites(ctx any, qry any) *MockApp_GetCampsites_Call {
+	return &MockApp_GetCampsites_Call{Call: _e.mock.On("GetCampsites", ctx, qry)}
+}
+
+func (_c *MockApp_GetCampsites_Call) Run(run func(ctx context.Context, qry query.GetCampsites)) *MockApp_GetCampsites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetCampsites
+		if args[1] != nil {
+			arg1 = args[1].(query.GetCampsites)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetCampsites_Call) Return(campsites []*domain.Campsite, err error) *MockApp_GetCampsites_Call {
+	_c.Call.Return(campsites, err)
+	return _c
+}
+
+func (_c *MockApp_GetCampsites_Call) RunAndReturn(run func(ctx context.Context, qry query.GetCampsites) ([]*domain.Campsite, error)) *MockApp_GetCampsites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupBooking provides a mock function for the type MockApp
+func (_mock *MockApp) GetGroupBooking(ctx context.Context, qry query.GetGroupBooking) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupBooking")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetGroupBooking) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetGroupBooking) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetGroupBooking) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetGroupBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupBooking'
+type MockApp_GetGroupBooking_Call struct {
+	*mock.Call
+}
+
+// GetGroupBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetGroupBooking
+func (_e *MockApp_Expecter) GetGroupBooking(ctx any, qry any) *MockApp_GetGroupBooking_Call {
+	return &MockApp_GetGroupBooking_Call{Call: _e.mock.On("GetGroupBooking", ctx, qry)}
+}
+
+func (_c *MockApp_GetGroupBooking_Call) Run(run func(ctx context.Context, qry query.GetGroupBooking)) *MockApp_GetGroupBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetGroupBooking
+		if args[1] != nil {
+			arg1 = args[1].(query.GetGroupBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetGroupBooking_Call) Return(bookings []*domain.Booking, err error) *MockApp_GetGroupBooking_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockApp_GetGroupBooking_Call) RunAndReturn(run func(ctx context.Context, qry query.GetGroupBooking) ([]*domain.Booking, error)) *MockApp_GetGroupBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuest provides a mock function for the type MockApp
+func (_mock *MockApp) GetGuest(ctx context.Context, qry query.GetGuest) (*domain.Guest, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuest")
+	}
+
+	var r0 *domain.Guest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetGuest) (*domain.Guest, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetGuest) *domain.Guest); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Guest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetGuest) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuest'
+type MockApp_GetGuest_Call struct {
+	*mock.Call
+}
+
+// GetGuest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetGuest
+func (_e *MockApp_Expecter) GetGuest(ctx any, qry any) *MockApp_GetGuest_Call {
+	return &MockApp_GetGuest_Call{Call: _e.mock.On("GetGuest", ctx, qry)}
+}
+
+func (_c *MockApp_GetGuest_Call) Run(run func(ctx context.Context, qry query.GetGuest)) *MockApp_GetGuest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetGuest
+		if args[1] != nil {
+			arg1 = args[1].(query.GetGuest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetGuest_Call) Return(guest *domain.Guest, err error) *MockApp_GetGuest_Call {
+	_c.Call.Return(guest, err)
+	return _c
+}
+
+func (_c *MockApp_GetGuest_Call) RunAndReturn(run func(ctx context.Context, qry query.GetGuest) (*domain.Guest, error)) *MockApp_GetGuest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVacantDates provides a mock function for the type MockApp
+func (_mock *MockApp) GetVacantDates(ctx context.Context, qry query.GetVacantDates) (*domain.Vacancy, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVacantDates")
+	}
+
+	var r0 *domain.Vacancy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetVacantDates) (*domain.Vacancy, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.GetVacantDates) *domain.Vacancy); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Vacancy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.GetVacantDates) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_GetVacantDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVacantDates'
+type MockApp_GetVacantDates_Call struct {
+	*mock.Call
+}
+
+// GetVacantDates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.GetVacantDates
+func (_e *MockApp_Expecter) GetVacantDates(ctx any, qry any) *MockApp_GetVacantDates_Call {
+	return &MockApp_GetVacantDates_Call{Call: _e.mock.On("GetVacantDates", ctx, qry)}
+}
+
+func (_c *MockApp_GetVacantDates_Call) Run(run func(ctx context.Context, qry query.GetVacantDates)) *MockApp_GetVacantDates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.GetVacantDates
+		if args[1] != nil {
+			arg1 = args[1].(query.GetVacantDates)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_GetVacantDates_Call) Return(vacancy *domain.Vacancy, err error) *MockApp_GetVacantDates_Call {
+	_c.Call.Return(vacancy, err)
+	return _c
+}
+
+func (_c *MockApp_GetVacantDates_Call) RunAndReturn(run func(ctx context.Context, qry query.GetVacantDates) (*domain.Vacancy, error)) *MockApp_GetVacantDates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportCampsites provides a mock function for the type MockApp
+func (_mock *MockApp) ImportCampsites(ctx context.Context, cmd command.ImportCampsites) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCampsites")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.ImportCampsites) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_ImportCampsites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportCampsites'
+type MockApp_ImportCampsites_Call struct {
+	*mock.Call
+}
+
+// ImportCampsites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.ImportCampsites
+func (_e *MockApp_Expecter) ImportCampsites(ctx any, cmd any) *MockApp_ImportCampsites_Call {
+	return &MockApp_ImportCampsites_Call{Call: _e.mock.On("ImportCampsites", ctx, cmd)}
+}
+
+func (_c *MockApp_ImportCampsites_Call) Run(run func(ctx context.Context, cmd command.ImportCampsites)) *MockApp_ImportCampsites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.ImportCampsites
+		if args[1] != nil {
+			arg1 = args[1].(command.ImportCampsites)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ImportCampsites_Call) Return(err error) *MockApp_ImportCampsites_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_ImportCampsites_Call) RunAndReturn(run func(ctx context.Context, cmd command.ImportCampsites) error) *MockApp_ImportCampsites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinWaitlist provides a mock function for the type MockApp
+func (_mock *MockApp) JoinWaitlist(ctx context.Context, cmd command.JoinWaitlist) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinWaitlist")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.JoinWaitlist) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_JoinWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinWaitlist'
+type MockApp_JoinWaitlist_Call struct {
+	*mock.Call
+}
+
+// JoinWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.JoinWaitlist
+func (_e *MockApp_Expecter) JoinWaitlist(ctx any, cmd any) *MockApp_JoinWaitlist_Call {
+	return &MockApp_JoinWaitlist_Call{Call: _e.mock.On("JoinWaitlist", ctx, cmd)}
+}
+
+func (_c *MockApp_JoinWaitlist_Call) Run(run func(ctx context.Context, cmd command.JoinWaitlist)) *MockApp_JoinWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.JoinWaitlist
+		if args[1] != nil {
+			arg1 = args[1].(command.JoinWaitlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_JoinWaitlist_Call) Return(err error) *MockApp_JoinWaitlist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_JoinWaitlist_Call) RunAndReturn(run func(ctx context.Context, cmd command.JoinWaitlist) error) *MockApp_JoinWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveWaitlist provides a mock function for the type MockApp
+func (_mock *MockApp) LeaveWaitlist(ctx context.Context, cmd command.LeaveWaitlist) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveWaitlist")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.LeaveWaitlist) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_LeaveWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveWaitlist'
+type MockApp_LeaveWaitlist_Call struct {
+	*mock.Call
+}
+
+// LeaveWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.LeaveWaitlist
+func (_e *MockApp_Expecter) LeaveWaitlist(ctx any, cmd any) *MockApp_LeaveWaitlist_Call {
+	return &MockApp_LeaveWaitlist_Call{Call: _e.mock.On("LeaveWaitlist", ctx, cmd)}
+}
+
+func (_c *MockApp_LeaveWaitlist_Call) Run(run func(ctx context.Context, cmd command.LeaveWaitlist)) *MockApp_LeaveWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.LeaveWaitlist
+		if args[1] != nil {
+			arg1 = args[1].(command.LeaveWaitlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_LeaveWaitlist_Call) Return(err error) *MockApp_LeaveWaitlist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_LeaveWaitlist_Call) RunAndReturn(run func(ctx context.Context, cmd command.LeaveWaitlist) error) *MockApp_LeaveWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlackouts provides a mock function for the type MockApp
+func (_mock *MockApp) ListBlackouts(ctx context.Context, qry query.ListBlackouts) ([]*domain.CampsiteBlackout, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlackouts")
+	}
+
+	var r0 []*domain.CampsiteBlackout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListBlackouts) ([]*domain.CampsiteBlackout, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListBlackouts) []*domain.CampsiteBlackout); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CampsiteBlackout)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ListBlackouts) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_ListBlackouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlackouts'
+type MockApp_ListBlackouts_Call struct {
+	*mock.Call
+}
+
+// ListBlackouts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.ListBlackouts
+func (_e *MockApp_Expecter) ListBlackouts(ctx any, qry any) *MockApp_ListBlackouts_Call {
+	return &MockApp_ListBlackouts_Call{Call: _e.mock.On("ListBlackouts", ctx, qry)}
+}
+
+func (_c *MockApp_ListBlackouts_Call) Run(run func(ctx context.Context, qry query.ListBlackouts)) *MockApp_ListBlackouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ListBlackouts
+		if args[1] != nil {
+			arg1 = args[1].(query.ListBlackouts)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ListBlackouts_Call) Return(campsiteBlackouts []*domain.CampsiteBlackout, err error) *MockApp_ListBlackouts_Call {
+	_c.Call.Return(campsiteBlackouts, err)
+	return _c
+}
+
+func (_c *MockApp_ListBlackouts_Call) RunAndReturn(run func(ctx context.Context, qry query.ListBlackouts) ([]*domain.CampsiteBlackout, error)) *MockApp_ListBlackouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCalendarSubscriptions provides a mock function for the type MockApp
+func (_mock *MockApp) ListCalendarSubscriptions(ctx context.Context, qry query.ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCalendarSubscriptions")
+	}
+
+	var r0 []*domain.CalendarSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListCalendarSubscriptions) []*domain.CalendarSubscription); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CalendarSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ListCalendarSubscriptions) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_ListCalendarSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCalendarSubscriptions'
+type MockApp_ListCalendarSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListCalendarSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.ListCalendarSubscriptions
+func (_e *MockApp_Expecter) ListCalendarSubscriptions(ctx any, qry any) *MockApp_ListCalendarSubscriptions_Call {
+	return &MockApp_ListCalendarSubscriptions_Call{Call: _e.mock.On("ListCalendarSubscriptions", ctx, qry)}
+}
+
+func (_c *MockApp_ListCalendarSubscriptions_Call) Run(run func(ctx context.Context, qry query.ListCalendarSubscriptions)) *MockApp_ListCalendarSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ListCalendarSubscriptions
+		if args[1] != nil {
+			arg1 = args[1].(query.ListCalendarSubscriptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ListCalendarSubscriptions_Call) Return(calendarSubscriptions []*domain.CalendarSubscription, err error) *MockApp_ListCalendarSubscriptions_Call {
+	_c.Call.Return(calendarSubscriptions, err)
+	return _c
+}
+
+func (_c *MockApp_ListCalendarSubscriptions_Call) RunAndReturn(run func(ctx context.Context, qry query.ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error)) *MockApp_ListCalendarSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGuestBookings provides a mock function for the type MockApp
+func (_mock *MockApp) ListGuestBookings(ctx context.Context, qry query.ListGuestBookings) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGuestBookings")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListGuestBookings) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListGuestBookings) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ListGuestBookings) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_ListGuestBookings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGuestBookings'
+type MockApp_ListGuestBookings_Call struct {
+	*mock.Call
+}
+
+// ListGuestBookings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.ListGuestBookings
+func (_e *MockApp_Expecter) ListGuestBookings(ctx any, qry any) *MockApp_ListGuestBookings_Call {
+	return &MockApp_ListGuestBookings_Call{Call: _e.mock.On("ListGuestBookings", ctx, qry)}
+}
+
+func (_c *MockApp_ListGuestBookings_Call) Run(run func(ctx context.Context, qry query.ListGuestBookings)) *MockApp_ListGuestBookings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ListGuestBookings
+		if args[1] != nil {
+			arg1 = args[1].(query.ListGuestBookings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ListGuestBookings_Call) Return(bookings []*domain.Booking, err error) *MockApp_ListGuestBookings_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockApp_ListGuestBookings_Call) RunAndReturn(run func(ctx context.Context, qry query.ListGuestBookings) ([]*domain.Booking, error)) *MockApp_ListGuestBookings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWaitlist provides a mock function for the type MockApp
+func (_mock *MockApp) ListWaitlist(ctx context.Context, qry query.ListWaitlist) ([]*domain.WaitlistEntry, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWaitlist")
+	}
+
+	var r0 []*domain.WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListWaitlist) ([]*domain.WaitlistEntry, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ListWaitlist) []*domain.WaitlistEntry); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ListWaitlist) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_ListWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWaitlist'
+type MockApp_ListWaitlist_Call struct {
+	*mock.Call
+}
+
+// ListWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.ListWaitlist
+func (_e *MockApp_Expecter) ListWaitlist(ctx any, qry any) *MockApp_ListWaitlist_Call {
+	return &MockApp_ListWaitlist_Call{Call: _e.mock.On("ListWaitlist", ctx, qry)}
+}
+
+func (_c *MockApp_ListWaitlist_Call) Run(run func(ctx context.Context, qry query.ListWaitlist)) *MockApp_ListWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ListWaitlist
+		if args[1] != nil {
+			arg1 = args[1].(query.ListWaitlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_ListWaitlist_Call) Return(waitlistEntrys []*domain.WaitlistEntry, err error) *MockApp_ListWaitlist_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockApp_ListWaitlist_Call) RunAndReturn(run func(ctx context.Context, qry query.ListWaitlist) ([]*domain.WaitlistEntry, error)) *MockApp_ListWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNoShow provides a mock function for the type MockApp
+func (_mock *MockApp) MarkNoShow(ctx context.Context, cmd command.MarkNoShow) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNoShow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.MarkNoShow) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_MarkNoShow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNoShow'
+type MockApp_MarkNoShow_Call struct {
+	*mock.Call
+}
+
+// MarkNoShow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.MarkNoShow
+func (_e *MockApp_Expecter) MarkNoShow(ctx any, cmd any) *MockApp_MarkNoShow_Call {
+	return &MockApp_MarkNoShow_Call{Call: _e.mock.On("MarkNoShow", ctx, cmd)}
+}
+
+func (_c *MockApp_MarkNoShow_Call) Run(run func(ctx context.Context, cmd command.MarkNoShow)) *MockApp_MarkNoShow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.MarkNoShow
+		if args[1] != nil {
+			arg1 = args[1].(command.MarkNoShow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_MarkNoShow_Call) Return(err error) *MockApp_MarkNoShow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_MarkNoShow_Call) RunAndReturn(run func(ctx context.Context, cmd command.MarkNoShow) error) *MockApp_MarkNoShow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuoteBooking provides a mock function for the type MockApp
+func (_mock *MockApp) QuoteBooking(ctx context.Context, qry query.QuoteBooking) (*domain.Quote, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteBooking")
+	}
+
+	var r0 *domain.Quote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.QuoteBooking) (*domain.Quote, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.QuoteBooking) *domain.Quote); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Quote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.QuoteBooking) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockApp_QuoteBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuoteBooking'
+type MockApp_QuoteBooking_Call struct {
+	*mock.Call
+}
+
+// QuoteBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry query.QuoteBooking
+func (_e *MockApp_Expecter) QuoteBooking(ctx any, qry any) *MockApp_QuoteBooking_Call {
+	return &MockApp_QuoteBooking_Call{Call: _e.mock.On("QuoteBooking", ctx, qry)}
+}
+
+func (_c *MockApp_QuoteBooking_Call) Run(run func(ctx context.Context, qry query.QuoteBooking)) *MockApp_QuoteBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.QuoteBooking
+		if args[1] != nil {
+			arg1 = args[1].(query.QuoteBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_QuoteBooking_Call) Return(quote *domain.Quote, err error) *MockApp_QuoteBooking_Call {
+	_c.Call.Return(quote, err)
+	return _c
+}
+
+func (_c *MockApp_QuoteBooking_Call) RunAndReturn(run func(ctx context.Context, qry query.QuoteBooking) (*domain.Quote, error)) *MockApp_QuoteBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCampgroundSeason provides a mock function for the type MockApp
+func (_mock *MockApp) SetCampgroundSeason(ctx context.Context, cmd command.SetCampgroundSeason) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCampgroundSeason")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.SetCampgroundSeason) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_SetCampgroundSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCampgroundSeason'
+type MockApp_SetCampgroundSeason_Call struct {
+	*mock.Call
+}
+
+// SetCampgroundSeason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.SetCampgroundSeason
+func (_e *MockApp_Expecter) SetCampgroundSeason(ctx any, cmd any) *MockApp_SetCampgroundSeason_Call {
+	return &MockApp_SetCampgroundSeason_Call{Call: _e.mock.On("SetCampgroundSeason", ctx, cmd)}
+}
+
+func (_c *MockApp_SetCampgroundSeason_Call) Run(run func(ctx context.Context, cmd command.SetCampgroundSeason)) *MockApp_SetCampgroundSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.SetCampgroundSeason
+		if args[1] != nil {
+			arg1 = args[1].(command.SetCampgroundSeason)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_SetCampgroundSeason_Call) Return(err error) *MockApp_SetCampgroundSeason_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_SetCampgroundSeason_Call) RunAndReturn(run func(ctx context.Context, cmd command.SetCampgroundSeason) error) *MockApp_SetCampgroundSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCampsiteRates provides a mock function for the type MockApp
+func (_mock *MockApp) SetCampsiteRates(ctx context.Context, cmd command.SetCampsiteRates) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCampsiteRates")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.SetCampsiteRates) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_SetCampsiteRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCampsiteRates'
+type MockApp_SetCampsiteRates_Call struct {
+	*mock.Call
+}
+
+// SetCampsiteRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.SetCampsiteRates
+func (_e *MockApp_Expecter) SetCampsiteRates(ctx any, cmd any) *MockApp_SetCampsiteRates_Call {
+	return &MockApp_SetCampsiteRates_Call{Call: _e.mock.On("SetCampsiteRates", ctx, cmd)}
+}
+
+func (_c *MockApp_SetCampsiteRates_Call) Run(run func(ctx context.Context, cmd command.SetCampsiteRates)) *MockApp_SetCampsiteRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.SetCampsiteRates
+		if args[1] != nil {
+			arg1 = args[1].(command.SetCampsiteRates)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_SetCampsiteRates_Call) Return(err error) *MockApp_SetCampsiteRates_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_SetCampsiteRates_Call) RunAndReturn(run func(ctx context.Context, cmd command.SetCampsiteRates) error) *MockApp_SetCampsiteRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncCalendarSubscriptions provides a mock function for the type MockApp
+func (_mock *MockApp) SyncCalendarSubscriptions(ctx context.Context, cmd command.SyncCalendarSubscriptions) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCalendarSubscriptions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.SyncCalendarSubscriptions) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_SyncCalendarSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncCalendarSubscriptions'
+type MockApp_SyncCalendarSubscriptions_Call struct {
+	*mock.Call
+}
+
+// SyncCalendarSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.SyncCalendarSubscriptions
+func (_e *MockApp_Expecter) SyncCalendarSubscriptions(ctx any, cmd any) *MockApp_SyncCalendarSubscriptions_Call {
+	return &MockApp_SyncCalendarSubscriptions_Call{Call: _e.mock.On("SyncCalendarSubscriptions", ctx, cmd)}
+}
+
+func (_c *MockApp_SyncCalendarSubscriptions_Call) Run(run func(ctx context.Context, cmd command.SyncCalendarSubscriptions)) *MockApp_SyncCalendarSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.SyncCalendarSubscriptions
+		if args[1] != nil {
+			arg1 = args[1].(command.SyncCalendarSubscriptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_SyncCalendarSubscriptions_Call) Return(err error) *MockApp_SyncCalendarSubscriptions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_SyncCalendarSubscriptions_Call) RunAndReturn(run func(ctx context.Context, cmd command.SyncCalendarSubscriptions) error) *MockApp_SyncCalendarSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBooking provides a mock function for the type MockApp
+func (_mock *MockApp) UpdateBooking(ctx context.Context, cmd command.UpdateBooking) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.UpdateBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_UpdateBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBooking'
+type MockApp_UpdateBooking_Call struct {
+	*mock.Call
+}
+
+// UpdateBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.UpdateBooking
+func (_e *MockApp_Expecter) UpdateBooking(ctx any, cmd any) *MockApp_UpdateBooking_Call {
+	return &MockApp_UpdateBooking_Call{Call: _e.mock.On("UpdateBooking", ctx, cmd)}
+}
+
+func (_c *MockApp_UpdateBooking_Call) Run(run func(ctx context.Context, cmd command.UpdateBooking)) *MockApp_UpdateBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.UpdateBooking
+		if args[1] != nil {
+			arg1 = args[1].(command.UpdateBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_UpdateBooking_Call) Return(err error) *MockApp_UpdateBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_UpdateBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.UpdateBooking) error) *MockApp_UpdateBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCampground provides a mock function for the type MockApp
+func (_mock *MockApp) UpdateCampground(ctx context.Context, cmd command.UpdateCampground) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampground")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.UpdateCampground) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_UpdateCampground_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCampground'
+type MockApp_UpdateCampground_Call struct {
+	*mock.Call
+}
+
+// UpdateCampground is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.UpdateCampground
+func (_e *MockApp_Expecter) UpdateCampground(ctx any, cmd any) *MockApp_UpdateCampground_Call {
+	return &MockApp_UpdateCampground_Call{Call: _e.mock.On("UpdateCampground", ctx, cmd)}
+}
+
+func (_c *MockApp_UpdateCampground_Call) Run(run func(ctx context.Context, cmd command.UpdateCampground)) *MockApp_UpdateCampground_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.UpdateCampground
+		if args[1] != nil {
+			arg1 = args[1].(command.UpdateCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_UpdateCampground_Call) Return(err error) *MockApp_UpdateCampground_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_UpdateCampground_Call) RunAndReturn(run func(ctx context.Context, cmd command.UpdateCampground) error) *MockApp_UpdateCampground_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGroupBooking provides a mock function for the type MockApp
+func (_mock *MockApp) UpdateGroupBooking(ctx context.Context, cmd command.UpdateGroupBooking) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroupBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.UpdateGroupBooking) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_UpdateGroupBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroupBooking'
+type MockApp_UpdateGroupBooking_Call struct {
+	*mock.Call
+}
+
+// UpdateGroupBooking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.UpdateGroupBooking
+func (_e *MockApp_Expecter) UpdateGroupBooking(ctx any, cmd any) *MockApp_UpdateGroupBooking_Call {
+	return &MockApp_UpdateGroupBooking_Call{Call: _e.mock.On("UpdateGroupBooking", ctx, cmd)}
+}
+
+func (_c *MockApp_UpdateGroupBooking_Call) Run(run func(ctx context.Context, cmd command.UpdateGroupBooking)) *MockApp_UpdateGroupBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.UpdateGroupBooking
+		if args[1] != nil {
+			arg1 = args[1].(command.UpdateGroupBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_UpdateGroupBooking_Call) Return(err error) *MockApp_UpdateGroupBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_UpdateGroupBooking_Call) RunAndReturn(run func(ctx context.Context, cmd command.UpdateGroupBooking) error) *MockApp_UpdateGroupBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGuest provides a mock function for the type MockApp
+func (_mock *MockApp) UpdateGuest(ctx context.Context, cmd command.UpdateGuest) error {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, command.UpdateGuest) error); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockApp_UpdateGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGuest'
+type MockApp_UpdateGuest_Call struct {
+	*mock.Call
+}
+
+// UpdateGuest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd command.UpdateGuest
+func (_e *MockApp_Expecter) UpdateGuest(ctx any, cmd any) *MockApp_UpdateGuest_Call {
+	return &MockApp_UpdateGuest_Call{Call: _e.mock.On("UpdateGuest", ctx, cmd)}
+}
+
+func (_c *MockApp_UpdateGuest_Call) Run(run func(ctx context.Context, cmd command.UpdateGuest)) *MockApp_UpdateGuest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 command.UpdateGuest
+		if args[1] != nil {
+			arg1 = args[1].(command.UpdateGuest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApp_UpdateGuest_Call) Return(err error) *MockApp_UpdateGuest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockApp_UpdateGuest_Call) RunAndReturn(run func(ctx context.Context, cmd command.UpdateGuest) error) *MockApp_UpdateGuest_Call {
 	_c.Call.Return(run)
 	return _c
 }
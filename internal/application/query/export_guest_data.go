@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ExportGuestData struct {
+		Email string
+	}
+
+	// ExportGuestDataHandler is a logging decorator for the exportGuestDataHandler struct.
+	ExportGuestDataHandler handler.Query[ExportGuestData, *domain.GuestData]
+
+	exportGuestDataHandler struct {
+		guests domain.GuestRepository
+	}
+)
+
+func NewExportGuestDataHandler(guests domain.GuestRepository) ExportGuestDataHandler {
+	return decorator.ApplyQueryDecorator[ExportGuestData, *domain.GuestData](
+		exportGuestDataHandler{guests: guests},
+	)
+}
+
+// Handle returns the personal data held about the person with the email, on
+// their request; the data is empty rather than not found if none is held.
+func (h exportGuestDataHandler) Handle(
+	ctx context.Context,
+	qry ExportGuestData,
+) (*domain.GuestData, error) {
+	data, err := h.guests.FindData(ctx, domain.NormalizeEmail(qry.Email))
+	if err != nil {
+		return nil, err
+	}
+	data.ExportedAt = time.Now().UTC()
+	return data, nil
+}
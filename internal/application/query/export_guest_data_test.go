@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportGuestDataHandler(t *testing.T) {
+	type mocks struct {
+		guests *domain.MockGuestRepository
+	}
+	guest := bootstrap.NewGuest()
+	booking, err := bootstrap.NewBooking(uuid.New().String())
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GuestID = guest.GuestID
+	data := func() *domain.GuestData {
+		return &domain.GuestData{
+			Email:    guest.Email,
+			Guest:    guest,
+			Bookings: []*domain.Booking{booking},
+		}
+	}
+
+	tests := map[string]struct {
+		qry     ExportGuestData
+		on      func(f mocks)
+		want    *domain.GuestData
+		wantErr error
+	}{
+		"Success": {
+			qry: ExportGuestData{Email: " " + guest.Email + " "},
+			on: func(f mocks) {
+				f.guests.
+					On("FindData", context.TODO(), guest.Email).
+					Return(data(), nil)
+			},
+			want:    data(),
+			wantErr: nil,
+		},
+		"Success_NoDataHeld": {
+			qry: ExportGuestData{Email: "nobody@example.com"},
+			on: func(f mocks) {
+				f.guests.
+					On("FindData", context.TODO(), "nobody@example.com").
+					Return(&domain.GuestData{Email: "nobody@example.com"}, nil)
+			},
+			want:    &domain.GuestData{Email: "nobody@example.com"},
+			wantErr: nil,
+		},
+		"Error_FindData": {
+			qry: ExportGuestData{Email: guest.Email},
+			on: func(f mocks) {
+				f.guests.
+					On("FindData", context.TODO(), guest.Email).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests: domain.NewMockGuestRepository(t),
+			}
+			h := NewExportGuestDataHandler(m.guests)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			if got != nil {
+				assert.False(t, got.ExportedAt.IsZero(), "ExportedAt not set")
+				tc.want.ExportedAt = got.ExportedAt
+			}
+			assert.Equal(t, tc.want, got,
+				"ExportGuestDataHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ExportGuestDataHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests)
+		})
+	}
+}
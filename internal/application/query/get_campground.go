@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetCampground struct {
+		CampgroundID string
+	}
+
+	// GetCampgroundHandler is a logging decorator for the getCampgroundHandler struct.
+	GetCampgroundHandler handler.Query[GetCampground, *domain.Campground]
+
+	getCampgroundHandler struct {
+		campgrounds domain.CampgroundRepository
+	}
+)
+
+func NewGetCampgroundHandler(campgrounds domain.CampgroundRepository) GetCampgroundHandler {
+	return decorator.ApplyQueryDecorator[GetCampground, *domain.Campground](
+		getCampgroundHandler{campgrounds: campgrounds},
+	)
+}
+
+func (h getCampgroundHandler) Handle(
+	ctx context.Context,
+	qry GetCampground,
+) (*domain.Campground, error) {
+	return h.campgrounds.Find(ctx, qry.CampgroundID)
+}
@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetCampgroundSeason struct {
+		CampgroundID string
+	}
+
+	// GetCampgroundSeasonHandler is a logging decorator for the getCampgroundSeasonHandler struct.
+	GetCampgroundSeasonHandler handler.Query[GetCampgroundSeason, *domain.CampgroundSeason]
+
+	getCampgroundSeasonHandler struct {
+		campgrounds domain.CampgroundRepository
+		seasons     domain.CampgroundSeasonRepository
+		policy      domain.SeasonPolicy
+	}
+)
+
+func NewGetCampgroundSeasonHandler(
+	campgrounds domain.CampgroundRepository,
+	seasons domain.CampgroundSeasonRepository,
+	policy domain.SeasonPolicy,
+) GetCampgroundSeasonHandler {
+	return decorator.ApplyQueryDecorator[GetCampgroundSeason, *domain.CampgroundSeason](
+		getCampgroundSeasonHandler{campgrounds: campgrounds, seasons: seasons, policy: policy},
+	)
+}
+
+// Handle returns the season in effect for the campground, the default season
+// if the campground has none of its own.
+func (h getCampgroundSeasonHandler) Handle(
+	ctx context.Context,
+	qry GetCampgroundSeason,
+) (*domain.CampgroundSeason, error) {
+	if _, err := h.campgrounds.Find(ctx, qry.CampgroundID); err != nil {
+		return nil, err
+	}
+	season, err := h.policy.SeasonFor(ctx, h.seasons, qry.CampgroundID)
+	if err != nil {
+		return nil, err
+	}
+	if season.CampgroundID == "" {
+		// copy of the default season reported for the campground
+		defaultSeason := *season
+		defaultSeason.CampgroundID = qry.CampgroundID
+		season = &defaultSeason
+	}
+	return season, nil
+}
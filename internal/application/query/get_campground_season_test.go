@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCampgroundSeasonHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+		seasons     *domain.MockCampgroundSeasonRepository
+	}
+	campgroundID := "campground-id"
+	campground := &domain.Campground{CampgroundID: campgroundID}
+	season := bootstrap.NewCampgroundSeason(campgroundID)
+	policy := domain.SeasonPolicy{Default: domain.CampgroundSeason{
+		OpensOn:        "06-01",
+		ClosesOn:       "09-30",
+		ClosedWeekdays: []time.Weekday{time.Tuesday},
+	}}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campgroundID}
+	errCampgroundSeasonNotFound := domain.ErrCampgroundSeasonNotFound{CampgroundID: campgroundID}
+
+	tests := map[string]struct {
+		qry     GetCampgroundSeason
+		on      func(f mocks)
+		want    *domain.CampgroundSeason
+		wantErr error
+	}{
+		"Success": {
+			qry: GetCampgroundSeason{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.seasons.
+					On("Find", context.TODO(), campgroundID).
+					Return(season, nil)
+			},
+			want:    season,
+			wantErr: nil,
+		},
+		"Success_DefaultSeason": {
+			qry: GetCampgroundSeason{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(campground, nil)
+				f.seasons.
+					On("Find", context.TODO(), campgroundID).
+					Return(nil, errCampgroundSeasonNotFound)
+			},
+			want: &domain.CampgroundSeason{
+				CampgroundID:   campgroundID,
+				OpensOn:        "06-01",
+				ClosesOn:       "09-30",
+				ClosedWeekdays: []time.Weekday{time.Tuesday},
+			},
+			wantErr: nil,
+		},
+		"Error_CampgroundNotFound": {
+			qry: GetCampgroundSeason{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			want:    nil,
+			wantErr: errCampgroundNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+				seasons:     domain.NewMockCampgroundSeasonRepository(t),
+			}
+			h := NewGetCampgroundSeasonHandler(m.campgrounds, m.seasons, policy)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampgroundSeasonHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetCampgroundSeasonHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds, m.seasons)
+		})
+	}
+}
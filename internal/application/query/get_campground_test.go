@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCampgroundHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		qry     GetCampground
+		on      func(f mocks)
+		want    *domain.Campground
+		wantErr error
+	}{
+		"Success": {
+			qry: GetCampground{CampgroundID: campground.CampgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(campground, nil)
+			},
+			want:    campground,
+			wantErr: nil,
+		},
+		"Error_CampgroundNotFound": {
+			qry: GetCampground{CampgroundID: campground.CampgroundID},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("Find", context.TODO(), campground.CampgroundID).
+					Return(nil, errCampgroundNotFound)
+			},
+			want:    nil,
+			wantErr: errCampgroundNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+			}
+			h := NewGetCampgroundHandler(m.campgrounds)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampgroundHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetCampgroundHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds)
+		})
+	}
+}
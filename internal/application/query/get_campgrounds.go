@@ -0,0 +1,33 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetCampgrounds struct{}
+
+	// GetCampgroundsHandler is a logging decorator for the getCampgroundsHandler struct.
+	GetCampgroundsHandler handler.Query[GetCampgrounds, []*domain.Campground]
+
+	getCampgroundsHandler struct {
+		campgrounds domain.CampgroundRepository
+	}
+)
+
+func NewGetCampgroundsHandler(campgrounds domain.CampgroundRepository) GetCampgroundsHandler {
+	return decorator.ApplyQueryDecorator[GetCampgrounds, []*domain.Campground](
+		getCampgroundsHandler{campgrounds: campgrounds},
+	)
+}
+
+func (h getCampgroundsHandler) Handle(
+	ctx context.Context,
+	_ GetCampgrounds,
+) ([]*domain.Campground, error) {
+	return h.campgrounds.FindAll(ctx)
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCampgroundsHandler(t *testing.T) {
+	type mocks struct {
+		campgrounds *domain.MockCampgroundRepository
+	}
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+
+	tests := map[string]struct {
+		qry     GetCampgrounds
+		on      func(f mocks)
+		want    []*domain.Campground
+		wantErr error
+	}{
+		"Success": {
+			qry: GetCampgrounds{},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("FindAll", context.TODO()).
+					Return([]*domain.Campground{campground}, nil)
+			},
+			want:    []*domain.Campground{campground},
+			wantErr: nil,
+		},
+		"Error_BeginTx": {
+			qry: GetCampgrounds{},
+			on: func(f mocks) {
+				f.campgrounds.
+					On("FindAll", context.TODO()).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campgrounds: domain.NewMockCampgroundRepository(t),
+			}
+			h := NewGetCampgroundsHandler(m.campgrounds)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampgroundsHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetCampgroundsHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campgrounds)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetCampsiteCalendar struct {
+		CampsiteID string
+	}
+
+	// GetCampsiteCalendarHandler is a logging decorator for the getCampsiteCalendarHandler struct.
+	GetCampsiteCalendarHandler handler.Query[GetCampsiteCalendar, *domain.CampsiteCalendar]
+
+	getCampsiteCalendarHandler struct {
+		campsites domain.CampsiteRepository
+		bookings  domain.BookingRepository
+		blackouts domain.CampsiteBlackoutRepository
+	}
+)
+
+func NewGetCampsiteCalendarHandler(
+	campsites domain.CampsiteRepository,
+	bookings domain.BookingRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+) GetCampsiteCalendarHandler {
+	return decorator.ApplyQueryDecorator[GetCampsiteCalendar, *domain.CampsiteCalendar](
+		getCampsiteCalendarHandler{campsites: campsites, bookings: bookings, blackouts: blackouts},
+	)
+}
+
+// Handle returns the calendar of the campsite from a month ago to a year ahead.
+func (h getCampsiteCalendarHandler) Handle(
+	ctx context.Context,
+	qry GetCampsiteCalendar,
+) (*domain.CampsiteCalendar, error) {
+	if _, err := h.campsites.Find(ctx, qry.CampsiteID); err != nil {
+		return nil, err
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	calendar := &domain.CampsiteCalendar{
+		CampsiteID: qry.CampsiteID,
+		StartDate:  today.AddDate(0, -1, 0),
+		EndDate:    today.AddDate(1, 0, 0),
+	}
+	bookings, err := h.bookings.FindForDateRange(
+		ctx, qry.CampsiteID, calendar.StartDate, calendar.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(bookings, func(a, b *domain.Booking) int {
+		return a.StartDate.Compare(b.StartDate)
+	})
+	calendar.Bookings = bookings
+
+	calendar.Blackouts, err = h.blackouts.FindForDateRange(
+		ctx, qry.CampsiteID, calendar.StartDate, calendar.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
@@ -0,0 +1,125 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCampsiteCalendarHandler(t *testing.T) {
+	type mocks struct {
+		campsites *domain.MockCampsiteRepository
+		bookings  *domain.MockBookingRepository
+		blackouts *domain.MockCampsiteBlackoutRepository
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	earlier, err := bootstrap.NewBookingWithAddDays(campsiteID, 1, 2)
+	assert.NoError(t, err)
+	later, err := bootstrap.NewBookingWithAddDays(campsiteID, 5, 7)
+	assert.NoError(t, err)
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	startDate := today.AddDate(0, -1, 0)
+	endDate := today.AddDate(1, 0, 0)
+
+	tests := map[string]struct {
+		qry     GetCampsiteCalendar
+		on      func(f mocks)
+		want    *domain.CampsiteCalendar
+		wantErr error
+	}{
+		"Success": {
+			qry: GetCampsiteCalendar{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsiteID, startDate, endDate).
+					Return([]*domain.Booking{later, earlier}, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsiteID, startDate, endDate).
+					Return([]*domain.CampsiteBlackout{blackout}, nil)
+			},
+			want: &domain.CampsiteCalendar{
+				CampsiteID: campsiteID,
+				StartDate:  startDate,
+				EndDate:    endDate,
+				Bookings:   []*domain.Booking{earlier, later},
+				Blackouts:  []*domain.CampsiteBlackout{blackout},
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			qry: GetCampsiteCalendar{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_FindBookings": {
+			qry: GetCampsiteCalendar{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsiteID, startDate, endDate).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_FindBlackouts": {
+			qry: GetCampsiteCalendar{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.bookings.
+					On("FindForDateRange", context.TODO(), campsiteID, startDate, endDate).
+					Return([]*domain.Booking{earlier}, nil)
+				f.blackouts.
+					On("FindForDateRange", context.TODO(), campsiteID, startDate, endDate).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites: domain.NewMockCampsiteRepository(t),
+				bookings:  domain.NewMockBookingRepository(t),
+				blackouts: domain.NewMockCampsiteBlackoutRepository(t),
+			}
+			h := NewGetCampsiteCalendarHandler(m.campsites, m.bookings, m.blackouts)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampsiteCalendarHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetCampsiteCalendarHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.bookings, m.blackouts)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetCampsiteRates struct {
+		CampsiteID string
+	}
+
+	// GetCampsiteRatesHandler is a logging decorator for the getCampsiteRatesHandler struct.
+	GetCampsiteRatesHandler handler.Query[GetCampsiteRates, *domain.CampsiteRates]
+
+	getCampsiteRatesHandler struct {
+		rates domain.CampsiteRatesRepository
+	}
+)
+
+func NewGetCampsiteRatesHandler(rates domain.CampsiteRatesRepository) GetCampsiteRatesHandler {
+	return decorator.ApplyQueryDecorator[GetCampsiteRates, *domain.CampsiteRates](
+		getCampsiteRatesHandler{rates: rates},
+	)
+}
+
+func (h getCampsiteRatesHandler) Handle(
+	ctx context.Context,
+	qry GetCampsiteRates,
+) (*domain.CampsiteRates, error) {
+	return h.rates.Find(ctx, qry.CampsiteID)
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCampsiteRatesHandler(t *testing.T) {
+	type mocks struct {
+		rates *domain.MockCampsiteRatesRepository
+	}
+	campsiteID := "campsite-id"
+	rates := bootstrap.NewCampsiteRates(campsiteID)
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}
+
+	tests := map[string]struct {
+		qry     GetCampsiteRates
+		on      func(f mocks)
+		want    *domain.CampsiteRates
+		wantErr error
+	}{
+		"Success": {
+			qry: GetCampsiteRates{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(rates, nil)
+			},
+			want:    rates,
+			wantErr: nil,
+		},
+		"Error_CampsiteRatesNotFound": {
+			qry: GetCampsiteRates{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteRatesNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				rates: domain.NewMockCampsiteRatesRepository(t),
+			}
+			h := NewGetCampsiteRatesHandler(m.rates)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampsiteRatesHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetCampsiteRatesHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.rates)
+		})
+	}
+}
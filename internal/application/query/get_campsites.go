@@ -9,7 +9,9 @@ import (
 )
 
 type (
-	GetCampsites struct{}
+	GetCampsites struct {
+		CampgroundID string
+	}
 
 	// GetCampsitesHandler is a logging decorator for the getCampsitesHandler struct.
 	GetCampsitesHandler handler.Query[GetCampsites, []*domain.Campsite]
@@ -27,7 +29,10 @@ func NewGetCampsitesHandler(campsites domain.CampsiteRepository) GetCampsitesHan
 
 func (h getCampsitesHandler) Handle(
 	ctx context.Context,
-	_ GetCampsites,
+	qry GetCampsites,
 ) ([]*domain.Campsite, error) {
+	if qry.CampgroundID != "" {
+		return h.campsites.FindByCampgroundID(ctx, qry.CampgroundID)
+	}
 	return h.campsites.FindAll(ctx)
 }
@@ -55,6 +55,16 @@ func TestGetCampsitesHandler(t *testing.T) {
 			want:    nil,
 			wantErr: bootstrap.ErrBeginTx,
 		},
+		"Success_ByCampgroundID": {
+			qry: GetCampsites{CampgroundID: "campground-id"},
+			on: func(f mocks) {
+				f.campsites.
+					On("FindByCampgroundID", context.TODO(), "campground-id").
+					Return([]*domain.Campsite{campsite}, nil)
+			},
+			want:    []*domain.Campsite{campsite},
+			wantErr: nil,
+		},
 	}
 
 	for name, tc := range tests {
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetGroupBooking struct {
+		GroupID string
+	}
+
+	// GetGroupBookingHandler is a logging decorator for the getGroupBookingHandler struct.
+	GetGroupBookingHandler handler.Query[GetGroupBooking, []*domain.Booking]
+
+	getGroupBookingHandler struct {
+		bookings domain.BookingRepository
+	}
+)
+
+func NewGetGroupBookingHandler(bookings domain.BookingRepository) GetGroupBookingHandler {
+	return decorator.ApplyQueryDecorator[GetGroupBooking, []*domain.Booking](
+		getGroupBookingHandler{bookings: bookings},
+	)
+}
+
+func (h getGroupBookingHandler) Handle(
+	ctx context.Context,
+	qry GetGroupBooking,
+) ([]*domain.Booking, error) {
+	return h.bookings.FindByGroupID(ctx, qry.GroupID)
+}
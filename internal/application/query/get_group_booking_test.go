@@ -0,0 +1,74 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetGroupBookingHandler(t *testing.T) {
+	type mocks struct {
+		bookings *domain.MockBookingRepository
+	}
+	groupID := uuid.New().String()
+	booking, err := bootstrap.NewBooking("campsite-id")
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GroupID = groupID
+	errGroupBookingNotFound := domain.ErrGroupBookingNotFound{GroupID: groupID}
+
+	tests := map[string]struct {
+		qry     GetGroupBooking
+		on      func(f mocks)
+		want    []*domain.Booking
+		wantErr error
+	}{
+		"Success": {
+			qry: GetGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return([]*domain.Booking{booking}, nil)
+			},
+			want:    []*domain.Booking{booking},
+			wantErr: nil,
+		},
+		"Error_GroupBookingNotFound": {
+			qry: GetGroupBooking{GroupID: groupID},
+			on: func(f mocks) {
+				f.bookings.
+					On("FindByGroupID", context.TODO(), groupID).
+					Return(nil, errGroupBookingNotFound)
+			},
+			want:    nil,
+			wantErr: errGroupBookingNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				bookings: domain.NewMockBookingRepository(t),
+			}
+			h := NewGetGroupBookingHandler(m.bookings)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetGroupBookingHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetGroupBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.bookings)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	GetGuest struct {
+		GuestID string
+	}
+
+	// GetGuestHandler is a logging decorator for the getGuestHandler struct.
+	GetGuestHandler handler.Query[GetGuest, *domain.Guest]
+
+	getGuestHandler struct {
+		guests domain.GuestRepository
+	}
+)
+
+func NewGetGuestHandler(guests domain.GuestRepository) GetGuestHandler {
+	return decorator.ApplyQueryDecorator[GetGuest, *domain.Guest](
+		getGuestHandler{guests: guests},
+	)
+}
+
+func (h getGuestHandler) Handle(ctx context.Context, qry GetGuest) (*domain.Guest, error) {
+	return h.guests.Find(ctx, qry.GuestID)
+}
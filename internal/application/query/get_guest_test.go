@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetGuestHandler(t *testing.T) {
+	type mocks struct {
+		guests *domain.MockGuestRepository
+	}
+	guest := bootstrap.NewGuest()
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+
+	tests := map[string]struct {
+		qry     GetGuest
+		on      func(f mocks)
+		want    *domain.Guest
+		wantErr error
+	}{
+		"Success": {
+			qry: GetGuest{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(guest, nil)
+			},
+			want:    guest,
+			wantErr: nil,
+		},
+		"Error_GuestNotFound": {
+			qry: GetGuest{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(nil, errGuestNotFound)
+			},
+			want:    nil,
+			wantErr: errGuestNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests: domain.NewMockGuestRepository(t),
+			}
+			h := NewGetGuestHandler(m.guests)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetGuestHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"GetGuestHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests)
+		})
+	}
+}
@@ -7,44 +7,77 @@ import (
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
-	"github.com/stackus/errors"
 )
 
 type (
 	GetVacantDates struct {
-		CampsiteID string
-		StartDate  string
-		EndDate    string
+		CampgroundID string
+		CampsiteID   string
+		StartDate    string
+		EndDate      string
+		MinNights    int32
 	}
 
 	// GetVacantDatesHandler is a logging decorator for the getVacantDatesHandler struct.
-	GetVacantDatesHandler handler.Query[GetVacantDates, []string]
+	GetVacantDatesHandler handler.Query[GetVacantDates, *domain.Vacancy]
 
 	getVacantDatesHandler struct {
-		bookings domain.BookingRepository
+		campsites     domain.CampsiteRepository
+		bookings      domain.BookingRepository
+		blackouts     domain.CampsiteBlackoutRepository
+		seasons       domain.CampgroundSeasonRepository
+		subscriptions domain.CalendarSubscriptionRepository
+		waitlist      domain.WaitlistRepository
+		policy        domain.SeasonPolicy
 	}
 )
 
-func NewGetVacantDatesHandler(bookings domain.BookingRepository) GetVacantDatesHandler {
-	return decorator.ApplyQueryDecorator[GetVacantDates, []string](
-		getVacantDatesHandler{bookings: bookings},
+func NewGetVacantDatesHandler(
+	campsites domain.CampsiteRepository,
+	bookings domain.BookingRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+	seasons domain.CampgroundSeasonRepository,
+	subscriptions domain.CalendarSubscriptionRepository,
+	waitlist domain.WaitlistRepository,
+	policy domain.SeasonPolicy,
+) GetVacantDatesHandler {
+	return decorator.ApplyQueryDecorator[GetVacantDates, *domain.Vacancy](
+		getVacantDatesHandler{
+			campsites:     campsites,
+			bookings:      bookings,
+			blackouts:     blackouts,
+			seasons:       seasons,
+			subscriptions: subscriptions,
+			waitlist:      waitlist,
+			policy:        policy,
+		},
 	)
 }
 
-func (h getVacantDatesHandler) Handle(ctx context.Context, qry GetVacantDates) ([]string, error) {
-	startDate, err := time.Parse(time.DateOnly, qry.StartDate)
+func (h getVacantDatesHandler) Handle(
+	ctx context.Context,
+	qry GetVacantDates,
+) (*domain.Vacancy, error) {
+	startDate, err := domain.ParseDate("start_date", qry.StartDate)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse start date %s", qry.StartDate)
+		return nil, err
 	}
 
-	endDate, err := time.Parse(time.DateOnly, qry.EndDate)
+	endDate, err := domain.ParseDate("end_date", qry.EndDate)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse end date %s", qry.EndDate)
+		return nil, err
 	}
 
-	datesForRange := make(map[time.Time]bool)
-	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
-		datesForRange[date] = true
+	campsite, err := h.campsites.Find(ctx, qry.CampsiteID)
+	if err != nil {
+		return nil, err
+	}
+	if qry.CampgroundID != "" && campsite.CampgroundID != qry.CampgroundID {
+		return nil, domain.ErrCampsiteNotFound{CampsiteID: qry.CampsiteID}
+	}
+	season, err := h.policy.SeasonFor(ctx, h.seasons, campsite.CampgroundID)
+	if err != nil {
+		return nil, err
 	}
 
 	bookings, err := h.bookings.FindForDateRange(ctx, qry.CampsiteID, startDate, endDate)
@@ -52,15 +85,68 @@ func (h getVacantDatesHandler) Handle(ctx context.Context, qry GetVacantDates) (
 		return nil, err
 	}
 
+	blackouts, err := h.blackouts.FindForDateRange(ctx, qry.CampsiteID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := h.subscriptions.FindBlocksForDateRange(ctx, qry.CampsiteID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// dates held for the guest offered them are not vacant to others
+	offers, err := h.waitlist.FindOffered(ctx, qry.CampsiteID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	bookedDates := make(map[time.Time]bool)
 	for _, booking := range bookings {
 		for _, bookingDate := range booking.BookingDates() {
-			delete(datesForRange, bookingDate)
+			bookedDates[bookingDate] = true
+		}
+	}
+	for _, blackout := range blackouts {
+		for _, blackoutDate := range blackout.BlackoutDates() {
+			bookedDates[blackoutDate] = true
+		}
+	}
+	for _, block := range blocks {
+		for _, blockDate := range block.BlockDates() {
+			bookedDates[blockDate] = true
+		}
+	}
+	for _, offer := range offers {
+		for _, offerDate := range offer.OfferDates() {
+			bookedDates[offerDate] = true
 		}
 	}
 
-	var vacantDates []string
-	for d := range datesForRange {
-		vacantDates = append(vacantDates, d.Format(time.DateOnly))
+	vacancy := &domain.Vacancy{}
+	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+		if !bookedDates[date] && season.IsOpen(date) {
+			vacancy.Dates = append(vacancy.Dates, date)
+		}
+	}
+	for _, r := range vacantRanges(vacancy.Dates) {
+		if r.Nights() >= int(qry.MinNights) {
+			vacancy.Ranges = append(vacancy.Ranges, r)
+		}
+	}
+	return vacancy, nil
+}
+
+// vacantRanges groups sorted vacant dates into ranges of consecutive nights.
+func vacantRanges(dates []time.Time) []domain.DateRange {
+	var ranges []domain.DateRange
+	for _, date := range dates {
+		last := len(ranges) - 1
+		if last >= 0 && ranges[last].EndDate.Equal(date) {
+			ranges[last].EndDate = date.AddDate(0, 0, 1)
+			continue
+		}
+		ranges = append(ranges, domain.DateRange{StartDate: date, EndDate: date.AddDate(0, 0, 1)})
 	}
-	return vacantDates, nil
+	return ranges
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
-	"github.com/stackus/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -22,15 +21,30 @@ func parseDateStr(t *testing.T, d string) time.Time {
 
 func TestGetVacantDatesHandler(t *testing.T) {
 	type mocks struct {
-		bookings *domain.MockBookingRepository
+		campsites     *domain.MockCampsiteRepository
+		bookings      *domain.MockBookingRepository
+		blackouts     *domain.MockCampsiteBlackoutRepository
+		seasons       *domain.MockCampgroundSeasonRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+		waitlist      *domain.MockWaitlistRepository
 	}
 	campsiteID := "campsite-id"
+	campgroundID := "campground-id"
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+	errCampgroundSeasonNotFound := domain.ErrCampgroundSeasonNotFound{CampgroundID: campgroundID}
+	policy := domain.SeasonPolicy{}
+	// campsite not part of a campground, open all year
+	onCampsite := func(f mocks) {
+		f.campsites.
+			On("Find", context.TODO(), campsiteID).
+			Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+	}
 	monthOutOfRangeDate := "2024-99-01"
 
 	tests := map[string]struct {
 		qry     GetVacantDates
 		on      func(f mocks)
-		want    []string
+		want    *domain.Vacancy
 		wantErr error
 	}{
 		"Success_NoBookingsFoundForGivenDateRange": {
@@ -40,12 +54,33 @@ func TestGetVacantDatesHandler(t *testing.T) {
 				EndDate:    "2006-01-03",
 			},
 			on: func(f mocks) {
+				onCampsite(f)
 				f.bookings.On(
 					"FindForDateRange", context.TODO(), campsiteID,
 					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
 				).Return([]*domain.Booking{}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{parseDateStr(t, "2006-01-02")},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-02"),
+						EndDate:   parseDateStr(t, "2006-01-03"),
+					},
+				},
 			},
-			want:    []string{"2006-01-02"},
 			wantErr: nil,
 		},
 		"Success_BookingsFoundForGivenDateRange": {
@@ -55,6 +90,7 @@ func TestGetVacantDatesHandler(t *testing.T) {
 				EndDate:    "2006-01-03",
 			},
 			on: func(f mocks) {
+				onCampsite(f)
 				f.bookings.On(
 					"FindForDateRange", context.TODO(), campsiteID,
 					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
@@ -64,8 +100,124 @@ func TestGetVacantDatesHandler(t *testing.T) {
 						EndDate:   parseDateStr(t, "2006-01-03"),
 					},
 				}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+			},
+			want:    &domain.Vacancy{},
+			wantErr: nil,
+		},
+		"Success_VacantDatesSortedAndGroupedIntoRanges": {
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-01",
+				EndDate:    "2006-01-08",
+			},
+			on: func(f mocks) {
+				onCampsite(f)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return([]*domain.Booking{
+					{
+						StartDate: parseDateStr(t, "2006-01-03"),
+						EndDate:   parseDateStr(t, "2006-01-04"),
+					},
+					{
+						StartDate: parseDateStr(t, "2006-01-07"),
+						EndDate:   parseDateStr(t, "2006-01-09"),
+					},
+				}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{
+					parseDateStr(t, "2006-01-01"),
+					parseDateStr(t, "2006-01-02"),
+					parseDateStr(t, "2006-01-04"),
+					parseDateStr(t, "2006-01-05"),
+					parseDateStr(t, "2006-01-06"),
+				},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-01"),
+						EndDate:   parseDateStr(t, "2006-01-03"),
+					},
+					{
+						StartDate: parseDateStr(t, "2006-01-04"),
+						EndDate:   parseDateStr(t, "2006-01-07"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Success_RangesShorterThanMinNightsFilteredOut": {
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-01",
+				EndDate:    "2006-01-08",
+				MinNights:  3,
+			},
+			on: func(f mocks) {
+				onCampsite(f)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return([]*domain.Booking{
+					{
+						StartDate: parseDateStr(t, "2006-01-03"),
+						EndDate:   parseDateStr(t, "2006-01-04"),
+					},
+				}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-08"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{
+					parseDateStr(t, "2006-01-01"),
+					parseDateStr(t, "2006-01-02"),
+					parseDateStr(t, "2006-01-04"),
+					parseDateStr(t, "2006-01-05"),
+					parseDateStr(t, "2006-01-06"),
+					parseDateStr(t, "2006-01-07"),
+				},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-04"),
+						EndDate:   parseDateStr(t, "2006-01-08"),
+					},
+				},
 			},
-			want:    nil,
 			wantErr: nil,
 		},
 		"Error_ParseStartDate": {
@@ -76,7 +228,7 @@ func TestGetVacantDatesHandler(t *testing.T) {
 			},
 			on:      nil,
 			want:    nil,
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "start_date", Value: monthOutOfRangeDate},
 		},
 		"Error_ParseEndDate": {
 			qry: GetVacantDates{
@@ -86,7 +238,153 @@ func TestGetVacantDatesHandler(t *testing.T) {
 			},
 			on:      nil,
 			want:    nil,
-			wantErr: &time.ParseError{Value: monthOutOfRangeDate},
+			wantErr: domain.ErrInvalidDate{FieldName: "end_date", Value: monthOutOfRangeDate},
+		},
+		"Success_BlackedOutDatesNotVacant": {
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-01",
+				EndDate:    "2006-01-06",
+			},
+			on: func(f mocks) {
+				onCampsite(f)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return([]*domain.Booking{
+					{
+						StartDate: parseDateStr(t, "2006-01-01"),
+						EndDate:   parseDateStr(t, "2006-01-02"),
+					},
+				}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return([]*domain.CampsiteBlackout{
+					{
+						StartDate: parseDateStr(t, "2006-01-03"),
+						EndDate:   parseDateStr(t, "2006-01-05"),
+						Reason:    "maintenance",
+					},
+				}, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{
+					parseDateStr(t, "2006-01-02"),
+					parseDateStr(t, "2006-01-05"),
+				},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-02"),
+						EndDate:   parseDateStr(t, "2006-01-03"),
+					},
+					{
+						StartDate: parseDateStr(t, "2006-01-05"),
+						EndDate:   parseDateStr(t, "2006-01-06"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Success_ExternalBlocksNotVacant": {
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-01",
+				EndDate:    "2006-01-06",
+			},
+			on: func(f mocks) {
+				onCampsite(f)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return(nil, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return([]*domain.ExternalBlock{
+					{
+						StartDate: parseDateStr(t, "2006-01-02"),
+						EndDate:   parseDateStr(t, "2006-01-05"),
+						Summary:   "Reserved",
+					},
+				}, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-06"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{
+					parseDateStr(t, "2006-01-01"),
+					parseDateStr(t, "2006-01-05"),
+				},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-01"),
+						EndDate:   parseDateStr(t, "2006-01-02"),
+					},
+					{
+						StartDate: parseDateStr(t, "2006-01-05"),
+						EndDate:   parseDateStr(t, "2006-01-06"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Success_WaitlistOffersNotVacant": {
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-01",
+				EndDate:    "2006-01-04",
+			},
+			on: func(f mocks) {
+				onCampsite(f)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-04"),
+				).Return(nil, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-04"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-04"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-01"), parseDateStr(t, "2006-01-04"),
+				).Return([]*domain.WaitlistEntry{
+					{
+						StartDate:       parseDateStr(t, "2006-01-02"),
+						EndDate:         parseDateStr(t, "2006-01-04"),
+						Status:          domain.WaitlistStatusOffered,
+						OfferCampsiteID: campsiteID,
+					},
+				}, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{parseDateStr(t, "2006-01-01")},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-01"),
+						EndDate:   parseDateStr(t, "2006-01-02"),
+					},
+				},
+			},
+			wantErr: nil,
 		},
 		"Error_BeginTx": {
 			qry: GetVacantDates{
@@ -95,6 +393,7 @@ func TestGetVacantDatesHandler(t *testing.T) {
 				EndDate:    "2006-01-03",
 			},
 			on: func(f mocks) {
+				onCampsite(f)
 				f.bookings.On(
 					"FindForDateRange", context.TODO(), campsiteID,
 					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
@@ -103,15 +402,151 @@ func TestGetVacantDatesHandler(t *testing.T) {
 			want:    nil,
 			wantErr: bootstrap.ErrBeginTx,
 		},
+		"Success_CampsiteBelongsToCampground": {
+			qry: GetVacantDates{
+				CampgroundID: campgroundID,
+				CampsiteID:   campsiteID,
+				StartDate:    "2006-01-02",
+				EndDate:      "2006-01-03",
+			},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID, CampgroundID: campgroundID}, nil)
+				f.seasons.
+					On("Find", context.TODO(), campgroundID).
+					Return(nil, errCampgroundSeasonNotFound)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return([]*domain.Booking{}, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-01-02"), parseDateStr(t, "2006-01-03"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{parseDateStr(t, "2006-01-02")},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-01-02"),
+						EndDate:   parseDateStr(t, "2006-01-03"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Success_ClosedDatesNotVacant": {
+			// Sunday to Saturday, season opens on Tuesday and campground closed on Thursdays
+			qry: GetVacantDates{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-05-28",
+				EndDate:    "2006-06-04",
+			},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID, CampgroundID: campgroundID}, nil)
+				f.seasons.
+					On("Find", context.TODO(), campgroundID).
+					Return(&domain.CampgroundSeason{
+						CampgroundID:   campgroundID,
+						OpensOn:        "05-30",
+						ClosesOn:       "10-31",
+						ClosedWeekdays: []time.Weekday{time.Thursday},
+					}, nil)
+				f.bookings.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-05-28"), parseDateStr(t, "2006-06-04"),
+				).Return(nil, nil)
+				f.blackouts.On(
+					"FindForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-05-28"), parseDateStr(t, "2006-06-04"),
+				).Return(nil, nil)
+				f.subscriptions.On(
+					"FindBlocksForDateRange", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-05-28"), parseDateStr(t, "2006-06-04"),
+				).Return(nil, nil)
+				f.waitlist.On(
+					"FindOffered", context.TODO(), campsiteID,
+					parseDateStr(t, "2006-05-28"), parseDateStr(t, "2006-06-04"),
+				).Return(nil, nil)
+			},
+			want: &domain.Vacancy{
+				Dates: []time.Time{
+					parseDateStr(t, "2006-05-30"),
+					parseDateStr(t, "2006-05-31"),
+					parseDateStr(t, "2006-06-02"),
+					parseDateStr(t, "2006-06-03"),
+				},
+				Ranges: []domain.DateRange{
+					{
+						StartDate: parseDateStr(t, "2006-05-30"),
+						EndDate:   parseDateStr(t, "2006-06-01"),
+					},
+					{
+						StartDate: parseDateStr(t, "2006-06-02"),
+						EndDate:   parseDateStr(t, "2006-06-04"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotInCampground": {
+			qry: GetVacantDates{
+				CampgroundID: campgroundID,
+				CampsiteID:   campsiteID,
+				StartDate:    "2006-01-02",
+				EndDate:      "2006-01-03",
+			},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(&domain.Campsite{CampsiteID: campsiteID, CampgroundID: "other-id"}, nil)
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_CampsiteNotFound": {
+			qry: GetVacantDates{
+				CampgroundID: campgroundID,
+				CampsiteID:   campsiteID,
+				StartDate:    "2006-01-02",
+				EndDate:      "2006-01-03",
+			},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// given
 			m := mocks{
-				bookings: domain.NewMockBookingRepository(t),
+				campsites:     domain.NewMockCampsiteRepository(t),
+				bookings:      domain.NewMockBookingRepository(t),
+				blackouts:     domain.NewMockCampsiteBlackoutRepository(t),
+				seasons:       domain.NewMockCampgroundSeasonRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+				waitlist:      domain.NewMockWaitlistRepository(t),
 			}
-			h := NewGetVacantDatesHandler(m.bookings)
+			h := NewGetVacantDatesHandler(
+				m.campsites, m.bookings, m.blackouts, m.seasons, m.subscriptions, m.waitlist,
+				policy,
+			)
 			if tc.on != nil {
 				tc.on(m)
 			}
@@ -120,14 +555,11 @@ func TestGetVacantDatesHandler(t *testing.T) {
 			// then
 			assert.Equal(t, tc.want, got,
 				"GetVacantDatesHandler.Handle() got = %v, want %v", got, tc.want)
-			if err != nil {
-				var parseErr *time.ParseError
-				if errors.As(err, &parseErr) {
-					assert.Equal(t, monthOutOfRangeDate, parseErr.Value,
-						"GetVacantDatesHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
-				}
-			}
-			mock.AssertExpectationsForObjects(t, m.bookings)
+			assert.Equal(t, tc.wantErr, err,
+				"GetVacantDatesHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(
+				t, m.campsites, m.bookings, m.blackouts, m.seasons, m.subscriptions, m.waitlist,
+			)
 		})
 	}
 }
@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ListBlackouts struct {
+		CampsiteID string
+	}
+
+	// ListBlackoutsHandler is a logging decorator for the listBlackoutsHandler struct.
+	ListBlackoutsHandler handler.Query[ListBlackouts, []*domain.CampsiteBlackout]
+
+	listBlackoutsHandler struct {
+		campsites domain.CampsiteRepository
+		blackouts domain.CampsiteBlackoutRepository
+	}
+)
+
+func NewListBlackoutsHandler(
+	campsites domain.CampsiteRepository,
+	blackouts domain.CampsiteBlackoutRepository,
+) ListBlackoutsHandler {
+	return decorator.ApplyQueryDecorator[ListBlackouts, []*domain.CampsiteBlackout](
+		listBlackoutsHandler{campsites: campsites, blackouts: blackouts},
+	)
+}
+
+func (h listBlackoutsHandler) Handle(
+	ctx context.Context,
+	qry ListBlackouts,
+) ([]*domain.CampsiteBlackout, error) {
+	if _, err := h.campsites.Find(ctx, qry.CampsiteID); err != nil {
+		return nil, err
+	}
+	return h.blackouts.FindByCampsiteID(ctx, qry.CampsiteID)
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListBlackoutsHandler(t *testing.T) {
+	type mocks struct {
+		campsites *domain.MockCampsiteRepository
+		blackouts *domain.MockCampsiteBlackoutRepository
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+
+	tests := map[string]struct {
+		qry     ListBlackouts
+		on      func(f mocks)
+		want    []*domain.CampsiteBlackout
+		wantErr error
+	}{
+		"Success": {
+			qry: ListBlackouts{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.blackouts.
+					On("FindByCampsiteID", context.TODO(), campsiteID).
+					Return([]*domain.CampsiteBlackout{blackout}, nil)
+			},
+			want:    []*domain.CampsiteBlackout{blackout},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			qry: ListBlackouts{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_BeginTx": {
+			qry: ListBlackouts{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.blackouts.
+					On("FindByCampsiteID", context.TODO(), campsiteID).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites: domain.NewMockCampsiteRepository(t),
+				blackouts: domain.NewMockCampsiteBlackoutRepository(t),
+			}
+			h := NewListBlackoutsHandler(m.campsites, m.blackouts)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"ListBlackoutsHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ListBlackoutsHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.blackouts)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ListCalendarSubscriptions struct {
+		CampsiteID string
+	}
+
+	// ListCalendarSubscriptionsHandler is a logging decorator for the listCalendarSubscriptionsHandler struct.
+	ListCalendarSubscriptionsHandler handler.Query[ListCalendarSubscriptions, []*domain.CalendarSubscription]
+
+	listCalendarSubscriptionsHandler struct {
+		campsites     domain.CampsiteRepository
+		subscriptions domain.CalendarSubscriptionRepository
+	}
+)
+
+func NewListCalendarSubscriptionsHandler(
+	campsites domain.CampsiteRepository,
+	subscriptions domain.CalendarSubscriptionRepository,
+) ListCalendarSubscriptionsHandler {
+	return decorator.ApplyQueryDecorator[ListCalendarSubscriptions, []*domain.CalendarSubscription](
+		listCalendarSubscriptionsHandler{campsites: campsites, subscriptions: subscriptions},
+	)
+}
+
+func (h listCalendarSubscriptionsHandler) Handle(
+	ctx context.Context,
+	qry ListCalendarSubscriptions,
+) ([]*domain.CalendarSubscription, error) {
+	if _, err := h.campsites.Find(ctx, qry.CampsiteID); err != nil {
+		return nil, err
+	}
+	return h.subscriptions.FindByCampsiteID(ctx, qry.CampsiteID)
+}
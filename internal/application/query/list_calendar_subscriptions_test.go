@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListCalendarSubscriptionsHandler(t *testing.T) {
+	type mocks struct {
+		campsites     *domain.MockCampsiteRepository
+		subscriptions *domain.MockCalendarSubscriptionRepository
+	}
+	campsiteID := uuid.New().String()
+	campsite := &domain.Campsite{CampsiteID: campsiteID}
+	subscription := bootstrap.NewCalendarSubscription(campsiteID)
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
+
+	tests := map[string]struct {
+		qry     ListCalendarSubscriptions
+		on      func(f mocks)
+		want    []*domain.CalendarSubscription
+		wantErr error
+	}{
+		"Success": {
+			qry: ListCalendarSubscriptions{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.subscriptions.
+					On("FindByCampsiteID", context.TODO(), campsiteID).
+					Return([]*domain.CalendarSubscription{subscription}, nil)
+			},
+			want:    []*domain.CalendarSubscription{subscription},
+			wantErr: nil,
+		},
+		"Error_CampsiteNotFound": {
+			qry: ListCalendarSubscriptions{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_BeginTx": {
+			qry: ListCalendarSubscriptions{CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsiteID).
+					Return(campsite, nil)
+				f.subscriptions.
+					On("FindByCampsiteID", context.TODO(), campsiteID).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites:     domain.NewMockCampsiteRepository(t),
+				subscriptions: domain.NewMockCalendarSubscriptionRepository(t),
+			}
+			h := NewListCalendarSubscriptionsHandler(m.campsites, m.subscriptions)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"ListCalendarSubscriptionsHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ListCalendarSubscriptionsHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.campsites, m.subscriptions)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ListGuestBookings struct {
+		GuestID string
+	}
+
+	// ListGuestBookingsHandler is a logging decorator for the listGuestBookingsHandler struct.
+	ListGuestBookingsHandler handler.Query[ListGuestBookings, []*domain.Booking]
+
+	listGuestBookingsHandler struct {
+		guests   domain.GuestRepository
+		bookings domain.BookingRepository
+	}
+)
+
+func NewListGuestBookingsHandler(
+	guests domain.GuestRepository,
+	bookings domain.BookingRepository,
+) ListGuestBookingsHandler {
+	return decorator.ApplyQueryDecorator[ListGuestBookings, []*domain.Booking](
+		listGuestBookingsHandler{guests: guests, bookings: bookings},
+	)
+}
+
+// Handle returns the stay history of the guest, latest stay first, including
+// cancelled and no-show bookings.
+func (h listGuestBookingsHandler) Handle(
+	ctx context.Context,
+	qry ListGuestBookings,
+) ([]*domain.Booking, error) {
+	if _, err := h.guests.Find(ctx, qry.GuestID); err != nil {
+		return nil, err
+	}
+	return h.bookings.FindByGuestID(ctx, qry.GuestID)
+}
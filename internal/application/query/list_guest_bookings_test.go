@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListGuestBookingsHandler(t *testing.T) {
+	type mocks struct {
+		guests   *domain.MockGuestRepository
+		bookings *domain.MockBookingRepository
+	}
+	guest := bootstrap.NewGuest()
+	booking, err := bootstrap.NewBooking(uuid.New().String())
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GuestID = guest.GuestID
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+
+	tests := map[string]struct {
+		qry     ListGuestBookings
+		on      func(f mocks)
+		want    []*domain.Booking
+		wantErr error
+	}{
+		"Success": {
+			qry: ListGuestBookings{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(guest, nil)
+				f.bookings.
+					On("FindByGuestID", context.TODO(), guest.GuestID).
+					Return([]*domain.Booking{booking}, nil)
+			},
+			want:    []*domain.Booking{booking},
+			wantErr: nil,
+		},
+		"Error_GuestNotFound": {
+			qry: ListGuestBookings{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(nil, errGuestNotFound)
+			},
+			want:    nil,
+			wantErr: errGuestNotFound,
+		},
+		"Error_FindByGuestID": {
+			qry: ListGuestBookings{GuestID: guest.GuestID},
+			on: func(f mocks) {
+				f.guests.
+					On("Find", context.TODO(), guest.GuestID).
+					Return(guest, nil)
+				f.bookings.
+					On("FindByGuestID", context.TODO(), guest.GuestID).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				guests:   domain.NewMockGuestRepository(t),
+				bookings: domain.NewMockBookingRepository(t),
+			}
+			h := NewListGuestBookingsHandler(m.guests, m.bookings)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"ListGuestBookingsHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ListGuestBookingsHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.guests, m.bookings)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	ListWaitlist struct {
+		CampgroundID string
+		CampsiteID   string
+	}
+
+	// ListWaitlistHandler is a logging decorator for the listWaitlistHandler struct.
+	ListWaitlistHandler handler.Query[ListWaitlist, []*domain.WaitlistEntry]
+
+	listWaitlistHandler struct {
+		waitlist domain.WaitlistRepository
+	}
+)
+
+func NewListWaitlistHandler(waitlist domain.WaitlistRepository) ListWaitlistHandler {
+	return decorator.ApplyQueryDecorator[ListWaitlist, []*domain.WaitlistEntry](
+		listWaitlistHandler{waitlist: waitlist},
+	)
+}
+
+func (h listWaitlistHandler) Handle(
+	ctx context.Context,
+	qry ListWaitlist,
+) ([]*domain.WaitlistEntry, error) {
+	entries, err := h.waitlist.FindByCampgroundID(ctx, qry.CampgroundID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var result []*domain.WaitlistEntry
+	for _, entry := range entries {
+		if qry.CampsiteID != "" && entry.CampsiteID != qry.CampsiteID {
+			continue
+		}
+		// offers are expired lazily, report them as such before they are acted on
+		if entry.OfferExpired(now) {
+			entry.Status = domain.WaitlistStatusExpired
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListWaitlistHandler(t *testing.T) {
+	type mocks struct {
+		waitlist *domain.MockWaitlistRepository
+	}
+	campgroundID := uuid.New().String()
+	campsiteID := uuid.New().String()
+
+	waitingEntry := bootstrap.NewWaitlistEntry(campgroundID)
+	campsiteEntry := bootstrap.NewWaitlistEntry(campgroundID)
+	campsiteEntry.CampsiteID = campsiteID
+	expiredEntry := bootstrap.NewWaitlistEntry(campgroundID)
+	expiredEntry.Offer(campsiteID, time.Now().Add(-2*time.Hour), domain.WaitlistPolicy{
+		OfferTTL: time.Hour,
+	})
+	entries := []*domain.WaitlistEntry{waitingEntry, campsiteEntry, expiredEntry}
+
+	reportedExpiredEntry := *expiredEntry
+	reportedExpiredEntry.Status = domain.WaitlistStatusExpired
+
+	tests := map[string]struct {
+		qry     ListWaitlist
+		on      func(f mocks)
+		want    []*domain.WaitlistEntry
+		wantErr error
+	}{
+		"Success": {
+			qry: ListWaitlist{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("FindByCampgroundID", context.TODO(), campgroundID).
+					Return(entries, nil)
+			},
+			want:    []*domain.WaitlistEntry{waitingEntry, campsiteEntry, &reportedExpiredEntry},
+			wantErr: nil,
+		},
+		"Success_Campsite": {
+			qry: ListWaitlist{CampgroundID: campgroundID, CampsiteID: campsiteID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("FindByCampgroundID", context.TODO(), campgroundID).
+					Return(entries, nil)
+			},
+			want:    []*domain.WaitlistEntry{campsiteEntry},
+			wantErr: nil,
+		},
+		"Error_FindByCampgroundID_BeginTx": {
+			qry: ListWaitlist{CampgroundID: campgroundID},
+			on: func(f mocks) {
+				f.waitlist.
+					On("FindByCampgroundID", context.TODO(), campgroundID).
+					Return(nil, bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				waitlist: domain.NewMockWaitlistRepository(t),
+			}
+			h := NewListWaitlistHandler(m.waitlist)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"ListWaitlistHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"ListWaitlistHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.waitlist)
+		})
+	}
+}
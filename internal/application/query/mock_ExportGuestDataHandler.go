@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExportGuestDataHandler creates a new instance of MockExportGuestDataHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportGuestDataHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportGuestDataHandler {
+	mock := &MockExportGuestDataHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportGuestDataHandler is an autogenerated mock type for the ExportGuestDataHandler type
+type MockExportGuestDataHandler struct {
+	mock.Mock
+}
+
+type MockExportGuestDataHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportGuestDataHandler) EXPECT() *MockExportGuestDataHandler_Expecter {
+	return &MockExportGuestDataHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockExportGuestDataHandler
+func (_mock *MockExportGuestDataHandler) Handle(ctx context.Context, qry ExportGuestData) (*domain.GuestData, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.GuestData
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ExportGuestData) (*domain.GuestData, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ExportGuestData) *domain.GuestData); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.GuestData)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ExportGuestData) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportGuestDataHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockExportGuestDataHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry ExportGuestData
+func (_e *MockExportGuestDataHandler_Expecter) Handle(ctx any, qry any) *MockExportGuestDataHandler_Handle_Call {
+	return &MockExportGuestDataHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockExportGuestDataHandler_Handle_Call) Run(run func(ctx context.Context, qry ExportGuestData)) *MockExportGuestDataHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ExportGuestData
+		if args[1] != nil {
+			arg1 = args[1].(ExportGuestData)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportGuestDataHandler_Handle_Call) Return(guestData *domain.GuestData, err error) *MockExportGuestDataHandler_Handle_Call {
+	_c.Call.Return(guestData, err)
+	return _c
+}
+
+func (_c *MockExportGuestDataHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry ExportGuestData) (*domain.GuestData, error)) *MockExportGuestDataHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
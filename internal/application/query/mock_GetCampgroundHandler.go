@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetCampgroundHandler creates a new instance of MockGetCampgroundHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCampgroundHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCampgroundHandler {
+	mock := &MockGetCampgroundHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCampgroundHandler is an autogenerated mock type for the GetCampgroundHandler type
+type MockGetCampgroundHandler struct {
+	mock.Mock
+}
+
+type MockGetCampgroundHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCampgroundHandler) EXPECT() *MockGetCampgroundHandler_Expecter {
+	return &MockGetCampgroundHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetCampgroundHandler
+func (_mock *MockGetCampgroundHandler) Handle(ctx context.Context, qry GetCampground) (*domain.Campground, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampground) (*domain.Campground, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampground) *domain.Campground); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCampground) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetCampgroundHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetCampgroundHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetCampground
+func (_e *MockGetCampgroundHandler_Expecter) Handle(ctx any, qry any) *MockGetCampgroundHandler_Handle_Call {
+	return &MockGetCampgroundHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetCampgroundHandler_Handle_Call) Run(run func(ctx context.Context, qry GetCampground)) *MockGetCampgroundHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetCampground
+		if args[1] != nil {
+			arg1 = args[1].(GetCampground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetCampgroundHandler_Handle_Call) Return(campground *domain.Campground, err error) *MockGetCampgroundHandler_Handle_Call {
+	_c.Call.Return(campground, err)
+	return _c
+}
+
+func (_c *MockGetCampgroundHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetCampground) (*domain.Campground, error)) *MockGetCampgroundHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
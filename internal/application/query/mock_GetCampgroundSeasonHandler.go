@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetCampgroundSeasonHandler creates a new instance of MockGetCampgroundSeasonHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCampgroundSeasonHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCampgroundSeasonHandler {
+	mock := &MockGetCampgroundSeasonHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCampgroundSeasonHandler is an autogenerated mock type for the GetCampgroundSeasonHandler type
+type MockGetCampgroundSeasonHandler struct {
+	mock.Mock
+}
+
+type MockGetCampgroundSeasonHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCampgroundSeasonHandler) EXPECT() *MockGetCampgroundSeasonHandler_Expecter {
+	return &MockGetCampgroundSeasonHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetCampgroundSeasonHandler
+func (_mock *MockGetCampgroundSeasonHandler) Handle(ctx context.Context, qry GetCampgroundSeason) (*domain.CampgroundSeason, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.CampgroundSeason
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampgroundSeason) (*domain.CampgroundSeason, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampgroundSeason) *domain.CampgroundSeason); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampgroundSeason)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCampgroundSeason) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetCampgroundSeasonHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetCampgroundSeasonHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetCampgroundSeason
+func (_e *MockGetCampgroundSeasonHandler_Expecter) Handle(ctx any, qry any) *MockGetCampgroundSeasonHandler_Handle_Call {
+	return &MockGetCampgroundSeasonHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetCampgroundSeasonHandler_Handle_Call) Run(run func(ctx context.Context, qry GetCampgroundSeason)) *MockGetCampgroundSeasonHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetCampgroundSeason
+		if args[1] != nil {
+			arg1 = args[1].(GetCampgroundSeason)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetCampgroundSeasonHandler_Handle_Call) Return(campgroundSeason *domain.CampgroundSeason, err error) *MockGetCampgroundSeasonHandler_Handle_Call {
+	_c.Call.Return(campgroundSeason, err)
+	return _c
+}
+
+func (_c *MockGetCampgroundSeasonHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetCampgroundSeason) (*domain.CampgroundSeason, error)) *MockGetCampgroundSeasonHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
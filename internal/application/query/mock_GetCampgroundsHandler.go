@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetCampgroundsHandler creates a new instance of MockGetCampgroundsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCampgroundsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCampgroundsHandler {
+	mock := &MockGetCampgroundsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCampgroundsHandler is an autogenerated mock type for the GetCampgroundsHandler type
+type MockGetCampgroundsHandler struct {
+	mock.Mock
+}
+
+type MockGetCampgroundsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCampgroundsHandler) EXPECT() *MockGetCampgroundsHandler_Expecter {
+	return &MockGetCampgroundsHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetCampgroundsHandler
+func (_mock *MockGetCampgroundsHandler) Handle(ctx context.Context, qry GetCampgrounds) ([]*domain.Campground, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampgrounds) ([]*domain.Campground, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampgrounds) []*domain.Campground); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCampgrounds) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetCampgroundsHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetCampgroundsHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetCampgrounds
+func (_e *MockGetCampgroundsHandler_Expecter) Handle(ctx any, qry any) *MockGetCampgroundsHandler_Handle_Call {
+	return &MockGetCampgroundsHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetCampgroundsHandler_Handle_Call) Run(run func(ctx context.Context, qry GetCampgrounds)) *MockGetCampgroundsHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetCampgrounds
+		if args[1] != nil {
+			arg1 = args[1].(GetCampgrounds)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetCampgroundsHandler_Handle_Call) Return(campgrounds []*domain.Campground, err error) *MockGetCampgroundsHandler_Handle_Call {
+	_c.Call.Return(campgrounds, err)
+	return _c
+}
+
+func (_c *MockGetCampgroundsHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetCampgrounds) ([]*domain.Campground, error)) *MockGetCampgroundsHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetCampsiteCalendarHandler creates a new instance of MockGetCampsiteCalendarHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCampsiteCalendarHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCampsiteCalendarHandler {
+	mock := &MockGetCampsiteCalendarHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCampsiteCalendarHandler is an autogenerated mock type for the GetCampsiteCalendarHandler type
+type MockGetCampsiteCalendarHandler struct {
+	mock.Mock
+}
+
+type MockGetCampsiteCalendarHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCampsiteCalendarHandler) EXPECT() *MockGetCampsiteCalendarHandler_Expecter {
+	return &MockGetCampsiteCalendarHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetCampsiteCalendarHandler
+func (_mock *MockGetCampsiteCalendarHandler) Handle(ctx context.Context, qry GetCampsiteCalendar) (*domain.CampsiteCalendar, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.CampsiteCalendar
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampsiteCalendar) (*domain.CampsiteCalendar, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampsiteCalendar) *domain.CampsiteCalendar); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampsiteCalendar)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCampsiteCalendar) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetCampsiteCalendarHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetCampsiteCalendarHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetCampsiteCalendar
+func (_e *MockGetCampsiteCalendarHandler_Expecter) Handle(ctx any, qry any) *MockGetCampsiteCalendarHandler_Handle_Call {
+	return &MockGetCampsiteCalendarHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetCampsiteCalendarHandler_Handle_Call) Run(run func(ctx context.Context, qry GetCampsiteCalendar)) *MockGetCampsiteCalendarHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetCampsiteCalendar
+		if args[1] != nil {
+			arg1 = args[1].(GetCampsiteCalendar)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetCampsiteCalendarHandler_Handle_Call) Return(campsiteCalendar *domain.CampsiteCalendar, err error) *MockGetCampsiteCalendarHandler_Handle_Call {
+	_c.Call.Return(campsiteCalendar, err)
+	return _c
+}
+
+func (_c *MockGetCampsiteCalendarHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetCampsiteCalendar) (*domain.CampsiteCalendar, error)) *MockGetCampsiteCalendarHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetCampsiteRatesHandler creates a new instance of MockGetCampsiteRatesHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCampsiteRatesHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCampsiteRatesHandler {
+	mock := &MockGetCampsiteRatesHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetCampsiteRatesHandler is an autogenerated mock type for the GetCampsiteRatesHandler type
+type MockGetCampsiteRatesHandler struct {
+	mock.Mock
+}
+
+type MockGetCampsiteRatesHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCampsiteRatesHandler) EXPECT() *MockGetCampsiteRatesHandler_Expecter {
+	return &MockGetCampsiteRatesHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetCampsiteRatesHandler
+func (_mock *MockGetCampsiteRatesHandler) Handle(ctx context.Context, qry GetCampsiteRates) (*domain.CampsiteRates, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.CampsiteRates
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampsiteRates) (*domain.CampsiteRates, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetCampsiteRates) *domain.CampsiteRates); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CampsiteRates)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetCampsiteRates) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetCampsiteRatesHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetCampsiteRatesHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetCampsiteRates
+func (_e *MockGetCampsiteRatesHandler_Expecter) Handle(ctx any, qry any) *MockGetCampsiteRatesHandler_Handle_Call {
+	return &MockGetCampsiteRatesHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetCampsiteRatesHandler_Handle_Call) Run(run func(ctx context.Context, qry GetCampsiteRates)) *MockGetCampsiteRatesHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetCampsiteRates
+		if args[1] != nil {
+			arg1 = args[1].(GetCampsiteRates)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetCampsiteRatesHandler_Handle_Call) Return(campsiteRates *domain.CampsiteRates, err error) *MockGetCampsiteRatesHandler_Handle_Call {
+	_c.Call.Return(campsiteRates, err)
+	return _c
+}
+
+func (_c *MockGetCampsiteRatesHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetCampsiteRates) (*domain.CampsiteRates, error)) *MockGetCampsiteRatesHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
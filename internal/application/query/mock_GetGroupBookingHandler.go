@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetGroupBookingHandler creates a new instance of MockGetGroupBookingHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetGroupBookingHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetGroupBookingHandler {
+	mock := &MockGetGroupBookingHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetGroupBookingHandler is an autogenerated mock type for the GetGroupBookingHandler type
+type MockGetGroupBookingHandler struct {
+	mock.Mock
+}
+
+type MockGetGroupBookingHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetGroupBookingHandler) EXPECT() *MockGetGroupBookingHandler_Expecter {
+	return &MockGetGroupBookingHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetGroupBookingHandler
+func (_mock *MockGetGroupBookingHandler) Handle(ctx context.Context, qry GetGroupBooking) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetGroupBooking) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetGroupBooking) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetGroupBooking) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetGroupBookingHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetGroupBookingHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetGroupBooking
+func (_e *MockGetGroupBookingHandler_Expecter) Handle(ctx any, qry any) *MockGetGroupBookingHandler_Handle_Call {
+	return &MockGetGroupBookingHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetGroupBookingHandler_Handle_Call) Run(run func(ctx context.Context, qry GetGroupBooking)) *MockGetGroupBookingHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetGroupBooking
+		if args[1] != nil {
+			arg1 = args[1].(GetGroupBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetGroupBookingHandler_Handle_Call) Return(bookings []*domain.Booking, err error) *MockGetGroupBookingHandler_Handle_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockGetGroupBookingHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetGroupBooking) ([]*domain.Booking, error)) *MockGetGroupBookingHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
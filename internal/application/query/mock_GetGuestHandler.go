@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetGuestHandler creates a new instance of MockGetGuestHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetGuestHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetGuestHandler {
+	mock := &MockGetGuestHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetGuestHandler is an autogenerated mock type for the GetGuestHandler type
+type MockGetGuestHandler struct {
+	mock.Mock
+}
+
+type MockGetGuestHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetGuestHandler) EXPECT() *MockGetGuestHandler_Expecter {
+	return &MockGetGuestHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockGetGuestHandler
+func (_mock *MockGetGuestHandler) Handle(ctx context.Context, qry GetGuest) (*domain.Guest, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.Guest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetGuest) (*domain.Guest, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetGuest) *domain.Guest); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Guest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetGuest) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetGuestHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockGetGuestHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry GetGuest
+func (_e *MockGetGuestHandler_Expecter) Handle(ctx any, qry any) *MockGetGuestHandler_Handle_Call {
+	return &MockGetGuestHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockGetGuestHandler_Handle_Call) Run(run func(ctx context.Context, qry GetGuest)) *MockGetGuestHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetGuest
+		if args[1] != nil {
+			arg1 = args[1].(GetGuest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetGuestHandler_Handle_Call) Return(guest *domain.Guest, err error) *MockGetGuestHandler_Handle_Call {
+	_c.Call.Return(guest, err)
+	return _c
+}
+
+func (_c *MockGetGuestHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetGuest) (*domain.Guest, error)) *MockGetGuestHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package query
 import (
 	"context"
 
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,23 +39,23 @@ func (_m *MockGetVacantDatesHandler) EXPECT() *MockGetVacantDatesHandler_Expecte
 }
 
 // Handle provides a mock function for the type MockGetVacantDatesHandler
-func (_mock *MockGetVacantDatesHandler) Handle(ctx context.Context, qry GetVacantDates) ([]string, error) {
+func (_mock *MockGetVacantDatesHandler) Handle(ctx context.Context, qry GetVacantDates) (*domain.Vacancy, error) {
 	ret := _mock.Called(ctx, qry)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Handle")
 	}
 
-	var r0 []string
+	var r0 *domain.Vacancy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, GetVacantDates) ([]string, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetVacantDates) (*domain.Vacancy, error)); ok {
 		return returnFunc(ctx, qry)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, GetVacantDates) []string); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetVacantDates) *domain.Vacancy); ok {
 		r0 = returnFunc(ctx, qry)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*domain.Vacancy)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, GetVacantDates) error); ok {
@@ -95,12 +96,12 @@ func (_c *MockGetVacantDatesHandler_Handle_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockGetVacantDatesHandler_Handle_Call) Return(strings []string, err error) *MockGetVacantDatesHandler_Handle_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockGetVacantDatesHandler_Handle_Call) Return(vacancy *domain.Vacancy, err error) *MockGetVacantDatesHandler_Handle_Call {
+	_c.Call.Return(vacancy, err)
 	return _c
 }
 
-func (_c *MockGetVacantDatesHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetVacantDates) ([]string, error)) *MockGetVacantDatesHandler_Handle_Call {
+func (_c *MockGetVacantDatesHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry GetVacantDates) (*domain.Vacancy, error)) *MockGetVacantDatesHandler_Handle_Call {
 	_c.Call.Return(run)
 	return _c
 }
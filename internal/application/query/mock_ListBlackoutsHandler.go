@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockListBlackoutsHandler creates a new instance of MockListBlackoutsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListBlackoutsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListBlackoutsHandler {
+	mock := &MockListBlackoutsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListBlackoutsHandler is an autogenerated mock type for the ListBlackoutsHandler type
+type MockListBlackoutsHandler struct {
+	mock.Mock
+}
+
+type MockListBlackoutsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListBlackoutsHandler) EXPECT() *MockListBlackoutsHandler_Expecter {
+	return &MockListBlackoutsHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockListBlackoutsHandler
+func (_mock *MockListBlackoutsHandler) Handle(ctx context.Context, qry ListBlackouts) ([]*domain.CampsiteBlackout, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.CampsiteBlackout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBlackouts) ([]*domain.CampsiteBlackout, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBlackouts) []*domain.CampsiteBlackout); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CampsiteBlackout)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListBlackouts) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListBlackoutsHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockListBlackoutsHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry ListBlackouts
+func (_e *MockListBlackoutsHandler_Expecter) Handle(ctx any, qry any) *MockListBlackoutsHandler_Handle_Call {
+	return &MockListBlackoutsHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockListBlackoutsHandler_Handle_Call) Run(run func(ctx context.Context, qry ListBlackouts)) *MockListBlackoutsHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListBlackouts
+		if args[1] != nil {
+			arg1 = args[1].(ListBlackouts)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListBlackoutsHandler_Handle_Call) Return(campsiteBlackouts []*domain.CampsiteBlackout, err error) *MockListBlackoutsHandler_Handle_Call {
+	_c.Call.Return(campsiteBlackouts, err)
+	return _c
+}
+
+func (_c *MockListBlackoutsHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry ListBlackouts) ([]*domain.CampsiteBlackout, error)) *MockListBlackoutsHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
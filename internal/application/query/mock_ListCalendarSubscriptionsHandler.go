@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockListCalendarSubscriptionsHandler creates a new instance of MockListCalendarSubscriptionsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListCalendarSubscriptionsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListCalendarSubscriptionsHandler {
+	mock := &MockListCalendarSubscriptionsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListCalendarSubscriptionsHandler is an autogenerated mock type for the ListCalendarSubscriptionsHandler type
+type MockListCalendarSubscriptionsHandler struct {
+	mock.Mock
+}
+
+type MockListCalendarSubscriptionsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListCalendarSubscriptionsHandler) EXPECT() *MockListCalendarSubscriptionsHandler_Expecter {
+	return &MockListCalendarSubscriptionsHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockListCalendarSubscriptionsHandler
+func (_mock *MockListCalendarSubscriptionsHandler) Handle(ctx context.Context, qry ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.CalendarSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListCalendarSubscriptions) []*domain.CalendarSubscription); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CalendarSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListCalendarSubscriptions) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListCalendarSubscriptionsHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockListCalendarSubscriptionsHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry ListCalendarSubscriptions
+func (_e *MockListCalendarSubscriptionsHandler_Expecter) Handle(ctx any, qry any) *MockListCalendarSubscriptionsHandler_Handle_Call {
+	return &MockListCalendarSubscriptionsHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockListCalendarSubscriptionsHandler_Handle_Call) Run(run func(ctx context.Context, qry ListCalendarSubscriptions)) *MockListCalendarSubscriptionsHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListCalendarSubscriptions
+		if args[1] != nil {
+			arg1 = args[1].(ListCalendarSubscriptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListCalendarSubscriptionsHandler_Handle_Call) Return(calendarSubscriptions []*domain.CalendarSubscription, err error) *MockListCalendarSubscriptionsHandler_Handle_Call {
+	_c.Call.Return(calendarSubscriptions, err)
+	return _c
+}
+
+func (_c *MockListCalendarSubscriptionsHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry ListCalendarSubscriptions) ([]*domain.CalendarSubscription, error)) *MockListCalendarSubscriptionsHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockListGuestBookingsHandler creates a new instance of MockListGuestBookingsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListGuestBookingsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListGuestBookingsHandler {
+	mock := &MockListGuestBookingsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListGuestBookingsHandler is an autogenerated mock type for the ListGuestBookingsHandler type
+type MockListGuestBookingsHandler struct {
+	mock.Mock
+}
+
+type MockListGuestBookingsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListGuestBookingsHandler) EXPECT() *MockListGuestBookingsHandler_Expecter {
+	return &MockListGuestBookingsHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockListGuestBookingsHandler
+func (_mock *MockListGuestBookingsHandler) Handle(ctx context.Context, qry ListGuestBookings) ([]*domain.Booking, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListGuestBookings) ([]*domain.Booking, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListGuestBookings) []*domain.Booking); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListGuestBookings) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListGuestBookingsHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockListGuestBookingsHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry ListGuestBookings
+func (_e *MockListGuestBookingsHandler_Expecter) Handle(ctx any, qry any) *MockListGuestBookingsHandler_Handle_Call {
+	return &MockListGuestBookingsHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockListGuestBookingsHandler_Handle_Call) Run(run func(ctx context.Context, qry ListGuestBookings)) *MockListGuestBookingsHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListGuestBookings
+		if args[1] != nil {
+			arg1 = args[1].(ListGuestBookings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListGuestBookingsHandler_Handle_Call) Return(bookings []*domain.Booking, err error) *MockListGuestBookingsHandler_Handle_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockListGuestBookingsHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry ListGuestBookings) ([]*domain.Booking, error)) *MockListGuestBookingsHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
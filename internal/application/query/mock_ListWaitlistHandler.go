@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockListWaitlistHandler creates a new instance of MockListWaitlistHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListWaitlistHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListWaitlistHandler {
+	mock := &MockListWaitlistHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListWaitlistHandler is an autogenerated mock type for the ListWaitlistHandler type
+type MockListWaitlistHandler struct {
+	mock.Mock
+}
+
+type MockListWaitlistHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListWaitlistHandler) EXPECT() *MockListWaitlistHandler_Expecter {
+	return &MockListWaitlistHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockListWaitlistHandler
+func (_mock *MockListWaitlistHandler) Handle(ctx context.Context, qry ListWaitlist) ([]*domain.WaitlistEntry, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 []*domain.WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListWaitlist) ([]*domain.WaitlistEntry, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListWaitlist) []*domain.WaitlistEntry); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListWaitlist) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListWaitlistHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockListWaitlistHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry ListWaitlist
+func (_e *MockListWaitlistHandler_Expecter) Handle(ctx any, qry any) *MockListWaitlistHandler_Handle_Call {
+	return &MockListWaitlistHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockListWaitlistHandler_Handle_Call) Run(run func(ctx context.Context, qry ListWaitlist)) *MockListWaitlistHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListWaitlist
+		if args[1] != nil {
+			arg1 = args[1].(ListWaitlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListWaitlistHandler_Handle_Call) Return(waitlistEntrys []*domain.WaitlistEntry, err error) *MockListWaitlistHandler_Handle_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockListWaitlistHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry ListWaitlist) ([]*domain.WaitlistEntry, error)) *MockListWaitlistHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
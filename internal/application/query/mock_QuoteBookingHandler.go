@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockQuoteBookingHandler creates a new instance of MockQuoteBookingHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuoteBookingHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuoteBookingHandler {
+	mock := &MockQuoteBookingHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockQuoteBookingHandler is an autogenerated mock type for the QuoteBookingHandler type
+type MockQuoteBookingHandler struct {
+	mock.Mock
+}
+
+type MockQuoteBookingHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuoteBookingHandler) EXPECT() *MockQuoteBookingHandler_Expecter {
+	return &MockQuoteBookingHandler_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockQuoteBookingHandler
+func (_mock *MockQuoteBookingHandler) Handle(ctx context.Context, qry QuoteBooking) (*domain.Quote, error) {
+	ret := _mock.Called(ctx, qry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *domain.Quote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QuoteBooking) (*domain.Quote, error)); ok {
+		return returnFunc(ctx, qry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QuoteBooking) *domain.Quote); ok {
+		r0 = returnFunc(ctx, qry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Quote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, QuoteBooking) error); ok {
+		r1 = returnFunc(ctx, qry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuoteBookingHandler_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockQuoteBookingHandler_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qry QuoteBooking
+func (_e *MockQuoteBookingHandler_Expecter) Handle(ctx any, qry any) *MockQuoteBookingHandler_Handle_Call {
+	return &MockQuoteBookingHandler_Handle_Call{Call: _e.mock.On("Handle", ctx, qry)}
+}
+
+func (_c *MockQuoteBookingHandler_Handle_Call) Run(run func(ctx context.Context, qry QuoteBooking)) *MockQuoteBookingHandler_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 QuoteBooking
+		if args[1] != nil {
+			arg1 = args[1].(QuoteBooking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuoteBookingHandler_Handle_Call) Return(quote *domain.Quote, err error) *MockQuoteBookingHandler_Handle_Call {
+	_c.Call.Return(quote, err)
+	return _c
+}
+
+func (_c *MockQuoteBookingHandler_Handle_Call) RunAndReturn(run func(ctx context.Context, qry QuoteBooking) (*domain.Quote, error)) *MockQuoteBookingHandler_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
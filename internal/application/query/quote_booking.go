@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/decorator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/handler"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+type (
+	QuoteBooking struct {
+		CampsiteID string
+		StartDate  string
+		EndDate    string
+		Guests     int32
+	}
+
+	// QuoteBookingHandler is a logging decorator for the quoteBookingHandler struct.
+	QuoteBookingHandler handler.Query[QuoteBooking, *domain.Quote]
+
+	quoteBookingHandler struct {
+		rates      domain.CampsiteRatesRepository
+		validators []domain.BookingValidator
+	}
+)
+
+func NewQuoteBookingHandler(
+	rates domain.CampsiteRatesRepository,
+	validators []domain.BookingValidator,
+) QuoteBookingHandler {
+	return decorator.ApplyQueryDecorator[QuoteBooking, *domain.Quote](
+		quoteBookingHandler{rates: rates, validators: validators},
+	)
+}
+
+func (h quoteBookingHandler) Handle(ctx context.Context, qry QuoteBooking) (*domain.Quote, error) {
+	startDate, err := domain.ParseDate("start_date", qry.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate, err := domain.ParseDate("end_date", qry.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	booking := &domain.Booking{
+		CampsiteID: qry.CampsiteID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Guests:     max(qry.Guests, 1),
+	}
+	if err = validator.Apply(ctx, h.validators, booking); err != nil {
+		return nil, err
+	}
+
+	rates, err := h.rates.Find(ctx, qry.CampsiteID)
+	if err != nil {
+		return nil, err
+	}
+	return rates.Quote(booking.StartDate, booking.EndDate, booking.Guests), nil
+}
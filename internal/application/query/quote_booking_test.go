@@ -0,0 +1,155 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQuoteBookingHandler(t *testing.T) {
+	type mocks struct {
+		rates     *domain.MockCampsiteRatesRepository
+		validator *domain.MockBookingValidator
+	}
+	campsiteID := "campsite-id"
+	rates := bootstrap.NewCampsiteRates(campsiteID)
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}
+	errBookingMaximumStay := validator.ErrBookingMaximumStay{}
+	monthOutOfRangeDate := "2024-99-01"
+
+	tests := map[string]struct {
+		qry     QuoteBooking
+		on      func(f mocks)
+		want    *domain.Quote
+		wantErr error
+	}{
+		"Success_WeekendAndExtraGuestRates": {
+			qry: QuoteBooking{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-05-25",
+				EndDate:    "2006-05-28",
+				Guests:     3,
+			},
+			on: func(f mocks) {
+				f.validator.On("Validate", context.TODO(), mock.Anything).Return(nil)
+				f.rates.On("Find", context.TODO(), campsiteID).Return(rates, nil)
+			},
+			want: &domain.Quote{
+				CampsiteID: campsiteID,
+				StartDate:  parseDateStr(t, "2006-05-25"),
+				EndDate:    parseDateStr(t, "2006-05-28"),
+				Guests:     3,
+				Currency:   "USD",
+				Nights: []domain.NightlyPrice{
+					{Date: parseDateStr(t, "2006-05-25"), Amount: 4000},
+					{Date: parseDateStr(t, "2006-05-26"), Amount: 5000, Weekend: true},
+					{Date: parseDateStr(t, "2006-05-27"), Amount: 5000, Weekend: true},
+				},
+				NightsSubtotal: 14000,
+				ExtraGuestFees: 1500,
+				Taxes:          2015,
+				Total:          17515,
+			},
+			wantErr: nil,
+		},
+		"Success_SeasonalRateOverridesBaseRates": {
+			qry: QuoteBooking{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-05-31",
+				EndDate:    "2006-06-03",
+			},
+			on: func(f mocks) {
+				f.validator.On("Validate", context.TODO(), mock.Anything).Return(nil)
+				f.rates.On("Find", context.TODO(), campsiteID).Return(rates, nil)
+			},
+			want: &domain.Quote{
+				CampsiteID: campsiteID,
+				StartDate:  parseDateStr(t, "2006-05-31"),
+				EndDate:    parseDateStr(t, "2006-06-03"),
+				Guests:     1,
+				Currency:   "USD",
+				Nights: []domain.NightlyPrice{
+					{Date: parseDateStr(t, "2006-05-31"), Amount: 4000},
+					{Date: parseDateStr(t, "2006-06-01"), Amount: 6000, Season: "summer"},
+					{
+						Date: parseDateStr(t, "2006-06-02"), Amount: 6000, Weekend: true,
+						Season: "summer",
+					},
+				},
+				NightsSubtotal: 16000,
+				ExtraGuestFees: 0,
+				Taxes:          2080,
+				Total:          18080,
+			},
+			wantErr: nil,
+		},
+		"Error_ParseStartDate": {
+			qry: QuoteBooking{
+				CampsiteID: campsiteID,
+				StartDate:  monthOutOfRangeDate,
+				EndDate:    "2006-01-02",
+			},
+			on:      nil,
+			want:    nil,
+			wantErr: domain.ErrInvalidDate{FieldName: "start_date", Value: monthOutOfRangeDate},
+		},
+		"Error_Validate_BookingMaximumStay": {
+			qry: QuoteBooking{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-02",
+				EndDate:    "2006-01-12",
+			},
+			on: func(f mocks) {
+				f.validator.On("Validate", context.TODO(), mock.Anything).
+					Return(errBookingMaximumStay)
+			},
+			want: nil,
+			wantErr: domain.ErrBookingValidation{
+				MultiErr: multierror.Append(errBookingMaximumStay),
+			},
+		},
+		"Error_CampsiteRatesNotFound": {
+			qry: QuoteBooking{
+				CampsiteID: campsiteID,
+				StartDate:  "2006-01-02",
+				EndDate:    "2006-01-03",
+			},
+			on: func(f mocks) {
+				f.validator.On("Validate", context.TODO(), mock.Anything).Return(nil)
+				f.rates.
+					On("Find", context.TODO(), campsiteID).
+					Return(nil, errCampsiteRatesNotFound)
+			},
+			want:    nil,
+			wantErr: errCampsiteRatesNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				rates:     domain.NewMockCampsiteRatesRepository(t),
+				validator: domain.NewMockBookingValidator(t),
+			}
+			h := NewQuoteBookingHandler(m.rates, []domain.BookingValidator{m.validator})
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := h.Handle(context.TODO(), tc.qry)
+			// then
+			assert.Equal(t, tc.want, got,
+				"QuoteBookingHandler.Handle() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.wantErr, err,
+				"QuoteBookingHandler.Handle() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.rates, m.validator)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package validator
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
@@ -12,13 +15,25 @@ type BookingMaximumStay struct{}
 
 type BookingStartDateBeforeEndDate struct{}
 
+// BookingWithinSeason rejects bookings with nights the campground of the
+// campsite is closed, see domain.CampgroundSeason.
+type BookingWithinSeason struct {
+	Campsites domain.CampsiteRepository
+	Seasons   domain.CampgroundSeasonRepository
+	Policy    domain.SeasonPolicy
+}
+
 type ErrBookingAllowedStartDate struct{}
 
 type ErrBookingMaximumStay struct{}
 
 type ErrBookingStartDateBeforeEndDate struct{}
 
-func (v BookingAllowedStartDate) Validate(b *domain.Booking) error {
+type ErrBookingWithinSeason struct {
+	Date time.Time
+}
+
+func (v BookingAllowedStartDate) Validate(_ context.Context, b *domain.Booking) error {
 	now := time.Now()
 	if b.StartDate.After(now) && b.StartDate.Before(now.AddDate(0, 1, 0)) {
 		return nil
@@ -26,38 +41,90 @@ func (v BookingAllowedStartDate) Validate(b *domain.Booking) error {
 	return ErrBookingAllowedStartDate{}
 }
 
-func (v BookingMaximumStay) Validate(b *domain.Booking) error {
+func (v BookingMaximumStay) Validate(_ context.Context, b *domain.Booking) error {
 	if b.EndDate.Sub(b.StartDate).Hours()/24 <= 3 {
 		return nil
 	}
 	return ErrBookingMaximumStay{}
 }
 
-func (v BookingStartDateBeforeEndDate) Validate(b *domain.Booking) error {
+func (v BookingStartDateBeforeEndDate) Validate(_ context.Context, b *domain.Booking) error {
 	if b.StartDate.Before(b.EndDate) {
 		return nil
 	}
 	return ErrBookingStartDateBeforeEndDate{}
 }
 
+// Validate skips bookings without a campsite, e.g. waitlist entries for any
+// campsite of a campground.
+func (v BookingWithinSeason) Validate(ctx context.Context, b *domain.Booking) error {
+	if b.CampsiteID == "" {
+		return nil
+	}
+	campsite, err := v.Campsites.Find(ctx, b.CampsiteID)
+	if err != nil {
+		return err
+	}
+	season, err := v.Policy.SeasonFor(ctx, v.Seasons, campsite.CampgroundID)
+	if err != nil {
+		return err
+	}
+	if date, closed := season.FirstClosedDate(b.StartDate, b.EndDate); closed {
+		return ErrBookingWithinSeason{Date: date}
+	}
+	return nil
+}
+
 func (e ErrBookingAllowedStartDate) Error() string {
 	return "start_date: must be from 1 day to up to 1 month ahead"
 }
 
+func (e ErrBookingAllowedStartDate) Field() string {
+	return "start_date"
+}
+
 func (e ErrBookingMaximumStay) Error() string {
 	return "maximum stay: must be less or equal to three days"
 }
 
+func (e ErrBookingMaximumStay) Field() string {
+	return "end_date"
+}
+
 func (e ErrBookingStartDateBeforeEndDate) Error() string {
 	return "start_date: must be before end_date"
 }
 
-func Apply(validators []domain.BookingValidator, booking *domain.Booking) error {
+func (e ErrBookingStartDateBeforeEndDate) Field() string {
+	return "start_date"
+}
+
+func (e ErrBookingWithinSeason) Error() string {
+	return fmt.Sprintf("dates: campground closed on %s", e.Date.Format(time.DateOnly))
+}
+
+func (e ErrBookingWithinSeason) Field() string {
+	return "start_date"
+}
+
+// Apply collects the violations of the validators, i.e. their domain.FieldError
+// errors, into a domain.ErrBookingValidation. Any other error, e.g. of a
+// repository, is returned as is at once.
+func Apply(
+	ctx context.Context,
+	validators []domain.BookingValidator,
+	booking *domain.Booking,
+) error {
 	merr := domain.ErrBookingValidation{}
 
 	for _, v := range validators {
-		if err := v.Validate(booking); err != nil {
+		var violation domain.FieldError
+		switch err := v.Validate(ctx, booking); {
+		case err == nil:
+		case errors.As(err, &violation):
 			merr.Append(err)
+		default:
+			return err
 		}
 	}
 	if merr.MultiErr.ErrorOrNil() != nil {
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -41,7 +42,7 @@ func TestBookingAllowedStartDate_Validate(t *testing.T) {
 			// given
 
 			// when
-			err := v.Validate(tc.booking)
+			err := v.Validate(context.TODO(), tc.booking)
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"BookingAllowedStartDate.Validate() error = %v, wantErr %v",
@@ -86,7 +87,7 @@ func TestBookingMaximumStay_Validate(t *testing.T) {
 			// given
 
 			// when
-			err := v.Validate(tc.booking)
+			err := v.Validate(context.TODO(), tc.booking)
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"BookingMaximumStay.Validate() error = %v, wantErr %v",
@@ -131,7 +132,7 @@ func TestBookingStartDateBeforeEndDate_Validate(t *testing.T) {
 			// given
 
 			// when
-			err := v.Validate(tc.booking)
+			err := v.Validate(context.TODO(), tc.booking)
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"BookingStartDateBeforeEndDate.Validate() error = %v, wantErr %v",
@@ -140,11 +141,133 @@ func TestBookingStartDateBeforeEndDate_Validate(t *testing.T) {
 	}
 }
 
+func TestBookingWithinSeason_Validate(t *testing.T) {
+	type mocks struct {
+		campsites *domain.MockCampsiteRepository
+		seasons   *domain.MockCampgroundSeasonRepository
+	}
+	campsite := &domain.Campsite{CampsiteID: "campsite-id", CampgroundID: "campground-id"}
+	// May to October, closed on Mondays
+	season := &domain.CampgroundSeason{
+		CampgroundID:   campsite.CampgroundID,
+		OpensOn:        "05-01",
+		ClosesOn:       "10-31",
+		ClosedWeekdays: []time.Weekday{time.Monday},
+	}
+	policy := domain.SeasonPolicy{Default: domain.CampgroundSeason{
+		ClosedWeekdays: []time.Weekday{time.Tuesday},
+	}}
+	errCampgroundSeasonNotFound := domain.ErrCampgroundSeasonNotFound{
+		CampgroundID: campsite.CampgroundID,
+	}
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		booking *domain.Booking
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success_InSeason": {
+			// Friday to Sunday
+			booking: &domain.Booking{
+				CampsiteID: campsite.CampsiteID,
+				StartDate:  date(time.June, 6),
+				EndDate:    date(time.June, 9),
+			},
+			on: func(f mocks) {
+				f.campsites.On("Find", context.TODO(), campsite.CampsiteID).Return(campsite, nil)
+				f.seasons.On("Find", context.TODO(), campsite.CampgroundID).Return(season, nil)
+			},
+			wantErr: nil,
+		},
+		"Success_NoCampsite": {
+			booking: &domain.Booking{StartDate: date(time.June, 9), EndDate: date(time.June, 10)},
+			wantErr: nil,
+		},
+		"Error_BeforeSeasonOpens": {
+			booking: &domain.Booking{
+				CampsiteID: campsite.CampsiteID,
+				StartDate:  date(time.April, 30),
+				EndDate:    date(time.May, 2),
+			},
+			on: func(f mocks) {
+				f.campsites.On("Find", context.TODO(), campsite.CampsiteID).Return(campsite, nil)
+				f.seasons.On("Find", context.TODO(), campsite.CampgroundID).Return(season, nil)
+			},
+			wantErr: ErrBookingWithinSeason{Date: date(time.April, 30)},
+		},
+		"Error_ClosedWeekday": {
+			// Sunday to Tuesday
+			booking: &domain.Booking{
+				CampsiteID: campsite.CampsiteID,
+				StartDate:  date(time.June, 8),
+				EndDate:    date(time.June, 10),
+			},
+			on: func(f mocks) {
+				f.campsites.On("Find", context.TODO(), campsite.CampsiteID).Return(campsite, nil)
+				f.seasons.On("Find", context.TODO(), campsite.CampgroundID).Return(season, nil)
+			},
+			wantErr: ErrBookingWithinSeason{Date: date(time.June, 9)},
+		},
+		"Error_DefaultSeason_ClosedWeekday": {
+			// Monday to Wednesday
+			booking: &domain.Booking{
+				CampsiteID: campsite.CampsiteID,
+				StartDate:  date(time.June, 9),
+				EndDate:    date(time.June, 11),
+			},
+			on: func(f mocks) {
+				f.campsites.On("Find", context.TODO(), campsite.CampsiteID).Return(campsite, nil)
+				f.seasons.
+					On("Find", context.TODO(), campsite.CampgroundID).
+					Return(nil, errCampgroundSeasonNotFound)
+			},
+			wantErr: ErrBookingWithinSeason{Date: date(time.June, 10)},
+		},
+		"Error_FindCampsite": {
+			booking: &domain.Booking{
+				CampsiteID: campsite.CampsiteID,
+				StartDate:  date(time.June, 6),
+				EndDate:    date(time.June, 9),
+			},
+			on: func(f mocks) {
+				f.campsites.
+					On("Find", context.TODO(), campsite.CampsiteID).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{
+				campsites: domain.NewMockCampsiteRepository(t),
+				seasons:   domain.NewMockCampgroundSeasonRepository(t),
+			}
+			v := BookingWithinSeason{Campsites: m.campsites, Seasons: m.seasons, Policy: policy}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			err := v.Validate(context.TODO(), tc.booking)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"BookingWithinSeason.Validate() error = %v, wantErr %v",
+				err, tc.wantErr)
+		})
+	}
+}
+
 func TestApply(t *testing.T) {
 	now := bootstrap.AsStartOfDayUTC(time.Now())
 
 	tests := map[string]struct {
 		booking *domain.Booking
+		err     error
 		wantErr error
 	}{
 		"Success": {
@@ -154,6 +277,14 @@ func TestApply(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"Error_NotViolation": {
+			booking: &domain.Booking{
+				StartDate: now.AddDate(0, 0, 2),
+				EndDate:   now.AddDate(0, 0, 1),
+			},
+			err:     bootstrap.ErrQuery,
+			wantErr: bootstrap.ErrQuery,
+		},
 		"Error_BookingStartDateBeforeEndDateValidator": {
 			booking: &domain.Booking{
 				StartDate: now.AddDate(0, 0, 2),
@@ -180,13 +311,16 @@ func TestApply(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// given
+			v := domain.NewMockBookingValidator(t)
+			v.On("Validate", context.TODO(), tc.booking).Return(tc.err)
 			validators := []domain.BookingValidator{
 				BookingStartDateBeforeEndDate{},
 				BookingAllowedStartDate{},
 				BookingMaximumStay{},
+				v,
 			}
 			// when
-			err := Apply(validators, tc.booking)
+			err := Apply(context.TODO(), validators, tc.booking)
 			// then
 			assert.Equal(t, tc.wantErr, err,
 				"Apply() error = %v, wantErr %v", err, tc.wantErr)
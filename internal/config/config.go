@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
@@ -12,20 +13,132 @@ import (
 
 type (
 	PGConfig struct {
-		Conn string `envconfig:"PG_CONN" default:"host=postgres dbname=${CAMPGROUNDS_DB} user=${CAMPGROUNDS_USER} password=${CAMPGROUNDS_PASSWORD}"`
+		Conn string `envconfig:"PG_CONN"                   default:"host=postgres dbname=${CAMPGROUNDS_DB} user=${CAMPGROUNDS_USER} password=${CAMPGROUNDS_PASSWORD}"`
+		// Time to wait for another replica to finish migrating the database.
+		MigrationLockTimeout time.Duration `envconfig:"PG_MIGRATION_LOCK_TIMEOUT" default:"5m"`
+		// Read replica the read-only transactions are routed to, none if empty.
+		ReplicaConn string `envconfig:"PG_REPLICA_CONN"`
+		// Replication lag beyond which the reads fall back to the primary.
+		ReplicaMaxLag        time.Duration `envconfig:"PG_REPLICA_MAX_LAG"        default:"5s"`
+		ReplicaCheckInterval time.Duration `envconfig:"PG_REPLICA_CHECK_INTERVAL" default:"5s"`
+		// Connections open at once to the primary, and to the replica.
+		MaxOpenConns int `envconfig:"PG_MAX_OPEN_CONNS"         default:"25"`
 	}
 
 	RPCConfig struct {
 		Host string `default:"0.0.0.0"`
 		Port string `default:":8085"`
+		// PEM files of the server certificate and key, plaintext is served if
+		// not set. The files are reloaded when modified.
+		TLSCertFile string `                  envconfig:"RPC_TLS_CERT_FILE"`
+		TLSKeyFile  string `                  envconfig:"RPC_TLS_KEY_FILE"`
+		// PEM file of the CAs to verify client certificates against, enables
+		// mutual TLS if set.
+		TLSClientCAFile string `                  envconfig:"RPC_TLS_CLIENT_CA_FILE"`
+		// Minimum TLS version, either 1.2 or 1.3.
+		TLSMinVersion string `default:"1.2"     envconfig:"RPC_TLS_MIN_VERSION"`
+	}
+
+	RateLimitConfig struct {
+		// Calls per second and burst of a client, identified by its certificate
+		// or its address, to all methods; 0 is unlimited.
+		ClientRPS   float64 `envconfig:"RATE_LIMIT_CLIENT_RPS"    default:"100"`
+		ClientBurst int     `envconfig:"RATE_LIMIT_CLIENT_BURST"  default:"200"`
+		// Calls per second of a client to the methods keyed by name, with a
+		// burst of as many calls, e.g. CreateBooking:10.
+		MethodRPS map[string]float64 `envconfig:"RATE_LIMIT_METHOD_RPS"    default:"CreateBooking:10,UpdateBooking:10"`
+		// Calls handled at once across all clients, the size of the database
+		// connection pool if 0; negative is unlimited.
+		MaxInFlight int `envconfig:"RATE_LIMIT_MAX_IN_FLIGHT"`
+	}
+
+	HTTPConfig struct {
+		Host string `default:"0.0.0.0"`
+		Port string `default:":8086"`
+	}
+
+	AdminConfig struct {
+		// Serves the metrics, build info and log level endpoints, on the
+		// loopback interface only unless another host is set.
+		Enabled bool   `envconfig:"ADMIN_ENABLED" default:"true"`
+		Host    string `envconfig:"ADMIN_HOST"    default:"127.0.0.1"`
+		Port    string `envconfig:"ADMIN_PORT"    default:":6060"`
+		// Serves the pprof profiles too, exposing the internals of the process.
+		Pprof bool `envconfig:"ADMIN_PPROF"   default:"false"`
+	}
+
+	ProbeConfig struct {
+		// Serves the liveness and readiness endpoints apart from the admin
+		// server, on all interfaces for the probes to reach them.
+		Enabled bool   `envconfig:"PROBE_ENABLED" default:"true"`
+		Host    string `envconfig:"PROBE_HOST"    default:"0.0.0.0"`
+		Port    string `envconfig:"PROBE_PORT"    default:":6061"`
+	}
+
+	PaymentConfig struct {
+		// Payment gateway adapter, either "fake" (in-process) or "http".
+		Gateway        string        `envconfig:"PAYMENT_GATEWAY"         default:"fake"`
+		APIURL         string        `envconfig:"PAYMENT_API_URL"`
+		APIKey         string        `envconfig:"PAYMENT_API_KEY"`
+		Timeout        time.Duration `envconfig:"PAYMENT_TIMEOUT"         default:"10s"`
+		DepositPercent int32         `envconfig:"PAYMENT_DEPOSIT_PERCENT" default:"30"`
+	}
+
+	CancellationConfig struct {
+		// Refund percentages of the deposit keyed by minimum number of days
+		// between cancellation and start date, e.g. 8:100,0:50.
+		RefundTiers map[int]int32 `envconfig:"CANCELLATION_REFUND_TIERS" default:"8:100,0:50"`
+	}
+
+	WaitlistConfig struct {
+		// Time a guest has to accept an offer for vacated dates.
+		OfferTTL time.Duration `envconfig:"WAITLIST_OFFER_TTL"       default:"24h"`
+		// Interval between runs of the job expiring the offers not accepted in
+		// time and offering their dates again.
+		ExpiryInterval time.Duration `envconfig:"WAITLIST_EXPIRY_INTERVAL" default:"1m"`
+	}
+
+	SeasonConfig struct {
+		// Default season of campgrounds without one of their own, open all year
+		// if not set. Opening and closing month-days, e.g. 05-01 and 10-31.
+		OpensOn  string `envconfig:"SEASON_OPENS_ON"`
+		ClosesOn string `envconfig:"SEASON_CLOSES_ON"`
+		// Weekdays closed, e.g. MONDAY,TUESDAY.
+		ClosedWeekdays []string `envconfig:"SEASON_CLOSED_WEEKDAYS"`
+	}
+
+	CalendarConfig struct {
+		// Interval between syncs of the external calendars subscribed to.
+		SyncInterval time.Duration `envconfig:"CALENDAR_SYNC_INTERVAL" default:"15m"`
+		FetchTimeout time.Duration `envconfig:"CALENDAR_FETCH_TIMEOUT" default:"30s"`
+	}
+
+	RetentionConfig struct {
+		// Days the personal data of bookings is kept after their stay ended,
+		// kept forever if 0.
+		Days int `envconfig:"RETENTION_DAYS"     default:"0"`
+		// Interval between runs of the retention job.
+		Interval time.Duration `envconfig:"RETENTION_INTERVAL" default:"24h"`
 	}
 
 	AppConfig struct {
 		Environment     string
-		LogLevel        string `envconfig:"LOG_LEVEL"        default:"DEBUG"`
+		LogLevel        string `envconfig:"LOG_LEVEL"            default:"DEBUG"`
 		PG              PGConfig
 		RPC             RPCConfig
-		ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"30s"`
+		RateLimit       RateLimitConfig
+		HTTP            HTTPConfig
+		Admin           AdminConfig
+		Probe           ProbeConfig
+		Payment         PaymentConfig
+		Cancellation    CancellationConfig
+		Waitlist        WaitlistConfig
+		Season          SeasonConfig
+		Calendar        CalendarConfig
+		Retention       RetentionConfig
+		ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT"     default:"30s"`
+		// Fields redacted in the logs, matched ignoring case and underscores.
+		LogSensitiveFields []string `envconfig:"LOG_SENSITIVE_FIELDS" default:"Email,FullName,Phone,Address,Preferences,GuestData,PaymentMethod"`
 	}
 )
 
@@ -33,14 +146,85 @@ func (c RPCConfig) Address() string {
 	return fmt.Sprintf("%s%s", c.Host, c.Port)
 }
 
+// TLSEnabled reports whether the server certificate is set.
+func (c RPCConfig) TLSEnabled() bool {
+	return c.TLSCertFile != "" || c.TLSKeyFile != ""
+}
+
+// validate rejects a server certificate without its key, or the other way
+// around, and a client CA without the server certificate, as mutual TLS could
+// not be served.
+func (c RPCConfig) validate() error {
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("RPC_TLS_CERT_FILE and RPC_TLS_KEY_FILE must be set together")
+	}
+	if c.TLSClientCAFile != "" && !c.TLSEnabled() {
+		return errors.New(
+			"RPC_TLS_CLIENT_CA_FILE requires RPC_TLS_CERT_FILE and RPC_TLS_KEY_FILE to be set",
+		)
+	}
+	return nil
+}
+
+// MaxInFlight returns the cap of the calls handled at once, derived from the
+// database connection pool unless set, as every call holds at most one
+// connection at a time and the calls over the pool would only wait for one.
+func (c AppConfig) MaxInFlight() int {
+	if c.RateLimit.MaxInFlight != 0 {
+		return c.RateLimit.MaxInFlight
+	}
+	return c.PG.MaxOpenConns
+}
+
+func (c HTTPConfig) Address() string {
+	return fmt.Sprintf("%s%s", c.Host, c.Port)
+}
+
+func (c AdminConfig) Address() string {
+	return fmt.Sprintf("%s%s", c.Host, c.Port)
+}
+
+func (c ProbeConfig) Address() string {
+	return fmt.Sprintf("%s%s", c.Host, c.Port)
+}
+
 func InitConfig() (AppConfig, error) {
 	cfg := AppConfig{}
 	if err := dotenv.Load(dotenv.EnvironmentFiles(os.Getenv("ENVIRONMENT"))); err != nil {
 		return cfg, err
 	}
-	err := envconfig.Process("", &cfg)
+	if err := envconfig.Process("", &cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.validate()
+}
 
-	return cfg, err
+// validate rejects the settings the app would fail on once started, e.g. the
+// intervals of its jobs, which must be positive, or would silently run with
+// less security than asked for, e.g. plaintext with a client CA set.
+func (c AppConfig) validate() error {
+	if err := c.RPC.validate(); err != nil {
+		return err
+	}
+	type interval struct {
+		name     string
+		interval time.Duration
+	}
+	intervals := []interval{
+		{name: "RETENTION_INTERVAL", interval: c.Retention.Interval},
+		{name: "WAITLIST_EXPIRY_INTERVAL", interval: c.Waitlist.ExpiryInterval},
+		{name: "CALENDAR_SYNC_INTERVAL", interval: c.Calendar.SyncInterval},
+	}
+	if c.PG.ReplicaConn != "" {
+		intervals = append(intervals,
+			interval{name: "PG_REPLICA_CHECK_INTERVAL", interval: c.PG.ReplicaCheckInterval})
+	}
+	for _, i := range intervals {
+		if i.interval <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", i.name, i.interval)
+		}
+	}
+	return nil
 }
 
 // ReplaceEnvPlaceholders replaces placeholders of the format ${VAR_NAME}
@@ -12,12 +12,159 @@ func TestInitConfig(t *testing.T) {
 	// given
 	os.Setenv("LOG_LEVEL", "INFO")
 	os.Setenv("SHUTDOWN_TIMEOUT", "15s")
+	os.Setenv("PAYMENT_DEPOSIT_PERCENT", "50")
 	// when
 	cfg, err := InitConfig()
 	// then
 	assert.NoError(t, err)
 	assert.Equal(t, "INFO", cfg.LogLevel)
 	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, []string{
+		"Email", "FullName", "Phone", "Address", "Preferences",
+		"GuestData", "PaymentMethod",
+	},
+		cfg.LogSensitiveFields)
+	assert.Equal(t, "0.0.0.0:8086", cfg.HTTP.Address())
+	assert.False(t, cfg.RPC.TLSEnabled())
+	assert.Equal(t, "1.2", cfg.RPC.TLSMinVersion)
+	assert.Equal(t, 100.0, cfg.RateLimit.ClientRPS)
+	assert.Equal(t, 200, cfg.RateLimit.ClientBurst)
+	assert.Equal(t, map[string]float64{"CreateBooking": 10, "UpdateBooking": 10},
+		cfg.RateLimit.MethodRPS)
+	assert.Equal(t, 0, cfg.RateLimit.MaxInFlight)
+	assert.Equal(t, 25, cfg.MaxInFlight())
+	assert.True(t, cfg.Admin.Enabled)
+	assert.Equal(t, "127.0.0.1:6060", cfg.Admin.Address())
+	assert.False(t, cfg.Admin.Pprof)
+	assert.True(t, cfg.Probe.Enabled)
+	assert.Equal(t, "0.0.0.0:6061", cfg.Probe.Address())
+	assert.Equal(t, 5*time.Minute, cfg.PG.MigrationLockTimeout)
+	assert.Empty(t, cfg.PG.ReplicaConn)
+	assert.Equal(t, 5*time.Second, cfg.PG.ReplicaMaxLag)
+	assert.Equal(t, 5*time.Second, cfg.PG.ReplicaCheckInterval)
+	assert.Equal(t, 25, cfg.PG.MaxOpenConns)
+	assert.Equal(t, "fake", cfg.Payment.Gateway)
+	assert.Equal(t, int32(50), cfg.Payment.DepositPercent)
+	assert.Equal(t, 10*time.Second, cfg.Payment.Timeout)
+	assert.Equal(t, map[int]int32{8: 100, 0: 50}, cfg.Cancellation.RefundTiers)
+	assert.Equal(t, 24*time.Hour, cfg.Waitlist.OfferTTL)
+	assert.Equal(t, time.Minute, cfg.Waitlist.ExpiryInterval)
+	assert.Equal(t, SeasonConfig{}, cfg.Season)
+	assert.Equal(t, 15*time.Minute, cfg.Calendar.SyncInterval)
+	assert.Equal(t, 30*time.Second, cfg.Calendar.FetchTimeout)
+	assert.Equal(t, 0, cfg.Retention.Days)
+	assert.Equal(t, 24*time.Hour, cfg.Retention.Interval)
+}
+
+func TestInitConfig_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		env     map[string]string
+		wantErr string
+	}{
+		"RetentionInterval_Zero": {
+			env:     map[string]string{"RETENTION_INTERVAL": "0s"},
+			wantErr: "RETENTION_INTERVAL must be positive, got 0s",
+		},
+		"RetentionInterval_Negative": {
+			env:     map[string]string{"RETENTION_INTERVAL": "-1h"},
+			wantErr: "RETENTION_INTERVAL must be positive, got -1h0m0s",
+		},
+		"WaitlistExpiryInterval_Zero": {
+			env:     map[string]string{"WAITLIST_EXPIRY_INTERVAL": "0s"},
+			wantErr: "WAITLIST_EXPIRY_INTERVAL must be positive, got 0s",
+		},
+		"CalendarSyncInterval_Negative": {
+			env:     map[string]string{"CALENDAR_SYNC_INTERVAL": "-15m"},
+			wantErr: "CALENDAR_SYNC_INTERVAL must be positive, got -15m0s",
+		},
+		"ReplicaCheckInterval_Zero": {
+			env: map[string]string{
+				"PG_REPLICA_CONN":           "host=replica",
+				"PG_REPLICA_CHECK_INTERVAL": "0s",
+			},
+			wantErr: "PG_REPLICA_CHECK_INTERVAL must be positive, got 0s",
+		},
+		"TLSCertFile_WithoutKeyFile": {
+			env:     map[string]string{"RPC_TLS_CERT_FILE": "server.crt"},
+			wantErr: "RPC_TLS_CERT_FILE and RPC_TLS_KEY_FILE must be set together",
+		},
+		"TLSKeyFile_WithoutCertFile": {
+			env:     map[string]string{"RPC_TLS_KEY_FILE": "server.key"},
+			wantErr: "RPC_TLS_CERT_FILE and RPC_TLS_KEY_FILE must be set together",
+		},
+		"TLSClientCAFile_WithoutCertAndKeyFiles": {
+			env:     map[string]string{"RPC_TLS_CLIENT_CA_FILE": "ca.crt"},
+			wantErr: "RPC_TLS_CLIENT_CA_FILE requires RPC_TLS_CERT_FILE and RPC_TLS_KEY_FILE to be set",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			// when
+			_, err := InitConfig()
+			// then
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestInitConfig_ReplicaCheckInterval_NoReplica(t *testing.T) {
+	// given
+	t.Setenv("PG_REPLICA_CHECK_INTERVAL", "0s")
+	// when
+	_, err := InitConfig()
+	// then
+	assert.NoError(t, err)
+}
+
+func TestInitConfig_MutualTLS(t *testing.T) {
+	// given
+	t.Setenv("RPC_TLS_CERT_FILE", "server.crt")
+	t.Setenv("RPC_TLS_KEY_FILE", "server.key")
+	t.Setenv("RPC_TLS_CLIENT_CA_FILE", "ca.crt")
+	// when
+	cfg, err := InitConfig()
+	// then
+	assert.NoError(t, err)
+	assert.True(t, cfg.RPC.TLSEnabled())
+}
+
+func TestAppConfig_MaxInFlight(t *testing.T) {
+	tests := map[string]struct {
+		maxInFlight int
+		want        int
+	}{
+		"NotSet_PoolSize": {
+			maxInFlight: 0,
+			want:        25,
+		},
+		"Set": {
+			maxInFlight: 10,
+			want:        10,
+		},
+		"Unlimited": {
+			maxInFlight: -1,
+			want:        -1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			cfg := AppConfig{
+				PG:        PGConfig{MaxOpenConns: 25},
+				RateLimit: RateLimitConfig{MaxInFlight: tc.maxInFlight},
+			}
+			// when
+			got := cfg.MaxInFlight()
+			// then
+			assert.Equal(t, tc.want, got, "MaxInFlight() got = %v, want %v", got, tc.want)
+		})
+	}
 }
 
 func TestReplaceEnvPlaceholders(t *testing.T) {
@@ -1,10 +1,39 @@
 package domain
 
 import (
-	"encoding/json"
+	"log/slog"
+	"slices"
 	"time"
 )
 
+type BookingStatus string
+
+const (
+	// BookingStatusPending is a booking whose deposit is not yet captured.
+	BookingStatusPending    BookingStatus = "PENDING"
+	BookingStatusConfirmed  BookingStatus = "CONFIRMED"
+	BookingStatusCheckedIn  BookingStatus = "CHECKED_IN"
+	BookingStatusCheckedOut BookingStatus = "CHECKED_OUT"
+	BookingStatusCancelled  BookingStatus = "CANCELLED"
+	BookingStatusNoShow     BookingStatus = "NO_SHOW"
+)
+
+// bookingTransitions lists the statuses a booking may move to from its
+// current status; checked-out, cancelled and no-show bookings are final.
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending: {BookingStatusConfirmed, BookingStatusCancelled},
+	BookingStatusConfirmed: {
+		BookingStatusCheckedIn, BookingStatusCancelled, BookingStatusNoShow,
+	},
+	BookingStatusCheckedIn: {BookingStatusCheckedOut},
+}
+
+// OccupyingBookingStatuses are the statuses of bookings holding their campsite
+// for their dates, i.e. counted when checking availability.
+var OccupyingBookingStatuses = []BookingStatus{
+	BookingStatusPending, BookingStatusConfirmed, BookingStatusCheckedIn,
+}
+
 type Booking struct {
 	// Persistence ID
 	ID int64
@@ -15,8 +44,44 @@ type Booking struct {
 	FullName   string
 	StartDate  time.Time
 	EndDate    time.Time
-	Active     bool
-	Version    int64
+	Guests     int32
+	// Price snapshot taken when booking was created or last updated, in minor
+	// units of Currency; zero with empty Currency if campsite has no rates.
+	TotalPrice int64
+	Currency   string
+	// Deposit charged when booking was created and reference of its payment at
+	// payment gateway; zero with empty PaymentRef if nothing was charged.
+	DepositAmount int64
+	PaymentRef    string
+	// Share of the deposit refunded when booking was cancelled.
+	RefundPercent int32
+	RefundAmount  int64
+	// Group booking the booking was created in, empty if booked alone.
+	GroupID string
+	// Guest the booking was matched to by email.
+	GuestID string
+	Status  BookingStatus
+	Version int64
+}
+
+// Occupies reports whether the booking holds its campsite for its dates.
+func (b *Booking) Occupies() bool {
+	return slices.Contains(OccupyingBookingStatuses, b.Status)
+}
+
+// Modifiable reports whether the dates, campsite or guest of the booking may
+// still change, i.e. the guest has not arrived yet.
+func (b *Booking) Modifiable() bool {
+	return b.Status == BookingStatusPending || b.Status == BookingStatusConfirmed
+}
+
+// TransitionTo moves the booking to the status if allowed from its current one.
+func (b *Booking) TransitionTo(status BookingStatus) error {
+	if !slices.Contains(bookingTransitions[b.Status], status) {
+		return ErrBookingStatusTransition{BookingID: b.BookingID, From: b.Status, To: status}
+	}
+	b.Status = status
+	return nil
 }
 
 func (b *Booking) BookingDates() []time.Time {
@@ -27,7 +92,24 @@ func (b *Booking) BookingDates() []time.Time {
 	return dates
 }
 
+// String formats the booking as LogValue does, so that the personal data of
+// its guest is left out of errors and logs formatting it with %v.
 func (b *Booking) String() string {
-	result, _ := json.Marshal(b)
-	return string(result)
+	return b.LogValue().String()
+}
+
+// LogValue logs the booking without the personal data of its guest, i.e. its
+// Email and FullName.
+func (b *Booking) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("booking_id", b.BookingID),
+		slog.String("campsite_id", b.CampsiteID),
+		slog.String("guest_id", b.GuestID),
+		slog.String("group_id", b.GroupID),
+		slog.String("start_date", b.StartDate.Format(time.DateOnly)),
+		slog.String("end_date", b.EndDate.Format(time.DateOnly)),
+		slog.Int64("guests", int64(b.Guests)),
+		slog.String("status", string(b.Status)),
+		slog.Int64("version", b.Version),
+	)
 }
@@ -13,6 +13,14 @@ type BookingRepository interface {
 		startDate time.Time,
 		endDate time.Time,
 	) ([]*Booking, error)
+	FindByGroupID(ctx context.Context, groupID string) ([]*Booking, error)
+	// FindByGuestID returns all bookings of the guest, latest stay first.
+	FindByGuestID(ctx context.Context, guestID string) ([]*Booking, error)
 	Insert(ctx context.Context, booking *Booking) error
+	// InsertGroup inserts all bookings of a group in a single transaction,
+	// none is inserted if dates of any of them are not available.
+	InsertGroup(ctx context.Context, bookings []*Booking) error
 	Update(ctx context.Context, booking *Booking) error
+	// UpdateGroup updates all bookings of a group in a single transaction.
+	UpdateGroup(ctx context.Context, bookings []*Booking) error
 }
@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"context"
+)
+
+// BookingValidator returns a FieldError if the booking violates its rule, and
+// any other error if it cannot tell, e.g. as a repository failed.
 type BookingValidator interface {
-	Validate(b *Booking) error
+	Validate(ctx context.Context, b *Booking) error
 }
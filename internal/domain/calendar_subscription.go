@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type (
+	// CalendarSubscription is an external calendar of a campsite listed on a
+	// third-party booking platform, whose events block the campsite like
+	// bookings do. It is either synced periodically from URL or, with an
+	// empty URL, uploaded once.
+	CalendarSubscription struct {
+		// Persistence ID
+		ID int64
+		// Business ID
+		SubscriptionID string
+		CampsiteID     string
+		Name           string
+		URL            string
+		// Time of the last successful sync, zero if never synced.
+		LastSyncedAt time.Time
+		// Reason the last sync failed, empty if it succeeded; blocks of the last
+		// successful sync are kept meanwhile.
+		LastSyncError string
+	}
+
+	// ExternalBlock is a date range reserved by an event of an external calendar.
+	ExternalBlock struct {
+		SubscriptionID string
+		CampsiteID     string
+		// UID of the event in the external calendar.
+		UID       string
+		StartDate time.Time
+		EndDate   time.Time
+		Summary   string
+	}
+)
+
+func (s *CalendarSubscription) Validate() error {
+	if s.Name == "" {
+		return ErrCalendarSubscriptionValidation{Reason: "name required"}
+	}
+	if s.URL == "" {
+		return nil
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Host == "" {
+		return ErrCalendarSubscriptionValidation{Reason: "invalid url " + s.URL}
+	}
+	switch u.Scheme {
+	case "http", "https", "webcal":
+		return nil
+	default:
+		return ErrCalendarSubscriptionValidation{Reason: "unsupported url scheme " + u.Scheme}
+	}
+}
+
+func (s *CalendarSubscription) String() string {
+	result, _ := json.Marshal(s)
+	return string(result)
+}
+
+func (b *ExternalBlock) BlockDates() []time.Time {
+	var dates []time.Time
+	for d := b.StartDate; d.Before(b.EndDate); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
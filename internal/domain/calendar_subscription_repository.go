@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type CalendarSubscriptionRepository interface {
+	Find(ctx context.Context, subscriptionID string) (*CalendarSubscription, error)
+	FindByCampsiteID(ctx context.Context, campsiteID string) ([]*CalendarSubscription, error)
+	// FindAllWithURL returns the subscriptions synced periodically from their URL.
+	FindAllWithURL(ctx context.Context) ([]*CalendarSubscription, error)
+	// FindBlocksForDateRange returns blocks of all subscriptions of the campsite
+	// overlapping the date range, in order of their start date.
+	FindBlocksForDateRange(
+		ctx context.Context,
+		campsiteID string,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*ExternalBlock, error)
+	// Insert inserts the subscription along with the blocks of an uploaded calendar.
+	Insert(ctx context.Context, subscription *CalendarSubscription, blocks []*ExternalBlock) error
+	// ReplaceBlocks replaces all blocks of the subscription with those of a
+	// successful sync and records the time of the sync.
+	ReplaceBlocks(
+		ctx context.Context,
+		subscriptionID string,
+		blocks []*ExternalBlock,
+		syncedAt time.Time,
+	) error
+	// UpdateSyncError records the reason the last sync of the subscription failed.
+	UpdateSyncError(ctx context.Context, subscriptionID string, reason string) error
+	// Delete deletes the subscription and its blocks.
+	Delete(ctx context.Context, subscriptionID string) error
+}
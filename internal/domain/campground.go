@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"encoding/json"
+)
+
+type Campground struct {
+	// Persistence ID
+	ID int64
+	// Business ID
+	CampgroundID string
+	Name         string
+	Description  string
+	Active       bool
+}
+
+func (c *Campground) String() string {
+	result, _ := json.Marshal(c)
+	return string(result)
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+)
+
+type CampgroundRepository interface {
+	Find(ctx context.Context, campgroundID string) (*Campground, error)
+	FindAll(ctx context.Context) ([]*Campground, error)
+	Insert(ctx context.Context, campground *Campground) error
+	Update(ctx context.Context, campground *Campground) error
+	Delete(ctx context.Context, campgroundID string) error
+}
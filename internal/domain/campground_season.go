@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MonthDayLayout is the layout of OpensOn and ClosesOn, recurring every year.
+const MonthDayLayout = "01-02"
+
+// CampgroundSeason is the yearly opening calendar of a campground. Nights
+// from OpensOn up to and including ClosesOn are open, except for nights
+// falling on ClosedWeekdays; a campground with neither OpensOn nor ClosesOn is
+// open all year. A season may span the new year, e.g. from 12-01 to 03-31.
+type CampgroundSeason struct {
+	// Persistence ID
+	ID int64
+	// Business ID
+	CampgroundID   string
+	OpensOn        string
+	ClosesOn       string
+	ClosedWeekdays []time.Weekday
+}
+
+// SeasonPolicy holds the season applying to campgrounds without one of their own.
+type SeasonPolicy struct {
+	Default CampgroundSeason
+}
+
+func (s *CampgroundSeason) Validate() error {
+	if (s.OpensOn == "") != (s.ClosesOn == "") {
+		return ErrCampgroundSeasonValidation{Reason: "opens_on and closes_on must both be set"}
+	}
+	for _, monthDay := range []string{s.OpensOn, s.ClosesOn} {
+		if monthDay == "" {
+			continue
+		}
+		if _, err := time.Parse(MonthDayLayout, monthDay); err != nil {
+			return ErrCampgroundSeasonValidation{Reason: "invalid month-day " + monthDay}
+		}
+	}
+	if len(s.ClosedWeekdays) == 7 {
+		return ErrCampgroundSeasonValidation{Reason: "closed on every weekday"}
+	}
+	return nil
+}
+
+// IsOpen reports whether the night of the given date is open for booking.
+func (s *CampgroundSeason) IsOpen(date time.Time) bool {
+	if slices.Contains(s.ClosedWeekdays, date.Weekday()) {
+		return false
+	}
+	if s.OpensOn == "" {
+		return true
+	}
+	monthDay := date.Format(MonthDayLayout)
+	if s.OpensOn <= s.ClosesOn {
+		return s.OpensOn <= monthDay && monthDay <= s.ClosesOn
+	}
+	return s.OpensOn <= monthDay || monthDay <= s.ClosesOn
+}
+
+// FirstClosedDate returns the first closed night from startDate up to, but not
+// including, endDate and false if all of them are open.
+func (s *CampgroundSeason) FirstClosedDate(startDate, endDate time.Time) (time.Time, bool) {
+	for d := startDate; d.Before(endDate); d = d.AddDate(0, 0, 1) {
+		if !s.IsOpen(d) {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (s *CampgroundSeason) String() string {
+	result, _ := json.Marshal(s)
+	return string(result)
+}
+
+// SeasonFor returns the season of the campground, the default season if the
+// campground has none of its own or the campsite is not part of a campground.
+func (p SeasonPolicy) SeasonFor(
+	ctx context.Context,
+	seasons CampgroundSeasonRepository,
+	campgroundID string,
+) (*CampgroundSeason, error) {
+	if campgroundID == "" {
+		return &p.Default, nil
+	}
+	season, err := seasons.Find(ctx, campgroundID)
+	if err != nil {
+		var notFound ErrCampgroundSeasonNotFound
+		if errors.As(err, &notFound) {
+			return &p.Default, nil
+		}
+		return nil, err
+	}
+	return season, nil
+}
+
+// ParseWeekdays parses weekday names, e.g. MONDAY, regardless of case.
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	for _, name := range names {
+		i := slices.IndexFunc(allWeekdays, func(w time.Weekday) bool {
+			return strings.EqualFold(w.String(), strings.TrimSpace(name))
+		})
+		if i < 0 {
+			return nil, ErrCampgroundSeasonValidation{Reason: "invalid weekday " + name}
+		}
+		if !slices.Contains(weekdays, allWeekdays[i]) {
+			weekdays = append(weekdays, allWeekdays[i])
+		}
+	}
+	slices.Sort(weekdays)
+	return weekdays, nil
+}
+
+// FormatWeekdays formats weekdays as upper case names, e.g. MONDAY.
+func FormatWeekdays(weekdays []time.Weekday) []string {
+	var names []string
+	for _, w := range weekdays {
+		names = append(names, strings.ToUpper(w.String()))
+	}
+	return names
+}
+
+var allWeekdays = []time.Weekday{
+	time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+	time.Saturday,
+}
@@ -0,0 +1,10 @@
+package domain
+
+import (
+	"context"
+)
+
+type CampgroundSeasonRepository interface {
+	Find(ctx context.Context, campgroundID string) (*CampgroundSeason, error)
+	Upsert(ctx context.Context, season *CampgroundSeason) error
+}
@@ -9,6 +9,7 @@ type Campsite struct {
 	ID int64
 	// Business ID
 	CampsiteID    string
+	CampgroundID  string
 	CampsiteCode  string
 	Capacity      int32
 	DrinkingWater bool
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CampsiteBlackout closes a campsite for a date range, e.g. for maintenance;
+// its dates are unavailable for booking just like dates of an active booking.
+type CampsiteBlackout struct {
+	// Persistence ID
+	ID int64
+	// Business ID
+	BlackoutID string
+	CampsiteID string
+	StartDate  time.Time
+	EndDate    time.Time
+	Reason     string
+}
+
+func (b *CampsiteBlackout) Validate() error {
+	if !b.StartDate.Before(b.EndDate) {
+		return ErrCampsiteBlackoutValidation{Reason: "start_date must be before end_date"}
+	}
+	if b.Reason == "" {
+		return ErrCampsiteBlackoutValidation{Reason: "reason required"}
+	}
+	return nil
+}
+
+func (b *CampsiteBlackout) BlackoutDates() []time.Time {
+	var dates []time.Time
+	for d := b.StartDate; d.Before(b.EndDate); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+func (b *CampsiteBlackout) String() string {
+	result, _ := json.Marshal(b)
+	return string(result)
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type CampsiteBlackoutRepository interface {
+	Find(ctx context.Context, blackoutID string) (*CampsiteBlackout, error)
+	FindByCampsiteID(ctx context.Context, campsiteID string) ([]*CampsiteBlackout, error)
+	// FindForDateRange returns blackouts of the campsite overlapping the date
+	// range, in order of their start date.
+	FindForDateRange(
+		ctx context.Context,
+		campsiteID string,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*CampsiteBlackout, error)
+	Insert(ctx context.Context, blackout *CampsiteBlackout) error
+	Delete(ctx context.Context, blackoutID string) error
+}
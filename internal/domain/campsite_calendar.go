@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CampsiteCalendar lists what holds a campsite between two dates: its
+// occupying bookings and its blackouts, each in order of their start date.
+type CampsiteCalendar struct {
+	CampsiteID string
+	StartDate  time.Time
+	EndDate    time.Time
+	Bookings   []*Booking
+	Blackouts  []*CampsiteBlackout
+}
+
+func (c *CampsiteCalendar) String() string {
+	result, _ := json.Marshal(c)
+	return string(result)
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"encoding/json"
+)
+
+type CampsiteImportMode string
+
+const (
+	// CampsiteImportModeAllOrNothing imports no campsite unless every row is
+	// valid and upserted.
+	CampsiteImportModeAllOrNothing CampsiteImportMode = "ALL_OR_NOTHING"
+	// CampsiteImportModeBestEffort imports the valid rows and reports the others.
+	CampsiteImportModeBestEffort CampsiteImportMode = "BEST_EFFORT"
+)
+
+type CampsiteImportStatus string
+
+const (
+	CampsiteImportStatusCreated CampsiteImportStatus = "CREATED"
+	CampsiteImportStatusUpdated CampsiteImportStatus = "UPDATED"
+	CampsiteImportStatusFailed  CampsiteImportStatus = "FAILED"
+	// CampsiteImportStatusSkipped is set on the valid rows left out by an
+	// all-or-nothing import that failed.
+	CampsiteImportStatusSkipped CampsiteImportStatus = "SKIPPED"
+)
+
+// CampsiteImportRow is a campsite upserted by its code along with the outcome
+// of the upsert.
+type CampsiteImportRow struct {
+	// Position of the row in the import, starting at 1
+	Row      int
+	Campsite *Campsite
+	// Whether Campsite.Active was imported, an existing campsite keeps its
+	// active flag otherwise.
+	ActiveImported bool
+	Status         CampsiteImportStatus
+	Error          string
+}
+
+// Fail marks the row as failed for the reason.
+func (r *CampsiteImportRow) Fail(reason string) {
+	r.Status = CampsiteImportStatusFailed
+	r.Error = reason
+}
+
+func (r *CampsiteImportRow) String() string {
+	result, _ := json.Marshal(r)
+	return string(result)
+}
+
+// GoString formats the row as String does within the commands logged with %#v.
+func (r *CampsiteImportRow) GoString() string {
+	return r.String()
+}
+
+// SkipCampsiteImportRows marks every row but the failed ones as skipped.
+func SkipCampsiteImportRows(rows []*CampsiteImportRow) {
+	for _, row := range rows {
+		if row.Status != CampsiteImportStatusFailed {
+			row.Status = CampsiteImportStatusSkipped
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// CampsiteRates is the price list of a campsite. All amounts are in minor units
+// of Currency, e.g. cents.
+type CampsiteRates struct {
+	// Persistence ID
+	ID int64
+	// Business ID
+	CampsiteID         string
+	Currency           string
+	NightlyRate        int64
+	WeekendNightlyRate int64
+	IncludedGuests     int32
+	ExtraGuestFee      int64
+	// Tax rate in basis points, i.e. 1300 is 13%.
+	TaxRateBps int32
+	Seasons    []SeasonalRate
+}
+
+// SeasonalRate overrides the base rates for the nights from StartDate up to,
+// but not including, EndDate.
+type SeasonalRate struct {
+	Name               string
+	StartDate          time.Time
+	EndDate            time.Time
+	NightlyRate        int64
+	WeekendNightlyRate int64
+}
+
+type NightlyPrice struct {
+	Date    time.Time
+	Amount  int64
+	Weekend bool
+	// Name of the season applied, empty for the base rates.
+	Season string
+}
+
+type Quote struct {
+	CampsiteID     string
+	StartDate      time.Time
+	EndDate        time.Time
+	Guests         int32
+	Currency       string
+	Nights         []NightlyPrice
+	NightsSubtotal int64
+	ExtraGuestFees int64
+	Taxes          int64
+	Total          int64
+}
+
+// Validate checks that every season spans at least one night and that no two
+// seasons overlap.
+func (r *CampsiteRates) Validate() error {
+	seasons := slices.Clone(r.Seasons)
+	slices.SortFunc(seasons, func(a, b SeasonalRate) int {
+		return a.StartDate.Compare(b.StartDate)
+	})
+	for i, s := range seasons {
+		if !s.StartDate.Before(s.EndDate) {
+			return ErrCampsiteRatesValidation{
+				Reason: "season " + s.Name + ": start_date must be before end_date",
+			}
+		}
+		if i > 0 && s.StartDate.Before(seasons[i-1].EndDate) {
+			return ErrCampsiteRatesValidation{
+				Reason: "season " + s.Name + ": overlaps season " + seasons[i-1].Name,
+			}
+		}
+	}
+	return nil
+}
+
+// Quote prices every night from startDate up to, but not including, endDate
+// for the given number of guests.
+func (r *CampsiteRates) Quote(startDate time.Time, endDate time.Time, guests int32) *Quote {
+	quote := &Quote{
+		CampsiteID: r.CampsiteID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Guests:     guests,
+		Currency:   r.Currency,
+	}
+	extraGuests := int64(max(guests-r.IncludedGuests, 0))
+
+	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+		night := r.nightlyPrice(date)
+		quote.Nights = append(quote.Nights, night)
+		quote.NightsSubtotal += night.Amount
+		quote.ExtraGuestFees += extraGuests * r.ExtraGuestFee
+	}
+	// round half up to the nearest minor unit
+	quote.Taxes = ((quote.NightsSubtotal+quote.ExtraGuestFees)*int64(r.TaxRateBps) + 5000) / 10000
+	quote.Total = quote.NightsSubtotal + quote.ExtraGuestFees + quote.Taxes
+	return quote
+}
+
+func (r *CampsiteRates) nightlyPrice(date time.Time) NightlyPrice {
+	weekend := date.Weekday() == time.Friday || date.Weekday() == time.Saturday
+	price := NightlyPrice{Date: date, Amount: r.NightlyRate, Weekend: weekend}
+	if weekend && r.WeekendNightlyRate > 0 {
+		price.Amount = r.WeekendNightlyRate
+	}
+
+	for _, s := range r.Seasons {
+		if date.Before(s.StartDate) || !date.Before(s.EndDate) {
+			continue
+		}
+		price.Season = s.Name
+		price.Amount = s.NightlyRate
+		if weekend && s.WeekendNightlyRate > 0 {
+			price.Amount = s.WeekendNightlyRate
+		}
+		break
+	}
+	return price
+}
+
+func (r *CampsiteRates) String() string {
+	result, _ := json.Marshal(r)
+	return string(result)
+}
+
+func (q *Quote) String() string {
+	result, _ := json.Marshal(q)
+	return string(result)
+}
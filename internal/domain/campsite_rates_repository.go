@@ -0,0 +1,10 @@
+package domain
+
+import (
+	"context"
+)
+
+type CampsiteRatesRepository interface {
+	Find(ctx context.Context, campsiteID string) (*CampsiteRates, error)
+	Upsert(ctx context.Context, rates *CampsiteRates) error
+}
@@ -5,6 +5,12 @@ import (
 )
 
 type CampsiteRepository interface {
+	Find(ctx context.Context, campsiteID string) (*Campsite, error)
 	FindAll(ctx context.Context) ([]*Campsite, error)
+	FindByCampgroundID(ctx context.Context, campgroundID string) ([]*Campsite, error)
 	Insert(ctx context.Context, campsite *Campsite) error
+	// Upsert inserts or updates by code the campsites of the rows in one
+	// transaction and sets the outcome of each row. In the all-or-nothing mode
+	// a failed row rolls back the transaction and skips the other rows.
+	Upsert(ctx context.Context, rows []*CampsiteImportRow, mode CampsiteImportMode) error
 }
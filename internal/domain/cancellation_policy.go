@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type (
+	// CancellationPolicy defines the share of the deposit refunded when booking
+	// is cancelled, depending on how many days ahead of its start date.
+	CancellationPolicy struct {
+		// Refund tiers ordered by MinDaysBefore descending.
+		Tiers []RefundTier
+	}
+
+	RefundTier struct {
+		// Minimum number of days between cancellation date and start date.
+		MinDaysBefore int
+		RefundPercent int32
+	}
+
+	CancellationRefund struct {
+		Percent int32
+		// Refunded share of the deposit, in minor units of booking currency.
+		Amount int64
+	}
+)
+
+// NewCancellationPolicy creates a policy from refund percentages keyed by
+// minimum number of days before start date, e.g. {8: 100, 0: 50}.
+func NewCancellationPolicy(refundTiers map[int]int32) CancellationPolicy {
+	p := CancellationPolicy{}
+	for days, percent := range refundTiers {
+		p.Tiers = append(p.Tiers, RefundTier{
+			MinDaysBefore: days,
+			RefundPercent: min(max(percent, 0), 100),
+		})
+	}
+	sort.Slice(p.Tiers, func(i, j int) bool {
+		return p.Tiers[i].MinDaysBefore > p.Tiers[j].MinDaysBefore
+	})
+	return p
+}
+
+// Evaluate returns the refund for cancelling the booking on the date of
+// cancelledAt. Bookings can be cancelled with no refund after their start date
+// and cannot be cancelled once their stay has ended.
+func (p CancellationPolicy) Evaluate(
+	booking *Booking,
+	cancelledAt time.Time,
+) (CancellationRefund, error) {
+	cancelDate := time.Date(
+		cancelledAt.Year(), cancelledAt.Month(), cancelledAt.Day(), 0, 0, 0, 0, time.UTC,
+	)
+	if !cancelDate.Before(booking.EndDate) {
+		return CancellationRefund{}, ErrCancellationNotAllowed{
+			BookingID: booking.BookingID,
+			Reason:    "stay has already ended",
+		}
+	}
+	if cancelDate.After(booking.StartDate) {
+		return CancellationRefund{}, nil
+	}
+
+	daysBefore := int(booking.StartDate.Sub(cancelDate).Hours() / 24)
+	for _, tier := range p.Tiers {
+		if daysBefore >= tier.MinDaysBefore {
+			return CancellationRefund{
+				Percent: tier.RefundPercent,
+				Amount:  (booking.DepositAmount*int64(tier.RefundPercent) + 50) / 100,
+			}, nil
+		}
+	}
+	return CancellationRefund{}, nil
+}
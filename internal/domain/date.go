@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// ParseDate parses the YYYY-MM-DD value of the field of a request, named as in
+// the API, and returns ErrInvalidDate if it is not a valid date.
+func ParseDate(field, value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate{FieldName: field, Value: value}
+	}
+	return date, nil
+}
@@ -8,6 +8,51 @@ import (
 )
 
 type (
+	// FieldError is implemented by validation errors of a single field of a
+	// request, named as in the API.
+	FieldError interface {
+		error
+		Field() string
+	}
+
+	ErrCampgroundNotFound struct {
+		CampgroundID string
+	}
+
+	// ErrInvalidDate is returned for a date of a request that is well-formed
+	// but not a valid YYYY-MM-DD date, e.g. 2025-02-30.
+	ErrInvalidDate struct {
+		FieldName string
+		Value     string
+	}
+
+	ErrGuestNotFound struct {
+		GuestID string
+	}
+
+	// ErrGuestEmailInUse carries no email, so that it can be logged.
+	ErrGuestEmailInUse struct{}
+
+	// ErrGuestHasActiveBookings is returned when anonymizing a guest whose
+	// bookings still hold campsites, which must be cancelled first.
+	ErrGuestHasActiveBookings struct{}
+
+	ErrCampgroundInUse struct {
+		CampgroundID string
+	}
+
+	ErrCampsiteNotFound struct {
+		CampsiteID string
+	}
+
+	ErrCampsiteRatesNotFound struct {
+		CampsiteID string
+	}
+
+	ErrCampsiteRatesValidation struct {
+		Reason string
+	}
+
 	ErrBookingNotFound struct {
 		BookingID string
 	}
@@ -17,17 +62,144 @@ type (
 		EndDate   time.Time
 	}
 
+	// ErrBookingDatesBlackedOut is returned instead of ErrBookingDatesNotAvailable
+	// when the dates are unavailable because the campsite is closed.
+	ErrBookingDatesBlackedOut struct {
+		StartDate time.Time
+		EndDate   time.Time
+		Reason    string
+	}
+
 	ErrBookingAlreadyCancelled struct {
 		BookingID string
 	}
 
+	ErrBookingStatusTransition struct {
+		BookingID string
+		From      BookingStatus
+		To        BookingStatus
+	}
+
+	ErrBookingNotModifiable struct {
+		BookingID string
+		Status    BookingStatus
+	}
+
+	ErrBookingNotStarted struct {
+		BookingID string
+		StartDate time.Time
+	}
+
 	ErrBookingValidation struct {
 		MultiErr *multierror.Error
 	}
 
 	ErrBookingConcurrentUpdate struct{}
+
+	ErrGroupBookingNotFound struct {
+		GroupID string
+	}
+
+	ErrGroupBookingAlreadyCancelled struct {
+		GroupID string
+	}
+
+	ErrGroupBookingValidation struct {
+		Reason string
+	}
+
+	ErrCampsiteBlackoutNotFound struct {
+		BlackoutID string
+	}
+
+	ErrCampsiteBlackoutValidation struct {
+		Reason string
+	}
+
+	ErrCampgroundSeasonNotFound struct {
+		CampgroundID string
+	}
+
+	ErrCampgroundSeasonValidation struct {
+		Reason string
+	}
+
+	ErrCampsiteImportValidation struct {
+		Reason string
+	}
+
+	ErrCalendarSubscriptionNotFound struct {
+		SubscriptionID string
+	}
+
+	ErrCalendarSubscriptionValidation struct {
+		Reason string
+	}
+
+	ErrCancellationNotAllowed struct {
+		BookingID string
+		Reason    string
+	}
+
+	ErrWaitlistEntryNotFound struct {
+		EntryID string
+	}
+
+	ErrWaitlistOfferNotFound struct {
+		EntryID string
+	}
+
+	ErrWaitlistOfferExpired struct {
+		EntryID string
+	}
+
+	ErrPaymentMethodRequired struct{}
+
+	ErrPaymentDeclined struct {
+		Reason string
+	}
 )
 
+func (e ErrCampgroundNotFound) Error() string {
+	return fmt.Sprintf("campground not found for CampgroundID %s", e.CampgroundID)
+}
+
+func (e ErrInvalidDate) Error() string {
+	return fmt.Sprintf("%s: invalid date %q, must be YYYY-MM-DD", e.FieldName, e.Value)
+}
+
+func (e ErrInvalidDate) Field() string {
+	return e.FieldName
+}
+
+func (e ErrGuestNotFound) Error() string {
+	return fmt.Sprintf("guest not found for GuestID %s", e.GuestID)
+}
+
+func (e ErrGuestEmailInUse) Error() string {
+	return "guest email already in use"
+}
+
+func (e ErrGuestHasActiveBookings) Error() string {
+	return "guest has pending, confirmed or checked-in bookings"
+}
+
+func (e ErrCampgroundInUse) Error() string {
+	return fmt.Sprintf("campground still has campsites for CampgroundID %s", e.CampgroundID)
+}
+
+func (e ErrCampsiteNotFound) Error() string {
+	return fmt.Sprintf("campsite not found for CampsiteID %s", e.CampsiteID)
+}
+
+func (e ErrCampsiteRatesNotFound) Error() string {
+	return fmt.Sprintf("campsite rates not found for CampsiteID %s", e.CampsiteID)
+}
+
+func (e ErrCampsiteRatesValidation) Error() string {
+	return fmt.Sprintf("campsite rates validation: %s", e.Reason)
+}
+
 func (e ErrBookingNotFound) Error() string {
 	return fmt.Sprintf("booking not found for BookingID %s", e.BookingID)
 }
@@ -37,10 +209,33 @@ func (e ErrBookingDatesNotAvailable) Error() string {
 		e.StartDate.Format(time.DateOnly), e.EndDate.Format(time.DateOnly))
 }
 
+func (e ErrBookingDatesBlackedOut) Error() string {
+	return fmt.Sprintf("booking dates not available from %s to %s: campsite closed for %s",
+		e.StartDate.Format(time.DateOnly), e.EndDate.Format(time.DateOnly), e.Reason)
+}
+
 func (e ErrBookingAlreadyCancelled) Error() string {
 	return fmt.Sprintf("booking already cancelled for BookingID %s", e.BookingID)
 }
 
+func (e ErrBookingStatusTransition) Error() string {
+	return fmt.Sprintf(
+		"booking status cannot change from %s to %s for BookingID %s",
+		e.From, e.To, e.BookingID,
+	)
+}
+
+func (e ErrBookingNotModifiable) Error() string {
+	return fmt.Sprintf("booking %s cannot be modified for BookingID %s", e.Status, e.BookingID)
+}
+
+func (e ErrBookingNotStarted) Error() string {
+	return fmt.Sprintf(
+		"booking not started before %s for BookingID %s",
+		e.StartDate.Format(time.DateOnly), e.BookingID,
+	)
+}
+
 func (e ErrBookingValidation) Error() string {
 	if e.MultiErr != nil {
 		return fmt.Sprintf("booking validation: %s", e.MultiErr.Error())
@@ -58,3 +253,67 @@ func (e *ErrBookingValidation) Append(err error) {
 func (e ErrBookingConcurrentUpdate) Error() string {
 	return "booking could not be updated due to concurrent modification"
 }
+
+func (e ErrGroupBookingNotFound) Error() string {
+	return fmt.Sprintf("group booking not found for GroupID %s", e.GroupID)
+}
+
+func (e ErrGroupBookingAlreadyCancelled) Error() string {
+	return fmt.Sprintf("group booking already cancelled for GroupID %s", e.GroupID)
+}
+
+func (e ErrGroupBookingValidation) Error() string {
+	return fmt.Sprintf("group booking validation: %s", e.Reason)
+}
+
+func (e ErrCampsiteBlackoutNotFound) Error() string {
+	return fmt.Sprintf("campsite blackout not found for BlackoutID %s", e.BlackoutID)
+}
+
+func (e ErrCampsiteBlackoutValidation) Error() string {
+	return fmt.Sprintf("campsite blackout validation: %s", e.Reason)
+}
+
+func (e ErrCampgroundSeasonNotFound) Error() string {
+	return fmt.Sprintf("campground season not found for CampgroundID %s", e.CampgroundID)
+}
+
+func (e ErrCampgroundSeasonValidation) Error() string {
+	return fmt.Sprintf("campground season validation: %s", e.Reason)
+}
+
+func (e ErrCampsiteImportValidation) Error() string {
+	return fmt.Sprintf("campsite import validation: %s", e.Reason)
+}
+
+func (e ErrCalendarSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("calendar subscription not found for SubscriptionID %s", e.SubscriptionID)
+}
+
+func (e ErrCalendarSubscriptionValidation) Error() string {
+	return fmt.Sprintf("calendar subscription validation: %s", e.Reason)
+}
+
+func (e ErrCancellationNotAllowed) Error() string {
+	return fmt.Sprintf("cancellation not allowed for BookingID %s: %s", e.BookingID, e.Reason)
+}
+
+func (e ErrWaitlistEntryNotFound) Error() string {
+	return fmt.Sprintf("waitlist entry not found for EntryID %s", e.EntryID)
+}
+
+func (e ErrWaitlistOfferNotFound) Error() string {
+	return fmt.Sprintf("no open offer for waitlist EntryID %s", e.EntryID)
+}
+
+func (e ErrWaitlistOfferExpired) Error() string {
+	return fmt.Sprintf("waitlist offer expired for EntryID %s", e.EntryID)
+}
+
+func (e ErrPaymentMethodRequired) Error() string {
+	return "payment method required to charge booking deposit"
+}
+
+func (e ErrPaymentDeclined) Error() string {
+	return fmt.Sprintf("payment declined: %s", e.Reason)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+)
+
+// ExternalCalendarReader is the port to calendars of third-party booking
+// platforms, it returns the date ranges blocked by their events. Blocks
+// returned have neither SubscriptionID nor CampsiteID set.
+type ExternalCalendarReader interface {
+	// Fetch downloads the calendar published at the URL.
+	Fetch(ctx context.Context, url string) ([]*ExternalBlock, error)
+	// Read reads an uploaded calendar.
+	Read(calendar []byte) ([]*ExternalBlock, error)
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// Guest is the profile of a person making bookings, matched by email when a
+// booking is created. Bookings keep a snapshot of Email and FullName taken
+// when they were created or the guest was last updated before their stay.
+type Guest struct {
+	// Persistence ID
+	ID int64
+	// Business ID
+	GuestID     string
+	Email       string
+	FullName    string
+	Phone       string
+	Address     string
+	Preferences string
+}
+
+// NormalizeEmail returns the email guests are matched by.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// String formats the guest as LogValue does.
+func (g *Guest) String() string {
+	return g.LogValue().String()
+}
+
+// LogValue logs the guest by its ID only, all other fields being personal
+// data.
+func (g *Guest) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("guest_id", g.GuestID))
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"log/slog"
+	"time"
+)
+
+type (
+	// GuestData is the personal data held about the person with an email, as
+	// exported on their request: their guest profile, the bookings made with
+	// the email or matched to the profile, and their waitlist entries.
+	GuestData struct {
+		Email string
+		// Guest profile with the email, nil if none.
+		Guest           *Guest
+		Bookings        []*Booking
+		WaitlistEntries []*WaitlistEntry
+		ExportedAt      time.Time
+	}
+
+	// RetentionPolicy defines how long the personal data of bookings is kept
+	// after their stay ended, before the bookings are anonymized.
+	RetentionPolicy struct {
+		// Days after the end of stay, personal data is kept forever if 0.
+		Days int
+	}
+)
+
+// Enabled reports whether bookings are anonymized once the period is over.
+func (p RetentionPolicy) Enabled() bool {
+	return p.Days > 0
+}
+
+// Cutoff returns the date bookings ending before are to be anonymized.
+func (p RetentionPolicy) Cutoff(now time.Time) time.Time {
+	y, m, d := now.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -p.Days)
+}
+
+// String formats the data as LogValue does.
+func (d *GuestData) String() string {
+	return d.LogValue().String()
+}
+
+// LogValue logs the number of records exported only, all of them holding
+// personal data.
+func (d *GuestData) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.Int("bookings", len(d.Bookings)),
+		slog.Int("waitlist_entries", len(d.WaitlistEntries)),
+		slog.Time("exported_at", d.ExportedAt),
+	}
+	if d.Guest != nil {
+		attrs = append(attrs, slog.String("guest_id", d.Guest.GuestID))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type GuestRepository interface {
+	Find(ctx context.Context, guestID string) (*Guest, error)
+	// FindOrInsert returns the guest with the email of the given guest,
+	// inserting the given guest if there is none.
+	FindOrInsert(ctx context.Context, guest *Guest) (*Guest, error)
+	// Update updates the guest and copies its email and full name to its
+	// pending and confirmed bookings not started yet, in a single transaction.
+	Update(ctx context.Context, guest *Guest) error
+	// FindData returns the data held about the person with the email, read in
+	// a single transaction.
+	FindData(ctx context.Context, email string) (*GuestData, error)
+	// Anonymize erases the data held about the person with the email in a
+	// single transaction: their guest profile and waitlist entries are
+	// deleted, and the email, full name and guest of their bookings cleared,
+	// keeping the bookings for occupancy statistics. It returns
+	// ErrGuestHasActiveBookings if any of their bookings is occupying.
+	Anonymize(ctx context.Context, email string) error
+	// AnonymizeEndedBefore anonymizes the bookings ending before the date as
+	// Anonymize does, deletes the waitlist entries ending before the date and
+	// the guests left without bookings not updated since, in a single
+	// transaction. It returns the number of bookings anonymized.
+	AnonymizeEndedBefore(ctx context.Context, date time.Time) (int64, error)
+}
@@ -106,6 +106,142 @@ func (_c *MockBookingRepository_Find_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// FindByGroupID provides a mock function for the type MockBookingRepository
+func (_mock *MockBookingRepository) FindByGroupID(ctx context.Context, groupID string) ([]*Booking, error) {
+	ret := _mock.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGroupID")
+	}
+
+	var r0 []*Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*Booking, error)); ok {
+		return returnFunc(ctx, groupID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*Booking); ok {
+		r0 = returnFunc(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingRepository_FindByGroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGroupID'
+type MockBookingRepository_FindByGroupID_Call struct {
+	*mock.Call
+}
+
+// FindByGroupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+func (_e *MockBookingRepository_Expecter) FindByGroupID(ctx any, groupID any) *MockBookingRepository_FindByGroupID_Call {
+	return &MockBookingRepository_FindByGroupID_Call{Call: _e.mock.On("FindByGroupID", ctx, groupID)}
+}
+
+func (_c *MockBookingRepository_FindByGroupID_Call) Run(run func(ctx context.Context, groupID string)) *MockBookingRepository_FindByGroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepository_FindByGroupID_Call) Return(bookings []*Booking, err error) *MockBookingRepository_FindByGroupID_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockBookingRepository_FindByGroupID_Call) RunAndReturn(run func(ctx context.Context, groupID string) ([]*Booking, error)) *MockBookingRepository_FindByGroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByGuestID provides a mock function for the type MockBookingRepository
+func (_mock *MockBookingRepository) FindByGuestID(ctx context.Context, guestID string) ([]*Booking, error) {
+	ret := _mock.Called(ctx, guestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGuestID")
+	}
+
+	var r0 []*Booking
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*Booking, error)); ok {
+		return returnFunc(ctx, guestID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*Booking); ok {
+		r0 = returnFunc(ctx, guestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Booking)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookingRepository_FindByGuestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGuestID'
+type MockBookingRepository_FindByGuestID_Call struct {
+	*mock.Call
+}
+
+// FindByGuestID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guestID string
+func (_e *MockBookingRepository_Expecter) FindByGuestID(ctx any, guestID any) *MockBookingRepository_FindByGuestID_Call {
+	return &MockBookingRepository_FindByGuestID_Call{Call: _e.mock.On("FindByGuestID", ctx, guestID)}
+}
+
+func (_c *MockBookingRepository_FindByGuestID_Call) Run(run func(ctx context.Context, guestID string)) *MockBookingRepository_FindByGuestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepository_FindByGuestID_Call) Return(bookings []*Booking, err error) *MockBookingRepository_FindByGuestID_Call {
+	_c.Call.Return(bookings, err)
+	return _c
+}
+
+func (_c *MockBookingRepository_FindByGuestID_Call) RunAndReturn(run func(ctx context.Context, guestID string) ([]*Booking, error)) *MockBookingRepository_FindByGuestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindForDateRange provides a mock function for the type MockBookingRepository
 func (_mock *MockBookingRepository) FindForDateRange(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*Booking, error) {
 	ret := _mock.Called(ctx, campsiteID, startDate, endDate)
@@ -243,6 +379,63 @@ func (_c *MockBookingRepository_Insert_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// InsertGroup provides a mock function for the type MockBookingRepository
+func (_mock *MockBookingRepository) InsertGroup(ctx context.Context, bookings []*Booking) error {
+	ret := _mock.Called(ctx, bookings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertGroup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Booking) error); ok {
+		r0 = returnFunc(ctx, bookings)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingRepository_InsertGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertGroup'
+type MockBookingRepository_InsertGroup_Call struct {
+	*mock.Call
+}
+
+// InsertGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookings []*Booking
+func (_e *MockBookingRepository_Expecter) InsertGroup(ctx any, bookings any) *MockBookingRepository_InsertGroup_Call {
+	return &MockBookingRepository_InsertGroup_Call{Call: _e.mock.On("InsertGroup", ctx, bookings)}
+}
+
+func (_c *MockBookingRepository_InsertGroup_Call) Run(run func(ctx context.Context, bookings []*Booking)) *MockBookingRepository_InsertGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*Booking
+		if args[1] != nil {
+			arg1 = args[1].([]*Booking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepository_InsertGroup_Call) Return(err error) *MockBookingRepository_InsertGroup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingRepository_InsertGroup_Call) RunAndReturn(run func(ctx context.Context, bookings []*Booking) error) *MockBookingRepository_InsertGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockBookingRepository
 func (_mock *MockBookingRepository) Update(ctx context.Context, booking *Booking) error {
 	ret := _mock.Called(ctx, booking)
@@ -299,3 +492,60 @@ func (_c *MockBookingRepository_Update_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateGroup provides a mock function for the type MockBookingRepository
+func (_mock *MockBookingRepository) UpdateGroup(ctx context.Context, bookings []*Booking) error {
+	ret := _mock.Called(ctx, bookings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*Booking) error); ok {
+		r0 = returnFunc(ctx, bookings)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingRepository_UpdateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroup'
+type MockBookingRepository_UpdateGroup_Call struct {
+	*mock.Call
+}
+
+// UpdateGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookings []*Booking
+func (_e *MockBookingRepository_Expecter) UpdateGroup(ctx any, bookings any) *MockBookingRepository_UpdateGroup_Call {
+	return &MockBookingRepository_UpdateGroup_Call{Call: _e.mock.On("UpdateGroup", ctx, bookings)}
+}
+
+func (_c *MockBookingRepository_UpdateGroup_Call) Run(run func(ctx context.Context, bookings []*Booking)) *MockBookingRepository_UpdateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*Booking
+		if args[1] != nil {
+			arg1 = args[1].([]*Booking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRepository_UpdateGroup_Call) Return(err error) *MockBookingRepository_UpdateGroup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingRepository_UpdateGroup_Call) RunAndReturn(run func(ctx context.Context, bookings []*Booking) error) *MockBookingRepository_UpdateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
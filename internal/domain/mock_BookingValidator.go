@@ -5,6 +5,8 @@
 package domain
 
 import (
+	"context"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -36,16 +38,16 @@ func (_m *MockBookingValidator) EXPECT() *MockBookingValidator_Expecter {
 }
 
 // Validate provides a mock function for the type MockBookingValidator
-func (_mock *MockBookingValidator) Validate(b *Booking) error {
-	ret := _mock.Called(b)
+func (_mock *MockBookingValidator) Validate(ctx context.Context, b *Booking) error {
+	ret := _mock.Called(ctx, b)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Validate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*Booking) error); ok {
-		r0 = returnFunc(b)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Booking) error); ok {
+		r0 = returnFunc(ctx, b)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,19 +60,25 @@ type MockBookingValidator_Validate_Call struct {
 }
 
 // Validate is a helper method to define mock.On call
+//   - ctx context.Context
 //   - b *Booking
-func (_e *MockBookingValidator_Expecter) Validate(b any) *MockBookingValidator_Validate_Call {
-	return &MockBookingValidator_Validate_Call{Call: _e.mock.On("Validate", b)}
+func (_e *MockBookingValidator_Expecter) Validate(ctx any, b any) *MockBookingValidator_Validate_Call {
+	return &MockBookingValidator_Validate_Call{Call: _e.mock.On("Validate", ctx, b)}
 }
 
-func (_c *MockBookingValidator_Validate_Call) Run(run func(b *Booking)) *MockBookingValidator_Validate_Call {
+func (_c *MockBookingValidator_Validate_Call) Run(run func(ctx context.Context, b *Booking)) *MockBookingValidator_Validate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *Booking
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*Booking)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Booking
+		if args[1] != nil {
+			arg1 = args[1].(*Booking)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -81,7 +89,7 @@ func (_c *MockBookingValidator_Validate_Call) Return(err error) *MockBookingVali
 	return _c
 }
 
-func (_c *MockBookingValidator_Validate_Call) RunAndReturn(run func(b *Booking) error) *MockBookingValidator_Validate_Call {
+func (_c *MockBookingValidator_Validate_Call) RunAndReturn(run func(ctx context.Context, b *Booking) error) *MockBookingValidator_Validate_Call {
 	_c.Call.Return(run)
 	return _c
 }
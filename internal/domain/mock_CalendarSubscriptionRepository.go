@@ -0,0 +1,569 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCalendarSubscriptionRepository creates a new instance of MockCalendarSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarSubscriptionRepository {
+	mock := &MockCalendarSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarSubscriptionRepository is an autogenerated mock type for the CalendarSubscriptionRepository type
+type MockCalendarSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockCalendarSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarSubscriptionRepository) EXPECT() *MockCalendarSubscriptionRepository_Expecter {
+	return &MockCalendarSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) Delete(ctx context.Context, subscriptionID string) error {
+	ret := _mock.Called(ctx, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, subscriptionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarSubscriptionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCalendarSubscriptionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+func (_e *MockCalendarSubscriptionRepository_Expecter) Delete(ctx any, subscriptionID any) *MockCalendarSubscriptionRepository_Delete_Call {
+	return &MockCalendarSubscriptionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, subscriptionID)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_Delete_Call) Run(run func(ctx context.Context, subscriptionID string)) *MockCalendarSubscriptionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Delete_Call) Return(err error) *MockCalendarSubscriptionRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string) error) *MockCalendarSubscriptionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) Find(ctx context.Context, subscriptionID string) (*CalendarSubscription, error) {
+	ret := _mock.Called(ctx, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *CalendarSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*CalendarSubscription, error)); ok {
+		return returnFunc(ctx, subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *CalendarSubscription); ok {
+		r0 = returnFunc(ctx, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CalendarSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSubscriptionRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCalendarSubscriptionRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+func (_e *MockCalendarSubscriptionRepository_Expecter) Find(ctx any, subscriptionID any) *MockCalendarSubscriptionRepository_Find_Call {
+	return &MockCalendarSubscriptionRepository_Find_Call{Call: _e.mock.On("Find", ctx, subscriptionID)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_Find_Call) Run(run func(ctx context.Context, subscriptionID string)) *MockCalendarSubscriptionRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Find_Call) Return(calendarSubscription *CalendarSubscription, err error) *MockCalendarSubscriptionRepository_Find_Call {
+	_c.Call.Return(calendarSubscription, err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Find_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string) (*CalendarSubscription, error)) *MockCalendarSubscriptionRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllWithURL provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) FindAllWithURL(ctx context.Context) ([]*CalendarSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllWithURL")
+	}
+
+	var r0 []*CalendarSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*CalendarSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*CalendarSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CalendarSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSubscriptionRepository_FindAllWithURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllWithURL'
+type MockCalendarSubscriptionRepository_FindAllWithURL_Call struct {
+	*mock.Call
+}
+
+// FindAllWithURL is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCalendarSubscriptionRepository_Expecter) FindAllWithURL(ctx any) *MockCalendarSubscriptionRepository_FindAllWithURL_Call {
+	return &MockCalendarSubscriptionRepository_FindAllWithURL_Call{Call: _e.mock.On("FindAllWithURL", ctx)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindAllWithURL_Call) Run(run func(ctx context.Context)) *MockCalendarSubscriptionRepository_FindAllWithURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindAllWithURL_Call) Return(calendarSubscriptions []*CalendarSubscription, err error) *MockCalendarSubscriptionRepository_FindAllWithURL_Call {
+	_c.Call.Return(calendarSubscriptions, err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindAllWithURL_Call) RunAndReturn(run func(ctx context.Context) ([]*CalendarSubscription, error)) *MockCalendarSubscriptionRepository_FindAllWithURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBlocksForDateRange provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) FindBlocksForDateRange(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*ExternalBlock, error) {
+	ret := _mock.Called(ctx, campsiteID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBlocksForDateRange")
+	}
+
+	var r0 []*ExternalBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*ExternalBlock, error)); ok {
+		return returnFunc(ctx, campsiteID, startDate, endDate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*ExternalBlock); ok {
+		r0 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ExternalBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBlocksForDateRange'
+type MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call struct {
+	*mock.Call
+}
+
+// FindBlocksForDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockCalendarSubscriptionRepository_Expecter) FindBlocksForDateRange(ctx any, campsiteID any, startDate any, endDate any) *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call {
+	return &MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call{Call: _e.mock.On("FindBlocksForDateRange", ctx, campsiteID, startDate, endDate)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call) Run(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time)) *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call) Return(externalBlocks []*ExternalBlock, err error) *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call {
+	_c.Call.Return(externalBlocks, err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call) RunAndReturn(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*ExternalBlock, error)) *MockCalendarSubscriptionRepository_FindBlocksForDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByCampsiteID provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) FindByCampsiteID(ctx context.Context, campsiteID string) ([]*CalendarSubscription, error) {
+	ret := _mock.Called(ctx, campsiteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCampsiteID")
+	}
+
+	var r0 []*CalendarSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*CalendarSubscription, error)); ok {
+		return returnFunc(ctx, campsiteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*CalendarSubscription); ok {
+		r0 = returnFunc(ctx, campsiteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CalendarSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campsiteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarSubscriptionRepository_FindByCampsiteID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByCampsiteID'
+type MockCalendarSubscriptionRepository_FindByCampsiteID_Call struct {
+	*mock.Call
+}
+
+// FindByCampsiteID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+func (_e *MockCalendarSubscriptionRepository_Expecter) FindByCampsiteID(ctx any, campsiteID any) *MockCalendarSubscriptionRepository_FindByCampsiteID_Call {
+	return &MockCalendarSubscriptionRepository_FindByCampsiteID_Call{Call: _e.mock.On("FindByCampsiteID", ctx, campsiteID)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindByCampsiteID_Call) Run(run func(ctx context.Context, campsiteID string)) *MockCalendarSubscriptionRepository_FindByCampsiteID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindByCampsiteID_Call) Return(calendarSubscriptions []*CalendarSubscription, err error) *MockCalendarSubscriptionRepository_FindByCampsiteID_Call {
+	_c.Call.Return(calendarSubscriptions, err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_FindByCampsiteID_Call) RunAndReturn(run func(ctx context.Context, campsiteID string) ([]*CalendarSubscription, error)) *MockCalendarSubscriptionRepository_FindByCampsiteID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) Insert(ctx context.Context, subscription *CalendarSubscription, blocks []*ExternalBlock) error {
+	ret := _mock.Called(ctx, subscription, blocks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CalendarSubscription, []*ExternalBlock) error); ok {
+		r0 = returnFunc(ctx, subscription, blocks)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarSubscriptionRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockCalendarSubscriptionRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *CalendarSubscription
+//   - blocks []*ExternalBlock
+func (_e *MockCalendarSubscriptionRepository_Expecter) Insert(ctx any, subscription any, blocks any) *MockCalendarSubscriptionRepository_Insert_Call {
+	return &MockCalendarSubscriptionRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, subscription, blocks)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_Insert_Call) Run(run func(ctx context.Context, subscription *CalendarSubscription, blocks []*ExternalBlock)) *MockCalendarSubscriptionRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CalendarSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*CalendarSubscription)
+		}
+		var arg2 []*ExternalBlock
+		if args[2] != nil {
+			arg2 = args[2].([]*ExternalBlock)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Insert_Call) Return(err error) *MockCalendarSubscriptionRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, subscription *CalendarSubscription, blocks []*ExternalBlock) error) *MockCalendarSubscriptionRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceBlocks provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) ReplaceBlocks(ctx context.Context, subscriptionID string, blocks []*ExternalBlock, syncedAt time.Time) error {
+	ret := _mock.Called(ctx, subscriptionID, blocks, syncedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceBlocks")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []*ExternalBlock, time.Time) error); ok {
+		r0 = returnFunc(ctx, subscriptionID, blocks, syncedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarSubscriptionRepository_ReplaceBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceBlocks'
+type MockCalendarSubscriptionRepository_ReplaceBlocks_Call struct {
+	*mock.Call
+}
+
+// ReplaceBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+//   - blocks []*ExternalBlock
+//   - syncedAt time.Time
+func (_e *MockCalendarSubscriptionRepository_Expecter) ReplaceBlocks(ctx any, subscriptionID any, blocks any, syncedAt any) *MockCalendarSubscriptionRepository_ReplaceBlocks_Call {
+	return &MockCalendarSubscriptionRepository_ReplaceBlocks_Call{Call: _e.mock.On("ReplaceBlocks", ctx, subscriptionID, blocks, syncedAt)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_ReplaceBlocks_Call) Run(run func(ctx context.Context, subscriptionID string, blocks []*ExternalBlock, syncedAt time.Time)) *MockCalendarSubscriptionRepository_ReplaceBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []*ExternalBlock
+		if args[2] != nil {
+			arg2 = args[2].([]*ExternalBlock)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_ReplaceBlocks_Call) Return(err error) *MockCalendarSubscriptionRepository_ReplaceBlocks_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_ReplaceBlocks_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string, blocks []*ExternalBlock, syncedAt time.Time) error) *MockCalendarSubscriptionRepository_ReplaceBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSyncError provides a mock function for the type MockCalendarSubscriptionRepository
+func (_mock *MockCalendarSubscriptionRepository) UpdateSyncError(ctx context.Context, subscriptionID string, reason string) error {
+	ret := _mock.Called(ctx, subscriptionID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSyncError")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, subscriptionID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarSubscriptionRepository_UpdateSyncError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSyncError'
+type MockCalendarSubscriptionRepository_UpdateSyncError_Call struct {
+	*mock.Call
+}
+
+// UpdateSyncError is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID string
+//   - reason string
+func (_e *MockCalendarSubscriptionRepository_Expecter) UpdateSyncError(ctx any, subscriptionID any, reason any) *MockCalendarSubscriptionRepository_UpdateSyncError_Call {
+	return &MockCalendarSubscriptionRepository_UpdateSyncError_Call{Call: _e.mock.On("UpdateSyncError", ctx, subscriptionID, reason)}
+}
+
+func (_c *MockCalendarSubscriptionRepository_UpdateSyncError_Call) Run(run func(ctx context.Context, subscriptionID string, reason string)) *MockCalendarSubscriptionRepository_UpdateSyncError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_UpdateSyncError_Call) Return(err error) *MockCalendarSubscriptionRepository_UpdateSyncError_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarSubscriptionRepository_UpdateSyncError_Call) RunAndReturn(run func(ctx context.Context, subscriptionID string, reason string) error) *MockCalendarSubscriptionRepository_UpdateSyncError_Call {
+	_c.Call.Return(run)
+	return _c
+}
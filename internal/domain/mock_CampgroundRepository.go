@@ -0,0 +1,339 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCampgroundRepository creates a new instance of MockCampgroundRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampgroundRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampgroundRepository {
+	mock := &MockCampgroundRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCampgroundRepository is an autogenerated mock type for the CampgroundRepository type
+type MockCampgroundRepository struct {
+	mock.Mock
+}
+
+type MockCampgroundRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampgroundRepository) EXPECT() *MockCampgroundRepository_Expecter {
+	return &MockCampgroundRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockCampgroundRepository
+func (_mock *MockCampgroundRepository) Delete(ctx context.Context, campgroundID string) error {
+	ret := _mock.Called(ctx, campgroundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, campgroundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampgroundRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCampgroundRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campgroundID string
+func (_e *MockCampgroundRepository_Expecter) Delete(ctx any, campgroundID any) *MockCampgroundRepository_Delete_Call {
+	return &MockCampgroundRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, campgroundID)}
+}
+
+func (_c *MockCampgroundRepository_Delete_Call) Run(run func(ctx context.Context, campgroundID string)) *MockCampgroundRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Delete_Call) Return(err error) *MockCampgroundRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, campgroundID string) error) *MockCampgroundRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockCampgroundRepository
+func (_mock *MockCampgroundRepository) Find(ctx context.Context, campgroundID string) (*Campground, error) {
+	ret := _mock.Called(ctx, campgroundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Campground, error)); ok {
+		return returnFunc(ctx, campgroundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Campground); ok {
+		r0 = returnFunc(ctx, campgroundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campgroundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampgroundRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCampgroundRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campgroundID string
+func (_e *MockCampgroundRepository_Expecter) Find(ctx any, campgroundID any) *MockCampgroundRepository_Find_Call {
+	return &MockCampgroundRepository_Find_Call{Call: _e.mock.On("Find", ctx, campgroundID)}
+}
+
+func (_c *MockCampgroundRepository_Find_Call) Run(run func(ctx context.Context, campgroundID string)) *MockCampgroundRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Find_Call) Return(campground *Campground, err error) *MockCampgroundRepository_Find_Call {
+	_c.Call.Return(campground, err)
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Find_Call) RunAndReturn(run func(ctx context.Context, campgroundID string) (*Campground, error)) *MockCampgroundRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockCampgroundRepository
+func (_mock *MockCampgroundRepository) FindAll(ctx context.Context) ([]*Campground, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*Campground
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*Campground, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*Campground); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Campground)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampgroundRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockCampgroundRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCampgroundRepository_Expecter) FindAll(ctx any) *MockCampgroundRepository_FindAll_Call {
+	return &MockCampgroundRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockCampgroundRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockCampgroundRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundRepository_FindAll_Call) Return(campgrounds []*Campground, err error) *MockCampgroundRepository_FindAll_Call {
+	_c.Call.Return(campgrounds, err)
+	return _c
+}
+
+func (_c *MockCampgroundRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*Campground, error)) *MockCampgroundRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockCampgroundRepository
+func (_mock *MockCampgroundRepository) Insert(ctx context.Context, campground *Campground) error {
+	ret := _mock.Called(ctx, campground)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Campground) error); ok {
+		r0 = returnFunc(ctx, campground)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampgroundRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockCampgroundRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campground *Campground
+func (_e *MockCampgroundRepository_Expecter) Insert(ctx any, campground any) *MockCampgroundRepository_Insert_Call {
+	return &MockCampgroundRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, campground)}
+}
+
+func (_c *MockCampgroundRepository_Insert_Call) Run(run func(ctx context.Context, campground *Campground)) *MockCampgroundRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Campground
+		if args[1] != nil {
+			arg1 = args[1].(*Campground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Insert_Call) Return(err error) *MockCampgroundRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, campground *Campground) error) *MockCampgroundRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCampgroundRepository
+func (_mock *MockCampgroundRepository) Update(ctx context.Context, campground *Campground) error {
+	ret := _mock.Called(ctx, campground)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Campground) error); ok {
+		r0 = returnFunc(ctx, campground)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampgroundRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCampgroundRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campground *Campground
+func (_e *MockCampgroundRepository_Expecter) Update(ctx any, campground any) *MockCampgroundRepository_Update_Call {
+	return &MockCampgroundRepository_Update_Call{Call: _e.mock.On("Update", ctx, campground)}
+}
+
+func (_c *MockCampgroundRepository_Update_Call) Run(run func(ctx context.Context, campground *Campground)) *MockCampgroundRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Campground
+		if args[1] != nil {
+			arg1 = args[1].(*Campground)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Update_Call) Return(err error) *MockCampgroundRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampgroundRepository_Update_Call) RunAndReturn(run func(ctx context.Context, campground *Campground) error) *MockCampgroundRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,163 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCampgroundSeasonRepository creates a new instance of MockCampgroundSeasonRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampgroundSeasonRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampgroundSeasonRepository {
+	mock := &MockCampgroundSeasonRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCampgroundSeasonRepository is an autogenerated mock type for the CampgroundSeasonRepository type
+type MockCampgroundSeasonRepository struct {
+	mock.Mock
+}
+
+type MockCampgroundSeasonRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampgroundSeasonRepository) EXPECT() *MockCampgroundSeasonRepository_Expecter {
+	return &MockCampgroundSeasonRepository_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function for the type MockCampgroundSeasonRepository
+func (_mock *MockCampgroundSeasonRepository) Find(ctx context.Context, campgroundID string) (*CampgroundSeason, error) {
+	ret := _mock.Called(ctx, campgroundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *CampgroundSeason
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*CampgroundSeason, error)); ok {
+		return returnFunc(ctx, campgroundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *CampgroundSeason); ok {
+		r0 = returnFunc(ctx, campgroundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampgroundSeason)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campgroundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampgroundSeasonRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCampgroundSeasonRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campgroundID string
+func (_e *MockCampgroundSeasonRepository_Expecter) Find(ctx any, campgroundID any) *MockCampgroundSeasonRepository_Find_Call {
+	return &MockCampgroundSeasonRepository_Find_Call{Call: _e.mock.On("Find", ctx, campgroundID)}
+}
+
+func (_c *MockCampgroundSeasonRepository_Find_Call) Run(run func(ctx context.Context, campgroundID string)) *MockCampgroundSeasonRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundSeasonRepository_Find_Call) Return(campgroundSeason *CampgroundSeason, err error) *MockCampgroundSeasonRepository_Find_Call {
+	_c.Call.Return(campgroundSeason, err)
+	return _c
+}
+
+func (_c *MockCampgroundSeasonRepository_Find_Call) RunAndReturn(run func(ctx context.Context, campgroundID string) (*CampgroundSeason, error)) *MockCampgroundSeasonRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockCampgroundSeasonRepository
+func (_mock *MockCampgroundSeasonRepository) Upsert(ctx context.Context, season *CampgroundSeason) error {
+	ret := _mock.Called(ctx, season)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CampgroundSeason) error); ok {
+		r0 = returnFunc(ctx, season)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampgroundSeasonRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockCampgroundSeasonRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - season *CampgroundSeason
+func (_e *MockCampgroundSeasonRepository_Expecter) Upsert(ctx any, season any) *MockCampgroundSeasonRepository_Upsert_Call {
+	return &MockCampgroundSeasonRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, season)}
+}
+
+func (_c *MockCampgroundSeasonRepository_Upsert_Call) Run(run func(ctx context.Context, season *CampgroundSeason)) *MockCampgroundSeasonRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CampgroundSeason
+		if args[1] != nil {
+			arg1 = args[1].(*CampgroundSeason)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampgroundSeasonRepository_Upsert_Call) Return(err error) *MockCampgroundSeasonRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampgroundSeasonRepository_Upsert_Call) RunAndReturn(run func(ctx context.Context, season *CampgroundSeason) error) *MockCampgroundSeasonRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
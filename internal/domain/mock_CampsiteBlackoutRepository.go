@@ -0,0 +1,369 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCampsiteBlackoutRepository creates a new instance of MockCampsiteBlackoutRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampsiteBlackoutRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampsiteBlackoutRepository {
+	mock := &MockCampsiteBlackoutRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCampsiteBlackoutRepository is an autogenerated mock type for the CampsiteBlackoutRepository type
+type MockCampsiteBlackoutRepository struct {
+	mock.Mock
+}
+
+type MockCampsiteBlackoutRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampsiteBlackoutRepository) EXPECT() *MockCampsiteBlackoutRepository_Expecter {
+	return &MockCampsiteBlackoutRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockCampsiteBlackoutRepository
+func (_mock *MockCampsiteBlackoutRepository) Delete(ctx context.Context, blackoutID string) error {
+	ret := _mock.Called(ctx, blackoutID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, blackoutID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampsiteBlackoutRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCampsiteBlackoutRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blackoutID string
+func (_e *MockCampsiteBlackoutRepository_Expecter) Delete(ctx any, blackoutID any) *MockCampsiteBlackoutRepository_Delete_Call {
+	return &MockCampsiteBlackoutRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, blackoutID)}
+}
+
+func (_c *MockCampsiteBlackoutRepository_Delete_Call) Run(run func(ctx context.Context, blackoutID string)) *MockCampsiteBlackoutRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Delete_Call) Return(err error) *MockCampsiteBlackoutRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, blackoutID string) error) *MockCampsiteBlackoutRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockCampsiteBlackoutRepository
+func (_mock *MockCampsiteBlackoutRepository) Find(ctx context.Context, blackoutID string) (*CampsiteBlackout, error) {
+	ret := _mock.Called(ctx, blackoutID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *CampsiteBlackout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*CampsiteBlackout, error)); ok {
+		return returnFunc(ctx, blackoutID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *CampsiteBlackout); ok {
+		r0 = returnFunc(ctx, blackoutID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampsiteBlackout)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, blackoutID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteBlackoutRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCampsiteBlackoutRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blackoutID string
+func (_e *MockCampsiteBlackoutRepository_Expecter) Find(ctx any, blackoutID any) *MockCampsiteBlackoutRepository_Find_Call {
+	return &MockCampsiteBlackoutRepository_Find_Call{Call: _e.mock.On("Find", ctx, blackoutID)}
+}
+
+func (_c *MockCampsiteBlackoutRepository_Find_Call) Run(run func(ctx context.Context, blackoutID string)) *MockCampsiteBlackoutRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Find_Call) Return(campsiteBlackout *CampsiteBlackout, err error) *MockCampsiteBlackoutRepository_Find_Call {
+	_c.Call.Return(campsiteBlackout, err)
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Find_Call) RunAndReturn(run func(ctx context.Context, blackoutID string) (*CampsiteBlackout, error)) *MockCampsiteBlackoutRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByCampsiteID provides a mock function for the type MockCampsiteBlackoutRepository
+func (_mock *MockCampsiteBlackoutRepository) FindByCampsiteID(ctx context.Context, campsiteID string) ([]*CampsiteBlackout, error) {
+	ret := _mock.Called(ctx, campsiteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCampsiteID")
+	}
+
+	var r0 []*CampsiteBlackout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*CampsiteBlackout, error)); ok {
+		return returnFunc(ctx, campsiteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*CampsiteBlackout); ok {
+		r0 = returnFunc(ctx, campsiteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CampsiteBlackout)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campsiteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteBlackoutRepository_FindByCampsiteID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByCampsiteID'
+type MockCampsiteBlackoutRepository_FindByCampsiteID_Call struct {
+	*mock.Call
+}
+
+// FindByCampsiteID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+func (_e *MockCampsiteBlackoutRepository_Expecter) FindByCampsiteID(ctx any, campsiteID any) *MockCampsiteBlackoutRepository_FindByCampsiteID_Call {
+	return &MockCampsiteBlackoutRepository_FindByCampsiteID_Call{Call: _e.mock.On("FindByCampsiteID", ctx, campsiteID)}
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindByCampsiteID_Call) Run(run func(ctx context.Context, campsiteID string)) *MockCampsiteBlackoutRepository_FindByCampsiteID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindByCampsiteID_Call) Return(campsiteBlackouts []*CampsiteBlackout, err error) *MockCampsiteBlackoutRepository_FindByCampsiteID_Call {
+	_c.Call.Return(campsiteBlackouts, err)
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindByCampsiteID_Call) RunAndReturn(run func(ctx context.Context, campsiteID string) ([]*CampsiteBlackout, error)) *MockCampsiteBlackoutRepository_FindByCampsiteID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindForDateRange provides a mock function for the type MockCampsiteBlackoutRepository
+func (_mock *MockCampsiteBlackoutRepository) FindForDateRange(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*CampsiteBlackout, error) {
+	ret := _mock.Called(ctx, campsiteID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindForDateRange")
+	}
+
+	var r0 []*CampsiteBlackout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*CampsiteBlackout, error)); ok {
+		return returnFunc(ctx, campsiteID, startDate, endDate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*CampsiteBlackout); ok {
+		r0 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CampsiteBlackout)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteBlackoutRepository_FindForDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindForDateRange'
+type MockCampsiteBlackoutRepository_FindForDateRange_Call struct {
+	*mock.Call
+}
+
+// FindForDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockCampsiteBlackoutRepository_Expecter) FindForDateRange(ctx any, campsiteID any, startDate any, endDate any) *MockCampsiteBlackoutRepository_FindForDateRange_Call {
+	return &MockCampsiteBlackoutRepository_FindForDateRange_Call{Call: _e.mock.On("FindForDateRange", ctx, campsiteID, startDate, endDate)}
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindForDateRange_Call) Run(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time)) *MockCampsiteBlackoutRepository_FindForDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindForDateRange_Call) Return(campsiteBlackouts []*CampsiteBlackout, err error) *MockCampsiteBlackoutRepository_FindForDateRange_Call {
+	_c.Call.Return(campsiteBlackouts, err)
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_FindForDateRange_Call) RunAndReturn(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*CampsiteBlackout, error)) *MockCampsiteBlackoutRepository_FindForDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockCampsiteBlackoutRepository
+func (_mock *MockCampsiteBlackoutRepository) Insert(ctx context.Context, blackout *CampsiteBlackout) error {
+	ret := _mock.Called(ctx, blackout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CampsiteBlackout) error); ok {
+		r0 = returnFunc(ctx, blackout)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampsiteBlackoutRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockCampsiteBlackoutRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blackout *CampsiteBlackout
+func (_e *MockCampsiteBlackoutRepository_Expecter) Insert(ctx any, blackout any) *MockCampsiteBlackoutRepository_Insert_Call {
+	return &MockCampsiteBlackoutRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, blackout)}
+}
+
+func (_c *MockCampsiteBlackoutRepository_Insert_Call) Run(run func(ctx context.Context, blackout *CampsiteBlackout)) *MockCampsiteBlackoutRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CampsiteBlackout
+		if args[1] != nil {
+			arg1 = args[1].(*CampsiteBlackout)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Insert_Call) Return(err error) *MockCampsiteBlackoutRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampsiteBlackoutRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, blackout *CampsiteBlackout) error) *MockCampsiteBlackoutRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,163 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCampsiteRatesRepository creates a new instance of MockCampsiteRatesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampsiteRatesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampsiteRatesRepository {
+	mock := &MockCampsiteRatesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCampsiteRatesRepository is an autogenerated mock type for the CampsiteRatesRepository type
+type MockCampsiteRatesRepository struct {
+	mock.Mock
+}
+
+type MockCampsiteRatesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampsiteRatesRepository) EXPECT() *MockCampsiteRatesRepository_Expecter {
+	return &MockCampsiteRatesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function for the type MockCampsiteRatesRepository
+func (_mock *MockCampsiteRatesRepository) Find(ctx context.Context, campsiteID string) (*CampsiteRates, error) {
+	ret := _mock.Called(ctx, campsiteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *CampsiteRates
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*CampsiteRates, error)); ok {
+		return returnFunc(ctx, campsiteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *CampsiteRates); ok {
+		r0 = returnFunc(ctx, campsiteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampsiteRates)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campsiteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteRatesRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCampsiteRatesRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+func (_e *MockCampsiteRatesRepository_Expecter) Find(ctx any, campsiteID any) *MockCampsiteRatesRepository_Find_Call {
+	return &MockCampsiteRatesRepository_Find_Call{Call: _e.mock.On("Find", ctx, campsiteID)}
+}
+
+func (_c *MockCampsiteRatesRepository_Find_Call) Run(run func(ctx context.Context, campsiteID string)) *MockCampsiteRatesRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteRatesRepository_Find_Call) Return(campsiteRates *CampsiteRates, err error) *MockCampsiteRatesRepository_Find_Call {
+	_c.Call.Return(campsiteRates, err)
+	return _c
+}
+
+func (_c *MockCampsiteRatesRepository_Find_Call) RunAndReturn(run func(ctx context.Context, campsiteID string) (*CampsiteRates, error)) *MockCampsiteRatesRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockCampsiteRatesRepository
+func (_mock *MockCampsiteRatesRepository) Upsert(ctx context.Context, rates *CampsiteRates) error {
+	ret := _mock.Called(ctx, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CampsiteRates) error); ok {
+		r0 = returnFunc(ctx, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampsiteRatesRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockCampsiteRatesRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rates *CampsiteRates
+func (_e *MockCampsiteRatesRepository_Expecter) Upsert(ctx any, rates any) *MockCampsiteRatesRepository_Upsert_Call {
+	return &MockCampsiteRatesRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, rates)}
+}
+
+func (_c *MockCampsiteRatesRepository_Upsert_Call) Run(run func(ctx context.Context, rates *CampsiteRates)) *MockCampsiteRatesRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CampsiteRates
+		if args[1] != nil {
+			arg1 = args[1].(*CampsiteRates)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteRatesRepository_Upsert_Call) Return(err error) *MockCampsiteRatesRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampsiteRatesRepository_Upsert_Call) RunAndReturn(run func(ctx context.Context, rates *CampsiteRates) error) *MockCampsiteRatesRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
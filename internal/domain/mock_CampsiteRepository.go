@@ -37,6 +37,74 @@ func (_m *MockCampsiteRepository) EXPECT() *MockCampsiteRepository_Expecter {
 	return &MockCampsiteRepository_Expecter{mock: &_m.Mock}
 }
 
+// Find provides a mock function for the type MockCampsiteRepository
+func (_mock *MockCampsiteRepository) Find(ctx context.Context, campsiteID string) (*Campsite, error) {
+	ret := _mock.Called(ctx, campsiteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *Campsite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Campsite, error)); ok {
+		return returnFunc(ctx, campsiteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Campsite); ok {
+		r0 = returnFunc(ctx, campsiteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campsite)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campsiteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockCampsiteRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+func (_e *MockCampsiteRepository_Expecter) Find(ctx any, campsiteID any) *MockCampsiteRepository_Find_Call {
+	return &MockCampsiteRepository_Find_Call{Call: _e.mock.On("Find", ctx, campsiteID)}
+}
+
+func (_c *MockCampsiteRepository_Find_Call) Run(run func(ctx context.Context, campsiteID string)) *MockCampsiteRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteRepository_Find_Call) Return(campsite *Campsite, err error) *MockCampsiteRepository_Find_Call {
+	_c.Call.Return(campsite, err)
+	return _c
+}
+
+func (_c *MockCampsiteRepository_Find_Call) RunAndReturn(run func(ctx context.Context, campsiteID string) (*Campsite, error)) *MockCampsiteRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindAll provides a mock function for the type MockCampsiteRepository
 func (_mock *MockCampsiteRepository) FindAll(ctx context.Context) ([]*Campsite, error) {
 	ret := _mock.Called(ctx)
@@ -99,6 +167,74 @@ func (_c *MockCampsiteRepository_FindAll_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// FindByCampgroundID provides a mock function for the type MockCampsiteRepository
+func (_mock *MockCampsiteRepository) FindByCampgroundID(ctx context.Context, campgroundID string) ([]*Campsite, error) {
+	ret := _mock.Called(ctx, campgroundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCampgroundID")
+	}
+
+	var r0 []*Campsite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*Campsite, error)); ok {
+		return returnFunc(ctx, campgroundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*Campsite); ok {
+		r0 = returnFunc(ctx, campgroundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Campsite)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campgroundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCampsiteRepository_FindByCampgroundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByCampgroundID'
+type MockCampsiteRepository_FindByCampgroundID_Call struct {
+	*mock.Call
+}
+
+// FindByCampgroundID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campgroundID string
+func (_e *MockCampsiteRepository_Expecter) FindByCampgroundID(ctx any, campgroundID any) *MockCampsiteRepository_FindByCampgroundID_Call {
+	return &MockCampsiteRepository_FindByCampgroundID_Call{Call: _e.mock.On("FindByCampgroundID", ctx, campgroundID)}
+}
+
+func (_c *MockCampsiteRepository_FindByCampgroundID_Call) Run(run func(ctx context.Context, campgroundID string)) *MockCampsiteRepository_FindByCampgroundID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteRepository_FindByCampgroundID_Call) Return(campsites []*Campsite, err error) *MockCampsiteRepository_FindByCampgroundID_Call {
+	_c.Call.Return(campsites, err)
+	return _c
+}
+
+func (_c *MockCampsiteRepository_FindByCampgroundID_Call) RunAndReturn(run func(ctx context.Context, campgroundID string) ([]*Campsite, error)) *MockCampsiteRepository_FindByCampgroundID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Insert provides a mock function for the type MockCampsiteRepository
 func (_mock *MockCampsiteRepository) Insert(ctx context.Context, campsite *Campsite) error {
 	ret := _mock.Called(ctx, campsite)
@@ -155,3 +291,66 @@ func (_c *MockCampsiteRepository_Insert_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// Upsert provides a mock function for the type MockCampsiteRepository
+func (_mock *MockCampsiteRepository) Upsert(ctx context.Context, rows []*CampsiteImportRow, mode CampsiteImportMode) error {
+	ret := _mock.Called(ctx, rows, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*CampsiteImportRow, CampsiteImportMode) error); ok {
+		r0 = returnFunc(ctx, rows, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCampsiteRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockCampsiteRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rows []*CampsiteImportRow
+//   - mode CampsiteImportMode
+func (_e *MockCampsiteRepository_Expecter) Upsert(ctx any, rows any, mode any) *MockCampsiteRepository_Upsert_Call {
+	return &MockCampsiteRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, rows, mode)}
+}
+
+func (_c *MockCampsiteRepository_Upsert_Call) Run(run func(ctx context.Context, rows []*CampsiteImportRow, mode CampsiteImportMode)) *MockCampsiteRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*CampsiteImportRow
+		if args[1] != nil {
+			arg1 = args[1].([]*CampsiteImportRow)
+		}
+		var arg2 CampsiteImportMode
+		if args[2] != nil {
+			arg2 = args[2].(CampsiteImportMode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCampsiteRepository_Upsert_Call) Return(err error) *MockCampsiteRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCampsiteRepository_Upsert_Call) RunAndReturn(run func(ctx context.Context, rows []*CampsiteImportRow, mode CampsiteImportMode) error) *MockCampsiteRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,168 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExternalCalendarReader creates a new instance of MockExternalCalendarReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExternalCalendarReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExternalCalendarReader {
+	mock := &MockExternalCalendarReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExternalCalendarReader is an autogenerated mock type for the ExternalCalendarReader type
+type MockExternalCalendarReader struct {
+	mock.Mock
+}
+
+type MockExternalCalendarReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExternalCalendarReader) EXPECT() *MockExternalCalendarReader_Expecter {
+	return &MockExternalCalendarReader_Expecter{mock: &_m.Mock}
+}
+
+// Fetch provides a mock function for the type MockExternalCalendarReader
+func (_mock *MockExternalCalendarReader) Fetch(ctx context.Context, url string) ([]*ExternalBlock, error) {
+	ret := _mock.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 []*ExternalBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*ExternalBlock, error)); ok {
+		return returnFunc(ctx, url)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*ExternalBlock); ok {
+		r0 = returnFunc(ctx, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ExternalBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalCalendarReader_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type MockExternalCalendarReader_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *MockExternalCalendarReader_Expecter) Fetch(ctx any, url any) *MockExternalCalendarReader_Fetch_Call {
+	return &MockExternalCalendarReader_Fetch_Call{Call: _e.mock.On("Fetch", ctx, url)}
+}
+
+func (_c *MockExternalCalendarReader_Fetch_Call) Run(run func(ctx context.Context, url string)) *MockExternalCalendarReader_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExternalCalendarReader_Fetch_Call) Return(externalBlocks []*ExternalBlock, err error) *MockExternalCalendarReader_Fetch_Call {
+	_c.Call.Return(externalBlocks, err)
+	return _c
+}
+
+func (_c *MockExternalCalendarReader_Fetch_Call) RunAndReturn(run func(ctx context.Context, url string) ([]*ExternalBlock, error)) *MockExternalCalendarReader_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function for the type MockExternalCalendarReader
+func (_mock *MockExternalCalendarReader) Read(calendar []byte) ([]*ExternalBlock, error) {
+	ret := _mock.Called(calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 []*ExternalBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte) ([]*ExternalBlock, error)); ok {
+		return returnFunc(calendar)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte) []*ExternalBlock); ok {
+		r0 = returnFunc(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ExternalBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = returnFunc(calendar)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalCalendarReader_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockExternalCalendarReader_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - calendar []byte
+func (_e *MockExternalCalendarReader_Expecter) Read(calendar any) *MockExternalCalendarReader_Read_Call {
+	return &MockExternalCalendarReader_Read_Call{Call: _e.mock.On("Read", calendar)}
+}
+
+func (_c *MockExternalCalendarReader_Read_Call) Run(run func(calendar []byte)) *MockExternalCalendarReader_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExternalCalendarReader_Read_Call) Return(externalBlocks []*ExternalBlock, err error) *MockExternalCalendarReader_Read_Call {
+	_c.Call.Return(externalBlocks, err)
+	return _c
+}
+
+func (_c *MockExternalCalendarReader_Read_Call) RunAndReturn(run func(calendar []byte) ([]*ExternalBlock, error)) *MockExternalCalendarReader_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
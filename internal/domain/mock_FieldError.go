@@ -0,0 +1,124 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockFieldError creates a new instance of MockFieldError. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFieldError(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFieldError {
+	mock := &MockFieldError{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFieldError is an autogenerated mock type for the FieldError type
+type MockFieldError struct {
+	mock.Mock
+}
+
+type MockFieldError_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFieldError) EXPECT() *MockFieldError_Expecter {
+	return &MockFieldError_Expecter{mock: &_m.Mock}
+}
+
+// Error provides a mock function for the type MockFieldError
+func (_mock *MockFieldError) Error() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockFieldError_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockFieldError_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockFieldError_Expecter) Error() *MockFieldError_Error_Call {
+	return &MockFieldError_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockFieldError_Error_Call) Run(run func()) *MockFieldError_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFieldError_Error_Call) Return(s string) *MockFieldError_Error_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockFieldError_Error_Call) RunAndReturn(run func() string) *MockFieldError_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Field provides a mock function for the type MockFieldError
+func (_mock *MockFieldError) Field() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Field")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockFieldError_Field_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Field'
+type MockFieldError_Field_Call struct {
+	*mock.Call
+}
+
+// Field is a helper method to define mock.On call
+func (_e *MockFieldError_Expecter) Field() *MockFieldError_Field_Call {
+	return &MockFieldError_Field_Call{Call: _e.mock.On("Field")}
+}
+
+func (_c *MockFieldError_Field_Call) Run(run func()) *MockFieldError_Field_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFieldError_Field_Call) Return(s string) *MockFieldError_Field_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockFieldError_Field_Call) RunAndReturn(run func() string) *MockFieldError_Field_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,423 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGuestRepository creates a new instance of MockGuestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGuestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGuestRepository {
+	mock := &MockGuestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGuestRepository is an autogenerated mock type for the GuestRepository type
+type MockGuestRepository struct {
+	mock.Mock
+}
+
+type MockGuestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGuestRepository) EXPECT() *MockGuestRepository_Expecter {
+	return &MockGuestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Anonymize provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) Anonymize(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Anonymize")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGuestRepository_Anonymize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Anonymize'
+type MockGuestRepository_Anonymize_Call struct {
+	*mock.Call
+}
+
+// Anonymize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockGuestRepository_Expecter) Anonymize(ctx any, email any) *MockGuestRepository_Anonymize_Call {
+	return &MockGuestRepository_Anonymize_Call{Call: _e.mock.On("Anonymize", ctx, email)}
+}
+
+func (_c *MockGuestRepository_Anonymize_Call) Run(run func(ctx context.Context, email string)) *MockGuestRepository_Anonymize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_Anonymize_Call) Return(err error) *MockGuestRepository_Anonymize_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGuestRepository_Anonymize_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockGuestRepository_Anonymize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnonymizeEndedBefore provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) AnonymizeEndedBefore(ctx context.Context, date time.Time) (int64, error) {
+	ret := _mock.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnonymizeEndedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, date)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, date)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuestRepository_AnonymizeEndedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeEndedBefore'
+type MockGuestRepository_AnonymizeEndedBefore_Call struct {
+	*mock.Call
+}
+
+// AnonymizeEndedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date time.Time
+func (_e *MockGuestRepository_Expecter) AnonymizeEndedBefore(ctx any, date any) *MockGuestRepository_AnonymizeEndedBefore_Call {
+	return &MockGuestRepository_AnonymizeEndedBefore_Call{Call: _e.mock.On("AnonymizeEndedBefore", ctx, date)}
+}
+
+func (_c *MockGuestRepository_AnonymizeEndedBefore_Call) Run(run func(ctx context.Context, date time.Time)) *MockGuestRepository_AnonymizeEndedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_AnonymizeEndedBefore_Call) Return(n int64, err error) *MockGuestRepository_AnonymizeEndedBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockGuestRepository_AnonymizeEndedBefore_Call) RunAndReturn(run func(ctx context.Context, date time.Time) (int64, error)) *MockGuestRepository_AnonymizeEndedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) Find(ctx context.Context, guestID string) (*Guest, error) {
+	ret := _mock.Called(ctx, guestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *Guest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*Guest, error)); ok {
+		return returnFunc(ctx, guestID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *Guest); ok {
+		r0 = returnFunc(ctx, guestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Guest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuestRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockGuestRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guestID string
+func (_e *MockGuestRepository_Expecter) Find(ctx any, guestID any) *MockGuestRepository_Find_Call {
+	return &MockGuestRepository_Find_Call{Call: _e.mock.On("Find", ctx, guestID)}
+}
+
+func (_c *MockGuestRepository_Find_Call) Run(run func(ctx context.Context, guestID string)) *MockGuestRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_Find_Call) Return(guest *Guest, err error) *MockGuestRepository_Find_Call {
+	_c.Call.Return(guest, err)
+	return _c
+}
+
+func (_c *MockGuestRepository_Find_Call) RunAndReturn(run func(ctx context.Context, guestID string) (*Guest, error)) *MockGuestRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindData provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) FindData(ctx context.Context, email string) (*GuestData, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindData")
+	}
+
+	var r0 *GuestData
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*GuestData, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *GuestData); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GuestData)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuestRepository_FindData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindData'
+type MockGuestRepository_FindData_Call struct {
+	*mock.Call
+}
+
+// FindData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockGuestRepository_Expecter) FindData(ctx any, email any) *MockGuestRepository_FindData_Call {
+	return &MockGuestRepository_FindData_Call{Call: _e.mock.On("FindData", ctx, email)}
+}
+
+func (_c *MockGuestRepository_FindData_Call) Run(run func(ctx context.Context, email string)) *MockGuestRepository_FindData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_FindData_Call) Return(guestData *GuestData, err error) *MockGuestRepository_FindData_Call {
+	_c.Call.Return(guestData, err)
+	return _c
+}
+
+func (_c *MockGuestRepository_FindData_Call) RunAndReturn(run func(ctx context.Context, email string) (*GuestData, error)) *MockGuestRepository_FindData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindOrInsert provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) FindOrInsert(ctx context.Context, guest *Guest) (*Guest, error) {
+	ret := _mock.Called(ctx, guest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOrInsert")
+	}
+
+	var r0 *Guest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Guest) (*Guest, error)); ok {
+		return returnFunc(ctx, guest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Guest) *Guest); ok {
+		r0 = returnFunc(ctx, guest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Guest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *Guest) error); ok {
+		r1 = returnFunc(ctx, guest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuestRepository_FindOrInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOrInsert'
+type MockGuestRepository_FindOrInsert_Call struct {
+	*mock.Call
+}
+
+// FindOrInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guest *Guest
+func (_e *MockGuestRepository_Expecter) FindOrInsert(ctx any, guest any) *MockGuestRepository_FindOrInsert_Call {
+	return &MockGuestRepository_FindOrInsert_Call{Call: _e.mock.On("FindOrInsert", ctx, guest)}
+}
+
+func (_c *MockGuestRepository_FindOrInsert_Call) Run(run func(ctx context.Context, guest *Guest)) *MockGuestRepository_FindOrInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Guest
+		if args[1] != nil {
+			arg1 = args[1].(*Guest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_FindOrInsert_Call) Return(guest1 *Guest, err error) *MockGuestRepository_FindOrInsert_Call {
+	_c.Call.Return(guest1, err)
+	return _c
+}
+
+func (_c *MockGuestRepository_FindOrInsert_Call) RunAndReturn(run func(ctx context.Context, guest *Guest) (*Guest, error)) *MockGuestRepository_FindOrInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockGuestRepository
+func (_mock *MockGuestRepository) Update(ctx context.Context, guest *Guest) error {
+	ret := _mock.Called(ctx, guest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *Guest) error); ok {
+		r0 = returnFunc(ctx, guest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGuestRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockGuestRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guest *Guest
+func (_e *MockGuestRepository_Expecter) Update(ctx any, guest any) *MockGuestRepository_Update_Call {
+	return &MockGuestRepository_Update_Call{Call: _e.mock.On("Update", ctx, guest)}
+}
+
+func (_c *MockGuestRepository_Update_Call) Run(run func(ctx context.Context, guest *Guest)) *MockGuestRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *Guest
+		if args[1] != nil {
+			arg1 = args[1].(*Guest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuestRepository_Update_Call) Return(err error) *MockGuestRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGuestRepository_Update_Call) RunAndReturn(run func(ctx context.Context, guest *Guest) error) *MockGuestRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
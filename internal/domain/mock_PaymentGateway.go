@@ -0,0 +1,288 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPaymentGateway creates a new instance of MockPaymentGateway. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentGateway(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentGateway {
+	mock := &MockPaymentGateway{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPaymentGateway is an autogenerated mock type for the PaymentGateway type
+type MockPaymentGateway struct {
+	mock.Mock
+}
+
+type MockPaymentGateway_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentGateway) EXPECT() *MockPaymentGateway_Expecter {
+	return &MockPaymentGateway_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function for the type MockPaymentGateway
+func (_mock *MockPaymentGateway) Authorize(ctx context.Context, req PaymentRequest) (*Payment, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 *Payment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PaymentRequest) (*Payment, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PaymentRequest) *Payment); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Payment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PaymentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentGateway_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type MockPaymentGateway_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req PaymentRequest
+func (_e *MockPaymentGateway_Expecter) Authorize(ctx any, req any) *MockPaymentGateway_Authorize_Call {
+	return &MockPaymentGateway_Authorize_Call{Call: _e.mock.On("Authorize", ctx, req)}
+}
+
+func (_c *MockPaymentGateway_Authorize_Call) Run(run func(ctx context.Context, req PaymentRequest)) *MockPaymentGateway_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 PaymentRequest
+		if args[1] != nil {
+			arg1 = args[1].(PaymentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentGateway_Authorize_Call) Return(payment *Payment, err error) *MockPaymentGateway_Authorize_Call {
+	_c.Call.Return(payment, err)
+	return _c
+}
+
+func (_c *MockPaymentGateway_Authorize_Call) RunAndReturn(run func(ctx context.Context, req PaymentRequest) (*Payment, error)) *MockPaymentGateway_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Capture provides a mock function for the type MockPaymentGateway
+func (_mock *MockPaymentGateway) Capture(ctx context.Context, paymentRef string) error {
+	ret := _mock.Called(ctx, paymentRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capture")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, paymentRef)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentGateway_Capture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Capture'
+type MockPaymentGateway_Capture_Call struct {
+	*mock.Call
+}
+
+// Capture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentRef string
+func (_e *MockPaymentGateway_Expecter) Capture(ctx any, paymentRef any) *MockPaymentGateway_Capture_Call {
+	return &MockPaymentGateway_Capture_Call{Call: _e.mock.On("Capture", ctx, paymentRef)}
+}
+
+func (_c *MockPaymentGateway_Capture_Call) Run(run func(ctx context.Context, paymentRef string)) *MockPaymentGateway_Capture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentGateway_Capture_Call) Return(err error) *MockPaymentGateway_Capture_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentGateway_Capture_Call) RunAndReturn(run func(ctx context.Context, paymentRef string) error) *MockPaymentGateway_Capture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refund provides a mock function for the type MockPaymentGateway
+func (_mock *MockPaymentGateway) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refund")
+	}
+
+	var r0 *Refund
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefundRequest) (*Refund, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefundRequest) *Refund); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Refund)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RefundRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentGateway_Refund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refund'
+type MockPaymentGateway_Refund_Call struct {
+	*mock.Call
+}
+
+// Refund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req RefundRequest
+func (_e *MockPaymentGateway_Expecter) Refund(ctx any, req any) *MockPaymentGateway_Refund_Call {
+	return &MockPaymentGateway_Refund_Call{Call: _e.mock.On("Refund", ctx, req)}
+}
+
+func (_c *MockPaymentGateway_Refund_Call) Run(run func(ctx context.Context, req RefundRequest)) *MockPaymentGateway_Refund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RefundRequest
+		if args[1] != nil {
+			arg1 = args[1].(RefundRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentGateway_Refund_Call) Return(refund *Refund, err error) *MockPaymentGateway_Refund_Call {
+	_c.Call.Return(refund, err)
+	return _c
+}
+
+func (_c *MockPaymentGateway_Refund_Call) RunAndReturn(run func(ctx context.Context, req RefundRequest) (*Refund, error)) *MockPaymentGateway_Refund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Void provides a mock function for the type MockPaymentGateway
+func (_mock *MockPaymentGateway) Void(ctx context.Context, paymentRef string) error {
+	ret := _mock.Called(ctx, paymentRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, paymentRef)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPaymentGateway_Void_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Void'
+type MockPaymentGateway_Void_Call struct {
+	*mock.Call
+}
+
+// Void is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentRef string
+func (_e *MockPaymentGateway_Expecter) Void(ctx any, paymentRef any) *MockPaymentGateway_Void_Call {
+	return &MockPaymentGateway_Void_Call{Call: _e.mock.On("Void", ctx, paymentRef)}
+}
+
+func (_c *MockPaymentGateway_Void_Call) Run(run func(ctx context.Context, paymentRef string)) *MockPaymentGateway_Void_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentGateway_Void_Call) Return(err error) *MockPaymentGateway_Void_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPaymentGateway_Void_Call) RunAndReturn(run func(ctx context.Context, paymentRef string) error) *MockPaymentGateway_Void_Call {
+	_c.Call.Return(run)
+	return _c
+}
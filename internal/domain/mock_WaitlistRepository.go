@@ -0,0 +1,580 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWaitlistRepository creates a new instance of MockWaitlistRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWaitlistRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWaitlistRepository {
+	mock := &MockWaitlistRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWaitlistRepository is an autogenerated mock type for the WaitlistRepository type
+type MockWaitlistRepository struct {
+	mock.Mock
+}
+
+type MockWaitlistRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWaitlistRepository) EXPECT() *MockWaitlistRepository_Expecter {
+	return &MockWaitlistRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) Delete(ctx context.Context, entryID string) error {
+	ret := _mock.Called(ctx, entryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, entryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWaitlistRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWaitlistRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entryID string
+func (_e *MockWaitlistRepository_Expecter) Delete(ctx any, entryID any) *MockWaitlistRepository_Delete_Call {
+	return &MockWaitlistRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, entryID)}
+}
+
+func (_c *MockWaitlistRepository_Delete_Call) Run(run func(ctx context.Context, entryID string)) *MockWaitlistRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Delete_Call) Return(err error) *MockWaitlistRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, entryID string) error) *MockWaitlistRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) Find(ctx context.Context, entryID string) (*WaitlistEntry, error) {
+	ret := _mock.Called(ctx, entryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*WaitlistEntry, error)); ok {
+		return returnFunc(ctx, entryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *WaitlistEntry); ok {
+		r0 = returnFunc(ctx, entryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWaitlistRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockWaitlistRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entryID string
+func (_e *MockWaitlistRepository_Expecter) Find(ctx any, entryID any) *MockWaitlistRepository_Find_Call {
+	return &MockWaitlistRepository_Find_Call{Call: _e.mock.On("Find", ctx, entryID)}
+}
+
+func (_c *MockWaitlistRepository_Find_Call) Run(run func(ctx context.Context, entryID string)) *MockWaitlistRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Find_Call) Return(waitlistEntry *WaitlistEntry, err error) *MockWaitlistRepository_Find_Call {
+	_c.Call.Return(waitlistEntry, err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Find_Call) RunAndReturn(run func(ctx context.Context, entryID string) (*WaitlistEntry, error)) *MockWaitlistRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByCampgroundID provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) FindByCampgroundID(ctx context.Context, campgroundID string) ([]*WaitlistEntry, error) {
+	ret := _mock.Called(ctx, campgroundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCampgroundID")
+	}
+
+	var r0 []*WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*WaitlistEntry, error)); ok {
+		return returnFunc(ctx, campgroundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*WaitlistEntry); ok {
+		r0 = returnFunc(ctx, campgroundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, campgroundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWaitlistRepository_FindByCampgroundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByCampgroundID'
+type MockWaitlistRepository_FindByCampgroundID_Call struct {
+	*mock.Call
+}
+
+// FindByCampgroundID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campgroundID string
+func (_e *MockWaitlistRepository_Expecter) FindByCampgroundID(ctx any, campgroundID any) *MockWaitlistRepository_FindByCampgroundID_Call {
+	return &MockWaitlistRepository_FindByCampgroundID_Call{Call: _e.mock.On("FindByCampgroundID", ctx, campgroundID)}
+}
+
+func (_c *MockWaitlistRepository_FindByCampgroundID_Call) Run(run func(ctx context.Context, campgroundID string)) *MockWaitlistRepository_FindByCampgroundID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindByCampgroundID_Call) Return(waitlistEntrys []*WaitlistEntry, err error) *MockWaitlistRepository_FindByCampgroundID_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindByCampgroundID_Call) RunAndReturn(run func(ctx context.Context, campgroundID string) ([]*WaitlistEntry, error)) *MockWaitlistRepository_FindByCampgroundID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExpiredOffers provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) FindExpiredOffers(ctx context.Context, now time.Time) ([]*WaitlistEntry, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpiredOffers")
+	}
+
+	var r0 []*WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*WaitlistEntry, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*WaitlistEntry); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWaitlistRepository_FindExpiredOffers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpiredOffers'
+type MockWaitlistRepository_FindExpiredOffers_Call struct {
+	*mock.Call
+}
+
+// FindExpiredOffers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockWaitlistRepository_Expecter) FindExpiredOffers(ctx any, now any) *MockWaitlistRepository_FindExpiredOffers_Call {
+	return &MockWaitlistRepository_FindExpiredOffers_Call{Call: _e.mock.On("FindExpiredOffers", ctx, now)}
+}
+
+func (_c *MockWaitlistRepository_FindExpiredOffers_Call) Run(run func(ctx context.Context, now time.Time)) *MockWaitlistRepository_FindExpiredOffers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindExpiredOffers_Call) Return(waitlistEntrys []*WaitlistEntry, err error) *MockWaitlistRepository_FindExpiredOffers_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindExpiredOffers_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]*WaitlistEntry, error)) *MockWaitlistRepository_FindExpiredOffers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindOffered provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) FindOffered(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*WaitlistEntry, error) {
+	ret := _mock.Called(ctx, campsiteID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOffered")
+	}
+
+	var r0 []*WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*WaitlistEntry, error)); ok {
+		return returnFunc(ctx, campsiteID, startDate, endDate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*WaitlistEntry); ok {
+		r0 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, campsiteID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWaitlistRepository_FindOffered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOffered'
+type MockWaitlistRepository_FindOffered_Call struct {
+	*mock.Call
+}
+
+// FindOffered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockWaitlistRepository_Expecter) FindOffered(ctx any, campsiteID any, startDate any, endDate any) *MockWaitlistRepository_FindOffered_Call {
+	return &MockWaitlistRepository_FindOffered_Call{Call: _e.mock.On("FindOffered", ctx, campsiteID, startDate, endDate)}
+}
+
+func (_c *MockWaitlistRepository_FindOffered_Call) Run(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time)) *MockWaitlistRepository_FindOffered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindOffered_Call) Return(waitlistEntrys []*WaitlistEntry, err error) *MockWaitlistRepository_FindOffered_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindOffered_Call) RunAndReturn(run func(ctx context.Context, campsiteID string, startDate time.Time, endDate time.Time) ([]*WaitlistEntry, error)) *MockWaitlistRepository_FindOffered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWaiting provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) FindWaiting(ctx context.Context, campsiteID string, campgroundID string, startDate time.Time, endDate time.Time) ([]*WaitlistEntry, error) {
+	ret := _mock.Called(ctx, campsiteID, campgroundID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWaiting")
+	}
+
+	var r0 []*WaitlistEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) ([]*WaitlistEntry, error)); ok {
+		return returnFunc(ctx, campsiteID, campgroundID, startDate, endDate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) []*WaitlistEntry); ok {
+		r0 = returnFunc(ctx, campsiteID, campgroundID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WaitlistEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, campsiteID, campgroundID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWaitlistRepository_FindWaiting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWaiting'
+type MockWaitlistRepository_FindWaiting_Call struct {
+	*mock.Call
+}
+
+// FindWaiting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campsiteID string
+//   - campgroundID string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockWaitlistRepository_Expecter) FindWaiting(ctx any, campsiteID any, campgroundID any, startDate any, endDate any) *MockWaitlistRepository_FindWaiting_Call {
+	return &MockWaitlistRepository_FindWaiting_Call{Call: _e.mock.On("FindWaiting", ctx, campsiteID, campgroundID, startDate, endDate)}
+}
+
+func (_c *MockWaitlistRepository_FindWaiting_Call) Run(run func(ctx context.Context, campsiteID string, campgroundID string, startDate time.Time, endDate time.Time)) *MockWaitlistRepository_FindWaiting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindWaiting_Call) Return(waitlistEntrys []*WaitlistEntry, err error) *MockWaitlistRepository_FindWaiting_Call {
+	_c.Call.Return(waitlistEntrys, err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_FindWaiting_Call) RunAndReturn(run func(ctx context.Context, campsiteID string, campgroundID string, startDate time.Time, endDate time.Time) ([]*WaitlistEntry, error)) *MockWaitlistRepository_FindWaiting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) Insert(ctx context.Context, entry *WaitlistEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *WaitlistEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWaitlistRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockWaitlistRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *WaitlistEntry
+func (_e *MockWaitlistRepository_Expecter) Insert(ctx any, entry any) *MockWaitlistRepository_Insert_Call {
+	return &MockWaitlistRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, entry)}
+}
+
+func (_c *MockWaitlistRepository_Insert_Call) Run(run func(ctx context.Context, entry *WaitlistEntry)) *MockWaitlistRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *WaitlistEntry
+		if args[1] != nil {
+			arg1 = args[1].(*WaitlistEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Insert_Call) Return(err error) *MockWaitlistRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Insert_Call) RunAndReturn(run func(ctx context.Context, entry *WaitlistEntry) error) *MockWaitlistRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockWaitlistRepository
+func (_mock *MockWaitlistRepository) Update(ctx context.Context, entry *WaitlistEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *WaitlistEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWaitlistRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWaitlistRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *WaitlistEntry
+func (_e *MockWaitlistRepository_Expecter) Update(ctx any, entry any) *MockWaitlistRepository_Update_Call {
+	return &MockWaitlistRepository_Update_Call{Call: _e.mock.On("Update", ctx, entry)}
+}
+
+func (_c *MockWaitlistRepository_Update_Call) Run(run func(ctx context.Context, entry *WaitlistEntry)) *MockWaitlistRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *WaitlistEntry
+		if args[1] != nil {
+			arg1 = args[1].(*WaitlistEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Update_Call) Return(err error) *MockWaitlistRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWaitlistRepository_Update_Call) RunAndReturn(run func(ctx context.Context, entry *WaitlistEntry) error) *MockWaitlistRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+)
+
+type (
+	// PaymentGateway is the port to the payment provider charging booking deposits.
+	// Implementations must treat IdempotencyKey so that retried calls with the same
+	// key do not charge or refund twice.
+	PaymentGateway interface {
+		Authorize(ctx context.Context, req PaymentRequest) (*Payment, error)
+		Capture(ctx context.Context, paymentRef string) error
+		Void(ctx context.Context, paymentRef string) error
+		Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+	}
+
+	PaymentRequest struct {
+		IdempotencyKey string
+		// Opaque payment method token issued by the payment provider to the client.
+		PaymentMethod string
+		Description   string
+		Amount        int64
+		Currency      string
+	}
+
+	Payment struct {
+		Reference string
+		Amount    int64
+		Currency  string
+	}
+
+	RefundRequest struct {
+		IdempotencyKey string
+		PaymentRef     string
+		Amount         int64
+	}
+
+	Refund struct {
+		Reference string
+		Amount    int64
+	}
+
+	// DepositPolicy defines the share of the total booking price charged when
+	// booking is created.
+	DepositPolicy struct {
+		Percent int32
+	}
+)
+
+// Amount returns the deposit for the total price, rounded half up to the minor
+// currency unit.
+func (p DepositPolicy) Amount(totalPrice int64) int64 {
+	percent := min(max(p.Percent, 0), 100)
+	return (totalPrice*int64(percent) + 50) / 100
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+)
+
+type readYourWritesKey struct{}
+
+// WithReadYourWrites returns a copy of ctx whose reads see the writes committed
+// before them, i.e. are not served by a read replica lagging behind the
+// primary database.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+// ReadYourWrites reports whether the reads of ctx must see the writes committed
+// before them, see WithReadYourWrites.
+func ReadYourWrites(ctx context.Context) bool {
+	ryw, _ := ctx.Value(readYourWritesKey{}).(bool)
+	return ryw
+}
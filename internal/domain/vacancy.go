@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DateRange is a half-open range of nights, i.e. EndDate is the check-out date.
+type DateRange struct {
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type Vacancy struct {
+	Dates  []time.Time
+	Ranges []DateRange
+}
+
+func (r DateRange) Nights() int {
+	return int(r.EndDate.Sub(r.StartDate).Hours() / 24)
+}
+
+func (v *Vacancy) String() string {
+	result, _ := json.Marshal(v)
+	return string(result)
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"log/slog"
+	"time"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting  WaitlistStatus = "WAITING"
+	WaitlistStatusOffered  WaitlistStatus = "OFFERED"
+	WaitlistStatusAccepted WaitlistStatus = "ACCEPTED"
+	WaitlistStatusExpired  WaitlistStatus = "EXPIRED"
+)
+
+type (
+	// WaitlistEntry is a request of a guest for dates that were not available,
+	// either at a specific campsite or at any campsite of the campground with
+	// enough capacity if CampsiteID is empty.
+	WaitlistEntry struct {
+		// Persistence ID
+		ID int64
+		// Business ID
+		EntryID      string
+		CampgroundID string
+		CampsiteID   string
+		Email        string
+		FullName     string
+		StartDate    time.Time
+		EndDate      time.Time
+		Guests       int32
+		Status       WaitlistStatus
+		// Campsite offered to guest once its dates were vacated and deadline to
+		// accept the offer; set while Status is WaitlistStatusOffered.
+		OfferCampsiteID string
+		OfferExpiresAt  time.Time
+		// Booking created when offer was accepted.
+		BookingID string
+		CreatedAt time.Time
+	}
+
+	// WaitlistPolicy defines how long a guest has to accept an offer.
+	WaitlistPolicy struct {
+		OfferTTL time.Duration
+	}
+)
+
+// Matches reports whether the entry can be offered the campsite.
+func (e *WaitlistEntry) Matches(campsite *Campsite) bool {
+	if e.CampsiteID != "" {
+		return e.CampsiteID == campsite.CampsiteID
+	}
+	return e.CampgroundID == campsite.CampgroundID && campsite.Capacity >= e.Guests
+}
+
+// Offer holds the campsite for the guest to accept until the offer expires, the
+// dates cannot be booked by other guests meanwhile.
+func (e *WaitlistEntry) Offer(campsiteID string, now time.Time, policy WaitlistPolicy) {
+	e.Status = WaitlistStatusOffered
+	e.OfferCampsiteID = campsiteID
+	e.OfferExpiresAt = now.Add(policy.OfferTTL)
+}
+
+// OfferDates returns the nights the offered campsite is held for the guest.
+func (e *WaitlistEntry) OfferDates() []time.Time {
+	var dates []time.Time
+	for d := e.StartDate; d.Before(e.EndDate); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+func (e *WaitlistEntry) OfferExpired(now time.Time) bool {
+	return e.Status == WaitlistStatusOffered && now.After(e.OfferExpiresAt)
+}
+
+// String formats the entry as LogValue does.
+func (e *WaitlistEntry) String() string {
+	return e.LogValue().String()
+}
+
+// LogValue logs the entry without the personal data of its guest, i.e. its
+// Email and FullName.
+func (e *WaitlistEntry) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("entry_id", e.EntryID),
+		slog.String("campground_id", e.CampgroundID),
+		slog.String("campsite_id", e.CampsiteID),
+		slog.String("start_date", e.StartDate.Format(time.DateOnly)),
+		slog.String("end_date", e.EndDate.Format(time.DateOnly)),
+		slog.Int64("guests", int64(e.Guests)),
+		slog.String("status", string(e.Status)),
+		slog.String("offer_campsite_id", e.OfferCampsiteID),
+		slog.String("booking_id", e.BookingID),
+	)
+}
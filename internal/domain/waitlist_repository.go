@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type WaitlistRepository interface {
+	Find(ctx context.Context, entryID string) (*WaitlistEntry, error)
+	FindByCampgroundID(ctx context.Context, campgroundID string) ([]*WaitlistEntry, error)
+	// FindWaiting returns entries still waiting for dates overlapping the date
+	// range, either at the campsite or at any campsite of the campground, in
+	// order they joined the waitlist.
+	FindWaiting(
+		ctx context.Context,
+		campsiteID string,
+		campgroundID string,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*WaitlistEntry, error)
+	// FindOffered returns entries holding an unexpired offer of the campsite for
+	// dates overlapping the date range.
+	FindOffered(
+		ctx context.Context,
+		campsiteID string,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*WaitlistEntry, error)
+	// FindExpiredOffers returns entries whose offer expired by the given time,
+	// in order they expired.
+	FindExpiredOffers(ctx context.Context, now time.Time) ([]*WaitlistEntry, error)
+	Insert(ctx context.Context, entry *WaitlistEntry) error
+	Update(ctx context.Context, entry *WaitlistEntry) error
+	Delete(ctx context.Context, entryID string) error
+}
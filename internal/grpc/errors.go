@@ -0,0 +1,252 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const (
+	// errorDomain is the domain of the reasons of the ErrorInfo details.
+	errorDomain = "campgrounds.v1"
+	// internalErrorMessage replaces the message of unexpected errors, which
+	// may leak SQL or other internals; the error itself is only logged.
+	internalErrorMessage = "internal error"
+)
+
+// domainErrors are the domain errors with the code and the stable reason, for
+// clients to branch on, they are returned with.
+var domainErrors = []struct {
+	match  func(error) (error, bool)
+	code   codes.Code
+	reason string
+}{
+	{match: as[domain.ErrBookingNotFound], code: codes.NotFound, reason: "BOOKING_NOT_FOUND"},
+	{match: as[domain.ErrCampgroundNotFound], code: codes.NotFound, reason: "CAMPGROUND_NOT_FOUND"},
+	{
+		match:  as[domain.ErrCampgroundSeasonNotFound],
+		code:   codes.NotFound,
+		reason: "CAMPGROUND_SEASON_NOT_FOUND",
+	},
+	{match: as[domain.ErrCampsiteNotFound], code: codes.NotFound, reason: "CAMPSITE_NOT_FOUND"},
+	{
+		match:  as[domain.ErrCampsiteRatesNotFound],
+		code:   codes.NotFound,
+		reason: "CAMPSITE_RATES_NOT_FOUND",
+	},
+	{
+		match:  as[domain.ErrCampsiteBlackoutNotFound],
+		code:   codes.NotFound,
+		reason: "CAMPSITE_BLACKOUT_NOT_FOUND",
+	},
+	{
+		match:  as[domain.ErrWaitlistEntryNotFound],
+		code:   codes.NotFound,
+		reason: "WAITLIST_ENTRY_NOT_FOUND",
+	},
+	{
+		match:  as[domain.ErrGroupBookingNotFound],
+		code:   codes.NotFound,
+		reason: "GROUP_BOOKING_NOT_FOUND",
+	},
+	{match: as[domain.ErrGuestNotFound], code: codes.NotFound, reason: "GUEST_NOT_FOUND"},
+	{
+		match:  as[domain.ErrCalendarSubscriptionNotFound],
+		code:   codes.NotFound,
+		reason: "CALENDAR_SUBSCRIPTION_NOT_FOUND",
+	},
+	{
+		match:  as[domain.ErrBookingAlreadyCancelled],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_ALREADY_CANCELLED",
+	},
+	{
+		match:  as[domain.ErrBookingDatesNotAvailable],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_DATES_NOT_AVAILABLE",
+	},
+	{
+		match:  as[domain.ErrBookingDatesBlackedOut],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_DATES_BLACKED_OUT",
+	},
+	{
+		match:  as[domain.ErrBookingStatusTransition],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_STATUS_TRANSITION",
+	},
+	{
+		match:  as[domain.ErrBookingNotModifiable],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_NOT_MODIFIABLE",
+	},
+	{
+		match:  as[domain.ErrBookingNotStarted],
+		code:   codes.FailedPrecondition,
+		reason: "BOOKING_NOT_STARTED",
+	},
+	{
+		match:  as[domain.ErrCampgroundInUse],
+		code:   codes.FailedPrecondition,
+		reason: "CAMPGROUND_IN_USE",
+	},
+	{
+		match:  as[domain.ErrPaymentDeclined],
+		code:   codes.FailedPrecondition,
+		reason: "PAYMENT_DECLINED",
+	},
+	{
+		match:  as[domain.ErrCancellationNotAllowed],
+		code:   codes.FailedPrecondition,
+		reason: "CANCELLATION_NOT_ALLOWED",
+	},
+	{
+		match:  as[domain.ErrWaitlistOfferNotFound],
+		code:   codes.FailedPrecondition,
+		reason: "WAITLIST_OFFER_NOT_FOUND",
+	},
+	{
+		match:  as[domain.ErrWaitlistOfferExpired],
+		code:   codes.FailedPrecondition,
+		reason: "WAITLIST_OFFER_EXPIRED",
+	},
+	{
+		match:  as[domain.ErrGroupBookingAlreadyCancelled],
+		code:   codes.FailedPrecondition,
+		reason: "GROUP_BOOKING_ALREADY_CANCELLED",
+	},
+	{
+		match:  as[domain.ErrGuestEmailInUse],
+		code:   codes.FailedPrecondition,
+		reason: "GUEST_EMAIL_IN_USE",
+	},
+	{
+		match:  as[domain.ErrGuestHasActiveBookings],
+		code:   codes.FailedPrecondition,
+		reason: "GUEST_HAS_ACTIVE_BOOKINGS",
+	},
+	{
+		match:  as[domain.ErrBookingValidation],
+		code:   codes.InvalidArgument,
+		reason: "BOOKING_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrCampsiteRatesValidation],
+		code:   codes.InvalidArgument,
+		reason: "CAMPSITE_RATES_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrPaymentMethodRequired],
+		code:   codes.InvalidArgument,
+		reason: "PAYMENT_METHOD_REQUIRED",
+	},
+	{match: as[domain.ErrInvalidDate], code: codes.InvalidArgument, reason: "INVALID_DATE"},
+	{
+		match:  as[domain.ErrGroupBookingValidation],
+		code:   codes.InvalidArgument,
+		reason: "GROUP_BOOKING_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrCampsiteBlackoutValidation],
+		code:   codes.InvalidArgument,
+		reason: "CAMPSITE_BLACKOUT_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrCampgroundSeasonValidation],
+		code:   codes.InvalidArgument,
+		reason: "CAMPGROUND_SEASON_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrCampsiteImportValidation],
+		code:   codes.InvalidArgument,
+		reason: "CAMPSITE_IMPORT_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrCalendarSubscriptionValidation],
+		code:   codes.InvalidArgument,
+		reason: "CALENDAR_SUBSCRIPTION_VALIDATION",
+	},
+	{
+		match:  as[domain.ErrBookingConcurrentUpdate],
+		code:   codes.Aborted,
+		reason: "BOOKING_CONCURRENT_UPDATE",
+	},
+}
+
+// findDomainError returns the domain error in the tree of e, which may be
+// wrapped or joined with other errors, with its code and reason.
+func findDomainError(e error) (error, codes.Code, string, bool) {
+	for _, d := range domainErrors {
+		if domainErr, ok := d.match(e); ok {
+			return domainErr, d.code, d.reason, true
+		}
+	}
+	return nil, codes.Unknown, "", false
+}
+
+// as returns the first error of type T in the tree of e.
+func as[T error](e error) (error, bool) {
+	var target T
+	if errors.As(e, &target) {
+		return target, true
+	}
+	return nil, false
+}
+
+// handleDomainError converts e to a status carrying the reason of the domain
+// error as ErrorInfo and, for booking validation and other errors of a single
+// field, the failed validations as BadRequest field violations. The domain
+// error may be wrapped, its own message is then returned without the wrapping
+// ones. Errors that are not domain errors are logged and returned as Internal
+// without their detail.
+func handleDomainError(ctx context.Context, e error) error {
+	domainErr, code, reason, ok := findDomainError(e)
+	if !ok {
+		return handleUnexpectedError(ctx, e)
+	}
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain},
+	}
+	if v, ok := domainErr.(domain.ErrBookingValidation); ok && v.MultiErr != nil {
+		details = append(details, badRequest(v.MultiErr.Errors))
+	} else if _, ok := domainErr.(domain.FieldError); ok {
+		details = append(details, badRequest([]error{domainErr}))
+	}
+	st, err := status.New(code, domainErr.Error()).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, domainErr.Error())
+	}
+	return st.Err()
+}
+
+func handleUnexpectedError(ctx context.Context, e error) error {
+	switch {
+	case errors.Is(e, context.Canceled):
+		return status.Error(codes.Canceled, e.Error())
+	case errors.Is(e, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, e.Error())
+	}
+	slog.ErrorContext(ctx, "unexpected error", slog.Any("error", e))
+	return status.Error(codes.Internal, internalErrorMessage)
+}
+
+// badRequest returns a field violation per failed validation, with the field
+// of the validation error if it has one.
+func badRequest(errs []error) *errdetails.BadRequest {
+	br := &errdetails.BadRequest{}
+	for _, err := range errs {
+		v := &errdetails.BadRequest_FieldViolation{Description: err.Error()}
+		var fieldErr domain.FieldError
+		if errors.As(err, &fieldErr) {
+			v.Field = fieldErr.Field()
+		}
+		br.FieldViolations = append(br.FieldViolations, v)
+	}
+	return br
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// assertStatusError asserts the code and message of the status of got, the
+// details of the status are asserted by TestErrors_handleDomainError.
+func assertStatusError(t *testing.T, want, got error, msgAndArgs ...any) bool {
+	t.Helper()
+	if want == nil {
+		return assert.NoError(t, got, msgAndArgs...)
+	}
+	wantStatus, gotStatus := status.Convert(want), status.Convert(got)
+	return assert.Equal(t, wantStatus.Code(), gotStatus.Code(), msgAndArgs...) &&
+		assert.Equal(t, wantStatus.Message(), gotStatus.Message(), msgAndArgs...)
+}
+
+func TestErrors_handleDomainError(t *testing.T) {
+	errBookingValidation := domain.ErrBookingValidation{
+		MultiErr: multierror.Append(
+			validator.ErrBookingStartDateBeforeEndDate{},
+			validator.ErrBookingMaximumStay{},
+		),
+	}
+	errBookingNotFound := domain.ErrBookingNotFound{BookingID: "booking-id"}
+	errConcurrentUpdate := domain.ErrBookingConcurrentUpdate{}
+	errInvalidDate := domain.ErrInvalidDate{FieldName: "start_date", Value: "2025-02-30"}
+
+	tests := map[string]struct {
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+		wantDetails []proto.Message
+	}{
+		"NotFound": {
+			err:         errBookingNotFound,
+			wantCode:    codes.NotFound,
+			wantMessage: errBookingNotFound.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "BOOKING_NOT_FOUND", Domain: errorDomain},
+			},
+		},
+		"Aborted_ConcurrentUpdate": {
+			err:         errConcurrentUpdate,
+			wantCode:    codes.Aborted,
+			wantMessage: errConcurrentUpdate.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "BOOKING_CONCURRENT_UPDATE", Domain: errorDomain},
+			},
+		},
+		"InvalidArgument_BookingValidation": {
+			err:         errBookingValidation,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: errBookingValidation.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "BOOKING_VALIDATION", Domain: errorDomain},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{Field: "start_date", Description: "start_date: must be before end_date"},
+						{
+							Field:       "end_date",
+							Description: "maximum stay: must be less or equal to three days",
+						},
+					},
+				},
+			},
+		},
+		"InvalidArgument_InvalidDate": {
+			err:         errInvalidDate,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: errInvalidDate.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "INVALID_DATE", Domain: errorDomain},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{
+							Field:       "start_date",
+							Description: `start_date: invalid date "2025-02-30", must be YYYY-MM-DD`,
+						},
+					},
+				},
+			},
+		},
+		"NotFound_Wrapped": {
+			err:         errors.Wrap(errBookingNotFound, "find booking"),
+			wantCode:    codes.NotFound,
+			wantMessage: errBookingNotFound.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "BOOKING_NOT_FOUND", Domain: errorDomain},
+			},
+		},
+		"InvalidArgument_InvalidDate_Wrapped": {
+			err:         fmt.Errorf("parse booking: %w", errInvalidDate),
+			wantCode:    codes.InvalidArgument,
+			wantMessage: errInvalidDate.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "INVALID_DATE", Domain: errorDomain},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{
+							Field:       "start_date",
+							Description: `start_date: invalid date "2025-02-30", must be YYYY-MM-DD`,
+						},
+					},
+				},
+			},
+		},
+		"InvalidArgument_BookingValidation_InMultiError": {
+			err:         multierror.Append(bootstrap.ErrQuery, errBookingValidation),
+			wantCode:    codes.InvalidArgument,
+			wantMessage: errBookingValidation.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "BOOKING_VALIDATION", Domain: errorDomain},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{Field: "start_date", Description: "start_date: must be before end_date"},
+						{
+							Field:       "end_date",
+							Description: "maximum stay: must be less or equal to three days",
+						},
+					},
+				},
+			},
+		},
+		"Internal_Sanitized": {
+			err:         bootstrap.ErrQuery,
+			wantCode:    codes.Internal,
+			wantMessage: internalErrorMessage,
+		},
+		"Canceled": {
+			err:         context.Canceled,
+			wantCode:    codes.Canceled,
+			wantMessage: context.Canceled.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got := status.Convert(handleDomainError(context.TODO(), tc.err))
+			// then
+			assert.Equal(t, tc.wantCode, got.Code())
+			assert.Equal(t, tc.wantMessage, got.Message())
+			if assert.Len(t, got.Details(), len(tc.wantDetails)) {
+				for i, want := range tc.wantDetails {
+					assert.True(t, proto.Equal(want, got.Details()[i].(proto.Message)),
+						"handleDomainError() details[%d] = %v, want %v", i, got.Details()[i], want)
+				}
+			}
+		})
+	}
+}
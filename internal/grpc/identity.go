@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type (
+	// ClientIdentity identifies a client by the certificate it presented on
+	// a mutual TLS connection.
+	ClientIdentity struct {
+		CommonName   string
+		Organization []string
+		DNSNames     []string
+		URIs         []string
+		SerialNumber string
+	}
+
+	clientIdentityKey struct{}
+)
+
+// ClientIdentityFromContext returns the identity of the client of the call,
+// if the client presented a verified certificate.
+func ClientIdentityFromContext(ctx context.Context) (ClientIdentity, bool) {
+	id, ok := ctx.Value(clientIdentityKey{}).(ClientIdentity)
+	return id, ok
+}
+
+// ContextWithClientIdentity returns a copy of ctx carrying the identity.
+func ContextWithClientIdentity(ctx context.Context, id ClientIdentity) context.Context {
+	return context.WithValue(ctx, clientIdentityKey{}, id)
+}
+
+func newClientIdentity(cert *x509.Certificate) ClientIdentity {
+	id := ClientIdentity{
+		CommonName:   cert.Subject.CommonName,
+		Organization: cert.Subject.Organization,
+		DNSNames:     cert.DNSNames,
+		SerialNumber: cert.SerialNumber.String(),
+	}
+	for _, uri := range cert.URIs {
+		id.URIs = append(id.URIs, uri.String())
+	}
+	return id
+}
+
+// withClientIdentity adds the identity of the client to ctx, if the peer of
+// the call presented a verified certificate.
+func withClientIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return ctx
+	}
+	return ContextWithClientIdentity(ctx, newClientIdentity(tlsInfo.State.VerifiedChains[0][0]))
+}
+
+func clientIdentityUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withClientIdentity(ctx), req)
+}
+
+func clientIdentityStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	wrapped := middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = withClientIdentity(ss.Context())
+	return handler(srv, wrapped)
+}
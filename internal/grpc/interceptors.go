@@ -3,14 +3,196 @@ package grpc
 import (
 	"context"
 	"log/slog"
+	"runtime/debug"
+	"slices"
+	"strconv"
 
+	"github.com/google/uuid"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	requestIDHeader = "x-request-id"
+	// maxRequestIDLength bounds the request IDs accepted from clients, which
+	// end up in every log record of the call.
+	maxRequestIDLength = 128
+	// readYourWritesHeader set to true makes the reads of a call see the writes
+	// committed before it, e.g. by the previous call of the client.
+	readYourWritesHeader = "x-read-your-writes"
 )
 
 func interceptorLogger() logging.Logger {
 	return logging.LoggerFunc(
 		func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
-			slog.Log(ctx, slog.Level(lvl), msg, fields...)
+			slog.Log(ctx, slog.Level(lvl), msg, redactPayloads(fields)...)
 		},
 	)
 }
+
+// redactPayloads returns the fields with the request and response payloads
+// replaced by copies with their sensitive fields redacted, see
+// logger.Sensitive.
+func redactPayloads(fields []any) []any {
+	var redacted []any
+	for i, f := range fields {
+		m, ok := f.(proto.Message)
+		if !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = slices.Clone(fields)
+		}
+		redacted[i] = redactMessage(m)
+	}
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
+
+func redactMessage(m proto.Message) proto.Message {
+	c := proto.Clone(m)
+	redactFields(c.ProtoReflect())
+	return c
+}
+
+func redactFields(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case logger.Sensitive(string(fd.Name())):
+			sensitive = append(sensitive, fd)
+		case fd.IsList() && fd.Message() != nil:
+			for i := range v.List().Len() {
+				redactFields(v.List().Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactFields(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			redactFields(v.Message())
+		}
+		return true
+	})
+	for _, fd := range sensitive {
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			m.Set(fd, protoreflect.ValueOfString(logger.RedactedValue))
+		} else {
+			m.Clear(fd)
+		}
+	}
+}
+
+// payloadLoggingOpts log the request and response payloads of a call at the
+// debug level.
+func payloadLoggingOpts() []logging.Option {
+	return []logging.Option{
+		logging.WithLogOnEvents(logging.PayloadReceived, logging.PayloadSent),
+		logging.WithLevels(func(codes.Code) logging.Level { return logging.LevelDebug }),
+	}
+}
+
+// debugEnabled is evaluated on every call, so that payload logging follows
+// the log level changed at runtime.
+func debugEnabled(ctx context.Context, _ interceptors.CallMeta) bool {
+	return slog.Default().Enabled(ctx, slog.LevelDebug)
+}
+
+// withRequestID adds the request ID sent by the client to ctx, or a new one
+// if none or an invalid one was sent, and returns it in the response headers.
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDHeader); len(values) > 0 {
+		id = values[0]
+	}
+	if !validRequestID(id) {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+	return logger.WithRequestID(ctx, id)
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func requestIDUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func requestIDStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	wrapped := middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = withRequestID(ss.Context())
+	return handler(srv, wrapped)
+}
+
+// withReadYourWrites adds read-your-writes to ctx if requested by the client,
+// see domain.WithReadYourWrites.
+func withReadYourWrites(ctx context.Context) context.Context {
+	values := metadata.ValueFromIncomingContext(ctx, readYourWritesHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	if ryw, err := strconv.ParseBool(values[0]); err != nil || !ryw {
+		return ctx
+	}
+	return domain.WithReadYourWrites(ctx)
+}
+
+func readYourWritesUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withReadYourWrites(ctx), req)
+}
+
+func readYourWritesStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	wrapped := middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = withReadYourWrites(ss.Context())
+	return handler(srv, wrapped)
+}
+
+// recoverPanic logs the panic of a handler with its stack trace, and fails
+// the call with Internal instead of crashing the process.
+func recoverPanic(ctx context.Context, p any) error {
+	slog.ErrorContext(ctx, "recovered from panic",
+		slog.Any("panic", p), slog.String("stack", string(debug.Stack())))
+	return status.Error(codes.Internal, internalErrorMessage)
+}
@@ -4,11 +4,19 @@ import (
 	"bytes"
 	"context"
 	"log/slog"
+	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestInterceptors_InterceptorLogger(t *testing.T) {
@@ -23,3 +31,113 @@ func TestInterceptors_InterceptorLogger(t *testing.T) {
 	assert.Contains(t, got, want,
 		"interceptorLogger.Log() got = %s, want %s", got, want)
 }
+
+func TestInterceptors_redactPayloads(t *testing.T) {
+	// given
+	req := &api.CreateBookingRequest{
+		CampsiteId: "c-1", Email: "jane@example.com", FullName: "Jane Doe",
+	}
+	resp := &api.ListGuestBookingsResponse{Bookings: []*api.Booking{
+		{BookingId: "b-1", Email: "jane@example.com", FullName: "Jane Doe"},
+	}}
+	fields := []any{"grpc.request.content", req, "grpc.response.content", resp}
+	// when
+	got := redactPayloads(fields)
+	// then
+	assert.True(t, proto.Equal(&api.CreateBookingRequest{
+		CampsiteId: "c-1", Email: logger.RedactedValue, FullName: logger.RedactedValue,
+	}, got[1].(proto.Message)))
+	assert.True(t, proto.Equal(&api.ListGuestBookingsResponse{Bookings: []*api.Booking{
+		{BookingId: "b-1", Email: logger.RedactedValue, FullName: logger.RedactedValue},
+	}}, got[3].(proto.Message)))
+	assert.Equal(t, "jane@example.com", req.GetEmail(), "request modified")
+	assert.Same(t, req, fields[1], "fields modified")
+}
+
+func TestInterceptors_withRequestID(t *testing.T) {
+	tests := map[string]struct {
+		requestID string
+		want      string
+	}{
+		"Accepted": {
+			requestID: "5f0e8a1c-client",
+			want:      "5f0e8a1c-client",
+		},
+		"Generated_NoRequestID": {},
+		"Generated_TooLong": {
+			requestID: strings.Repeat("a", maxRequestIDLength+1),
+		},
+		"Generated_InvalidCharacters": {
+			requestID: "id\nforged log line",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			if tc.requestID != "" {
+				ctx = metadata.NewIncomingContext(
+					ctx,
+					metadata.Pairs(requestIDHeader, tc.requestID),
+				)
+			}
+			// when
+			got := logger.RequestID(withRequestID(ctx))
+			// then
+			if tc.want != "" {
+				assert.Equal(t, tc.want, got)
+				return
+			}
+			assert.NoError(t, uuid.Validate(got), "request ID %q not generated", got)
+		})
+	}
+}
+
+func TestInterceptors_withReadYourWrites(t *testing.T) {
+	tests := map[string]struct {
+		header string
+		want   bool
+	}{
+		"Requested": {
+			header: "true",
+			want:   true,
+		},
+		"NotRequested_NoHeader": {},
+		"NotRequested_False": {
+			header: "false",
+		},
+		"NotRequested_Invalid": {
+			header: "yes please",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.NewIncomingContext(
+					ctx,
+					metadata.Pairs(readYourWritesHeader, tc.header),
+				)
+			}
+			// when
+			got := domain.ReadYourWrites(withReadYourWrites(ctx))
+			// then
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestInterceptors_recoverPanic(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	slog.SetDefault(logger.NewDefault(&buf, nil))
+	ctx := logger.WithRequestID(context.Background(), "req-1")
+	// when
+	err := recoverPanic(ctx, "nil map")
+	// then
+	assert.Equal(t, status.Error(codes.Internal, internalErrorMessage), err)
+	assert.Contains(t, buf.String(), "recovered from panic")
+	assert.Contains(t, buf.String(), "req-1")
+	assert.Contains(t, buf.String(), "runtime/debug.Stack")
+}
@@ -0,0 +1,245 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// retryAfterHeader is set on rejected calls, in whole seconds, for
+	// clients not decoding the RetryInfo details of the status.
+	retryAfterHeader = "retry-after"
+	// overloadedRetryAfter is the hint given when the in-flight cap is reached.
+	overloadedRetryAfter = time.Second
+	// idleBucketTTL is the time after which the bucket of an idle client is
+	// dropped, a dropped bucket is full again on the next call.
+	idleBucketTTL = 10 * time.Minute
+)
+
+type (
+	// Rate allows Limit calls per second on average, and bursts of up to
+	// Burst calls.
+	Rate struct {
+		Limit float64
+		Burst int
+	}
+
+	// RateLimitConfig sets the limits of a client, either identified by its
+	// certificate or by its peer address. Zero rates and caps are unlimited.
+	RateLimitConfig struct {
+		// Client limits the calls of a client to all methods.
+		Client Rate
+		// Methods limits the calls of a client to the methods keyed by name,
+		// e.g. CreateBooking.
+		Methods map[string]Rate
+		// MaxInFlight caps the calls, and the streams, being handled at once
+		// across all clients, shedding load before the database connection
+		// pool is exhausted.
+		MaxInFlight int
+	}
+
+	// rateLimiter throttles the calls of every client with token buckets.
+	rateLimiter struct {
+		cfg      RateLimitConfig
+		inFlight chan struct{}
+		now      func() time.Time
+
+		mu        sync.Mutex
+		buckets   map[bucketKey]*tokenBucket
+		lastSweep time.Time
+	}
+
+	bucketKey struct {
+		client string
+		method string
+	}
+
+	tokenBucket struct {
+		tokens   float64
+		lastSeen time.Time
+	}
+)
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	l := &rateLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*tokenBucket),
+	}
+	if cfg.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, cfg.MaxInFlight)
+	}
+	return l
+}
+
+// UnaryServerInterceptor rejects the calls over the limits of their client,
+// and the calls over the in-flight cap, with ResourceExhausted.
+func (l *rateLimiter) UnaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	release, err := l.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor limits the streams as UnaryServerInterceptor does
+// the unary calls, a stream holds its in-flight slot until it ends.
+func (l *rateLimiter) StreamServerInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	release, err := l.admit(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+// admit takes a token from the buckets of the client and an in-flight slot,
+// returning the func releasing the slot once the call is handled.
+func (l *rateLimiter) admit(ctx context.Context, fullMethod string) (func(), error) {
+	client := clientKey(ctx)
+	method := methodName(fullMethod)
+	if retryAfter, ok := l.allow(client, method); !ok {
+		slog.WarnContext(ctx, "rate limit exceeded",
+			slog.String("client", client), slog.String("method", method))
+		return nil, resourceExhausted(ctx, "rate limit exceeded, retry later", retryAfter)
+	}
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, nil
+	default:
+		slog.WarnContext(ctx, "server overloaded, call shed",
+			slog.String("client", client), slog.String("method", method))
+		return nil, resourceExhausted(ctx, "server overloaded, retry later", overloadedRetryAfter)
+	}
+}
+
+// allow takes a token from the buckets of the client, returning the time to
+// wait for a token otherwise.
+func (l *rateLimiter) allow(client, method string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > idleBucketTTL {
+		l.sweep(now)
+	}
+	var wait time.Duration
+	checks := []struct {
+		key  bucketKey
+		rate Rate
+	}{
+		{key: bucketKey{client: client}, rate: l.cfg.Client},
+		{key: bucketKey{client: client, method: method}, rate: l.cfg.Methods[method]},
+	}
+	for _, c := range checks {
+		if c.rate.Limit > 0 {
+			wait = max(wait, l.bucket(c.key, c.rate, now).wait(c.rate))
+		}
+	}
+	if wait > 0 {
+		return wait, false
+	}
+	// tokens are taken only once all the buckets allow the call
+	for _, c := range checks {
+		if c.rate.Limit > 0 {
+			l.buckets[c.key].tokens--
+		}
+	}
+	return 0, true
+}
+
+func (l *rateLimiter) bucket(key bucketKey, rate Rate, now time.Time) *tokenBucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(max(rate.Burst, 1)), lastSeen: now}
+		l.buckets[key] = b
+	}
+	b.refill(rate, now)
+	return b
+}
+
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > idleBucketTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (b *tokenBucket) refill(rate Rate, now time.Time) {
+	elapsed := now.Sub(b.lastSeen).Seconds()
+	b.tokens = math.Min(float64(max(rate.Burst, 1)), b.tokens+elapsed*rate.Limit)
+	b.lastSeen = now
+}
+
+// wait returns the time until a token is available, zero if one is.
+func (b *tokenBucket) wait(rate Rate) time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / rate.Limit * float64(time.Second))
+}
+
+// clientKey identifies the client of the call by its certificate, or by its
+// peer address if it presented none.
+func clientKey(ctx context.Context) string {
+	if id, ok := ClientIdentityFromContext(ctx); ok {
+		return "cn:" + id.CommonName
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "addr:" + p.Addr.String()
+	}
+	return "addr:" + host
+}
+
+// methodName returns the name of the method of /package.Service/Method.
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func resourceExhausted(ctx context.Context, msg string, retryAfter time.Duration) error {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(
+		retryAfterHeader, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+	))
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+	return st.Err()
+}
@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/campgroundspb.v1.CampgroundsService/CreateBooking"
+
+func peerContext(addr string) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+}
+
+func TestRateLimit_UnaryServerInterceptor(t *testing.T) {
+	type call struct {
+		ctx     context.Context
+		method  string
+		advance time.Duration
+	}
+	alice := peerContext("10.0.0.1:50001")
+	aliceOtherPort := peerContext("10.0.0.1:50002")
+	bob := peerContext("10.0.0.2:50001")
+	carol := ContextWithClientIdentity(alice, ClientIdentity{CommonName: "carol"})
+
+	tests := map[string]struct {
+		cfg   RateLimitConfig
+		calls []call
+		want  []codes.Code
+	}{
+		"Unlimited": {
+			calls: []call{{ctx: alice}, {ctx: alice}, {ctx: alice}},
+			want:  []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		"Client_BurstExceeded": {
+			cfg:   RateLimitConfig{Client: Rate{Limit: 1, Burst: 2}},
+			calls: []call{{ctx: alice}, {ctx: aliceOtherPort}, {ctx: alice}},
+			want:  []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		"Client_Refilled": {
+			cfg: RateLimitConfig{Client: Rate{Limit: 1, Burst: 1}},
+			calls: []call{
+				{ctx: alice},
+				{ctx: alice, advance: 500 * time.Millisecond},
+				{ctx: alice, advance: 500 * time.Millisecond},
+			},
+			want: []codes.Code{codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+		"Client_LimitedSeparately": {
+			cfg:   RateLimitConfig{Client: Rate{Limit: 1, Burst: 1}},
+			calls: []call{{ctx: alice}, {ctx: bob}, {ctx: carol}, {ctx: alice}},
+			want:  []codes.Code{codes.OK, codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		"Method_Exceeded": {
+			cfg: RateLimitConfig{
+				Client:  Rate{Limit: 100, Burst: 100},
+				Methods: map[string]Rate{"CreateBooking": {Limit: 1, Burst: 1}},
+			},
+			calls: []call{
+				{ctx: alice, method: testMethod},
+				{ctx: alice, method: "/campgroundspb.v1.CampgroundsService/GetBooking"},
+				{ctx: alice, method: testMethod},
+				{ctx: bob, method: testMethod},
+			},
+			want: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			now := time.Now()
+			l := newRateLimiter(tc.cfg)
+			l.now = func() time.Time { return now }
+			handler := func(context.Context, any) (any, error) { return "ok", nil }
+			// when
+			var got []codes.Code
+			for _, c := range tc.calls {
+				now = now.Add(c.advance)
+				method := c.method
+				if method == "" {
+					method = testMethod
+				}
+				_, err := l.UnaryServerInterceptor(
+					c.ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler,
+				)
+				got = append(got, status.Code(err))
+			}
+			// then
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRateLimit_UnaryServerInterceptor_RetryInfo(t *testing.T) {
+	// given
+	now := time.Now()
+	l := newRateLimiter(RateLimitConfig{Client: Rate{Limit: 2, Burst: 1}})
+	l.now = func() time.Time { return now }
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	ctx := peerContext("10.0.0.1:50001")
+	_, err := l.UnaryServerInterceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	// when
+	_, err = l.UnaryServerInterceptor(ctx, nil, info, handler)
+	// then
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		assert.True(t, ok)
+		assert.Equal(t, 500*time.Millisecond, retryInfo.GetRetryDelay().AsDuration())
+	}
+}
+
+func TestRateLimit_UnaryServerInterceptor_MaxInFlight(t *testing.T) {
+	// given
+	l := newRateLimiter(RateLimitConfig{MaxInFlight: 1})
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	ctx := peerContext("10.0.0.1:50001")
+	entered, release := make(chan struct{}), make(chan struct{})
+	blocking := func(context.Context, any) (any, error) {
+		close(entered)
+		<-release
+		return "ok", nil
+	}
+	done := make(chan error)
+	go func() {
+		_, err := l.UnaryServerInterceptor(ctx, nil, info, blocking)
+		done <- err
+	}()
+	<-entered
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	// when
+	_, err := l.UnaryServerInterceptor(ctx, nil, info, handler)
+	// then
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// when the call in flight completes
+	close(release)
+	assert.NoError(t, <-done)
+	_, err = l.UnaryServerInterceptor(ctx, nil, info, handler)
+	// then
+	assert.NoError(t, err)
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestRateLimit_StreamServerInterceptor(t *testing.T) {
+	// given
+	l := newRateLimiter(RateLimitConfig{Client: Rate{Limit: 1, Burst: 2}, MaxInFlight: 1})
+	info := &grpc.StreamServerInfo{FullMethod: testMethod, IsClientStream: true}
+	stream := contextStream{ctx: peerContext("10.0.0.1:50001")}
+	entered, release := make(chan struct{}), make(chan struct{})
+	blocking := func(context.Context, any) (any, error) {
+		close(entered)
+		<-release
+		return "ok", nil
+	}
+	done := make(chan error)
+	go func() {
+		_, err := l.UnaryServerInterceptor(
+			stream.ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, blocking,
+		)
+		done <- err
+	}()
+	<-entered
+	handler := func(any, grpc.ServerStream) error { return nil }
+	// when the unary call in flight holds the only slot
+	err := l.StreamServerInterceptor(nil, stream, info, handler)
+	// then
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// when the call in flight completes, the client has no token left
+	close(release)
+	assert.NoError(t, <-done)
+	err = l.StreamServerInterceptor(nil, stream, info, handler)
+	// then
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRateLimit_sweep(t *testing.T) {
+	// given
+	now := time.Now()
+	l := newRateLimiter(RateLimitConfig{Client: Rate{Limit: 1, Burst: 1}})
+	l.now = func() time.Time { return now }
+	_, _ = l.allow("addr:10.0.0.1", "GetBooking")
+	now = now.Add(idleBucketTTL / 2)
+	_, _ = l.allow("addr:10.0.0.2", "GetBooking")
+	// when
+	now = now.Add(idleBucketTTL/2 + time.Second)
+	_, _ = l.allow("addr:10.0.0.3", "GetBooking")
+	// then
+	assert.Len(t, l.buckets, 2)
+	assert.NotContains(t, l.buckets, bucketKey{client: "addr:10.0.0.1"})
+}
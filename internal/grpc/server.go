@@ -1,22 +1,34 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
-	"log/slog"
+	"errors"
+	"fmt"
+	"io"
 	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	protovalidate_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
 	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/command"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/query"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	importPayloadCampsite = "campsite"
+	importPayloadCSV      = "CSV"
+	importPayloadJSON     = "JSON"
+	// maxImportDocumentSize limits the size of a CSV or JSON document imported.
+	maxImportDocumentSize = 16 << 20
 )
 
 type server struct {
@@ -26,7 +38,10 @@ type server struct {
 
 var _ api.CampgroundsServiceServer = (*server)(nil)
 
-func NewServer() (*grpc.Server, error) {
+// NewServer returns a server with the interceptors of the API, throttling
+// unary calls to the limits, and serving plaintext unless credentials are
+// given in opts.
+func NewServer(limits RateLimitConfig, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	requestValidator, err := protovalidate.New()
 	if err != nil {
 		return nil, err
@@ -34,19 +49,38 @@ func NewServer() (*grpc.Server, error) {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
 	}
-
-	if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
-		loggingOpts = append(
-			loggingOpts,
-			logging.WithLogOnEvents(logging.PayloadReceived, logging.PayloadSent),
-		)
-	}
-	opts := []grpc.ServerOption{
+	recoveryOpts := []recovery.Option{recovery.WithRecoveryHandlerContext(recoverPanic)}
+	// the unary calls and the streams share the buckets and the in-flight cap
+	limiter := newRateLimiter(limits)
+	opts = append(
+		opts,
 		grpc.ChainUnaryInterceptor(
+			requestIDUnaryInterceptor,
+			readYourWritesUnaryInterceptor,
+			clientIdentityUnaryInterceptor,
 			logging.UnaryServerInterceptor(interceptorLogger(), loggingOpts...),
+			selector.UnaryServerInterceptor(
+				logging.UnaryServerInterceptor(interceptorLogger(), payloadLoggingOpts()...),
+				selector.MatchFunc(debugEnabled),
+			),
+			recovery.UnaryServerInterceptor(recoveryOpts...),
+			limiter.UnaryServerInterceptor,
 			protovalidate_middleware.UnaryServerInterceptor(requestValidator),
 		),
-	}
+		grpc.ChainStreamInterceptor(
+			requestIDStreamInterceptor,
+			readYourWritesStreamInterceptor,
+			clientIdentityStreamInterceptor,
+			logging.StreamServerInterceptor(interceptorLogger(), loggingOpts...),
+			selector.StreamServerInterceptor(
+				logging.StreamServerInterceptor(interceptorLogger(), payloadLoggingOpts()...),
+				selector.MatchFunc(debugEnabled),
+			),
+			recovery.StreamServerInterceptor(recoveryOpts...),
+			limiter.StreamServerInterceptor,
+			protovalidate_middleware.StreamServerInterceptor(requestValidator),
+		),
+	)
 	return grpc.NewServer(opts...), nil
 }
 
@@ -55,13 +89,131 @@ func RegisterServer(app application.App, registrar grpc.ServiceRegistrar) error
 	return nil
 }
 
+func (s server) GetCampgrounds(
+	ctx context.Context,
+	_ *api.GetCampgroundsRequest,
+) (*api.GetCampgroundsResponse, error) {
+	campgrounds, err := s.app.GetCampgrounds(ctx, query.GetCampgrounds{})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	var protoCampgrounds []*api.Campground
+	for _, campground := range campgrounds {
+		protoCampgrounds = append(protoCampgrounds, CampgroundFromDomain(campground))
+	}
+
+	return &api.GetCampgroundsResponse{
+		Campgrounds: protoCampgrounds,
+	}, nil
+}
+
+func (s server) GetCampground(
+	ctx context.Context,
+	req *api.GetCampgroundRequest,
+) (*api.GetCampgroundResponse, error) {
+	campground, err := s.app.GetCampground(
+		ctx, query.GetCampground{CampgroundID: req.CampgroundId},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.GetCampgroundResponse{
+		Campground: CampgroundFromDomain(campground),
+	}, nil
+}
+
+func (s server) CreateCampground(
+	ctx context.Context,
+	req *api.CreateCampgroundRequest,
+) (*api.CreateCampgroundResponse, error) {
+	campground := command.CreateCampground{
+		CampgroundID: uuid.New().String(),
+		Name:         req.Name,
+		Description:  req.Description,
+	}
+	err := s.app.CreateCampground(ctx, campground)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.CreateCampgroundResponse{
+		CampgroundId: campground.CampgroundID,
+	}, nil
+}
+
+func (s server) UpdateCampground(
+	ctx context.Context,
+	req *api.UpdateCampgroundRequest,
+) (*api.UpdateCampgroundResponse, error) {
+	campground := command.UpdateCampground{
+		CampgroundID: req.Campground.CampgroundId,
+		Name:         req.Campground.Name,
+		Description:  req.Campground.Description,
+		Active:       req.Campground.Active,
+	}
+	err := s.app.UpdateCampground(ctx, campground)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.UpdateCampgroundResponse{}, nil
+}
+
+func (s server) DeleteCampground(
+	ctx context.Context,
+	req *api.DeleteCampgroundRequest,
+) (*api.DeleteCampgroundResponse, error) {
+	err := s.app.DeleteCampground(
+		ctx, command.DeleteCampground{CampgroundID: req.GetCampgroundId()},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.DeleteCampgroundResponse{}, nil
+}
+
+func (s server) GetCampgroundSeason(
+	ctx context.Context,
+	req *api.GetCampgroundSeasonRequest,
+) (*api.GetCampgroundSeasonResponse, error) {
+	season, err := s.app.GetCampgroundSeason(
+		ctx, query.GetCampgroundSeason{CampgroundID: req.GetCampgroundId()},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.GetCampgroundSeasonResponse{
+		Season: CampgroundSeasonFromDomain(season),
+	}, nil
+}
+
+func (s server) SetCampgroundSeason(
+	ctx context.Context,
+	req *api.SetCampgroundSeasonRequest,
+) (*api.SetCampgroundSeasonResponse, error) {
+	err := s.app.SetCampgroundSeason(ctx, command.SetCampgroundSeason{
+		CampgroundID:   req.Season.CampgroundId,
+		OpensOn:        req.Season.OpensOn,
+		ClosesOn:       req.Season.ClosesOn,
+		ClosedWeekdays: req.Season.ClosedWeekdays,
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.SetCampgroundSeasonResponse{}, nil
+}
+
 func (s server) GetCampsites(
 	ctx context.Context,
-	_ *api.GetCampsitesRequest,
+	req *api.GetCampsitesRequest,
 ) (*api.GetCampsitesResponse, error) {
-	campsites, err := s.app.GetCampsites(ctx, query.GetCampsites{})
+	campsites, err := s.app.GetCampsites(
+		ctx, query.GetCampsites{CampgroundID: req.GetCampgroundId()},
+	)
 	if err != nil {
-		return nil, err
+		return nil, handleDomainError(ctx, err)
 	}
 
 	var protoCampsites []*api.Campsite
@@ -86,10 +238,11 @@ func (s server) CreateCampsite(
 		Restrooms:     req.Restrooms,
 		PicnicTable:   req.PicnicTable,
 		FirePit:       req.FirePit,
+		CampgroundID:  req.CampgroundId,
 	}
 	err := s.app.CreateCampsite(ctx, campsite)
 	if err != nil {
-		return nil, handleDomainError(err)
+		return nil, handleDomainError(ctx, err)
 	}
 
 	return &api.CreateCampsiteResponse{
@@ -97,13 +250,157 @@ func (s server) CreateCampsite(
 	}, nil
 }
 
+func (s server) ImportCampsites(stream api.CampgroundsService_ImportCampsitesServer) error {
+	ctx := stream.Context()
+	var (
+		mode     string
+		payload  string
+		rows     []*domain.CampsiteImportRow
+		document bytes.Buffer
+	)
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			mode = req.Mode
+		}
+
+		kind := importPayload(req)
+		switch {
+		case kind == "":
+			return handleDomainError(
+				ctx,
+				domain.ErrCampsiteImportValidation{Reason: "message without payload"},
+			)
+		case payload == "":
+			payload = kind
+		case payload != kind:
+			return handleDomainError(ctx, domain.ErrCampsiteImportValidation{
+				Reason: fmt.Sprintf("%s payload mixed with %s payload", kind, payload),
+			})
+		}
+
+		if c := req.GetCampsite(); c != nil {
+			rows = append(rows, &domain.CampsiteImportRow{
+				Campsite: &domain.Campsite{
+					CampgroundID:  c.CampgroundId,
+					CampsiteCode:  c.CampsiteCode,
+					Capacity:      c.Capacity,
+					DrinkingWater: c.DrinkingWater,
+					Restrooms:     c.Restrooms,
+					PicnicTable:   c.PicnicTable,
+					FirePit:       c.FirePit,
+					Active:        true,
+				},
+			})
+			continue
+		}
+		document.Write(req.GetCsvChunk())
+		document.Write(req.GetJsonChunk())
+		if document.Len() > maxImportDocumentSize {
+			return handleDomainError(ctx, domain.ErrCampsiteImportValidation{
+				Reason: fmt.Sprintf("document exceeds %d bytes", maxImportDocumentSize),
+			})
+		}
+	}
+
+	var err error
+	switch payload {
+	case importPayloadCSV:
+		rows, err = command.ParseCampsitesCSV(&document)
+	case importPayloadJSON:
+		rows, err = command.ParseCampsitesJSON(&document)
+	}
+	if err != nil {
+		return handleDomainError(ctx, err)
+	}
+	for _, row := range rows {
+		row.Campsite.CampsiteID = uuid.New().String()
+	}
+
+	err = s.app.ImportCampsites(ctx, command.ImportCampsites{Mode: mode, Rows: rows})
+	if err != nil {
+		return handleDomainError(ctx, err)
+	}
+	return stream.SendAndClose(CampsiteImportFromDomain(rows))
+}
+
+func (s server) GetCampsiteRates(
+	ctx context.Context,
+	req *api.GetCampsiteRatesRequest,
+) (*api.GetCampsiteRatesResponse, error) {
+	rates, err := s.app.GetCampsiteRates(
+		ctx, query.GetCampsiteRates{CampsiteID: req.GetCampsiteId()},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.GetCampsiteRatesResponse{
+		Rates: CampsiteRatesFromDomain(rates),
+	}, nil
+}
+
+func (s server) SetCampsiteRates(
+	ctx context.Context,
+	req *api.SetCampsiteRatesRequest,
+) (*api.SetCampsiteRatesResponse, error) {
+	rates := command.SetCampsiteRates{
+		CampsiteID:         req.Rates.CampsiteId,
+		Currency:           req.Rates.Currency,
+		NightlyRate:        req.Rates.NightlyRate,
+		WeekendNightlyRate: req.Rates.WeekendNightlyRate,
+		IncludedGuests:     req.Rates.IncludedGuests,
+		ExtraGuestFee:      req.Rates.ExtraGuestFee,
+		TaxRateBps:         req.Rates.TaxRateBps,
+	}
+	for _, season := range req.Rates.Seasons {
+		rates.Seasons = append(rates.Seasons, command.SeasonalRate{
+			Name:               season.Name,
+			StartDate:          season.StartDate,
+			EndDate:            season.EndDate,
+			NightlyRate:        season.NightlyRate,
+			WeekendNightlyRate: season.WeekendNightlyRate,
+		})
+	}
+	err := s.app.SetCampsiteRates(ctx, rates)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.SetCampsiteRatesResponse{}, nil
+}
+
+func (s server) QuoteBooking(
+	ctx context.Context,
+	req *api.QuoteBookingRequest,
+) (*api.QuoteBookingResponse, error) {
+	quote, err := s.app.QuoteBooking(ctx, query.QuoteBooking{
+		CampsiteID: req.CampsiteId,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Guests:     req.Guests,
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.QuoteBookingResponse{
+		Quote: QuoteFromDomain(quote),
+	}, nil
+}
+
 func (s server) GetBooking(
 	ctx context.Context,
 	req *api.GetBookingRequest,
 ) (*api.GetBookingResponse, error) {
 	booking, err := s.app.GetBooking(ctx, query.GetBooking{BookingID: req.BookingId})
 	if err != nil {
-		return nil, handleDomainError(err)
+		return nil, handleDomainError(ctx, err)
 	}
 
 	return &api.GetBookingResponse{
@@ -116,16 +413,19 @@ func (s server) CreateBooking(
 	req *api.CreateBookingRequest,
 ) (*api.CreateBookingResponse, error) {
 	booking := command.CreateBooking{
-		BookingID:  uuid.New().String(),
-		CampsiteID: req.CampsiteId,
-		Email:      req.Email,
-		FullName:   req.FullName,
-		StartDate:  req.StartDate,
-		EndDate:    req.EndDate,
+		BookingID:     uuid.New().String(),
+		CampsiteID:    req.CampsiteId,
+		Email:         req.Email,
+		FullName:      req.FullName,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		Guests:        req.Guests,
+		PaymentMethod: req.PaymentMethod,
+		GuestID:       uuid.New().String(),
 	}
 	err := s.app.CreateBooking(ctx, booking)
 	if err != nil {
-		return nil, handleDomainError(err)
+		return nil, handleDomainError(ctx, err)
 	}
 
 	return &api.CreateBookingResponse{
@@ -144,11 +444,12 @@ func (s server) UpdateBooking(
 		FullName:   req.Booking.FullName,
 		StartDate:  req.Booking.StartDate,
 		EndDate:    req.Booking.EndDate,
+		Guests:     req.Booking.Guests,
 		Version:    req.Booking.Version,
 	}
 	err := s.app.UpdateBooking(ctx, booking)
 	if err != nil {
-		return nil, handleDomainError(err)
+		return nil, handleDomainError(ctx, err)
 	}
 	return &api.UpdateBookingResponse{}, nil
 }
@@ -162,29 +463,432 @@ func (s server) CancelBooking(
 	}
 	err := s.app.CancelBooking(ctx, booking)
 	if err != nil {
-		return nil, handleDomainError(err)
+		return nil, handleDomainError(ctx, err)
+	}
+	// read the refund back from the primary, a lagging replica may not have it yet
+	cancelled, err := s.app.GetBooking(
+		domain.WithReadYourWrites(ctx),
+		query.GetBooking{BookingID: booking.BookingID},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.CancelBookingResponse{
+		RefundPercent: cancelled.RefundPercent,
+		RefundAmount:  cancelled.RefundAmount,
+		Currency:      cancelled.Currency,
+	}, nil
+}
+
+func (s server) CheckIn(
+	ctx context.Context,
+	req *api.CheckInRequest,
+) (*api.CheckInResponse, error) {
+	err := s.app.CheckIn(ctx, command.CheckIn{
+		BookingID: req.GetBookingId(),
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.CheckInResponse{}, nil
+}
+
+func (s server) CheckOut(
+	ctx context.Context,
+	req *api.CheckOutRequest,
+) (*api.CheckOutResponse, error) {
+	err := s.app.CheckOut(ctx, command.CheckOut{
+		BookingID: req.GetBookingId(),
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.CheckOutResponse{}, nil
+}
+
+func (s server) MarkNoShow(
+	ctx context.Context,
+	req *api.MarkNoShowRequest,
+) (*api.MarkNoShowResponse, error) {
+	err := s.app.MarkNoShow(ctx, command.MarkNoShow{
+		BookingID: req.GetBookingId(),
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.MarkNoShowResponse{}, nil
+}
+
+func (s server) GetGroupBooking(
+	ctx context.Context,
+	req *api.GetGroupBookingRequest,
+) (*api.GetGroupBookingResponse, error) {
+	bookings, err := s.app.GetGroupBooking(ctx, query.GetGroupBooking{GroupID: req.GroupId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.GetGroupBookingResponse{}
+	for _, booking := range bookings {
+		resp.Bookings = append(resp.Bookings, BookingFromDomain(booking))
+	}
+	return resp, nil
+}
+
+func (s server) CreateGroupBooking(
+	ctx context.Context,
+	req *api.CreateGroupBookingRequest,
+) (*api.CreateGroupBookingResponse, error) {
+	group := command.CreateGroupBooking{
+		GroupID:       uuid.New().String(),
+		Email:         req.Email,
+		FullName:      req.FullName,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		PaymentMethod: req.PaymentMethod,
+		GuestID:       uuid.New().String(),
+	}
+	for _, campsite := range req.Campsites {
+		group.Campsites = append(group.Campsites, command.GroupBookingCampsite{
+			BookingID:  uuid.New().String(),
+			CampsiteID: campsite.CampsiteId,
+			Guests:     campsite.Guests,
+		})
+	}
+	err := s.app.CreateGroupBooking(ctx, group)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.CreateGroupBookingResponse{GroupId: group.GroupID}
+	for _, campsite := range group.Campsites {
+		resp.BookingIds = append(resp.BookingIds, campsite.BookingID)
+	}
+	return resp, nil
+}
+
+func (s server) UpdateGroupBooking(
+	ctx context.Context,
+	req *api.UpdateGroupBookingRequest,
+) (*api.UpdateGroupBookingResponse, error) {
+	err := s.app.UpdateGroupBooking(ctx, command.UpdateGroupBooking{
+		GroupID:   req.GroupId,
+		Email:     req.Email,
+		FullName:  req.FullName,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.UpdateGroupBookingResponse{}, nil
+}
+
+func (s server) CancelGroupBooking(
+	ctx context.Context,
+	req *api.CancelGroupBookingRequest,
+) (*api.CancelGroupBookingResponse, error) {
+	group := command.CancelGroupBooking{
+		GroupID: req.GetGroupId(),
+	}
+	err := s.app.CancelGroupBooking(ctx, group)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	// read the refunds back from the primary, a lagging replica may not have them yet
+	cancelled, err := s.app.GetGroupBooking(
+		domain.WithReadYourWrites(ctx),
+		query.GetGroupBooking{GroupID: group.GroupID},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.CancelGroupBookingResponse{}
+	for _, booking := range cancelled {
+		resp.RefundAmount += booking.RefundAmount
+		if booking.Currency != "" {
+			resp.Currency = booking.Currency
+		}
 	}
-	return &api.CancelBookingResponse{}, nil
+	return resp, nil
 }
 
 func (s server) GetVacantDates(
 	ctx context.Context,
 	req *api.GetVacantDatesRequest,
 ) (*api.GetVacantDatesResponse, error) {
-	vacantDates, err := s.app.GetVacantDates(ctx, query.GetVacantDates{
+	vacancy, err := s.app.GetVacantDates(ctx, query.GetVacantDates{
+		CampsiteID:   req.CampsiteId,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		MinNights:    req.MinNights,
+		CampgroundID: req.CampgroundId,
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.GetVacantDatesResponse{}
+	for _, date := range vacancy.Dates {
+		resp.VacantDates = append(resp.VacantDates, date.Format(time.DateOnly))
+	}
+	for _, r := range vacancy.Ranges {
+		resp.VacantRanges = append(resp.VacantRanges, DateRangeFromDomain(r))
+	}
+	return resp, nil
+}
+
+func (s server) JoinWaitlist(
+	ctx context.Context,
+	req *api.JoinWaitlistRequest,
+) (*api.JoinWaitlistResponse, error) {
+	entry := command.JoinWaitlist{
+		EntryID:      uuid.New().String(),
+		CampgroundID: req.CampgroundId,
+		CampsiteID:   req.CampsiteId,
+		Email:        req.Email,
+		FullName:     req.FullName,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Guests:       req.Guests,
+	}
+	err := s.app.JoinWaitlist(ctx, entry)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.JoinWaitlistResponse{
+		EntryId: entry.EntryID,
+	}, nil
+}
+
+func (s server) LeaveWaitlist(
+	ctx context.Context,
+	req *api.LeaveWaitlistRequest,
+) (*api.LeaveWaitlistResponse, error) {
+	err := s.app.LeaveWaitlist(ctx, command.LeaveWaitlist{EntryID: req.EntryId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.LeaveWaitlistResponse{}, nil
+}
+
+func (s server) ListWaitlist(
+	ctx context.Context,
+	req *api.ListWaitlistRequest,
+) (*api.ListWaitlistResponse, error) {
+	entries, err := s.app.ListWaitlist(ctx, query.ListWaitlist{
+		CampgroundID: req.CampgroundId,
+		CampsiteID:   req.CampsiteId,
+	})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.ListWaitlistResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, WaitlistEntryFromDomain(entry))
+	}
+	return resp, nil
+}
+
+func (s server) AcceptWaitlistOffer(
+	ctx context.Context,
+	req *api.AcceptWaitlistOfferRequest,
+) (*api.AcceptWaitlistOfferResponse, error) {
+	offer := command.AcceptWaitlistOffer{
+		EntryID:       req.EntryId,
+		BookingID:     uuid.New().String(),
+		PaymentMethod: req.PaymentMethod,
+		GuestID:       uuid.New().String(),
+	}
+	err := s.app.AcceptWaitlistOffer(ctx, offer)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.AcceptWaitlistOfferResponse{
+		BookingId: offer.BookingID,
+	}, nil
+}
+
+func (s server) CreateBlackout(
+	ctx context.Context,
+	req *api.CreateBlackoutRequest,
+) (*api.CreateBlackoutResponse, error) {
+	blackout := command.CreateBlackout{
+		BlackoutID: uuid.New().String(),
 		CampsiteID: req.CampsiteId,
 		StartDate:  req.StartDate,
 		EndDate:    req.EndDate,
-	})
+		Reason:     req.Reason,
+	}
+	err := s.app.CreateBlackout(ctx, blackout)
 	if err != nil {
-		return nil, err
+		return nil, handleDomainError(ctx, err)
+	}
+
+	return &api.CreateBlackoutResponse{
+		BlackoutId: blackout.BlackoutID,
+	}, nil
+}
+
+func (s server) DeleteBlackout(
+	ctx context.Context,
+	req *api.DeleteBlackoutRequest,
+) (*api.DeleteBlackoutResponse, error) {
+	err := s.app.DeleteBlackout(ctx, command.DeleteBlackout{BlackoutID: req.BlackoutId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.DeleteBlackoutResponse{}, nil
+}
+
+func (s server) ListBlackouts(
+	ctx context.Context,
+	req *api.ListBlackoutsRequest,
+) (*api.ListBlackoutsResponse, error) {
+	blackouts, err := s.app.ListBlackouts(ctx, query.ListBlackouts{CampsiteID: req.CampsiteId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.ListBlackoutsResponse{}
+	for _, blackout := range blackouts {
+		resp.Blackouts = append(resp.Blackouts, BlackoutFromDomain(blackout))
+	}
+	return resp, nil
+}
+
+func (s server) CreateCalendarSubscription(
+	ctx context.Context,
+	req *api.CreateCalendarSubscriptionRequest,
+) (*api.CreateCalendarSubscriptionResponse, error) {
+	subscription := command.CreateCalendarSubscription{
+		SubscriptionID: uuid.New().String(),
+		CampsiteID:     req.CampsiteId,
+		Name:           req.Name,
+		URL:            req.GetUrl(),
+		Calendar:       req.GetCalendar(),
+	}
+	err := s.app.CreateCalendarSubscription(ctx, subscription)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
 	}
 
-	return &api.GetVacantDatesResponse{
-		VacantDates: vacantDates,
+	return &api.CreateCalendarSubscriptionResponse{
+		SubscriptionId: subscription.SubscriptionID,
 	}, nil
 }
 
+func (s server) DeleteCalendarSubscription(
+	ctx context.Context,
+	req *api.DeleteCalendarSubscriptionRequest,
+) (*api.DeleteCalendarSubscriptionResponse, error) {
+	err := s.app.DeleteCalendarSubscription(
+		ctx, command.DeleteCalendarSubscription{SubscriptionID: req.SubscriptionId},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.DeleteCalendarSubscriptionResponse{}, nil
+}
+
+func (s server) ListCalendarSubscriptions(
+	ctx context.Context,
+	req *api.ListCalendarSubscriptionsRequest,
+) (*api.ListCalendarSubscriptionsResponse, error) {
+	subscriptions, err := s.app.ListCalendarSubscriptions(
+		ctx, query.ListCalendarSubscriptions{CampsiteID: req.CampsiteId},
+	)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.ListCalendarSubscriptionsResponse{}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(
+			resp.Subscriptions,
+			CalendarSubscriptionFromDomain(subscription),
+		)
+	}
+	return resp, nil
+}
+
+func (s server) GetGuest(
+	ctx context.Context,
+	req *api.GetGuestRequest,
+) (*api.GetGuestResponse, error) {
+	guest, err := s.app.GetGuest(ctx, query.GetGuest{GuestID: req.GuestId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.GetGuestResponse{Guest: GuestFromDomain(guest)}, nil
+}
+
+func (s server) UpdateGuest(
+	ctx context.Context,
+	req *api.UpdateGuestRequest,
+) (*api.UpdateGuestResponse, error) {
+	guest := command.UpdateGuest{
+		GuestID:     req.Guest.GuestId,
+		Email:       req.Guest.Email,
+		FullName:    req.Guest.FullName,
+		Phone:       req.Guest.Phone,
+		Address:     req.Guest.Address,
+		Preferences: req.Guest.Preferences,
+	}
+	err := s.app.UpdateGuest(ctx, guest)
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.UpdateGuestResponse{}, nil
+}
+
+func (s server) ListGuestBookings(
+	ctx context.Context,
+	req *api.ListGuestBookingsRequest,
+) (*api.ListGuestBookingsResponse, error) {
+	bookings, err := s.app.ListGuestBookings(ctx, query.ListGuestBookings{GuestID: req.GuestId})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+
+	resp := &api.ListGuestBookingsResponse{}
+	for _, booking := range bookings {
+		resp.Bookings = append(resp.Bookings, BookingFromDomain(booking))
+	}
+	return resp, nil
+}
+
+func (s server) ExportGuestData(
+	ctx context.Context,
+	req *api.ExportGuestDataRequest,
+) (*api.ExportGuestDataResponse, error) {
+	data, err := s.app.ExportGuestData(ctx, query.ExportGuestData{Email: req.Email})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	guestData, err := protojson.MarshalOptions{UseProtoNames: true}.
+		Marshal(GuestDataFromDomain(data))
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.ExportGuestDataResponse{GuestData: string(guestData)}, nil
+}
+
+func (s server) AnonymizeGuest(
+	ctx context.Context,
+	req *api.AnonymizeGuestRequest,
+) (*api.AnonymizeGuestResponse, error) {
+	err := s.app.AnonymizeGuest(ctx, command.AnonymizeGuest{Email: req.Email})
+	if err != nil {
+		return nil, handleDomainError(ctx, err)
+	}
+	return &api.AnonymizeGuestResponse{}, nil
+}
+
 func CampsiteFromDomain(campsite *domain.Campsite) *api.Campsite {
 	return &api.Campsite{
 		CampsiteId:    campsite.CampsiteID,
@@ -195,31 +899,237 @@ func CampsiteFromDomain(campsite *domain.Campsite) *api.Campsite {
 		PicnicTable:   campsite.PicnicTable,
 		FirePit:       campsite.FirePit,
 		Active:        campsite.Active,
+		CampgroundId:  campsite.CampgroundID,
+	}
+}
+
+func CampgroundFromDomain(campground *domain.Campground) *api.Campground {
+	return &api.Campground{
+		CampgroundId: campground.CampgroundID,
+		Name:         campground.Name,
+		Description:  campground.Description,
+		Active:       campground.Active,
 	}
 }
 
 func BookingFromDomain(booking *domain.Booking) *api.Booking {
 	return &api.Booking{
-		BookingId:  booking.BookingID,
-		CampsiteId: booking.CampsiteID,
-		Email:      booking.Email,
-		FullName:   booking.FullName,
-		StartDate:  booking.StartDate.Format(time.DateOnly),
-		EndDate:    booking.EndDate.Format(time.DateOnly),
-		Active:     booking.Active,
-		Version:    booking.Version,
-	}
-}
-
-func handleDomainError(e error) error {
-	switch e.(type) {
-	case domain.ErrBookingNotFound:
-		return status.Error(codes.NotFound, e.Error())
-	case domain.ErrBookingAlreadyCancelled, domain.ErrBookingDatesNotAvailable:
-		return status.Error(codes.FailedPrecondition, e.Error())
-	case domain.ErrBookingValidation:
-		return status.Error(codes.InvalidArgument, e.Error())
+		BookingId:     booking.BookingID,
+		CampsiteId:    booking.CampsiteID,
+		Email:         booking.Email,
+		FullName:      booking.FullName,
+		StartDate:     booking.StartDate.Format(time.DateOnly),
+		EndDate:       booking.EndDate.Format(time.DateOnly),
+		Status:        BookingStatusFromDomain(booking.Status),
+		Version:       booking.Version,
+		Guests:        booking.Guests,
+		TotalPrice:    booking.TotalPrice,
+		Currency:      booking.Currency,
+		DepositAmount: booking.DepositAmount,
+		PaymentRef:    booking.PaymentRef,
+		RefundPercent: booking.RefundPercent,
+		RefundAmount:  booking.RefundAmount,
+		GroupId:       booking.GroupID,
+		GuestId:       booking.GuestID,
+	}
+}
+
+func BookingStatusFromDomain(status domain.BookingStatus) api.BookingStatus {
+	switch status {
+	case domain.BookingStatusPending:
+		return api.BookingStatus_BOOKING_STATUS_PENDING
+	case domain.BookingStatusConfirmed:
+		return api.BookingStatus_BOOKING_STATUS_CONFIRMED
+	case domain.BookingStatusCheckedIn:
+		return api.BookingStatus_BOOKING_STATUS_CHECKED_IN
+	case domain.BookingStatusCheckedOut:
+		return api.BookingStatus_BOOKING_STATUS_CHECKED_OUT
+	case domain.BookingStatusCancelled:
+		return api.BookingStatus_BOOKING_STATUS_CANCELLED
+	case domain.BookingStatusNoShow:
+		return api.BookingStatus_BOOKING_STATUS_NO_SHOW
+	default:
+		return api.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	}
+}
+
+func CampsiteImportFromDomain(rows []*domain.CampsiteImportRow) *api.ImportCampsitesResponse {
+	resp := &api.ImportCampsitesResponse{}
+	for _, row := range rows {
+		switch row.Status {
+		case domain.CampsiteImportStatusCreated:
+			resp.Created++
+		case domain.CampsiteImportStatusUpdated:
+			resp.Updated++
+		case domain.CampsiteImportStatusFailed:
+			resp.Failed++
+		case domain.CampsiteImportStatusSkipped:
+			resp.Skipped++
+		}
+		result := &api.CampsiteImportResult{
+			Row:          int32(row.Row),
+			CampsiteCode: row.Campsite.CampsiteCode,
+			Status:       string(row.Status),
+			Error:        row.Error,
+		}
+		if row.Status == domain.CampsiteImportStatusCreated ||
+			row.Status == domain.CampsiteImportStatusUpdated {
+			result.CampsiteId = row.Campsite.CampsiteID
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp
+}
+
+func GuestFromDomain(guest *domain.Guest) *api.Guest {
+	return &api.Guest{
+		GuestId:     guest.GuestID,
+		Email:       guest.Email,
+		FullName:    guest.FullName,
+		Phone:       guest.Phone,
+		Address:     guest.Address,
+		Preferences: guest.Preferences,
+	}
+}
+
+func GuestDataFromDomain(data *domain.GuestData) *api.GuestData {
+	protoData := &api.GuestData{
+		Email:      data.Email,
+		ExportedAt: data.ExportedAt.UTC().Format(time.RFC3339),
+	}
+	if data.Guest != nil {
+		protoData.Guest = GuestFromDomain(data.Guest)
+	}
+	for _, booking := range data.Bookings {
+		protoData.Bookings = append(protoData.Bookings, BookingFromDomain(booking))
+	}
+	for _, entry := range data.WaitlistEntries {
+		protoData.WaitlistEntries = append(
+			protoData.WaitlistEntries,
+			WaitlistEntryFromDomain(entry),
+		)
+	}
+	return protoData
+}
+
+func CampgroundSeasonFromDomain(season *domain.CampgroundSeason) *api.CampgroundSeason {
+	return &api.CampgroundSeason{
+		CampgroundId:   season.CampgroundID,
+		OpensOn:        season.OpensOn,
+		ClosesOn:       season.ClosesOn,
+		ClosedWeekdays: domain.FormatWeekdays(season.ClosedWeekdays),
+	}
+}
+
+func CampsiteRatesFromDomain(rates *domain.CampsiteRates) *api.CampsiteRates {
+	protoRates := &api.CampsiteRates{
+		CampsiteId:         rates.CampsiteID,
+		Currency:           rates.Currency,
+		NightlyRate:        rates.NightlyRate,
+		WeekendNightlyRate: rates.WeekendNightlyRate,
+		IncludedGuests:     rates.IncludedGuests,
+		ExtraGuestFee:      rates.ExtraGuestFee,
+		TaxRateBps:         rates.TaxRateBps,
+	}
+	for _, season := range rates.Seasons {
+		protoRates.Seasons = append(protoRates.Seasons, &api.SeasonalRate{
+			Name:               season.Name,
+			StartDate:          season.StartDate.Format(time.DateOnly),
+			EndDate:            season.EndDate.Format(time.DateOnly),
+			NightlyRate:        season.NightlyRate,
+			WeekendNightlyRate: season.WeekendNightlyRate,
+		})
+	}
+	return protoRates
+}
+
+func QuoteFromDomain(quote *domain.Quote) *api.Quote {
+	protoQuote := &api.Quote{
+		CampsiteId:     quote.CampsiteID,
+		StartDate:      quote.StartDate.Format(time.DateOnly),
+		EndDate:        quote.EndDate.Format(time.DateOnly),
+		Guests:         quote.Guests,
+		Currency:       quote.Currency,
+		NightsSubtotal: quote.NightsSubtotal,
+		ExtraGuestFees: quote.ExtraGuestFees,
+		Taxes:          quote.Taxes,
+		Total:          quote.Total,
+	}
+	for _, night := range quote.Nights {
+		protoQuote.Nights = append(protoQuote.Nights, &api.NightlyPrice{
+			Date:    night.Date.Format(time.DateOnly),
+			Amount:  night.Amount,
+			Weekend: night.Weekend,
+			Season:  night.Season,
+		})
+	}
+	return protoQuote
+}
+
+func DateRangeFromDomain(r domain.DateRange) *api.DateRange {
+	return &api.DateRange{
+		StartDate: r.StartDate.Format(time.DateOnly),
+		EndDate:   r.EndDate.Format(time.DateOnly),
+		Nights:    int32(r.Nights()),
+	}
+}
+
+func WaitlistEntryFromDomain(entry *domain.WaitlistEntry) *api.WaitlistEntry {
+	protoEntry := &api.WaitlistEntry{
+		EntryId:         entry.EntryID,
+		CampgroundId:    entry.CampgroundID,
+		CampsiteId:      entry.CampsiteID,
+		Email:           entry.Email,
+		FullName:        entry.FullName,
+		StartDate:       entry.StartDate.Format(time.DateOnly),
+		EndDate:         entry.EndDate.Format(time.DateOnly),
+		Guests:          entry.Guests,
+		Status:          string(entry.Status),
+		OfferCampsiteId: entry.OfferCampsiteID,
+		BookingId:       entry.BookingID,
+	}
+	if !entry.OfferExpiresAt.IsZero() {
+		protoEntry.OfferExpiresAt = entry.OfferExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return protoEntry
+}
+
+func BlackoutFromDomain(blackout *domain.CampsiteBlackout) *api.Blackout {
+	return &api.Blackout{
+		BlackoutId: blackout.BlackoutID,
+		CampsiteId: blackout.CampsiteID,
+		StartDate:  blackout.StartDate.Format(time.DateOnly),
+		EndDate:    blackout.EndDate.Format(time.DateOnly),
+		Reason:     blackout.Reason,
+	}
+}
+
+func CalendarSubscriptionFromDomain(
+	subscription *domain.CalendarSubscription,
+) *api.CalendarSubscription {
+	protoSubscription := &api.CalendarSubscription{
+		SubscriptionId: subscription.SubscriptionID,
+		CampsiteId:     subscription.CampsiteID,
+		Name:           subscription.Name,
+		Url:            subscription.URL,
+		LastSyncError:  subscription.LastSyncError,
+	}
+	if !subscription.LastSyncedAt.IsZero() {
+		protoSubscription.LastSyncedAt = subscription.LastSyncedAt.UTC().Format(time.RFC3339)
+	}
+	return protoSubscription
+}
+
+// importPayload returns the kind of payload of the import message, empty if none.
+func importPayload(req *api.ImportCampsitesRequest) string {
+	switch req.GetPayload().(type) {
+	case *api.ImportCampsitesRequest_Campsite:
+		return importPayloadCampsite
+	case *api.ImportCampsitesRequest_CsvChunk:
+		return importPayloadCSV
+	case *api.ImportCampsitesRequest_JsonChunk:
+		return importPayloadJSON
 	default:
-		return e
+		return ""
 	}
 }
@@ -3,16 +3,21 @@
 package grpc_test
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
 	"net"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	rpc "github.com/igor-baiborodine/campsite-booking-go/internal/grpc"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/ical"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
 	"github.com/stretchr/testify/assert"
@@ -21,11 +26,21 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type mocks struct {
-	campsites *domain.MockCampsiteRepository
-	bookings  *domain.MockBookingRepository
+	campgrounds *domain.MockCampgroundRepository
+	campsites   *domain.MockCampsiteRepository
+	bookings    *domain.MockBookingRepository
+	guests      *domain.MockGuestRepository
+	rates       *domain.MockCampsiteRatesRepository
+	blackouts   *domain.MockCampsiteBlackoutRepository
+	seasons     *domain.MockCampgroundSeasonRepository
+	waitlist    *domain.MockWaitlistRepository
+	calendars   *domain.MockCalendarSubscriptionRepository
+	payments    *domain.MockPaymentGateway
 }
 
 type serverSuite struct {
@@ -51,7 +66,7 @@ func (s *serverSuite) SetupTest() {
 	slog.SetDefault(l)
 
 	var err error
-	s.server, err = rpc.NewServer()
+	s.server, err = rpc.NewServer(rpc.RateLimitConfig{})
 	if err != nil {
 		s.T().Fatal(err)
 	}
@@ -64,10 +79,26 @@ func (s *serverSuite) SetupTest() {
 	}
 
 	s.mocks = mocks{
-		campsites: domain.NewMockCampsiteRepository(s.T()),
-		bookings:  domain.NewMockBookingRepository(s.T()),
+		campgrounds: domain.NewMockCampgroundRepository(s.T()),
+		campsites:   domain.NewMockCampsiteRepository(s.T()),
+		bookings:    domain.NewMockBookingRepository(s.T()),
+		guests:      domain.NewMockGuestRepository(s.T()),
+		rates:       domain.NewMockCampsiteRatesRepository(s.T()),
+		blackouts:   domain.NewMockCampsiteBlackoutRepository(s.T()),
+		seasons:     domain.NewMockCampgroundSeasonRepository(s.T()),
+		waitlist:    domain.NewMockWaitlistRepository(s.T()),
+		calendars:   domain.NewMockCalendarSubscriptionRepository(s.T()),
+		payments:    domain.NewMockPaymentGateway(s.T()),
 	}
-	app := application.New(s.mocks.campsites, s.mocks.bookings)
+	app := application.New(
+		s.mocks.campgrounds, s.mocks.campsites, s.mocks.bookings, s.mocks.guests,
+		s.mocks.rates, s.mocks.blackouts, s.mocks.seasons, s.mocks.waitlist, s.mocks.calendars,
+		s.mocks.payments, ical.NewReader(http.DefaultClient),
+		domain.DepositPolicy{Percent: 30},
+		domain.NewCancellationPolicy(map[int]int32{8: 100, 0: 50}),
+		domain.WaitlistPolicy{OfferTTL: 24 * time.Hour}, domain.SeasonPolicy{},
+		domain.RetentionPolicy{},
+	)
 
 	if err = rpc.RegisterServer(app, s.server); err != nil {
 		s.T().Fatal(err)
@@ -91,6 +122,13 @@ func (s *serverSuite) TearDownTest() {
 	s.server.GracefulStop()
 }
 
+// SetupSubTest gives each subtest its own server and mocks, so that no subtest
+// depends on the expectations set by another one.
+func (s *serverSuite) SetupSubTest() {
+	s.TearDownTest()
+	s.SetupTest()
+}
+
 func (s *serverSuite) TestCampgroundsService_CreateCampsite() {
 	tests := map[string]struct {
 		req     *api.CreateCampsiteRequest
@@ -133,9 +171,20 @@ func (s *serverSuite) TestCampgroundsService_CreateCampsite() {
 			want:    nil,
 			wantErr: codes.InvalidArgument.String(),
 		},
+		"InvalidArgument_CampgroundID": {
+			req: &api.CreateCampsiteRequest{
+				CampsiteCode: "campsite-code",
+				Capacity:     1,
+				CampgroundId: "campground-id",
+			},
+			on:      nil,
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
 	}
 	for name, tc := range tests {
-		s.T().Run(name, func(t *testing.T) {
+		s.Run(name, func() {
+			t := s.T()
 			// given
 			if tc.on != nil {
 				tc.on(s.mocks)
@@ -154,6 +203,118 @@ func (s *serverSuite) TestCampgroundsService_CreateCampsite() {
 	}
 }
 
+func (s *serverSuite) TestCampgroundsService_PayloadLogging() {
+	tests := map[string]struct {
+		level slog.Level
+		want  bool
+	}{
+		"Debug_PayloadLogged": {
+			level: slog.LevelDebug,
+			want:  true,
+		},
+		"Info_PayloadNotLogged": {
+			level: slog.LevelInfo,
+			want:  false,
+		},
+	}
+	// the level is changed after the server is created, as at runtime
+	var buf bytes.Buffer
+	level := new(slog.LevelVar)
+	slog.SetDefault(logger.NewDefault(&buf, &slog.HandlerOptions{Level: level}))
+	s.mocks.campsites.On(
+		"Insert", mock.Anything, mock.AnythingOfType("*domain.Campsite"),
+	).Return(nil)
+
+	// the subtests share the server, on which only the level changes
+	for name, tc := range tests {
+		s.T().Run(name, func(t *testing.T) {
+			// given
+			buf.Reset()
+			level.Set(tc.level)
+			// when
+			_, err := s.client.CreateCampsite(context.Background(), &api.CreateCampsiteRequest{
+				CampsiteCode: "payload-code",
+				Capacity:     1,
+			})
+			// then
+			assert.NoError(t, err)
+			assert.Contains(t, buf.String(), "finished call")
+			assert.Equal(t, tc.want, strings.Contains(buf.String(), "request received"),
+				"payload logged = %t, want %t", !tc.want, tc.want)
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_RequestID() {
+	tests := map[string]struct {
+		requestID string
+	}{
+		"Accepted": {
+			requestID: "client-request-id",
+		},
+		"Generated": {},
+	}
+	var buf bytes.Buffer
+	slog.SetDefault(logger.NewDefault(&buf, nil))
+	s.mocks.campsites.On(
+		"Insert", mock.Anything, mock.AnythingOfType("*domain.Campsite"),
+	).Return(nil)
+
+	// the subtests share the server, on which only the level changes
+	for name, tc := range tests {
+		s.T().Run(name, func(t *testing.T) {
+			// given
+			buf.Reset()
+			ctx := context.Background()
+			if tc.requestID != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", tc.requestID)
+			}
+			var header metadata.MD
+			// when
+			_, err := s.client.CreateCampsite(ctx, &api.CreateCampsiteRequest{
+				CampsiteCode: "request-id-code",
+				Capacity:     1,
+			}, grpc.Header(&header))
+			// then
+			assert.NoError(t, err)
+			if assert.Len(t, header.Get("x-request-id"), 1) {
+				got := header.Get("x-request-id")[0]
+				if tc.requestID != "" {
+					assert.Equal(t, tc.requestID, got)
+				}
+				assert.Contains(t, buf.String(), "request_id="+got)
+			}
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_PanicRecovered() {
+	// given
+	var buf bytes.Buffer
+	slog.SetDefault(logger.NewDefault(&buf, nil))
+	s.mocks.campsites.On(
+		"Insert", mock.Anything, mock.AnythingOfType("*domain.Campsite"),
+	).Run(func(mock.Arguments) { panic("boom") }).Once()
+	// when
+	_, err := s.client.CreateCampsite(context.Background(), &api.CreateCampsiteRequest{
+		CampsiteCode: "panic-code",
+		Capacity:     1,
+	})
+	// then
+	s.Equal(codes.Internal, status.Code(err))
+	s.Contains(buf.String(), "recovered from panic")
+	// when the server is still serving
+	s.mocks.campsites.On(
+		"Insert", mock.Anything, mock.AnythingOfType("*domain.Campsite"),
+	).Return(nil).Once()
+	_, err = s.client.CreateCampsite(context.Background(), &api.CreateCampsiteRequest{
+		CampsiteCode: "panic-code",
+		Capacity:     1,
+	})
+	// then
+	s.NoError(err)
+}
+
 func (s *serverSuite) TestCampgroundsService_GetBooking() {
 	booking, err := bootstrap.NewBooking("campsite-id")
 	s.NoError(err)
@@ -182,7 +343,8 @@ func (s *serverSuite) TestCampgroundsService_GetBooking() {
 		},
 	}
 	for name, tc := range tests {
-		s.T().Run(name, func(t *testing.T) {
+		s.Run(name, func() {
+			t := s.T()
 			// given
 			if tc.on != nil {
 				tc.on(s.mocks)
@@ -212,15 +374,31 @@ func (s *serverSuite) TestCampgroundsService_CreateBooking() {
 	}{
 		"Success": {
 			req: &api.CreateBookingRequest{
-				CampsiteId: "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46",
-				Email:      "john.smith@example.com",
-				FullName:   "John Smith",
-				StartDate:  now.AddDate(0, 0, 1).Format(time.DateOnly),
-				EndDate:    now.AddDate(0, 0, 2).Format(time.DateOnly),
+				CampsiteId:    "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46",
+				Email:         "john.smith@example.com",
+				FullName:      "John Smith",
+				StartDate:     now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:       now.AddDate(0, 0, 2).Format(time.DateOnly),
+				PaymentMethod: "pm_card_visa",
 			},
 			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46",
+				).Return(&domain.Campsite{CampsiteID: "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46"}, nil)
+				s.mocks.rates.On(
+					"Find", mock.Anything, "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46",
+				).Return(bootstrap.NewCampsiteRates("b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46"), nil)
+				s.mocks.payments.On(
+					"Authorize", mock.Anything, mock.AnythingOfType("domain.PaymentRequest"),
+				).Return(&domain.Payment{Reference: "pi_123", Amount: 1200, Currency: "USD"}, nil)
+				s.mocks.payments.On("Capture", mock.Anything, "pi_123").Return(nil)
+				s.mocks.guests.On(
+					"FindOrInsert", mock.Anything, mock.AnythingOfType("*domain.Guest"),
+				).Return(&domain.Guest{GuestID: "6f1b5d2e-0c3a-4d8e-9b7f-2a4c6e8f0b1d"}, nil)
 				s.mocks.bookings.On(
 					"Insert", mock.Anything, mock.AnythingOfType("*domain.Booking"),
+				).Return(nil).On(
+					"Update", mock.Anything, mock.AnythingOfType("*domain.Booking"),
 				).Return(nil)
 			},
 			want:    nil,
@@ -294,13 +472,18 @@ func (s *serverSuite) TestCampgroundsService_CreateBooking() {
 				StartDate:  now.AddDate(0, 0, 2).Format(time.DateOnly),
 				EndDate:    now.AddDate(0, 0, 1).Format(time.DateOnly),
 			},
-			on:      nil,
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46",
+				).Return(&domain.Campsite{CampsiteID: "b5839e4a-1dab-4c0a-8aa5-6a4e6910ce46"}, nil)
+			},
 			want:    nil,
 			wantErr: codes.InvalidArgument.String(),
 		},
 	}
 	for name, tc := range tests {
-		s.T().Run(name, func(t *testing.T) {
+		s.Run(name, func() {
+			t := s.T()
 			// given
 			if tc.on != nil {
 				tc.on(s.mocks)
@@ -318,3 +501,374 @@ func (s *serverSuite) TestCampgroundsService_CreateBooking() {
 		})
 	}
 }
+
+func (s *serverSuite) TestCampgroundsService_JoinWaitlist() {
+	now := bootstrap.AsStartOfDayUTC(time.Now())
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+
+	tests := map[string]struct {
+		req     *api.JoinWaitlistRequest
+		on      func(f mocks)
+		want    *api.JoinWaitlistResponse
+		wantErr string
+	}{
+		"Success": {
+			req: &api.JoinWaitlistRequest{
+				CampgroundId: campground.CampgroundID,
+				Email:        "john.smith@example.com",
+				FullName:     "John Smith",
+				StartDate:    now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:      now.AddDate(0, 0, 2).Format(time.DateOnly),
+				Guests:       2,
+			},
+			on: func(f mocks) {
+				s.mocks.campgrounds.On(
+					"Find", mock.Anything, campground.CampgroundID,
+				).Return(campground, nil)
+				s.mocks.waitlist.On(
+					"Insert", mock.Anything, mock.AnythingOfType("*domain.WaitlistEntry"),
+				).Return(nil)
+			},
+			want:    nil,
+			wantErr: "",
+		},
+		"InvalidArgument_CampsiteId": {
+			req: &api.JoinWaitlistRequest{
+				CampgroundId: campground.CampgroundID,
+				CampsiteId:   "invalid-uuid-campsite-id",
+				Email:        "john.smith@example.com",
+				FullName:     "John Smith",
+				StartDate:    now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:      now.AddDate(0, 0, 2).Format(time.DateOnly),
+			},
+			on:      nil,
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			t := s.T()
+			// given
+			if tc.on != nil {
+				tc.on(s.mocks)
+			}
+			// when
+			resp, err := s.client.JoinWaitlist(context.Background(), tc.req)
+			// then
+			if tc.wantErr != "" {
+				s.Empty(resp)
+				assert.Contains(t, err.Error(), tc.wantErr,
+					"JoinWaitlist() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			s.NotEmpty(resp.EntryId)
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_CreateGroupBooking() {
+	now := bootstrap.AsStartOfDayUTC(time.Now())
+	campsiteIDs := []string{uuid.New().String(), uuid.New().String()}
+
+	tests := map[string]struct {
+		req     *api.CreateGroupBookingRequest
+		on      func(f mocks)
+		want    *api.CreateGroupBookingResponse
+		wantErr string
+	}{
+		"Success": {
+			req: &api.CreateGroupBookingRequest{
+				Campsites: []*api.GroupBookingCampsite{
+					{CampsiteId: campsiteIDs[0]},
+					{CampsiteId: campsiteIDs[1]},
+				},
+				Email:     "john.smith@example.com",
+				FullName:  "John Smith",
+				StartDate: now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:   now.AddDate(0, 0, 2).Format(time.DateOnly),
+			},
+			on: func(f mocks) {
+				for _, campsiteID := range campsiteIDs {
+					s.mocks.campsites.On(
+						"Find", mock.Anything, campsiteID,
+					).Return(&domain.Campsite{CampsiteID: campsiteID}, nil)
+					s.mocks.rates.On(
+						"Find", mock.Anything, campsiteID,
+					).Return(nil, domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID})
+				}
+				s.mocks.guests.On(
+					"FindOrInsert", mock.Anything, mock.AnythingOfType("*domain.Guest"),
+				).Return(&domain.Guest{GuestID: "6f1b5d2e-0c3a-4d8e-9b7f-2a4c6e8f0b1d"}, nil)
+				s.mocks.bookings.On(
+					"InsertGroup", mock.Anything, mock.AnythingOfType("[]*domain.Booking"),
+				).Return(nil)
+			},
+			want:    nil,
+			wantErr: "",
+		},
+		"InvalidArgument_Campsites": {
+			req: &api.CreateGroupBookingRequest{
+				Email:     "john.smith@example.com",
+				FullName:  "John Smith",
+				StartDate: now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:   now.AddDate(0, 0, 2).Format(time.DateOnly),
+			},
+			on:      nil,
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+		"InvalidArgument_RepeatedCampsite": {
+			req: &api.CreateGroupBookingRequest{
+				Campsites: []*api.GroupBookingCampsite{
+					{CampsiteId: campsiteIDs[0]},
+					{CampsiteId: campsiteIDs[0]},
+				},
+				Email:     "john.smith@example.com",
+				FullName:  "John Smith",
+				StartDate: now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:   now.AddDate(0, 0, 2).Format(time.DateOnly),
+			},
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, campsiteIDs[0],
+				).Return(&domain.Campsite{CampsiteID: campsiteIDs[0]}, nil)
+				s.mocks.rates.On(
+					"Find", mock.Anything, campsiteIDs[0],
+				).Return(nil, domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteIDs[0]})
+			},
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			t := s.T()
+			// given
+			if tc.on != nil {
+				tc.on(s.mocks)
+			}
+			// when
+			resp, err := s.client.CreateGroupBooking(context.Background(), tc.req)
+			// then
+			if tc.wantErr != "" {
+				s.Empty(resp)
+				assert.Contains(t, err.Error(), tc.wantErr,
+					"CreateGroupBooking() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			s.NotEmpty(resp.GroupId)
+			s.Len(resp.BookingIds, 2)
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_CreateBlackout() {
+	now := bootstrap.AsStartOfDayUTC(time.Now())
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+
+	tests := map[string]struct {
+		req     *api.CreateBlackoutRequest
+		on      func(f mocks)
+		want    *api.CreateBlackoutResponse
+		wantErr string
+	}{
+		"Success": {
+			req: &api.CreateBlackoutRequest{
+				CampsiteId: campsite.CampsiteID,
+				StartDate:  now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:    now.AddDate(0, 0, 3).Format(time.DateOnly),
+				Reason:     "maintenance",
+			},
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, campsite.CampsiteID,
+				).Return(campsite, nil)
+				s.mocks.blackouts.On(
+					"Insert", mock.Anything, mock.AnythingOfType("*domain.CampsiteBlackout"),
+				).Return(nil)
+			},
+			want:    nil,
+			wantErr: "",
+		},
+		"InvalidArgument_Reason": {
+			req: &api.CreateBlackoutRequest{
+				CampsiteId: campsite.CampsiteID,
+				StartDate:  now.AddDate(0, 0, 1).Format(time.DateOnly),
+				EndDate:    now.AddDate(0, 0, 3).Format(time.DateOnly),
+			},
+			on:      nil,
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			t := s.T()
+			// given
+			if tc.on != nil {
+				tc.on(s.mocks)
+			}
+			// when
+			resp, err := s.client.CreateBlackout(context.Background(), tc.req)
+			// then
+			if tc.wantErr != "" {
+				s.Empty(resp)
+				assert.Contains(t, err.Error(), tc.wantErr,
+					"CreateBlackout() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			s.NotEmpty(resp.BlackoutId)
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_CreateCalendarSubscription() {
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	calendar := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:reserved-1@example.com\r\n" +
+		"DTSTART;VALUE=DATE:20261102\r\n" +
+		"DTEND;VALUE=DATE:20261105\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	tests := map[string]struct {
+		req     *api.CreateCalendarSubscriptionRequest
+		on      func(f mocks)
+		wantErr string
+	}{
+		"Success_Calendar": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: campsite.CampsiteID,
+				Name:       "Hipcamp",
+				Source: &api.CreateCalendarSubscriptionRequest_Calendar{
+					Calendar: []byte(calendar),
+				},
+			},
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, campsite.CampsiteID,
+				).Return(campsite, nil)
+				s.mocks.calendars.On(
+					"Insert", mock.Anything, mock.AnythingOfType("*domain.CalendarSubscription"),
+					mock.MatchedBy(func(blocks []*domain.ExternalBlock) bool {
+						return len(blocks) == 1 && blocks[0].CampsiteID == campsite.CampsiteID
+					}),
+				).Return(nil)
+			},
+			wantErr: "",
+		},
+		"InvalidArgument_Source": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: campsite.CampsiteID,
+				Name:       "Hipcamp",
+			},
+			on:      nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+		"InvalidArgument_Calendar": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: campsite.CampsiteID,
+				Name:       "Hipcamp",
+				Source: &api.CreateCalendarSubscriptionRequest_Calendar{
+					Calendar: []byte("BEGIN:VCALENDAR\r\n"),
+				},
+			},
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Find", mock.Anything, campsite.CampsiteID,
+				).Return(campsite, nil)
+			},
+			wantErr: codes.InvalidArgument.String(),
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			t := s.T()
+			// given
+			if tc.on != nil {
+				tc.on(s.mocks)
+			}
+			// when
+			resp, err := s.client.CreateCalendarSubscription(context.Background(), tc.req)
+			// then
+			if tc.wantErr != "" {
+				s.Empty(resp)
+				assert.Contains(t, err.Error(), tc.wantErr,
+					"CreateCalendarSubscription() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if s.NoError(err) {
+				s.NotEmpty(resp.SubscriptionId)
+			}
+		})
+	}
+}
+
+func (s *serverSuite) TestCampgroundsService_ImportCampsites() {
+	tests := map[string]struct {
+		chunks  []string
+		on      func(f mocks)
+		want    *api.ImportCampsitesResponse
+		wantErr string
+	}{
+		"Success": {
+			chunks: []string{"campsite_code,capacity,fire_pit\nA01,4,", "true\nA02,0,false\n"},
+			on: func(f mocks) {
+				s.mocks.campsites.On(
+					"Upsert", mock.Anything, mock.AnythingOfType("[]*domain.CampsiteImportRow"),
+					domain.CampsiteImportModeBestEffort,
+				).Run(func(args mock.Arguments) {
+					for _, row := range args.Get(1).([]*domain.CampsiteImportRow) {
+						row.Status = domain.CampsiteImportStatusCreated
+					}
+				}).Return(nil)
+			},
+			want:    &api.ImportCampsitesResponse{Created: 1, Failed: 1},
+			wantErr: "",
+		},
+		"InvalidArgument_CSVHeader": {
+			chunks:  []string{"campsite_code\nA01\n"},
+			on:      nil,
+			want:    nil,
+			wantErr: codes.InvalidArgument.String(),
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			t := s.T()
+			// given
+			if tc.on != nil {
+				tc.on(s.mocks)
+			}
+			stream, err := s.client.ImportCampsites(context.Background())
+			s.NoError(err)
+			for _, chunk := range tc.chunks {
+				s.NoError(stream.Send(&api.ImportCampsitesRequest{
+					Mode:    "BEST_EFFORT",
+					Payload: &api.ImportCampsitesRequest_CsvChunk{CsvChunk: []byte(chunk)},
+				}))
+			}
+			// when
+			resp, err := stream.CloseAndRecv()
+			// then
+			if tc.wantErr != "" {
+				s.Empty(resp)
+				assert.Contains(t, err.Error(), tc.wantErr,
+					"ImportCampsites() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if s.NoError(err) {
+				s.Equal(tc.want.Created, resp.Created)
+				s.Equal(tc.want.Failed, resp.Failed)
+				s.Len(resp.Results, 2)
+				s.NotEmpty(resp.Results[0].CampsiteId)
+				s.Equal("capacity must be greater than 0", resp.Results[1].Error)
+			}
+		})
+	}
+}
@@ -4,57 +4,2238 @@ package grpc
 
 import (
 	"context"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/command"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/query"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application/validator"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type mocks struct {
 	app *application.MockApp
 }
 
-func TestServer_GetCampsites(t *testing.T) {
-	campsite, err := bootstrap.NewCampsite()
+func TestServer_GetCampgrounds(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		req     *api.GetCampgroundsRequest
+		on      func(f mocks)
+		want    *api.GetCampgroundsResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetCampgroundsRequest{},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampgrounds", context.TODO(), query.GetCampgrounds{}).
+					Return([]*domain.Campground{campground}, nil)
+			},
+			want: &api.GetCampgroundsResponse{
+				Campgrounds: []*api.Campground{CampgroundFromDomain(campground)},
+			},
+			wantErr: nil,
+		},
+		"Error_Internal_ErrQuery": {
+			req: &api.GetCampgroundsRequest{},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampgrounds", context.TODO(), query.GetCampgrounds{}).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetCampgrounds(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampgrounds() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetCampgrounds() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetCampground(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	assert.NoError(t, err)
+	nonExistingID := "non-existing-id"
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: nonExistingID}
+
+	tests := map[string]struct {
+		req     *api.GetCampgroundRequest
+		on      func(f mocks)
+		want    *api.GetCampgroundResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetCampgroundRequest{CampgroundId: campground.CampgroundID},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"GetCampground",
+						context.TODO(),
+						query.GetCampground{CampgroundID: campground.CampgroundID},
+					).
+					Return(campground, nil)
+			},
+			want: &api.GetCampgroundResponse{
+				Campground: CampgroundFromDomain(campground),
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_ErrCampgroundNotFound": {
+			req: &api.GetCampgroundRequest{CampgroundId: nonExistingID},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"GetCampground",
+						context.TODO(),
+						query.GetCampground{CampgroundID: nonExistingID},
+					).
+					Return(nil, errCampgroundNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampgroundNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetCampground(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampground() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetCampground() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CreateCampground(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	assert.NoError(t, err)
+	req := &api.CreateCampgroundRequest{
+		Name:        campground.Name,
+		Description: campground.Description,
+	}
+
+	tests := map[string]struct {
+		req     *api.CreateCampgroundRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateCampground", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_Internal_CommitTx": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateCampground", context.TODO(), mock.Anything).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CreateCampground(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"CreateCampground() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.CampgroundId)
+		})
+	}
+}
+
+func TestServer_UpdateCampground(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
 	assert.NoError(t, err)
+	req := &api.UpdateCampgroundRequest{Campground: CampgroundFromDomain(campground)}
+	cmd := command.UpdateCampground{
+		CampgroundID: campground.CampgroundID,
+		Name:         campground.Name,
+		Description:  campground.Description,
+		Active:       campground.Active,
+	}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		req     *api.UpdateCampgroundRequest
+		on      func(f mocks)
+		want    *api.UpdateCampgroundResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateCampground", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.UpdateCampgroundResponse{},
+			wantErr: nil,
+		},
+		"Error_NotFound_ErrCampgroundNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateCampground", context.TODO(), cmd).
+					Return(errCampgroundNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampgroundNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.UpdateCampground(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"UpdateCampground() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"UpdateCampground() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_DeleteCampground(t *testing.T) {
+	campgroundID := "campground-id"
+	cmd := command.DeleteCampground{CampgroundID: campgroundID}
+	errCampgroundInUse := domain.ErrCampgroundInUse{CampgroundID: campgroundID}
+
+	tests := map[string]struct {
+		req     *api.DeleteCampgroundRequest
+		on      func(f mocks)
+		want    *api.DeleteCampgroundResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.DeleteCampgroundRequest{CampgroundId: campgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("DeleteCampground", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.DeleteCampgroundResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_ErrCampgroundInUse": {
+			req: &api.DeleteCampgroundRequest{CampgroundId: campgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("DeleteCampground", context.TODO(), cmd).
+					Return(errCampgroundInUse)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errCampgroundInUse.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.DeleteCampground(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"DeleteCampground() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"DeleteCampground() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetCampgroundSeason(t *testing.T) {
+	season := bootstrap.NewCampgroundSeason("campground-id")
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: season.CampgroundID}
+	qry := query.GetCampgroundSeason{CampgroundID: season.CampgroundID}
+
+	tests := map[string]struct {
+		req     *api.GetCampgroundSeasonRequest
+		on      func(f mocks)
+		want    *api.GetCampgroundSeasonResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetCampgroundSeasonRequest{CampgroundId: season.CampgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampgroundSeason", context.TODO(), qry).
+					Return(season, nil)
+			},
+			want: &api.GetCampgroundSeasonResponse{
+				Season: &api.CampgroundSeason{
+					CampgroundId:   season.CampgroundID,
+					OpensOn:        "05-01",
+					ClosesOn:       "10-31",
+					ClosedWeekdays: []string{"MONDAY"},
+				},
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_CampgroundNotFound": {
+			req: &api.GetCampgroundSeasonRequest{CampgroundId: season.CampgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampgroundSeason", context.TODO(), qry).
+					Return(nil, errCampgroundNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampgroundNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetCampgroundSeason(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampgroundSeason() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetCampgroundSeason() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_SetCampgroundSeason(t *testing.T) {
+	season := bootstrap.NewCampgroundSeason("campground-id")
+	req := &api.SetCampgroundSeasonRequest{Season: CampgroundSeasonFromDomain(season)}
+	cmd := command.SetCampgroundSeason{
+		CampgroundID:   season.CampgroundID,
+		OpensOn:        "05-01",
+		ClosesOn:       "10-31",
+		ClosedWeekdays: []string{"MONDAY"},
+	}
+	errCampgroundSeasonValidation := domain.ErrCampgroundSeasonValidation{Reason: "reason"}
+
+	tests := map[string]struct {
+		req     *api.SetCampgroundSeasonRequest
+		on      func(f mocks)
+		want    *api.SetCampgroundSeasonResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("SetCampgroundSeason", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.SetCampgroundSeasonResponse{},
+			wantErr: nil,
+		},
+		"Error_InvalidArgument_ErrCampgroundSeasonValidation": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("SetCampgroundSeason", context.TODO(), cmd).
+					Return(errCampgroundSeasonValidation)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, errCampgroundSeasonValidation.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.SetCampgroundSeason(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"SetCampgroundSeason() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"SetCampgroundSeason() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetCampsites(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		req     *api.GetCampsitesRequest
+		on      func(f mocks)
+		want    *api.GetCampsitesResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetCampsitesRequest{},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsites", mock.Anything, mock.Anything).
+					Return([]*domain.Campsite{campsite}, nil)
+			},
+			want: &api.GetCampsitesResponse{
+				Campsites: []*api.Campsite{CampsiteFromDomain(campsite)},
+			},
+			wantErr: nil,
+		},
+		"Error_Internal_ErrQuery": {
+			req: &api.GetCampsitesRequest{},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsites", mock.Anything, mock.Anything).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+		"Success_ByCampgroundID": {
+			req: &api.GetCampsitesRequest{CampgroundId: "campground-id"},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"GetCampsites",
+						mock.Anything,
+						query.GetCampsites{CampgroundID: "campground-id"},
+					).
+					Return([]*domain.Campsite{campsite}, nil)
+			},
+			want: &api.GetCampsitesResponse{
+				Campsites: []*api.Campsite{CampsiteFromDomain(campsite)},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetCampsites(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampsites() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetCampsites() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CreateCampsite(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	assert.NoError(t, err)
+
+	req := &api.CreateCampsiteRequest{
+		CampsiteCode:  campsite.CampsiteCode,
+		Capacity:      campsite.Capacity,
+		DrinkingWater: campsite.DrinkingWater,
+		Restrooms:     campsite.Restrooms,
+		PicnicTable:   campsite.PicnicTable,
+		FirePit:       campsite.FirePit,
+	}
+
+	tests := map[string]struct {
+		req     *api.CreateCampsiteRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateCampsite", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_Internal_CommitTx": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateCampsite", context.TODO(), mock.Anything).
+					Return(bootstrap.ErrCommitTx)
+			},
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CreateCampsite(context.TODO(), req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"CreateCampsite() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.CampsiteId)
+		})
+	}
+}
+
+type importCampsitesStream struct {
+	grpc.ServerStream
+	reqs []*api.ImportCampsitesRequest
+	resp *api.ImportCampsitesResponse
+}
+
+func (s *importCampsitesStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (s *importCampsitesStream) Recv() (*api.ImportCampsitesRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *importCampsitesStream) SendAndClose(resp *api.ImportCampsitesResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestServer_ImportCampsites(t *testing.T) {
+	campsiteReq := func(code string) *api.ImportCampsitesRequest {
+		return &api.ImportCampsitesRequest{
+			Mode: "BEST_EFFORT",
+			Payload: &api.ImportCampsitesRequest_Campsite{
+				Campsite: &api.ImportCampsite{CampsiteCode: code, Capacity: 4},
+			},
+		}
+	}
+	csvReq := func(chunk string) *api.ImportCampsitesRequest {
+		return &api.ImportCampsitesRequest{
+			Payload: &api.ImportCampsitesRequest_CsvChunk{CsvChunk: []byte(chunk)},
+		}
+	}
+	jsonReq := func(chunk string) *api.ImportCampsitesRequest {
+		return &api.ImportCampsitesRequest{
+			Payload: &api.ImportCampsitesRequest_JsonChunk{JsonChunk: []byte(chunk)},
+		}
+	}
+	imported := func(mode string, codes ...string) any {
+		return mock.MatchedBy(func(cmd command.ImportCampsites) bool {
+			if cmd.Mode != mode || len(cmd.Rows) != len(codes) {
+				return false
+			}
+			for i, row := range cmd.Rows {
+				if row.Campsite.CampsiteCode != codes[i] || row.Campsite.CampsiteID == "" {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	// sets the outcomes of the rows as imported, the first row upserted as
+	// campsite-id and the second one failed
+	setOutcomes := func(args mock.Arguments) {
+		rows := args.Get(1).(command.ImportCampsites).Rows
+		for i, row := range rows {
+			row.Row = i + 1
+		}
+		rows[0].Campsite.CampsiteID = "campsite-id"
+		rows[0].Status = domain.CampsiteImportStatusCreated
+		rows[1].Fail("capacity must be greater than 0")
+	}
+	want := &api.ImportCampsitesResponse{
+		Created: 1,
+		Failed:  1,
+		Results: []*api.CampsiteImportResult{
+			{Row: 1, CampsiteCode: "A01", CampsiteId: "campsite-id", Status: "CREATED"},
+			{
+				Row:          2,
+				CampsiteCode: "A02",
+				Status:       "FAILED",
+				Error:        "capacity must be greater than 0",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		reqs    []*api.ImportCampsitesRequest
+		on      func(f mocks)
+		want    *api.ImportCampsitesResponse
+		wantErr error
+	}{
+		"Success_Campsites": {
+			reqs: []*api.ImportCampsitesRequest{campsiteReq("A01"), campsiteReq("A02")},
+			on: func(f mocks) {
+				f.app.
+					On("ImportCampsites", context.TODO(), imported("BEST_EFFORT", "A01", "A02")).
+					Run(setOutcomes).
+					Return(nil)
+			},
+			want:    want,
+			wantErr: nil,
+		},
+		"Success_CSV": {
+			reqs: []*api.ImportCampsitesRequest{
+				csvReq("campsite_code,capacity\nA0"), csvReq("1,4\nA02,0\n"),
+			},
+			on: func(f mocks) {
+				f.app.
+					On("ImportCampsites", context.TODO(), imported("", "A01", "A02")).
+					Run(setOutcomes).
+					Return(nil)
+			},
+			want:    want,
+			wantErr: nil,
+		},
+		"Success_JSON": {
+			reqs: []*api.ImportCampsitesRequest{
+				jsonReq(`[{"campsite_code": "A01", "capacity": 4},`),
+				jsonReq(`{"campsite_code": "A02", "capacity": 0}]`),
+			},
+			on: func(f mocks) {
+				f.app.
+					On("ImportCampsites", context.TODO(), imported("", "A01", "A02")).
+					Run(setOutcomes).
+					Return(nil)
+			},
+			want:    want,
+			wantErr: nil,
+		},
+		"Error_CampsiteImportValidation_MixedPayload": {
+			reqs: []*api.ImportCampsitesRequest{campsiteReq("A01"), csvReq("campsite_code")},
+			on:   nil,
+			want: nil,
+			wantErr: status.Error(codes.InvalidArgument,
+				"campsite import validation: CSV payload mixed with campsite payload"),
+		},
+		"Error_CampsiteImportValidation_NoPayload": {
+			reqs: []*api.ImportCampsitesRequest{{Mode: "BEST_EFFORT"}},
+			on:   nil,
+			want: nil,
+			wantErr: status.Error(codes.InvalidArgument,
+				"campsite import validation: message without payload"),
+		},
+		"Error_CampsiteImportValidation_CSVHeader": {
+			reqs: []*api.ImportCampsitesRequest{csvReq("campsite_code\nA01\n")},
+			on:   nil,
+			want: nil,
+			wantErr: status.Error(codes.InvalidArgument,
+				"campsite import validation: missing CSV column capacity"),
+		},
+		"Error_Internal_CommitTx": {
+			reqs: []*api.ImportCampsitesRequest{campsiteReq("A01")},
+			on: func(f mocks) {
+				f.app.
+					On("ImportCampsites", context.TODO(), imported("BEST_EFFORT", "A01")).
+					Return(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			stream := &importCampsitesStream{reqs: tc.reqs}
+			// when
+			err := s.ImportCampsites(stream)
+			// then
+			assertStatusError(t, tc.wantErr, err,
+				"ImportCampsites() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, tc.want, stream.resp,
+				"ImportCampsites() got = %v, want %v", stream.resp, tc.want)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetCampsiteRates(t *testing.T) {
+	rates := bootstrap.NewCampsiteRates("campsite-id")
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: rates.CampsiteID}
+	qry := query.GetCampsiteRates{CampsiteID: rates.CampsiteID}
+
+	tests := map[string]struct {
+		req     *api.GetCampsiteRatesRequest
+		on      func(f mocks)
+		want    *api.GetCampsiteRatesResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetCampsiteRatesRequest{CampsiteId: rates.CampsiteID},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsiteRates", context.TODO(), qry).
+					Return(rates, nil)
+			},
+			want: &api.GetCampsiteRatesResponse{
+				Rates: &api.CampsiteRates{
+					CampsiteId:         rates.CampsiteID,
+					Currency:           "USD",
+					NightlyRate:        4000,
+					WeekendNightlyRate: 5000,
+					IncludedGuests:     2,
+					ExtraGuestFee:      500,
+					TaxRateBps:         1300,
+					Seasons: []*api.SeasonalRate{
+						{
+							Name:        "summer",
+							StartDate:   "2006-06-01",
+							EndDate:     "2006-09-01",
+							NightlyRate: 6000,
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_ErrCampsiteRatesNotFound": {
+			req: &api.GetCampsiteRatesRequest{CampsiteId: rates.CampsiteID},
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsiteRates", context.TODO(), qry).
+					Return(nil, errCampsiteRatesNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampsiteRatesNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetCampsiteRates(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetCampsiteRates() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetCampsiteRates() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_SetCampsiteRates(t *testing.T) {
+	rates := bootstrap.NewCampsiteRates("campsite-id")
+	req := &api.SetCampsiteRatesRequest{Rates: CampsiteRatesFromDomain(rates)}
+	cmd := command.SetCampsiteRates{
+		CampsiteID:         rates.CampsiteID,
+		Currency:           rates.Currency,
+		NightlyRate:        rates.NightlyRate,
+		WeekendNightlyRate: rates.WeekendNightlyRate,
+		IncludedGuests:     rates.IncludedGuests,
+		ExtraGuestFee:      rates.ExtraGuestFee,
+		TaxRateBps:         rates.TaxRateBps,
+		Seasons: []command.SeasonalRate{
+			{
+				Name:        "summer",
+				StartDate:   "2006-06-01",
+				EndDate:     "2006-09-01",
+				NightlyRate: 6000,
+			},
+		},
+	}
+	errCampsiteRatesValidation := domain.ErrCampsiteRatesValidation{Reason: "reason"}
+
+	tests := map[string]struct {
+		req     *api.SetCampsiteRatesRequest
+		on      func(f mocks)
+		want    *api.SetCampsiteRatesResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("SetCampsiteRates", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.SetCampsiteRatesResponse{},
+			wantErr: nil,
+		},
+		"Error_InvalidArgument_ErrCampsiteRatesValidation": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("SetCampsiteRates", context.TODO(), cmd).
+					Return(errCampsiteRatesValidation)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, errCampsiteRatesValidation.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.SetCampsiteRates(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"SetCampsiteRates() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"SetCampsiteRates() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_QuoteBooking(t *testing.T) {
+	req := &api.QuoteBookingRequest{
+		CampsiteId: "campsite-id",
+		StartDate:  "2006-01-06",
+		EndDate:    "2006-01-07",
+		Guests:     3,
+	}
+	qry := query.QuoteBooking{
+		CampsiteID: req.CampsiteId,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Guests:     req.Guests,
+	}
+	night := time.Date(2006, 1, 6, 0, 0, 0, 0, time.UTC)
+	quote := &domain.Quote{
+		CampsiteID:     req.CampsiteId,
+		StartDate:      night,
+		EndDate:        night.AddDate(0, 0, 1),
+		Guests:         3,
+		Currency:       "USD",
+		Nights:         []domain.NightlyPrice{{Date: night, Amount: 5000, Weekend: true}},
+		NightsSubtotal: 5000,
+		ExtraGuestFees: 500,
+		Taxes:          715,
+		Total:          6215,
+	}
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: req.CampsiteId}
+
+	tests := map[string]struct {
+		req     *api.QuoteBookingRequest
+		on      func(f mocks)
+		want    *api.QuoteBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("QuoteBooking", context.TODO(), qry).
+					Return(quote, nil)
+			},
+			want: &api.QuoteBookingResponse{
+				Quote: &api.Quote{
+					CampsiteId: req.CampsiteId,
+					StartDate:  "2006-01-06",
+					EndDate:    "2006-01-07",
+					Guests:     3,
+					Currency:   "USD",
+					Nights: []*api.NightlyPrice{
+						{Date: "2006-01-06", Amount: 5000, Weekend: true},
+					},
+					NightsSubtotal: 5000,
+					ExtraGuestFees: 500,
+					Taxes:          715,
+					Total:          6215,
+				},
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_ErrCampsiteRatesNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("QuoteBooking", context.TODO(), qry).
+					Return(nil, errCampsiteRatesNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampsiteRatesNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.QuoteBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"QuoteBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"QuoteBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetBooking(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	nonExistingID := "non-existing-id"
+	errBookingNotFound := domain.ErrBookingNotFound{BookingID: nonExistingID}
+
+	tests := map[string]struct {
+		req     *api.GetBookingRequest
+		on      func(f mocks)
+		want    *api.GetBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetBookingRequest{BookingId: booking.BookingID},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"GetBooking",
+						context.TODO(),
+						query.GetBooking{BookingID: booking.BookingID},
+					).
+					Return(booking, nil)
+			},
+			want:    &api.GetBookingResponse{Booking: BookingFromDomain(booking)},
+			wantErr: nil,
+		},
+		"Error_NotFound_ErrBookingNotFound": {
+			req: &api.GetBookingRequest{BookingId: nonExistingID},
+			on: func(f mocks) {
+				f.app.
+					On("GetBooking", context.TODO(), query.GetBooking{BookingID: nonExistingID}).
+					Return(nil, errBookingNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errBookingNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CreateBooking(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+	}
+	errBookingDatesBlackedOut := domain.ErrBookingDatesBlackedOut{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+		Reason:    "maintenance",
+	}
+	errPaymentDeclined := domain.ErrPaymentDeclined{Reason: "card declined"}
+	errPaymentMethodRequired := domain.ErrPaymentMethodRequired{}
+	req := &api.CreateBookingRequest{
+		CampsiteId:    booking.CampsiteID,
+		Email:         booking.Email,
+		FullName:      booking.FullName,
+		StartDate:     booking.StartDate.Format(time.DateOnly),
+		EndDate:       booking.EndDate.Format(time.DateOnly),
+		PaymentMethod: "pm_card_visa",
+	}
+
+	tests := map[string]struct {
+		req     *api.CreateBookingRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBooking", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_BookingDatesNotAvailable": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBooking", context.TODO(), mock.Anything).
+					Return(errBookingDatesNotAvailable)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesNotAvailable.Error()),
+		},
+		"Error_FailedPrecondition_BookingDatesBlackedOut": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBooking", context.TODO(), mock.Anything).
+					Return(errBookingDatesBlackedOut)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesBlackedOut.Error()),
+		},
+		"Error_FailedPrecondition_PaymentDeclined": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBooking", context.TODO(), mock.Anything).
+					Return(errPaymentDeclined)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errPaymentDeclined.Error()),
+		},
+		"Error_InvalidArgument_PaymentMethodRequired": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBooking", context.TODO(), mock.Anything).
+					Return(errPaymentMethodRequired)
+			},
+			wantErr: status.Error(codes.InvalidArgument, errPaymentMethodRequired.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CreateBooking(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"CreateBooking() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.BookingId)
+		})
+	}
+}
+
+func TestServer_UpdateBooking(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+	}
+	errBookingValidation := domain.ErrBookingValidation{
+		MultiErr: multierror.Append(validator.ErrBookingStartDateBeforeEndDate{}),
+	}
+	req := &api.UpdateBookingRequest{Booking: BookingFromDomain(booking)}
+
+	tests := map[string]struct {
+		req     *api.UpdateBookingRequest
+		on      func(f mocks)
+		want    *api.UpdateBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateBooking", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			want:    &api.UpdateBookingResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_BookingDatesNotAvailable": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateBooking", context.TODO(), mock.Anything).
+					Return(errBookingDatesNotAvailable)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesNotAvailable.Error()),
+		},
+		"Error_InvalidArgument_BookingValidation": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateBooking", context.TODO(), mock.Anything).
+					Return(errBookingValidation)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, errBookingValidation.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.UpdateBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"UpdateBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"UpdateBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CancelBooking(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	errBookingAlreadyCancelled := domain.ErrBookingAlreadyCancelled{BookingID: booking.BookingID}
+	errCancellationNotAllowed := domain.ErrCancellationNotAllowed{
+		BookingID: booking.BookingID,
+		Reason:    "stay has already ended",
+	}
+	cancelled := *booking
+	cancelled.Status = domain.BookingStatusCancelled
+	cancelled.Currency = "USD"
+	cancelled.RefundPercent = 50
+	cancelled.RefundAmount = 2628
+	req := &api.CancelBookingRequest{BookingId: booking.BookingID}
+	getBooking := query.GetBooking{BookingID: booking.BookingID}
+
+	tests := map[string]struct {
+		req     *api.CancelBookingRequest
+		on      func(f mocks)
+		want    *api.CancelBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelBooking", context.TODO(), mock.Anything).
+					Return(nil).
+					On("GetBooking", mock.MatchedBy(domain.ReadYourWrites), getBooking).
+					Return(&cancelled, nil)
+			},
+			want: &api.CancelBookingResponse{
+				RefundPercent: 50,
+				RefundAmount:  2628,
+				Currency:      "USD",
+			},
+			wantErr: nil,
+		},
+		"Success_ReplicaLagging": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelBooking", context.TODO(), mock.Anything).
+					Return(nil).
+					On("GetBooking", mock.MatchedBy(domain.ReadYourWrites), getBooking).
+					Return(&cancelled, nil).
+					On("GetBooking", mock.Anything, getBooking).
+					Return(booking, nil).
+					Maybe()
+			},
+			want: &api.CancelBookingResponse{
+				RefundPercent: 50,
+				RefundAmount:  2628,
+				Currency:      "USD",
+			},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_CancellationNotAllowed": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelBooking", context.TODO(), mock.Anything).
+					Return(errCancellationNotAllowed)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errCancellationNotAllowed.Error()),
+		},
+		"Error_FailedPrecondition_BookingAlreadyCancelled": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelBooking", context.TODO(), mock.Anything).
+					Return(errBookingAlreadyCancelled)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errBookingAlreadyCancelled.Error()),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CancelBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"CancelBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"CancelBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CheckIn(t *testing.T) {
+	bookingID := uuid.New().String()
+	cmd := command.CheckIn{BookingID: bookingID}
+	errErrBookingNotStarted := domain.ErrBookingNotStarted{
+		BookingID: bookingID,
+		StartDate: time.Now().AddDate(0, 0, 1),
+	}
+
+	tests := map[string]struct {
+		req     *api.CheckInRequest
+		on      func(f mocks)
+		want    *api.CheckInResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.CheckInRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("CheckIn", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.CheckInResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_ErrBookingNotStarted": {
+			req: &api.CheckInRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("CheckIn", context.TODO(), cmd).
+					Return(errErrBookingNotStarted)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errErrBookingNotStarted.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CheckIn(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"CheckIn() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"CheckIn() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CheckOut(t *testing.T) {
+	bookingID := uuid.New().String()
+	cmd := command.CheckOut{BookingID: bookingID}
+	errErrBookingStatusTransition := domain.ErrBookingStatusTransition{
+		BookingID: bookingID,
+		From:      domain.BookingStatusConfirmed,
+		To:        domain.BookingStatusCheckedOut,
+	}
+
+	tests := map[string]struct {
+		req     *api.CheckOutRequest
+		on      func(f mocks)
+		want    *api.CheckOutResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.CheckOutRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("CheckOut", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.CheckOutResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_ErrBookingStatusTransition": {
+			req: &api.CheckOutRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("CheckOut", context.TODO(), cmd).
+					Return(errErrBookingStatusTransition)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errErrBookingStatusTransition.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CheckOut(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"CheckOut() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"CheckOut() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_MarkNoShow(t *testing.T) {
+	bookingID := uuid.New().String()
+	cmd := command.MarkNoShow{BookingID: bookingID}
+	errErrBookingStatusTransition := domain.ErrBookingStatusTransition{
+		BookingID: bookingID,
+		From:      domain.BookingStatusCheckedIn,
+		To:        domain.BookingStatusNoShow,
+	}
+
+	tests := map[string]struct {
+		req     *api.MarkNoShowRequest
+		on      func(f mocks)
+		want    *api.MarkNoShowResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.MarkNoShowRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("MarkNoShow", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.MarkNoShowResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_ErrBookingStatusTransition": {
+			req: &api.MarkNoShowRequest{BookingId: bookingID},
+			on: func(f mocks) {
+				f.app.
+					On("MarkNoShow", context.TODO(), cmd).
+					Return(errErrBookingStatusTransition)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, errErrBookingStatusTransition.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.MarkNoShow(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"MarkNoShow() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"MarkNoShow() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetGroupBooking(t *testing.T) {
+	groupID := uuid.New().String()
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	booking.GroupID = groupID
+	errGroupBookingNotFound := domain.ErrGroupBookingNotFound{GroupID: groupID}
+	req := &api.GetGroupBookingRequest{GroupId: groupID}
+
+	tests := map[string]struct {
+		req     *api.GetGroupBookingRequest
+		on      func(f mocks)
+		want    *api.GetGroupBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("GetGroupBooking", context.TODO(), query.GetGroupBooking{GroupID: groupID}).
+					Return([]*domain.Booking{booking}, nil)
+			},
+			want: &api.GetGroupBookingResponse{
+				Bookings: []*api.Booking{BookingFromDomain(booking)},
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("GetGroupBooking", context.TODO(), query.GetGroupBooking{GroupID: groupID}).
+					Return(nil, errGroupBookingNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errGroupBookingNotFound.Error()),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetGroupBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetGroupBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetGroupBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CreateGroupBooking(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	errGroupBookingValidation := domain.ErrGroupBookingValidation{
+		Reason: "campsite campsite-id booked more than once",
+	}
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+	}
+	req := &api.CreateGroupBookingRequest{
+		Campsites: []*api.GroupBookingCampsite{
+			{CampsiteId: uuid.New().String(), Guests: 2},
+			{CampsiteId: uuid.New().String(), Guests: 4},
+		},
+		Email:         booking.Email,
+		FullName:      booking.FullName,
+		StartDate:     booking.StartDate.Format(time.DateOnly),
+		EndDate:       booking.EndDate.Format(time.DateOnly),
+		PaymentMethod: "pm_card_visa",
+	}
+
+	tests := map[string]struct {
+		req     *api.CreateGroupBookingRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateGroupBooking", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_BookingDatesNotAvailable": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateGroupBooking", context.TODO(), mock.Anything).
+					Return(errBookingDatesNotAvailable)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesNotAvailable.Error()),
+		},
+		"Error_InvalidArgument_GroupBookingValidation": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateGroupBooking", context.TODO(), mock.Anything).
+					Return(errGroupBookingValidation)
+			},
+			wantErr: status.Error(codes.InvalidArgument, errGroupBookingValidation.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CreateGroupBooking(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"CreateGroupBooking() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.GroupId)
+			assert.Len(t, got.BookingIds, len(tc.req.Campsites))
+		})
+	}
+}
+
+func TestServer_UpdateGroupBooking(t *testing.T) {
+	groupID := uuid.New().String()
+	errGroupBookingAlreadyCancelled := domain.ErrGroupBookingAlreadyCancelled{GroupID: groupID}
+	req := &api.UpdateGroupBookingRequest{GroupId: groupID, FullName: "Jane Doe"}
+
+	tests := map[string]struct {
+		req     *api.UpdateGroupBookingRequest
+		on      func(f mocks)
+		want    *api.UpdateGroupBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateGroupBooking", context.TODO(), command.UpdateGroupBooking{
+						GroupID:  groupID,
+						FullName: "Jane Doe",
+					}).
+					Return(nil)
+			},
+			want:    &api.UpdateGroupBookingResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_GroupBookingAlreadyCancelled": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("UpdateGroupBooking", context.TODO(), mock.Anything).
+					Return(errGroupBookingAlreadyCancelled)
+			},
+			want: nil,
+			wantErr: status.Error(
+				codes.FailedPrecondition,
+				errGroupBookingAlreadyCancelled.Error(),
+			),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.UpdateGroupBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"UpdateGroupBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"UpdateGroupBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_CancelGroupBooking(t *testing.T) {
+	groupID := uuid.New().String()
+	errGroupBookingAlreadyCancelled := domain.ErrGroupBookingAlreadyCancelled{GroupID: groupID}
+	var cancelled []*domain.Booking
+	for _, refund := range []int64{2628, 1500} {
+		booking, err := bootstrap.NewBooking("campsite-id")
+		assert.NoError(t, err)
+		booking.GroupID = groupID
+		booking.Status = domain.BookingStatusCancelled
+		booking.Currency = "USD"
+		booking.RefundAmount = refund
+		cancelled = append(cancelled, booking)
+	}
+	req := &api.CancelGroupBookingRequest{GroupId: groupID}
+	getGroupBooking := query.GetGroupBooking{GroupID: groupID}
+
+	tests := map[string]struct {
+		req     *api.CancelGroupBookingRequest
+		on      func(f mocks)
+		want    *api.CancelGroupBookingResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On(
+						"CancelGroupBooking",
+						context.TODO(),
+						command.CancelGroupBooking{GroupID: groupID},
+					).
+					Return(nil).
+					On("GetGroupBooking", mock.MatchedBy(domain.ReadYourWrites), getGroupBooking).
+					Return(cancelled, nil)
+			},
+			want: &api.CancelGroupBookingResponse{
+				RefundAmount: 4128,
+				Currency:     "USD",
+			},
+			wantErr: nil,
+		},
+		"Success_ReplicaLagging": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelGroupBooking", context.TODO(), mock.Anything).
+					Return(nil).
+					On("GetGroupBooking", mock.MatchedBy(domain.ReadYourWrites), getGroupBooking).
+					Return(cancelled, nil).
+					On("GetGroupBooking", mock.Anything, getGroupBooking).
+					Return([]*domain.Booking{}, nil).
+					Maybe()
+			},
+			want: &api.CancelGroupBookingResponse{
+				RefundAmount: 4128,
+				Currency:     "USD",
+			},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_GroupBookingAlreadyCancelled": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CancelGroupBooking", context.TODO(), mock.Anything).
+					Return(errGroupBookingAlreadyCancelled)
+			},
+			want: nil,
+			wantErr: status.Error(
+				codes.FailedPrecondition,
+				errGroupBookingAlreadyCancelled.Error(),
+			),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CancelGroupBooking(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"CancelGroupBooking() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"CancelGroupBooking() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_GetVacantDates(t *testing.T) {
+	req := &api.GetVacantDatesRequest{
+		CampsiteId: "campsite-id",
+		StartDate:  "2006-01-02",
+		EndDate:    "2006-01-05",
+	}
+	vacantDate := time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)
+	vacancy := &domain.Vacancy{
+		Dates: []time.Time{vacantDate, vacantDate.AddDate(0, 0, 1)},
+		Ranges: []domain.DateRange{
+			{StartDate: vacantDate, EndDate: vacantDate.AddDate(0, 0, 2)},
+		},
+	}
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: req.CampsiteId}
+
+	tests := map[string]struct {
+		req     *api.GetVacantDatesRequest
+		on      func(f mocks)
+		want    *api.GetVacantDatesResponse
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("GetVacantDates", context.TODO(), mock.Anything).
+					Return(vacancy, nil)
+			},
+			want: &api.GetVacantDatesResponse{
+				VacantDates: []string{"2006-01-02", "2006-01-03"},
+				VacantRanges: []*api.DateRange{
+					{StartDate: "2006-01-02", EndDate: "2006-01-04", Nights: 2},
+				},
+			},
+			wantErr: nil,
+		},
+		"Error_Internal_CommitTx": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("GetVacantDates", context.TODO(), mock.Anything).
+					Return(nil, bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
+		},
+		"Error_NotFound_ErrCampsiteNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("GetVacantDates", context.TODO(), mock.Anything).
+					Return(nil, errCampsiteNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampsiteNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.GetVacantDates(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetVacantDates() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetVacantDates() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_JoinWaitlist(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry("campground-id")
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: entry.CampgroundID}
+	req := &api.JoinWaitlistRequest{
+		CampgroundId: entry.CampgroundID,
+		Email:        entry.Email,
+		FullName:     entry.FullName,
+		StartDate:    entry.StartDate.Format(time.DateOnly),
+		EndDate:      entry.EndDate.Format(time.DateOnly),
+		Guests:       entry.Guests,
+	}
+
+	tests := map[string]struct {
+		req     *api.JoinWaitlistRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("JoinWaitlist", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_CampgroundNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("JoinWaitlist", context.TODO(), mock.Anything).
+					Return(errCampgroundNotFound)
+			},
+			wantErr: status.Error(codes.NotFound, errCampgroundNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.JoinWaitlist(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"JoinWaitlist() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.EntryId)
+		})
+	}
+}
+
+func TestServer_LeaveWaitlist(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry("campground-id")
+	errWaitlistEntryNotFound := domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}
+
+	tests := map[string]struct {
+		req     *api.LeaveWaitlistRequest
+		on      func(f mocks)
+		want    *api.LeaveWaitlistResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.LeaveWaitlistRequest{EntryId: entry.EntryID},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"LeaveWaitlist",
+						context.TODO(),
+						command.LeaveWaitlist{EntryID: entry.EntryID},
+					).
+					Return(nil)
+			},
+			want:    &api.LeaveWaitlistResponse{},
+			wantErr: nil,
+		},
+		"Error_NotFound_WaitlistEntryNotFound": {
+			req: &api.LeaveWaitlistRequest{EntryId: entry.EntryID},
+			on: func(f mocks) {
+				f.app.
+					On(
+						"LeaveWaitlist",
+						context.TODO(),
+						command.LeaveWaitlist{EntryID: entry.EntryID},
+					).
+					Return(errWaitlistEntryNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errWaitlistEntryNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.LeaveWaitlist(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"LeaveWaitlist() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"LeaveWaitlist() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_ListWaitlist(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry("campground-id")
+	offeredEntry := bootstrap.NewWaitlistEntry("campground-id")
+	offeredEntry.Offer("campsite-id", time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	qry := query.ListWaitlist{CampgroundID: entry.CampgroundID}
+
+	tests := map[string]struct {
+		req     *api.ListWaitlistRequest
+		on      func(f mocks)
+		want    *api.ListWaitlistResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.ListWaitlistRequest{CampgroundId: entry.CampgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("ListWaitlist", context.TODO(), qry).
+					Return([]*domain.WaitlistEntry{entry, offeredEntry}, nil)
+			},
+			want: &api.ListWaitlistResponse{
+				Entries: []*api.WaitlistEntry{
+					WaitlistEntryFromDomain(entry), WaitlistEntryFromDomain(offeredEntry),
+				},
+			},
+			wantErr: nil,
+		},
+		"Success_NoEntries": {
+			req: &api.ListWaitlistRequest{CampgroundId: entry.CampgroundID},
+			on: func(f mocks) {
+				f.app.
+					On("ListWaitlist", context.TODO(), qry).
+					Return(nil, nil)
+			},
+			want:    &api.ListWaitlistResponse{},
+			wantErr: nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.ListWaitlist(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"ListWaitlist() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"ListWaitlist() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_AcceptWaitlistOffer(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry("campground-id")
+	errWaitlistOfferNotFound := domain.ErrWaitlistOfferNotFound{EntryID: entry.EntryID}
+	errWaitlistOfferExpired := domain.ErrWaitlistOfferExpired{EntryID: entry.EntryID}
+	req := &api.AcceptWaitlistOfferRequest{EntryId: entry.EntryID, PaymentMethod: "pm_card_visa"}
+
+	tests := map[string]struct {
+		req     *api.AcceptWaitlistOfferRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("AcceptWaitlistOffer", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_WaitlistOfferNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("AcceptWaitlistOffer", context.TODO(), mock.Anything).
+					Return(errWaitlistOfferNotFound)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errWaitlistOfferNotFound.Error()),
+		},
+		"Error_FailedPrecondition_WaitlistOfferExpired": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("AcceptWaitlistOffer", context.TODO(), mock.Anything).
+					Return(errWaitlistOfferExpired)
+			},
+			wantErr: status.Error(codes.FailedPrecondition, errWaitlistOfferExpired.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.AcceptWaitlistOffer(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"AcceptWaitlistOffer() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.BookingId)
+		})
+	}
+}
+
+func TestServer_CreateBlackout(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout("campsite-id")
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: blackout.CampsiteID}
+	errCampsiteBlackoutValidation := domain.ErrCampsiteBlackoutValidation{
+		Reason: "start_date must be before end_date",
+	}
+	req := &api.CreateBlackoutRequest{
+		CampsiteId: blackout.CampsiteID,
+		StartDate:  blackout.StartDate.Format(time.DateOnly),
+		EndDate:    blackout.EndDate.Format(time.DateOnly),
+		Reason:     blackout.Reason,
+	}
+
+	tests := map[string]struct {
+		req     *api.CreateBlackoutRequest
+		on      func(f mocks)
+		wantErr error
+	}{
+		"Success": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBlackout", context.TODO(), mock.Anything).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_CampsiteNotFound": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBlackout", context.TODO(), mock.Anything).
+					Return(errCampsiteNotFound)
+			},
+			wantErr: status.Error(codes.NotFound, errCampsiteNotFound.Error()),
+		},
+		"Error_InvalidArgument_CampsiteBlackoutValidation": {
+			req: req,
+			on: func(f mocks) {
+				f.app.
+					On("CreateBlackout", context.TODO(), mock.Anything).
+					Return(errCampsiteBlackoutValidation)
+			},
+			wantErr: status.Error(codes.InvalidArgument, errCampsiteBlackoutValidation.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.CreateBlackout(context.TODO(), tc.req)
+			// then
+			defer mock.AssertExpectationsForObjects(t, m.app)
+
+			if tc.wantErr != nil {
+				assertStatusError(t, tc.wantErr, err,
+					"CreateBlackout() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assert.NotEmpty(t, got.BlackoutId)
+		})
+	}
+}
+
+func TestServer_DeleteBlackout(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout("campsite-id")
+	errCampsiteBlackoutNotFound := domain.ErrCampsiteBlackoutNotFound{
+		BlackoutID: blackout.BlackoutID,
+	}
+	cmd := command.DeleteBlackout{BlackoutID: blackout.BlackoutID}
+
+	tests := map[string]struct {
+		req     *api.DeleteBlackoutRequest
+		on      func(f mocks)
+		want    *api.DeleteBlackoutResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.DeleteBlackoutRequest{BlackoutId: blackout.BlackoutID},
+			on: func(f mocks) {
+				f.app.
+					On("DeleteBlackout", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.DeleteBlackoutResponse{},
+			wantErr: nil,
+		},
+		"Error_NotFound_CampsiteBlackoutNotFound": {
+			req: &api.DeleteBlackoutRequest{BlackoutId: blackout.BlackoutID},
+			on: func(f mocks) {
+				f.app.
+					On("DeleteBlackout", context.TODO(), cmd).
+					Return(errCampsiteBlackoutNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampsiteBlackoutNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.DeleteBlackout(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"DeleteBlackout() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"DeleteBlackout() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_ListBlackouts(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout("campsite-id")
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: blackout.CampsiteID}
+	qry := query.ListBlackouts{CampsiteID: blackout.CampsiteID}
 
 	tests := map[string]struct {
-		req     *api.GetCampsitesRequest
+		req     *api.ListBlackoutsRequest
 		on      func(f mocks)
-		want    *api.GetCampsitesResponse
+		want    *api.ListBlackoutsResponse
 		wantErr error
 	}{
 		"Success": {
-			req: &api.GetCampsitesRequest{},
+			req: &api.ListBlackoutsRequest{CampsiteId: blackout.CampsiteID},
 			on: func(f mocks) {
 				f.app.
-					On("GetCampsites", mock.Anything, mock.Anything).
-					Return([]*domain.Campsite{campsite}, nil)
+					On("ListBlackouts", context.TODO(), qry).
+					Return([]*domain.CampsiteBlackout{blackout}, nil)
 			},
-			want: &api.GetCampsitesResponse{
-				Campsites: []*api.Campsite{CampsiteFromDomain(campsite)},
+			want: &api.ListBlackoutsResponse{
+				Blackouts: []*api.Blackout{BlackoutFromDomain(blackout)},
 			},
 			wantErr: nil,
 		},
-		"Error_ErrQuery": {
-			req: &api.GetCampsitesRequest{},
+		"Error_NotFound_CampsiteNotFound": {
+			req: &api.ListBlackoutsRequest{CampsiteId: blackout.CampsiteID},
 			on: func(f mocks) {
 				f.app.
-					On("GetCampsites", mock.Anything, mock.Anything).
-					Return(nil, bootstrap.ErrQuery)
+					On("ListBlackouts", context.TODO(), qry).
+					Return(nil, errCampsiteNotFound)
 			},
 			want:    nil,
-			wantErr: bootstrap.ErrQuery,
+			wantErr: status.Error(codes.NotFound, errCampsiteNotFound.Error()),
 		},
 	}
 
@@ -67,52 +2248,89 @@ func TestServer_GetCampsites(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.GetCampsites(context.TODO(), tc.req)
+			got, err := s.ListBlackouts(context.TODO(), tc.req)
 			// then
 			assert.Equal(t, tc.want, got,
-				"GetCampsites() got = %v, want %v", got, tc.want)
-			assert.Equal(t, tc.wantErr, err,
-				"GetCampsites() error = %v, wantErr %v", err, tc.wantErr)
+				"ListBlackouts() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"ListBlackouts() error = %v, wantErr %v", err, tc.wantErr)
 			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
 
-func TestServer_CreateCampsite(t *testing.T) {
-	campsite, err := bootstrap.NewCampsite()
-	assert.NoError(t, err)
-
-	req := &api.CreateCampsiteRequest{
-		CampsiteCode:  campsite.CampsiteCode,
-		Capacity:      campsite.Capacity,
-		DrinkingWater: campsite.DrinkingWater,
-		Restrooms:     campsite.Restrooms,
-		PicnicTable:   campsite.PicnicTable,
-		FirePit:       campsite.FirePit,
+func TestServer_CreateCalendarSubscription(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription("campsite-id")
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: subscription.CampsiteID}
+	errCalendarSubscriptionValidation := domain.ErrCalendarSubscriptionValidation{
+		Reason: "unsupported url scheme ftp",
 	}
+	calendar := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
 
 	tests := map[string]struct {
-		req     *api.CreateCampsiteRequest
+		req     *api.CreateCalendarSubscriptionRequest
 		on      func(f mocks)
 		wantErr error
 	}{
-		"Success": {
-			req: req,
+		"Success_URL": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: subscription.CampsiteID,
+				Name:       subscription.Name,
+				Source:     &api.CreateCalendarSubscriptionRequest_Url{Url: subscription.URL},
+			},
 			on: func(f mocks) {
 				f.app.
-					On("CreateCampsite", context.TODO(), mock.Anything).
+					On("CreateCalendarSubscription", context.TODO(),
+						mock.MatchedBy(func(cmd command.CreateCalendarSubscription) bool {
+							return cmd.URL == subscription.URL && cmd.Calendar == nil
+						})).
 					Return(nil)
 			},
 			wantErr: nil,
 		},
-		"Error_CommitTx": {
-			req: req,
+		"Success_Calendar": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: subscription.CampsiteID,
+				Name:       subscription.Name,
+				Source:     &api.CreateCalendarSubscriptionRequest_Calendar{Calendar: calendar},
+			},
 			on: func(f mocks) {
 				f.app.
-					On("CreateCampsite", context.TODO(), mock.Anything).
-					Return(bootstrap.ErrCommitTx)
+					On("CreateCalendarSubscription", context.TODO(),
+						mock.MatchedBy(func(cmd command.CreateCalendarSubscription) bool {
+							return cmd.URL == "" && string(cmd.Calendar) == string(calendar)
+						})).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		"Error_NotFound_CampsiteNotFound": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: subscription.CampsiteID,
+				Name:       subscription.Name,
+				Source:     &api.CreateCalendarSubscriptionRequest_Url{Url: subscription.URL},
+			},
+			on: func(f mocks) {
+				f.app.
+					On("CreateCalendarSubscription", context.TODO(), mock.Anything).
+					Return(errCampsiteNotFound)
+			},
+			wantErr: status.Error(codes.NotFound, errCampsiteNotFound.Error()),
+		},
+		"Error_InvalidArgument_CalendarSubscriptionValidation": {
+			req: &api.CreateCalendarSubscriptionRequest{
+				CampsiteId: subscription.CampsiteID,
+				Name:       subscription.Name,
+				Source:     &api.CreateCalendarSubscriptionRequest_Url{Url: "ftp://example.com"},
 			},
-			wantErr: bootstrap.ErrCommitTx,
+			on: func(f mocks) {
+				f.app.
+					On("CreateCalendarSubscription", context.TODO(), mock.Anything).
+					Return(errCalendarSubscriptionValidation)
+			},
+			wantErr: status.Error(
+				codes.InvalidArgument, errCalendarSubscriptionValidation.Error(),
+			),
 		},
 	}
 
@@ -125,55 +2343,50 @@ func TestServer_CreateCampsite(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.CreateCampsite(context.TODO(), req)
+			got, err := s.CreateCalendarSubscription(context.TODO(), tc.req)
 			// then
 			defer mock.AssertExpectationsForObjects(t, m.app)
 
 			if tc.wantErr != nil {
-				assert.Equal(t, tc.wantErr, err,
-					"CreateCampsite() error = %v, wantErr %v", err, tc.wantErr)
+				assertStatusError(t, tc.wantErr, err,
+					"CreateCalendarSubscription() error = %v, wantErr %v", err, tc.wantErr)
 				return
 			}
-			assert.NotEmpty(t, got.CampsiteId)
+			assert.NotEmpty(t, got.SubscriptionId)
 		})
 	}
 }
 
-func TestServer_GetBooking(t *testing.T) {
-	booking, err := bootstrap.NewBooking("campsite-id")
-	assert.NoError(t, err)
-	nonExistingID := "non-existing-id"
-	errBookingNotFound := domain.ErrBookingNotFound{BookingID: nonExistingID}
+func TestServer_DeleteCalendarSubscription(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription("campsite-id")
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscription.SubscriptionID,
+	}
+	cmd := command.DeleteCalendarSubscription{SubscriptionID: subscription.SubscriptionID}
+	req := &api.DeleteCalendarSubscriptionRequest{SubscriptionId: subscription.SubscriptionID}
 
 	tests := map[string]struct {
-		req     *api.GetBookingRequest
 		on      func(f mocks)
-		want    *api.GetBookingResponse
+		want    *api.DeleteCalendarSubscriptionResponse
 		wantErr error
 	}{
 		"Success": {
-			req: &api.GetBookingRequest{BookingId: booking.BookingID},
 			on: func(f mocks) {
 				f.app.
-					On(
-						"GetBooking",
-						context.TODO(),
-						query.GetBooking{BookingID: booking.BookingID},
-					).
-					Return(booking, nil)
+					On("DeleteCalendarSubscription", context.TODO(), cmd).
+					Return(nil)
 			},
-			want:    &api.GetBookingResponse{Booking: BookingFromDomain(booking)},
+			want:    &api.DeleteCalendarSubscriptionResponse{},
 			wantErr: nil,
 		},
-		"Error_NotFound_ErrBookingNotFound": {
-			req: &api.GetBookingRequest{BookingId: nonExistingID},
+		"Error_NotFound_CalendarSubscriptionNotFound": {
 			on: func(f mocks) {
 				f.app.
-					On("GetBooking", context.TODO(), query.GetBooking{BookingID: nonExistingID}).
-					Return(nil, errBookingNotFound)
+					On("DeleteCalendarSubscription", context.TODO(), cmd).
+					Return(errCalendarSubscriptionNotFound)
 			},
 			want:    nil,
-			wantErr: status.Error(codes.NotFound, errBookingNotFound.Error()),
+			wantErr: status.Error(codes.NotFound, errCalendarSubscriptionNotFound.Error()),
 		},
 	}
 
@@ -186,54 +2399,54 @@ func TestServer_GetBooking(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.GetBooking(context.TODO(), tc.req)
+			got, err := s.DeleteCalendarSubscription(context.TODO(), req)
 			// then
 			assert.Equal(t, tc.want, got,
-				"GetBooking() got = %v, want %v", got, tc.want)
-			assert.Equal(t, tc.wantErr, err,
-				"GetBooking() error = %v, wantErr %v", err, tc.wantErr)
+				"DeleteCalendarSubscription() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"DeleteCalendarSubscription() error = %v, wantErr %v", err, tc.wantErr)
 			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
 
-func TestServer_CreateBooking(t *testing.T) {
-	booking, err := bootstrap.NewBooking("campsite-id")
-	assert.NoError(t, err)
-	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
-		StartDate: booking.StartDate,
-		EndDate:   booking.EndDate,
-	}
-	req := &api.CreateBookingRequest{
-		CampsiteId: booking.CampsiteID,
-		Email:      booking.Email,
-		FullName:   booking.FullName,
-		StartDate:  booking.StartDate.Format(time.DateOnly),
-		EndDate:    booking.EndDate.Format(time.DateOnly),
-	}
+func TestServer_ListCalendarSubscriptions(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription("campsite-id")
+	subscription.LastSyncedAt = time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: subscription.CampsiteID}
+	qry := query.ListCalendarSubscriptions{CampsiteID: subscription.CampsiteID}
+	req := &api.ListCalendarSubscriptionsRequest{CampsiteId: subscription.CampsiteID}
 
 	tests := map[string]struct {
-		req     *api.CreateBookingRequest
 		on      func(f mocks)
+		want    *api.ListCalendarSubscriptionsResponse
 		wantErr error
 	}{
 		"Success": {
-			req: req,
 			on: func(f mocks) {
 				f.app.
-					On("CreateBooking", context.TODO(), mock.Anything).
-					Return(nil)
+					On("ListCalendarSubscriptions", context.TODO(), qry).
+					Return([]*domain.CalendarSubscription{subscription}, nil)
+			},
+			want: &api.ListCalendarSubscriptionsResponse{
+				Subscriptions: []*api.CalendarSubscription{{
+					SubscriptionId: subscription.SubscriptionID,
+					CampsiteId:     subscription.CampsiteID,
+					Name:           subscription.Name,
+					Url:            subscription.URL,
+					LastSyncedAt:   "2026-10-19T08:30:00Z",
+				}},
 			},
 			wantErr: nil,
 		},
-		"Error_FailedPrecondition_BookingDatesNotAvailable": {
-			req: req,
+		"Error_NotFound_CampsiteNotFound": {
 			on: func(f mocks) {
 				f.app.
-					On("CreateBooking", context.TODO(), mock.Anything).
-					Return(errBookingDatesNotAvailable)
+					On("ListCalendarSubscriptions", context.TODO(), qry).
+					Return(nil, errCampsiteNotFound)
 			},
-			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesNotAvailable.Error()),
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errCampsiteNotFound.Error()),
 		},
 	}
 
@@ -246,67 +2459,119 @@ func TestServer_CreateBooking(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.CreateBooking(context.TODO(), tc.req)
+			got, err := s.ListCalendarSubscriptions(context.TODO(), req)
 			// then
-			defer mock.AssertExpectationsForObjects(t, m.app)
+			assert.Equal(t, tc.want, got,
+				"ListCalendarSubscriptions() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"ListCalendarSubscriptions() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
 
-			if tc.wantErr != nil {
-				assert.Equal(t, tc.wantErr, err,
-					"CreateBooking() error = %v, wantErr %v", err, tc.wantErr)
-				return
+func TestServer_GetGuest(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+	qry := query.GetGuest{GuestID: guest.GuestID}
+
+	tests := map[string]struct {
+		req     *api.GetGuestRequest
+		on      func(f mocks)
+		want    *api.GetGuestResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.GetGuestRequest{GuestId: guest.GuestID},
+			on: func(f mocks) {
+				f.app.
+					On("GetGuest", context.TODO(), qry).
+					Return(guest, nil)
+			},
+			want:    &api.GetGuestResponse{Guest: GuestFromDomain(guest)},
+			wantErr: nil,
+		},
+		"Error_NotFound_GuestNotFound": {
+			req: &api.GetGuestRequest{GuestId: guest.GuestID},
+			on: func(f mocks) {
+				f.app.
+					On("GetGuest", context.TODO(), qry).
+					Return(nil, errGuestNotFound)
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, errGuestNotFound.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
 			}
-			assert.NotEmpty(t, got.BookingId)
+			// when
+			got, err := s.GetGuest(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.want, got,
+				"GetGuest() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"GetGuest() error = %v, wantErr %v", err, tc.wantErr)
+			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
 
-func TestServer_UpdateBooking(t *testing.T) {
-	booking, err := bootstrap.NewBooking("campsite-id")
-	assert.NoError(t, err)
-	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
-		StartDate: booking.StartDate,
-		EndDate:   booking.EndDate,
-	}
-	errBookingValidation := domain.ErrBookingValidation{
-		MultiErr: multierror.Append(validator.ErrBookingStartDateBeforeEndDate{}),
+func TestServer_UpdateGuest(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	req := &api.UpdateGuestRequest{Guest: GuestFromDomain(guest)}
+	cmd := command.UpdateGuest{
+		GuestID:     guest.GuestID,
+		Email:       guest.Email,
+		FullName:    guest.FullName,
+		Phone:       guest.Phone,
+		Address:     guest.Address,
+		Preferences: guest.Preferences,
 	}
-	req := &api.UpdateBookingRequest{Booking: BookingFromDomain(booking)}
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+	errGuestEmailInUse := domain.ErrGuestEmailInUse{}
 
 	tests := map[string]struct {
-		req     *api.UpdateBookingRequest
+		req     *api.UpdateGuestRequest
 		on      func(f mocks)
-		want    *api.UpdateBookingResponse
+		want    *api.UpdateGuestResponse
 		wantErr error
 	}{
 		"Success": {
 			req: req,
 			on: func(f mocks) {
 				f.app.
-					On("UpdateBooking", context.TODO(), mock.Anything).
+					On("UpdateGuest", context.TODO(), cmd).
 					Return(nil)
 			},
-			want:    &api.UpdateBookingResponse{},
+			want:    &api.UpdateGuestResponse{},
 			wantErr: nil,
 		},
-		"Error_FailedPrecondition_BookingDatesNotAvailable": {
+		"Error_NotFound_GuestNotFound": {
 			req: req,
 			on: func(f mocks) {
 				f.app.
-					On("UpdateBooking", context.TODO(), mock.Anything).
-					Return(errBookingDatesNotAvailable)
+					On("UpdateGuest", context.TODO(), cmd).
+					Return(errGuestNotFound)
 			},
 			want:    nil,
-			wantErr: status.Error(codes.FailedPrecondition, errBookingDatesNotAvailable.Error()),
+			wantErr: status.Error(codes.NotFound, errGuestNotFound.Error()),
 		},
-		"Error_InvalidArgument_BookingValidation": {
+		"Error_FailedPrecondition_GuestEmailInUse": {
 			req: req,
 			on: func(f mocks) {
 				f.app.
-					On("UpdateBooking", context.TODO(), mock.Anything).
-					Return(errBookingValidation)
+					On("UpdateGuest", context.TODO(), cmd).
+					Return(errGuestEmailInUse)
 			},
 			want:    nil,
-			wantErr: status.Error(codes.InvalidArgument, errBookingValidation.Error()),
+			wantErr: status.Error(codes.FailedPrecondition, errGuestEmailInUse.Error()),
 		},
 	}
 
@@ -319,50 +2584,57 @@ func TestServer_UpdateBooking(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.UpdateBooking(context.TODO(), tc.req)
+			got, err := s.UpdateGuest(context.TODO(), tc.req)
 			// then
 			assert.Equal(t, tc.want, got,
-				"UpdateBooking() got = %v, want %v", got, tc.want)
-			assert.Equal(t, tc.wantErr, err,
-				"UpdateBooking() error = %v, wantErr %v", err, tc.wantErr)
+				"UpdateGuest() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"UpdateGuest() error = %v, wantErr %v", err, tc.wantErr)
 			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
 
-func TestServer_CancelBooking(t *testing.T) {
+func TestServer_ListGuestBookings(t *testing.T) {
+	guest := bootstrap.NewGuest()
 	booking, err := bootstrap.NewBooking("campsite-id")
-	assert.NoError(t, err)
-	errBookingAlreadyCancelled := domain.ErrBookingAlreadyCancelled{BookingID: booking.BookingID}
-	req := &api.CancelBookingRequest{BookingId: booking.BookingID}
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GuestID = guest.GuestID
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+	qry := query.ListGuestBookings{GuestID: guest.GuestID}
 
 	tests := map[string]struct {
-		req     *api.CancelBookingRequest
+		req     *api.ListGuestBookingsRequest
 		on      func(f mocks)
-		want    *api.CancelBookingResponse
+		want    *api.ListGuestBookingsResponse
 		wantErr error
 	}{
 		"Success": {
-			req: req,
+			req: &api.ListGuestBookingsRequest{GuestId: guest.GuestID},
 			on: func(f mocks) {
 				f.app.
-					On("CancelBooking", context.TODO(), mock.Anything).
-					Return(nil)
+					On("ListGuestBookings", context.TODO(), qry).
+					Return([]*domain.Booking{booking}, nil)
+			},
+			want: &api.ListGuestBookingsResponse{
+				Bookings: []*api.Booking{BookingFromDomain(booking)},
 			},
-			want:    &api.CancelBookingResponse{},
 			wantErr: nil,
 		},
-		"Error_FailedPrecondition_BookingAlreadyCancelled": {
-			req: req,
+		"Error_NotFound_GuestNotFound": {
+			req: &api.ListGuestBookingsRequest{GuestId: guest.GuestID},
 			on: func(f mocks) {
 				f.app.
-					On("CancelBooking", context.TODO(), mock.Anything).
-					Return(errBookingAlreadyCancelled)
+					On("ListGuestBookings", context.TODO(), qry).
+					Return(nil, errGuestNotFound)
 			},
 			want:    nil,
-			wantErr: status.Error(codes.FailedPrecondition, errBookingAlreadyCancelled.Error()),
+			wantErr: status.Error(codes.NotFound, errGuestNotFound.Error()),
 		},
 	}
+
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// given
@@ -372,49 +2644,62 @@ func TestServer_CancelBooking(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.CancelBooking(context.TODO(), tc.req)
+			got, err := s.ListGuestBookings(context.TODO(), tc.req)
 			// then
 			assert.Equal(t, tc.want, got,
-				"CancelBooking() got = %v, want %v", got, tc.want)
-			assert.Equal(t, tc.wantErr, err,
-				"CancelBooking() error = %v, wantErr %v", err, tc.wantErr)
+				"ListGuestBookings() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"ListGuestBookings() error = %v, wantErr %v", err, tc.wantErr)
 			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
 
-func TestServer_GetVacantDates(t *testing.T) {
-	req := &api.GetVacantDatesRequest{
-		CampsiteId: "campsite-id",
-		StartDate:  "2006-01-02",
-		EndDate:    "2006-01-03",
+func TestServer_ExportGuestData(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	booking, err := bootstrap.NewBooking("campsite-id")
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GuestID = guest.GuestID
+	data := &domain.GuestData{
+		Email:      guest.Email,
+		Guest:      guest,
+		Bookings:   []*domain.Booking{booking},
+		ExportedAt: time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
 	}
+	qry := query.ExportGuestData{Email: guest.Email}
 
 	tests := map[string]struct {
-		req     *api.GetVacantDatesRequest
+		req     *api.ExportGuestDataRequest
 		on      func(f mocks)
-		want    *api.GetVacantDatesResponse
+		want    *api.GuestData
 		wantErr error
 	}{
 		"Success": {
-			req: req,
+			req: &api.ExportGuestDataRequest{Email: guest.Email},
 			on: func(f mocks) {
 				f.app.
-					On("GetVacantDates", context.TODO(), mock.Anything).
-					Return([]string{"2006-01-02"}, nil)
+					On("ExportGuestData", context.TODO(), qry).
+					Return(data, nil)
+			},
+			want: &api.GuestData{
+				Email:      guest.Email,
+				Guest:      GuestFromDomain(guest),
+				Bookings:   []*api.Booking{BookingFromDomain(booking)},
+				ExportedAt: "2024-05-01T10:30:00Z",
 			},
-			want:    &api.GetVacantDatesResponse{VacantDates: []string{"2006-01-02"}},
 			wantErr: nil,
 		},
-		"Error_CommitTx": {
-			req: req,
+		"Error_Internal": {
+			req: &api.ExportGuestDataRequest{Email: guest.Email},
 			on: func(f mocks) {
 				f.app.
-					On("GetVacantDates", context.TODO(), mock.Anything).
-					Return(nil, bootstrap.ErrCommitTx)
+					On("ExportGuestData", context.TODO(), qry).
+					Return(nil, bootstrap.ErrQuery)
 			},
 			want:    nil,
-			wantErr: bootstrap.ErrCommitTx,
+			wantErr: status.Error(codes.Internal, internalErrorMessage),
 		},
 	}
 
@@ -427,13 +2712,119 @@ func TestServer_GetVacantDates(t *testing.T) {
 				tc.on(m)
 			}
 			// when
-			got, err := s.GetVacantDates(context.TODO(), tc.req)
+			got, err := s.ExportGuestData(context.TODO(), tc.req)
+			// then
+			assertStatusError(t, tc.wantErr, err,
+				"ExportGuestData() error = %v, wantErr %v", err, tc.wantErr)
+			if tc.want == nil {
+				assert.Nil(t, got)
+			} else if assert.NotNil(t, got) {
+				gotData := &api.GuestData{}
+				assert.NoError(t, protojson.Unmarshal([]byte(got.GuestData), gotData))
+				assert.True(t, proto.Equal(tc.want, gotData),
+					"ExportGuestData() got = %v, want %v", gotData, tc.want)
+				assert.Contains(t, got.GuestData, `"exported_at"`)
+			}
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_AnonymizeGuest(t *testing.T) {
+	email := bootstrap.NewGuest().Email
+	cmd := command.AnonymizeGuest{Email: email}
+
+	tests := map[string]struct {
+		req     *api.AnonymizeGuestRequest
+		on      func(f mocks)
+		want    *api.AnonymizeGuestResponse
+		wantErr error
+	}{
+		"Success": {
+			req: &api.AnonymizeGuestRequest{Email: email},
+			on: func(f mocks) {
+				f.app.
+					On("AnonymizeGuest", context.TODO(), cmd).
+					Return(nil)
+			},
+			want:    &api.AnonymizeGuestResponse{},
+			wantErr: nil,
+		},
+		"Error_FailedPrecondition_GuestHasActiveBookings": {
+			req: &api.AnonymizeGuestRequest{Email: email},
+			on: func(f mocks) {
+				f.app.
+					On("AnonymizeGuest", context.TODO(), cmd).
+					Return(domain.ErrGuestHasActiveBookings{})
+			},
+			want: nil,
+			wantErr: status.Error(codes.FailedPrecondition,
+				domain.ErrGuestHasActiveBookings{}.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			s := server{app: m.app}
+			if tc.on != nil {
+				tc.on(m)
+			}
+			// when
+			got, err := s.AnonymizeGuest(context.TODO(), tc.req)
 			// then
 			assert.Equal(t, tc.want, got,
-				"GetVacantDates() got = %v, want %v", got, tc.want)
-			assert.Equal(t, tc.wantErr, err,
-				"GetVacantDates() error = %v, wantErr %v", err, tc.wantErr)
+				"AnonymizeGuest() got = %v, want %v", got, tc.want)
+			assertStatusError(t, tc.wantErr, err,
+				"AnonymizeGuest() error = %v, wantErr %v", err, tc.wantErr)
 			mock.AssertExpectationsForObjects(t, m.app)
 		})
 	}
 }
+
+func TestBookingStatusFromDomain(t *testing.T) {
+	tests := map[string]struct {
+		status domain.BookingStatus
+		want   api.BookingStatus
+	}{
+		"Pending": {
+			status: domain.BookingStatusPending,
+			want:   api.BookingStatus_BOOKING_STATUS_PENDING,
+		},
+		"Confirmed": {
+			status: domain.BookingStatusConfirmed,
+			want:   api.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		},
+		"CheckedIn": {
+			status: domain.BookingStatusCheckedIn,
+			want:   api.BookingStatus_BOOKING_STATUS_CHECKED_IN,
+		},
+		"CheckedOut": {
+			status: domain.BookingStatusCheckedOut,
+			want:   api.BookingStatus_BOOKING_STATUS_CHECKED_OUT,
+		},
+		"Cancelled": {
+			status: domain.BookingStatusCancelled,
+			want:   api.BookingStatus_BOOKING_STATUS_CANCELLED,
+		},
+		"NoShow": {
+			status: domain.BookingStatusNoShow,
+			want:   api.BookingStatus_BOOKING_STATUS_NO_SHOW,
+		},
+		"Unspecified": {
+			status: "",
+			want:   api.BookingStatus_BOOKING_STATUS_UNSPECIFIED,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got := BookingStatusFromDomain(tc.status)
+			// then
+			assert.Equal(t, tc.want, got,
+				"BookingStatusFromDomain() got = %v, want %v", got, tc.want)
+		})
+	}
+}
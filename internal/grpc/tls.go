@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/stackus/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+type (
+	// TLSConfig locates the PEM files of the server certificate and, for
+	// mutual TLS, of the CA certificates that client certificates are verified
+	// against.
+	TLSConfig struct {
+		CertFile     string
+		KeyFile      string
+		ClientCAFile string
+		// MinVersion is either 1.2 or 1.3.
+		MinVersion string
+	}
+
+	// certReloader reloads the certificates whenever one of their files is
+	// modified, so that renewed certificates are served without a restart.
+	certReloader struct {
+		cfg        TLSConfig
+		minVersion uint16
+		mu         sync.Mutex
+		modTimes   map[string]time.Time
+		tlsConfig  *tls.Config
+	}
+)
+
+// NewTLSCredentials returns the transport credentials of the server,
+// requiring and verifying client certificates if a client CA is set.
+func NewTLSCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	minVersion, ok := tlsVersions[cfg.MinVersion]
+	if !ok {
+		return nil, fmt.Errorf("unsupported minimum TLS version %q", cfg.MinVersion)
+	}
+	r := &certReloader{cfg: cfg, minVersion: minVersion}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:         minVersion,
+		GetConfigForClient: r.getConfigForClient,
+	}), nil
+}
+
+func (r *certReloader) getConfigForClient(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	cfg, err := r.load()
+	if err != nil {
+		// keep serving the certificates loaded last, until the files are fixed
+		slog.ErrorContext(hello.Context(), "failed to reload TLS certificates",
+			slog.Any("error", err))
+	}
+	return cfg, nil
+}
+
+// load returns the TLS config, built anew if any file was modified since the
+// last load.
+func (r *certReloader) load() (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return r.tlsConfig, err
+	}
+	if r.tlsConfig != nil && maps.Equal(modTimes, r.modTimes) {
+		return r.tlsConfig, nil
+	}
+	cfg, err := r.build()
+	if err != nil {
+		return r.tlsConfig, err
+	}
+	if r.tlsConfig != nil {
+		slog.Info("reloaded TLS certificates")
+	}
+	r.tlsConfig, r.modTimes = cfg, modTimes
+	return cfg, nil
+}
+
+func (r *certReloader) statFiles() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, 3)
+	for _, name := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.ClientCAFile} {
+		if name == "" {
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, errors.Wrap(err, "stat TLS file")
+		}
+		modTimes[name] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *certReloader) build() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "load server certificate")
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   r.minVersion,
+		NextProtos:   []string{"h2"},
+	}
+	if r.cfg.ClientCAFile == "" {
+		return cfg, nil
+	}
+	pem, err := os.ReadFile(r.cfg.ClientCAFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "read client CA certificates")
+	}
+	cfg.ClientCAs = x509.NewCertPool()
+	if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificates found in %s", r.cfg.ClientCAFile)
+	}
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg, nil
+}
@@ -0,0 +1,345 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	tls  tls.Certificate
+}
+
+// newTestCert returns a certificate signed by the parent, or self-signed if
+// the parent is nil.
+func newTestCert(t *testing.T, parent *testCert, tmpl *x509.Certificate) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{
+		cert: cert,
+		key:  key,
+		tls:  tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert},
+	}
+}
+
+func newTestCA(t *testing.T) *testCert {
+	return newTestCert(t, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+}
+
+func newTestServerCert(t *testing.T, ca *testCert) *testCert {
+	return newTestCert(t, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "campgrounds"},
+		DNSNames:    []string{"localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+}
+
+func newTestClientCert(t *testing.T, ca *testCert) *testCert {
+	return newTestCert(t, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "campctl", Organization: []string{"ops"}},
+		URIs:        []*url.URL{{Scheme: "spiffe", Host: "campgrounds", Path: "/campctl"}},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+}
+
+// writePEM writes the certificate, and its key if keyFile is set, modified at
+// the given time.
+func writePEM(t *testing.T, c *testCert, certFile, keyFile string, modTime time.Time) {
+	t.Helper()
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+	if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	files := []string{certFile}
+	if keyFile != "" {
+		der, err := x509.MarshalECPrivateKey(c.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		if err = os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, keyFile)
+	}
+	for _, name := range files {
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// handshake runs a TLS handshake of the client config against the server
+// credentials over loopback, returning the auth info of the server side.
+func handshake(
+	t *testing.T,
+	creds credentials.TransportCredentials,
+	clientCfg *tls.Config,
+) (credentials.AuthInfo, error) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	clientErr := make(chan error, 1)
+	go func() {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err != nil {
+			clientErr <- err
+			return
+		}
+		defer conn.Close()
+		clientCfg.NextProtos = []string{"h2"}
+		tlsConn := tls.Client(conn, clientCfg)
+		if err = tlsConn.Handshake(); err == nil {
+			// a client certificate rejected in TLS 1.3 is reported on read
+			_ = tlsConn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+			if _, err = tlsConn.Read(make([]byte, 1)); errors.Is(err, os.ErrDeadlineExceeded) {
+				err = nil
+			}
+		}
+		clientErr <- err
+	}()
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	// fail instead of hanging if either side stalls, e.g. on a slow race build
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	_, authInfo, serverErr := creds.ServerHandshake(conn)
+	if err = <-clientErr; serverErr != nil {
+		return nil, serverErr
+	}
+	return authInfo, err
+}
+
+func TestTLS_NewTLSCredentials(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	caFile := filepath.Join(dir, "ca.crt")
+	writePEM(t, newTestServerCert(t, ca), certFile, keyFile, time.Now())
+	writePEM(t, ca, caFile, "", time.Now())
+
+	tests := map[string]struct {
+		cfg     TLSConfig
+		wantErr string
+	}{
+		"TLS": {
+			cfg: TLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.3"},
+		},
+		"MutualTLS": {
+			cfg: TLSConfig{
+				CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile, MinVersion: "1.2",
+			},
+		},
+		"Error_UnsupportedMinVersion": {
+			cfg:     TLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.1"},
+			wantErr: `unsupported minimum TLS version "1.1"`,
+		},
+		"Error_KeyFileNotFound": {
+			cfg: TLSConfig{
+				CertFile: certFile, KeyFile: filepath.Join(dir, "missing.key"), MinVersion: "1.2",
+			},
+			wantErr: "stat TLS file",
+		},
+		"Error_NoCACertificates": {
+			cfg: TLSConfig{
+				CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile, MinVersion: "1.2",
+			},
+			wantErr: "no CA certificates found",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			creds, err := NewTLSCredentials(tc.cfg)
+			// then
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "tls", creds.Info().SecurityProtocol)
+		})
+	}
+}
+
+func TestTLS_NewTLSCredentials_MutualTLS(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	caFile := filepath.Join(dir, "ca.crt")
+	writePEM(t, newTestServerCert(t, ca), certFile, keyFile, time.Now())
+	writePEM(t, ca, caFile, "", time.Now())
+	creds, err := NewTLSCredentials(TLSConfig{
+		CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile, MinVersion: "1.2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	client := newTestClientCert(t, ca)
+
+	tests := map[string]struct {
+		clientCerts []tls.Certificate
+		wantErr     bool
+		want        ClientIdentity
+	}{
+		"ClientCertificate": {
+			clientCerts: []tls.Certificate{client.tls},
+			want: ClientIdentity{
+				CommonName:   "campctl",
+				Organization: []string{"ops"},
+				URIs:         []string{"spiffe://campgrounds/campctl"},
+				SerialNumber: client.cert.SerialNumber.String(),
+			},
+		},
+		"Error_NoClientCertificate": {
+			wantErr: true,
+		},
+		"Error_UntrustedClientCertificate": {
+			clientCerts: []tls.Certificate{newTestClientCert(t, newTestCA(t)).tls},
+			wantErr:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			authInfo, err := handshake(t, creds, &tls.Config{
+				RootCAs:      roots,
+				ServerName:   "localhost",
+				Certificates: tc.clientCerts,
+				MinVersion:   tls.VersionTLS12,
+			})
+			// then
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: authInfo})
+				got, ok := ClientIdentityFromContext(withClientIdentity(ctx))
+				assert.True(t, ok)
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTLS_NewTLSCredentials_ReloadOnChange(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	first := newTestServerCert(t, ca)
+	writePEM(t, first, certFile, keyFile, time.Now().Add(-time.Minute))
+	creds, err := NewTLSCredentials(TLSConfig{
+		CertFile: certFile, KeyFile: keyFile, MinVersion: "1.2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	servedCert := func() *x509.Certificate {
+		var served *x509.Certificate
+		_, err := handshake(t, creds, &tls.Config{
+			RootCAs:    roots,
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS12,
+			VerifyConnection: func(cs tls.ConnectionState) error {
+				served = cs.PeerCertificates[0]
+				return nil
+			},
+		})
+		assert.NoError(t, err)
+		return served
+	}
+	assert.Equal(t, first.cert.SerialNumber, servedCert().SerialNumber)
+	// when
+	second := newTestServerCert(t, ca)
+	writePEM(t, second, certFile, keyFile, time.Now())
+	// then
+	assert.Equal(t, second.cert.SerialNumber, servedCert().SerialNumber)
+	// when the files are broken, the certificate loaded last is still served
+	if err = os.WriteFile(keyFile, []byte("broken"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// then
+	assert.Equal(t, second.cert.SerialNumber, servedCert().SerialNumber)
+}
+
+func TestIdentity_withClientIdentity_NoPeerCertificate(t *testing.T) {
+	tests := map[string]struct {
+		ctx context.Context
+	}{
+		"NoPeer": {
+			ctx: context.Background(),
+		},
+		"Plaintext": {
+			ctx: peer.NewContext(context.Background(), &peer.Peer{}),
+		},
+		"TLSWithoutClientCertificate": {
+			ctx: peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}}),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, ok := ClientIdentityFromContext(withClientIdentity(tc.ctx))
+			// then
+			assert.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	prodID = "-//igor-baiborodine//campsite-booking-go//EN"
+	// maxLineOctets is the length content lines are folded at, per RFC 5545.
+	maxLineOctets = 75
+
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+)
+
+type EventStatus string
+
+const (
+	EventStatusTentative EventStatus = "TENTATIVE"
+	EventStatusConfirmed EventStatus = "CONFIRMED"
+	EventStatusCancelled EventStatus = "CANCELLED"
+)
+
+type (
+	// Calendar is an iCalendar object published as a feed, see RFC 5545.
+	Calendar struct {
+		Name string
+		// Time the calendar was generated, used as the stamp of its events.
+		Stamp  time.Time
+		Events []Event
+	}
+
+	// Event is an all-day event spanning StartDate up to, but excluding, EndDate.
+	Event struct {
+		// Globally unique ID, stable across all revisions of the event.
+		UID string
+		// Revision of the event, incremented on each change to it.
+		Sequence    int64
+		StartDate   time.Time
+		EndDate     time.Time
+		Summary     string
+		Description string
+		Status      EventStatus
+		// Transparent events do not block time, e.g. availability notes.
+		Transparent bool
+	}
+)
+
+// Encode writes the calendar as an iCalendar document.
+func (c Calendar) Encode(w io.Writer) error {
+	e := encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	for _, event := range c.Events {
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", escapeText(event.UID))
+		e.line("SEQUENCE", strconv.FormatInt(event.Sequence, 10))
+		e.line("DTSTAMP", c.Stamp.UTC().Format(dateTimeFormat))
+		e.line("DTSTART;VALUE=DATE", event.StartDate.Format(dateFormat))
+		e.line("DTEND;VALUE=DATE", event.EndDate.Format(dateFormat))
+		e.line("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			e.line("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.Status != "" {
+			e.line("STATUS", string(event.Status))
+		}
+		if event.Transparent {
+			e.line("TRANSP", "TRANSPARENT")
+		} else {
+			e.line("TRANSP", "OPAQUE")
+		}
+		e.line("END", "VEVENT")
+	}
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// encoder writes content lines, keeping the first error to check it once.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line terminated by CRLF, folding it so that no line
+// exceeds 75 octets without splitting a UTF-8 sequence.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	line := name + ":" + value
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, e.err = e.w.WriteString(b.String())
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escapeText escapes a value of the TEXT type.
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,118 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	stamp := time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	startDate := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		calendar Calendar
+		want     []string
+	}{
+		"Success": {
+			calendar: Calendar{
+				Name:  "Campsite A01",
+				Stamp: stamp,
+				Events: []Event{{
+					UID:         "booking-1@example.com",
+					Sequence:    2,
+					StartDate:   startDate,
+					EndDate:     endDate,
+					Summary:     "Reserved: 1",
+					Description: "Guests: 2\nStatus: CONFIRMED",
+					Status:      EventStatusConfirmed,
+				}},
+			},
+			want: []string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:" + prodID,
+				"CALSCALE:GREGORIAN",
+				"METHOD:PUBLISH",
+				"X-WR-CALNAME:Campsite A01",
+				"BEGIN:VEVENT",
+				"UID:booking-1@example.com",
+				"SEQUENCE:2",
+				"DTSTAMP:20261019T083000Z",
+				"DTSTART;VALUE=DATE:20261102",
+				"DTEND;VALUE=DATE:20261105",
+				"SUMMARY:Reserved: 1",
+				`DESCRIPTION:Guests: 2\nStatus: CONFIRMED`,
+				"STATUS:CONFIRMED",
+				"TRANSP:OPAQUE",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			},
+		},
+		"Success_EscapedText": {
+			calendar: Calendar{
+				Stamp: stamp,
+				Events: []Event{{
+					UID:       "blackout-1@example.com",
+					StartDate: startDate,
+					EndDate:   endDate,
+					Summary:   `Blackout: pipes, drains; \ other`,
+				}},
+			},
+			want: []string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:" + prodID,
+				"CALSCALE:GREGORIAN",
+				"METHOD:PUBLISH",
+				"BEGIN:VEVENT",
+				"UID:blackout-1@example.com",
+				"SEQUENCE:0",
+				"DTSTAMP:20261019T083000Z",
+				"DTSTART;VALUE=DATE:20261102",
+				"DTEND;VALUE=DATE:20261105",
+				`SUMMARY:Blackout: pipes\, drains\; \\ other`,
+				"TRANSP:OPAQUE",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			var got bytes.Buffer
+			// when
+			err := tc.calendar.Encode(&got)
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, strings.Join(tc.want, "\r\n")+"\r\n", got.String())
+		})
+	}
+}
+
+func TestCalendar_Encode_FoldsLongLines(t *testing.T) {
+	// given
+	summary := strings.Repeat("é", 80)
+	c := Calendar{Events: []Event{{UID: "1", Summary: summary}}}
+	var got bytes.Buffer
+	// when
+	err := c.Encode(&got)
+	// then
+	assert.NoError(t, err)
+	var unfolded []string
+	for _, line := range strings.Split(strings.TrimSuffix(got.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		if strings.HasPrefix(line, " ") {
+			unfolded[len(unfolded)-1] += line[1:]
+			continue
+		}
+		unfolded = append(unfolded, line)
+	}
+	assert.Contains(t, unfolded, "SUMMARY:"+summary)
+}
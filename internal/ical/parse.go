@@ -0,0 +1,259 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// localDateTimeFormat is the format of floating date-times and of those
+	// qualified with a TZID parameter.
+	localDateTimeFormat = "20060102T150405"
+	// maxContentLineBytes bounds an unfolded content line, longer lines are
+	// rejected rather than buffered.
+	maxContentLineBytes = 1 << 20
+)
+
+// durationPattern matches the dur-value of RFC 5545, e.g. P1D, PT12H or P2W.
+var durationPattern = regexp.MustCompile(
+	`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`,
+)
+
+type (
+	// contentLine is an unfolded "NAME;PARAM=VALUE:VALUE" line.
+	contentLine struct {
+		name   string
+		params map[string]string
+		value  string
+	}
+
+	// property is a date or date-time property of an event.
+	property struct {
+		params map[string]string
+		value  string
+	}
+)
+
+// Parse reads the events of an iCalendar document as all-day events. Dates
+// and date-times are both accepted: an event ends on the day after its last
+// occupied day, so a date-time end past midnight is rounded up. Recurrence
+// rules are ignored, only the first occurrence of an event is returned.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events     []Event
+		components []string
+		event      Event
+		start      *property
+		end        *property
+		duration   string
+	)
+	for i, l := range lines {
+		line, err := parseContentLine(l)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		switch line.name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(line.value))
+			if strings.EqualFold(line.value, "VEVENT") {
+				event, start, end, duration = Event{}, nil, nil, ""
+			}
+			continue
+		case "END":
+			if len(components) == 0 ||
+				components[len(components)-1] != strings.ToUpper(line.value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, line.value)
+			}
+			components = components[:len(components)-1]
+			if strings.EqualFold(line.value, "VEVENT") {
+				if event.StartDate, event.EndDate, err = eventDates(start, end, duration); err != nil {
+					return nil, fmt.Errorf("event %s: %w", event.UID, err)
+				}
+				events = append(events, event)
+			}
+			continue
+		}
+		if len(components) == 0 || components[len(components)-1] != "VEVENT" {
+			continue
+		}
+
+		switch line.name {
+		case "UID":
+			event.UID = line.value
+		case "SEQUENCE":
+			event.Sequence, _ = strconv.ParseInt(line.value, 10, 64)
+		case "SUMMARY":
+			event.Summary = unescapeText(line.value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(line.value)
+		case "STATUS":
+			event.Status = EventStatus(strings.ToUpper(line.value))
+		case "TRANSP":
+			event.Transparent = strings.EqualFold(line.value, "TRANSPARENT")
+		case "DTSTART":
+			start = &property{params: line.params, value: line.value}
+		case "DTEND":
+			end = &property{params: line.params, value: line.value}
+		case "DURATION":
+			duration = line.value
+		}
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("missing END:%s", components[len(components)-1])
+	}
+	return events, nil
+}
+
+// unfold splits a document into content lines, joining the continuation
+// lines that start with a space or a tab.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxContentLineBytes)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		switch {
+		case line == "":
+			continue
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseContentLine splits a content line at the first colon outside of a
+// quoted parameter value.
+func parseContentLine(line string) (contentLine, error) {
+	quoted := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			segments := strings.Split(line[:i], ";")
+			result := contentLine{
+				name:   strings.ToUpper(segments[0]),
+				params: make(map[string]string, len(segments)-1),
+				value:  line[i+1:],
+			}
+			for _, segment := range segments[1:] {
+				name, value, _ := strings.Cut(segment, "=")
+				result.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+			}
+			return result, nil
+		}
+	}
+	return contentLine{}, fmt.Errorf("invalid content line %q", line)
+}
+
+// eventDates returns the first and the day after the last day occupied by an
+// event; events without an end, or ending before they start, occupy one day.
+func eventDates(start, end *property, duration string) (time.Time, time.Time, error) {
+	if start == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("missing DTSTART")
+	}
+	startTime, err := parseDateTime(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("DTSTART: %w", err)
+	}
+
+	var endTime time.Time
+	switch {
+	case end != nil:
+		if endTime, err = parseDateTime(end); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("DTEND: %w", err)
+		}
+	case duration != "":
+		if endTime, err = addDuration(startTime, duration); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("DURATION: %w", err)
+		}
+	}
+
+	startDate := asDate(startTime)
+	endDate := asDate(endTime)
+	if !isMidnight(endTime) {
+		endDate = endDate.AddDate(0, 0, 1)
+	}
+	if !endDate.After(startDate) {
+		endDate = startDate.AddDate(0, 0, 1)
+	}
+	return startDate, endDate, nil
+}
+
+// parseDateTime parses a DATE, a UTC or floating DATE-TIME, or a DATE-TIME
+// local to the time zone of its TZID parameter.
+func parseDateTime(p *property) (time.Time, error) {
+	value := strings.TrimSpace(p.value)
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len(dateFormat) {
+		return time.ParseInLocation(dateFormat, value, time.UTC)
+	}
+	if strings.HasSuffix(value, "Z") {
+		return time.ParseInLocation(dateTimeFormat, value, time.UTC)
+	}
+	location := time.UTC
+	if tzid := p.params["TZID"]; tzid != "" {
+		var err error
+		if location, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, fmt.Errorf("unknown TZID %s", tzid)
+		}
+	}
+	return time.ParseInLocation(localDateTimeFormat, value, location)
+}
+
+func addDuration(t time.Time, duration string) (time.Time, error) {
+	m := durationPattern.FindStringSubmatch(strings.TrimSpace(duration))
+	if m == nil {
+		return time.Time{}, fmt.Errorf("invalid duration %s", duration)
+	}
+	n := make([]int, len(m))
+	for i := 2; i < len(m); i++ {
+		n[i], _ = strconv.Atoi(m[i])
+	}
+	if m[1] == "-" {
+		// ending before it starts, the event occupies its start date only
+		return t, nil
+	}
+	return t.AddDate(0, 0, 7*n[2]+n[3]).
+		Add(time.Duration(n[4])*time.Hour +
+			time.Duration(n[5])*time.Minute +
+			time.Duration(n[6])*time.Second), nil
+}
+
+// asDate returns the date of the time in its own location, as a UTC midnight
+// like the dates of the domain.
+func asDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+// unescapeText unescapes a value of the TEXT type.
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,173 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2026, 11, day, 0, 0, 0, 0, time.UTC)
+	}
+	calendar := func(lines ...string) string {
+		return strings.Join(append(append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...),
+			"END:VCALENDAR"), "\r\n") + "\r\n"
+	}
+
+	tests := map[string]struct {
+		calendar string
+		want     []Event
+		wantErr  string
+	}{
+		"Success_Date": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:1@example.com",
+				"DTSTART;VALUE=DATE:20261102",
+				"DTEND;VALUE=DATE:20261105",
+				`SUMMARY:Reserved\, Airbnb`,
+				"END:VEVENT",
+			),
+			want: []Event{{
+				UID: "1@example.com", StartDate: date(2), EndDate: date(5), Summary: "Reserved, Airbnb",
+			}},
+		},
+		"Success_DateTimeRoundedUp": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:2@example.com",
+				"DTSTART:20261102T150000Z",
+				"DTEND:20261105T110000Z",
+				"END:VEVENT",
+			),
+			want: []Event{{UID: "2@example.com", StartDate: date(2), EndDate: date(6)}},
+		},
+		"Success_DateTimeEndingAtMidnight": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:3@example.com",
+				"DTSTART:20261102T000000",
+				"DTEND:20261104T000000",
+				"END:VEVENT",
+			),
+			want: []Event{{UID: "3@example.com", StartDate: date(2), EndDate: date(4)}},
+		},
+		"Success_TZID": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:4@example.com",
+				`DTSTART;TZID="America/Toronto":20261102T230000`,
+				"DTEND;TZID=America/Toronto:20261103T000000",
+				"END:VEVENT",
+			),
+			want: []Event{{UID: "4@example.com", StartDate: date(2), EndDate: date(3)}},
+		},
+		"Success_Duration": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:5@example.com",
+				"DTSTART;VALUE=DATE:20261102",
+				"DURATION:P1W",
+				"END:VEVENT",
+			),
+			want: []Event{{UID: "5@example.com", StartDate: date(2), EndDate: date(9)}},
+		},
+		"Success_NoEnd": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:6@example.com",
+				"DTSTART;VALUE=DATE:20261102",
+				"END:VEVENT",
+			),
+			want: []Event{{UID: "6@example.com", StartDate: date(2), EndDate: date(3)}},
+		},
+		"Success_FoldedLinesAndNestedComponents": {
+			calendar: calendar(
+				"BEGIN:VTIMEZONE",
+				"TZID:America/Toronto",
+				"END:VTIMEZONE",
+				"BEGIN:VEVENT",
+				"UID:7@exa",
+				" mple.com",
+				"DTSTART;VALUE=DATE:20261102",
+				"DTEND;VALUE=DATE:20261103",
+				"STATUS:cancelled",
+				"TRANSP:TRANSPARENT",
+				"BEGIN:VALARM",
+				"DESCRIPTION:Reminder",
+				"END:VALARM",
+				"END:VEVENT",
+			),
+			want: []Event{{
+				UID: "7@example.com", StartDate: date(2), EndDate: date(3),
+				Status: EventStatusCancelled, Transparent: true,
+			}},
+		},
+		"Error_MissingDTSTART": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:8@example.com",
+				"END:VEVENT",
+			),
+			wantErr: "event 8@example.com: missing DTSTART",
+		},
+		"Error_UnknownTZID": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"UID:9@example.com",
+				"DTSTART;TZID=Mars/Olympus:20261102T150000",
+				"END:VEVENT",
+			),
+			wantErr: "event 9@example.com: DTSTART: unknown TZID Mars/Olympus",
+		},
+		"Error_UnexpectedEnd": {
+			calendar: calendar(
+				"BEGIN:VEVENT",
+				"END:VTODO",
+			),
+			wantErr: "line 4: unexpected END:VTODO",
+		},
+		"Error_InvalidContentLine": {
+			calendar: calendar("not a content line"),
+			wantErr:  `line 3: invalid content line "not a content line"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := Parse(strings.NewReader(tc.calendar))
+			// then
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParse_EncodedCalendar(t *testing.T) {
+	// given
+	event := Event{
+		UID:         strings.Repeat("booking-", 10) + "@example.com",
+		Sequence:    3,
+		StartDate:   time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC),
+		Summary:     "Booking: John Smith; 2 guests",
+		Description: "Guests: 2\nStatus: CONFIRMED",
+		Status:      EventStatusConfirmed,
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, Calendar{Name: "Campsite A01", Events: []Event{event}}.Encode(&buf))
+	// when
+	got, err := Parse(&buf)
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []Event{event}, got)
+}
@@ -0,0 +1,80 @@
+package ical
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+)
+
+// maxCalendarBytes bounds the size of a fetched or uploaded calendar.
+const maxCalendarBytes = 10 << 20
+
+// Reader reads the calendars that third-party booking platforms publish for
+// a listing, e.g. the iCal export links of Airbnb or Hipcamp.
+type Reader struct {
+	client *http.Client
+}
+
+var _ domain.ExternalCalendarReader = (*Reader)(nil)
+
+func NewReader(client *http.Client) *Reader {
+	return &Reader{client: client}
+}
+
+// Fetch downloads a calendar, webcal URLs are fetched over HTTPS.
+func (r *Reader) Fetch(ctx context.Context, url string) ([]*domain.ExternalBlock, error) {
+	if rest, ok := strings.CutPrefix(url, "webcal://"); ok {
+		url = "https://" + rest
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create request for %s", url)
+	}
+	req.Header.Set("Accept", "text/calendar")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.ErrUnavailable.Wrapf(err, "get %s", url)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.ErrUnavailable.Msgf("get %s: unexpected status %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCalendarBytes+1))
+	if err != nil {
+		return nil, errors.ErrUnavailable.Wrapf(err, "read response of %s", url)
+	}
+	return r.Read(body)
+}
+
+// Read returns the blocks of the events occupying the campsite; cancelled and
+// transparent events are skipped.
+func (r *Reader) Read(calendar []byte) ([]*domain.ExternalBlock, error) {
+	if len(calendar) > maxCalendarBytes {
+		return nil, errors.ErrInvalidArgument.Msgf("calendar exceeds %d bytes", maxCalendarBytes)
+	}
+	events, err := Parse(bytes.NewReader(calendar))
+	if err != nil {
+		return nil, errors.ErrInvalidArgument.Wrap(err, "parse calendar")
+	}
+
+	var blocks []*domain.ExternalBlock
+	for _, event := range events {
+		if event.Status == EventStatusCancelled || event.Transparent {
+			continue
+		}
+		blocks = append(blocks, &domain.ExternalBlock{
+			UID:       event.UID,
+			StartDate: event.StartDate,
+			EndDate:   event.EndDate,
+			Summary:   event.Summary,
+		})
+	}
+	return blocks, nil
+}
@@ -0,0 +1,116 @@
+package ical
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const externalCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Airbnb Inc//Hosting Calendar 1.0//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:reserved-1@airbnb.com\r\n" +
+	"DTSTART;VALUE=DATE:20261102\r\n" +
+	"DTEND;VALUE=DATE:20261105\r\n" +
+	"SUMMARY:Reserved\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cancelled-1@airbnb.com\r\n" +
+	"DTSTART;VALUE=DATE:20261110\r\n" +
+	"DTEND;VALUE=DATE:20261112\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:note-1@airbnb.com\r\n" +
+	"DTSTART;VALUE=DATE:20261120\r\n" +
+	"DTEND;VALUE=DATE:20261121\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+var externalBlocks = []*domain.ExternalBlock{{
+	UID:       "reserved-1@airbnb.com",
+	StartDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+	EndDate:   time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC),
+	Summary:   "Reserved",
+}}
+
+func TestReader_Fetch(t *testing.T) {
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		want    []*domain.ExternalBlock
+		wantErr error
+	}{
+		"Success": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", contentType)
+				_, _ = w.Write([]byte(externalCalendar))
+			},
+			want:    externalBlocks,
+			wantErr: nil,
+		},
+		"Error_Status": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			want:    nil,
+			wantErr: errors.ErrUnavailable,
+		},
+		"Error_InvalidCalendar": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("<html></html>"))
+			},
+			want:    nil,
+			wantErr: errors.ErrInvalidArgument,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+			reader := NewReader(ts.Client())
+			// when
+			got, err := reader.Fetch(context.TODO(), ts.URL+"/calendar.ics")
+			// then
+			assert.Equal(t, tc.want, got)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Fetch() error = %v, wantErr %v", err, tc.wantErr)
+		})
+	}
+}
+
+func TestReader_Fetch_Webcal(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(externalCalendar))
+	}))
+	defer ts.Close()
+	reader := NewReader(ts.Client())
+	// when
+	got, err := reader.Fetch(
+		context.TODO(), strings.Replace(ts.URL, "https://", "webcal://", 1)+"/calendar.ics",
+	)
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, externalBlocks, got)
+}
+
+func TestReader_Read(t *testing.T) {
+	// given
+	reader := NewReader(http.DefaultClient)
+	// when
+	got, err := reader.Read([]byte(externalCalendar))
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, externalBlocks, got)
+}
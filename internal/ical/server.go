@@ -0,0 +1,140 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/query"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+)
+
+const (
+	// uidDomain qualifies the IDs of events to make them globally unique.
+	uidDomain     = "campsite-booking-go"
+	fileExtension = ".ics"
+	contentType   = "text/calendar; charset=utf-8"
+)
+
+type server struct {
+	app application.App
+}
+
+// RegisterServer serves the campsite and booking calendar feeds, e.g.
+// /calendars/campsites/{campsiteID}.ics and /calendars/bookings/{bookingID}.ics.
+func RegisterServer(app application.App, mux *http.ServeMux) {
+	s := server{app: app}
+	mux.HandleFunc("GET /calendars/campsites/{file}", s.CampsiteCalendar)
+	mux.HandleFunc("GET /calendars/bookings/{file}", s.BookingCalendar)
+}
+
+// CampsiteCalendar serves the occupying bookings and blackouts of a campsite.
+func (s server) CampsiteCalendar(w http.ResponseWriter, r *http.Request) {
+	campsiteID, ok := strings.CutSuffix(r.PathValue("file"), fileExtension)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	calendar, err := s.app.GetCampsiteCalendar(
+		r.Context(), query.GetCampsiteCalendar{CampsiteID: campsiteID},
+	)
+	if err != nil {
+		handleDomainError(w, r, err)
+		return
+	}
+
+	c := Calendar{Name: "Campsite " + campsiteID, Stamp: time.Now()}
+	for _, booking := range calendar.Bookings {
+		c.Events = append(c.Events, BookingEvent(booking))
+	}
+	for _, blackout := range calendar.Blackouts {
+		c.Events = append(c.Events, BlackoutEvent(blackout))
+	}
+	writeCalendar(w, r, c, "campsite-"+campsiteID)
+}
+
+// BookingCalendar serves the stay of a booking, cancelled ones included so
+// that calendar apps remove them.
+func (s server) BookingCalendar(w http.ResponseWriter, r *http.Request) {
+	bookingID, ok := strings.CutSuffix(r.PathValue("file"), fileExtension)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	booking, err := s.app.GetBooking(r.Context(), query.GetBooking{BookingID: bookingID})
+	if err != nil {
+		handleDomainError(w, r, err)
+		return
+	}
+
+	c := Calendar{
+		Name:   "Booking " + bookingID,
+		Stamp:  time.Now(),
+		Events: []Event{BookingEvent(booking)},
+	}
+	writeCalendar(w, r, c, "booking-"+bookingID)
+}
+
+func writeCalendar(w http.ResponseWriter, r *http.Request, c Calendar, name string) {
+	var body bytes.Buffer
+	if err := c.Encode(&body); err != nil {
+		handleDomainError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("inline; filename=%q", name+fileExtension))
+	_, _ = body.WriteTo(w)
+}
+
+func handleDomainError(w http.ResponseWriter, r *http.Request, e error) {
+	switch e.(type) {
+	case domain.ErrCampsiteNotFound, domain.ErrBookingNotFound:
+		http.Error(w, e.Error(), http.StatusNotFound)
+	default:
+		slog.ErrorContext(r.Context(), "failed to serve calendar", slog.String("path", r.URL.Path),
+			slog.Any("error", e))
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+	}
+}
+
+// BookingEvent maps a booking to an event whose sequence follows the version
+// of the booking, so that each update of the booking is a new revision. The
+// feeds are shared with third parties, so the event holds no guest data.
+func BookingEvent(b *domain.Booking) Event {
+	status := EventStatusConfirmed
+	switch b.Status {
+	case domain.BookingStatusPending:
+		status = EventStatusTentative
+	case domain.BookingStatusCancelled, domain.BookingStatusNoShow:
+		status = EventStatusCancelled
+	}
+	return Event{
+		UID:       "booking-" + b.BookingID + "@" + uidDomain,
+		Sequence:  max(b.Version-1, 0),
+		StartDate: b.StartDate,
+		EndDate:   b.EndDate,
+		Summary:   "Reserved: " + b.BookingID,
+		Description: fmt.Sprintf("Booking ID: %s\nCampsite ID: %s\nGuests: %d\nStatus: %s",
+			b.BookingID, b.CampsiteID, b.Guests, b.Status),
+		Status: status,
+	}
+}
+
+// BlackoutEvent maps a blackout to an event, blackouts are never updated.
+func BlackoutEvent(b *domain.CampsiteBlackout) Event {
+	return Event{
+		UID:         "blackout-" + b.BlackoutID + "@" + uidDomain,
+		Sequence:    0,
+		StartDate:   b.StartDate,
+		EndDate:     b.EndDate,
+		Summary:     "Blackout: " + b.Reason,
+		Description: fmt.Sprintf("Blackout ID: %s\nCampsite ID: %s", b.BlackoutID, b.CampsiteID),
+		Status:      EventStatusConfirmed,
+	}
+}
@@ -0,0 +1,182 @@
+package ical
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/query"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mocks struct {
+	app *application.MockApp
+}
+
+func TestServer_CampsiteCalendar(t *testing.T) {
+	campsiteID := "campsite-id"
+	booking, err := bootstrap.NewBooking(campsiteID)
+	assert.NoError(t, err)
+	booking.Version = 3
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	calendar := &domain.CampsiteCalendar{
+		CampsiteID: campsiteID,
+		Bookings:   []*domain.Booking{booking},
+		Blackouts:  []*domain.CampsiteBlackout{blackout},
+	}
+	qry := query.GetCampsiteCalendar{CampsiteID: campsiteID}
+
+	tests := map[string]struct {
+		path       string
+		on         func(f mocks)
+		wantStatus int
+		wantBody   []string
+	}{
+		"Success": {
+			path: "/calendars/campsites/" + campsiteID + ".ics",
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsiteCalendar", mock.Anything, qry).
+					Return(calendar, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"UID:booking-" + booking.BookingID + "@" + uidDomain + "\r\n",
+				"SEQUENCE:2\r\n",
+				"UID:blackout-" + blackout.BlackoutID + "@" + uidDomain + "\r\n",
+				"DTSTART;VALUE=DATE:" + blackout.StartDate.Format(dateFormat) + "\r\n",
+			},
+		},
+		"Error_CampsiteNotFound": {
+			path: "/calendars/campsites/" + campsiteID + ".ics",
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsiteCalendar", mock.Anything, qry).
+					Return(nil, domain.ErrCampsiteNotFound{CampsiteID: campsiteID})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   []string{"campsite not found"},
+		},
+		"Error_Query": {
+			path: "/calendars/campsites/" + campsiteID + ".ics",
+			on: func(f mocks) {
+				f.app.
+					On("GetCampsiteCalendar", mock.Anything, qry).
+					Return(nil, bootstrap.ErrQuery)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   []string{http.StatusText(http.StatusInternalServerError)},
+		},
+		"Error_FileExtension": {
+			path:       "/calendars/campsites/" + campsiteID,
+			on:         nil,
+			wantStatus: http.StatusNotFound,
+			wantBody:   nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			mux := http.NewServeMux()
+			RegisterServer(m.app, mux)
+			if tc.on != nil {
+				tc.on(m)
+			}
+			rec := httptest.NewRecorder()
+			// when
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			// then
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			for _, want := range tc.wantBody {
+				assert.Contains(t, rec.Body.String(), want)
+			}
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+			}
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
+
+func TestServer_BookingCalendar(t *testing.T) {
+	booking, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	booking.Version = 1
+	cancelled, err := bootstrap.NewBooking("campsite-id")
+	assert.NoError(t, err)
+	cancelled.Version = 2
+	cancelled.Status = domain.BookingStatusCancelled
+
+	tests := map[string]struct {
+		booking    *domain.Booking
+		err        error
+		wantStatus int
+		wantBody   []string
+	}{
+		"Success": {
+			booking:    booking,
+			err:        nil,
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"UID:booking-" + booking.BookingID + "@" + uidDomain + "\r\n",
+				"SEQUENCE:0\r\n",
+				"STATUS:CONFIRMED\r\n",
+				"DTEND;VALUE=DATE:" + booking.EndDate.Format(dateFormat) + "\r\n",
+				"SUMMARY:Reserved: " + booking.BookingID + "\r\n",
+			},
+		},
+		"Success_Cancelled": {
+			booking:    cancelled,
+			err:        nil,
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"UID:booking-" + cancelled.BookingID + "@" + uidDomain + "\r\n",
+				"SEQUENCE:1\r\n",
+				"STATUS:CANCELLED\r\n",
+			},
+		},
+		"Error_BookingNotFound": {
+			booking:    booking,
+			err:        domain.ErrBookingNotFound{BookingID: booking.BookingID},
+			wantStatus: http.StatusNotFound,
+			wantBody:   []string{"booking not found"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			m := mocks{app: application.NewMockApp(t)}
+			mux := http.NewServeMux()
+			RegisterServer(m.app, mux)
+			var found *domain.Booking
+			if tc.err == nil {
+				found = tc.booking
+			}
+			m.app.
+				On("GetBooking", mock.Anything, query.GetBooking{BookingID: tc.booking.BookingID}).
+				Return(found, tc.err)
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				http.MethodGet, "/calendars/bookings/"+tc.booking.BookingID+".ics", nil,
+			).WithContext(context.TODO())
+			// when
+			mux.ServeHTTP(rec, req)
+			// then
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			for _, want := range tc.wantBody {
+				assert.Contains(t, rec.Body.String(), want)
+			}
+			assert.NotContains(t, rec.Body.String(), tc.booking.FullName)
+			assert.NotContains(t, rec.Body.String(), tc.booking.Email)
+			mock.AssertExpectationsForObjects(t, m.app)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// RequestIDKey is the key of the request ID attribute of the log records.
+const RequestIDKey = "request_id"
+
+type (
+	requestIDKey struct{}
+
+	// ContextHandler adds the request ID of the context, if any, to the
+	// records logged with a context, e.g. by slog.InfoContext, at the top
+	// level even if groups were opened.
+	ContextHandler struct {
+		slog.Handler
+		// root is the handler before the first group was opened and scopes
+		// the groups, and the attributes added since, to be replayed on root
+		// once the request ID is added to it.
+		root   slog.Handler
+		scopes []func(slog.Handler) slog.Handler
+	}
+)
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, empty if none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func NewContextHandler(h slog.Handler) ContextHandler {
+	return ContextHandler{Handler: h}
+}
+
+func (h ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	id := RequestID(ctx)
+	if id == "" {
+		return h.Handler.Handle(ctx, r)
+	}
+	if h.root == nil {
+		r.AddAttrs(slog.String(RequestIDKey, id))
+		return h.Handler.Handle(ctx, r)
+	}
+	// the attributes of the record would be added to the open group
+	handler := h.root.WithAttrs([]slog.Attr{slog.String(RequestIDKey, id)})
+	for _, scope := range h.scopes {
+		handler = scope(handler)
+	}
+	return handler.Handle(ctx, r)
+}
+
+func (h ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if h.root == nil {
+		return ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+	}
+	return h.withScope(func(handler slog.Handler) slog.Handler {
+		return handler.WithAttrs(attrs)
+	})
+}
+
+func (h ContextHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	if h.root == nil {
+		h.root = h.Handler
+	}
+	return h.withScope(func(handler slog.Handler) slog.Handler {
+		return handler.WithGroup(name)
+	})
+}
+
+func (h ContextHandler) withScope(scope func(slog.Handler) slog.Handler) ContextHandler {
+	return ContextHandler{
+		Handler: scope(h.Handler),
+		root:    h.root,
+		scopes:  append(slices.Clip(h.scopes), scope),
+	}
+}
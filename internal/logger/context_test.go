@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_ContextHandler(t *testing.T) {
+	tests := map[string]struct {
+		ctx  context.Context
+		want string
+	}{
+		"RequestID": {
+			ctx:  WithRequestID(context.Background(), "req-1"),
+			want: `"request_id":"req-1"`,
+		},
+		"NoRequestID": {
+			ctx: context.Background(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			var buf bytes.Buffer
+			l := slog.New(NewContextHandler(slog.NewJSONHandler(&buf, nil))).With("attr", "value")
+			// when
+			l.InfoContext(tc.ctx, "message")
+			// then
+			assert.Contains(t, buf.String(), `"attr":"value"`)
+			if tc.want != "" {
+				assert.Contains(t, buf.String(), tc.want)
+			} else {
+				assert.NotContains(t, buf.String(), RequestIDKey)
+			}
+		})
+	}
+}
+
+func TestLogger_ContextHandler_WithGroup(t *testing.T) {
+	tests := map[string]struct {
+		ctx  context.Context
+		want string
+	}{
+		"RequestID_TopLevel": {
+			ctx:  WithRequestID(context.Background(), "req-1"),
+			want: `{"attr":"value","request_id":"req-1","db":{"table":"bookings","rows":2}}`,
+		},
+		"NoRequestID": {
+			ctx:  context.Background(),
+			want: `{"attr":"value","db":{"table":"bookings","rows":2}}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			var buf bytes.Buffer
+			opts := &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 &&
+						(a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}
+			l := slog.New(NewContextHandler(slog.NewJSONHandler(&buf, opts))).
+				With("attr", "value").
+				WithGroup("db").
+				With("table", "bookings")
+			// when
+			l.InfoContext(tc.ctx, "message", "rows", 2)
+			// then
+			assert.JSONEq(t, tc.want, buf.String())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// levelBody is the body of the requests and responses of the log level
+// endpoint.
+type levelBody struct {
+	Level Level `json:"level"`
+}
+
+// ParseLevel returns the slog level of one of DEBUG, INFO, WARN or ERROR,
+// case-insensitively.
+func ParseLevel(s string) (slog.Level, error) {
+	switch level := Level(strings.ToUpper(s)); level {
+	case DEBUG, INFO, WARN, ERROR:
+		return logLevelToSlog(level), nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q, expected one of DEBUG, INFO, WARN or ERROR", s)
+	}
+}
+
+// RegisterLevelHandler registers the endpoint getting and setting the level
+// of the logger at runtime, e.g. to debug a production issue temporarily:
+//
+//	GET /admin/log-level            {"level":"INFO"}
+//	PUT /admin/log-level            {"level":"DEBUG"}
+func RegisterLevelHandler(level *slog.LevelVar, mux *http.ServeMux) {
+	mux.HandleFunc("GET /admin/log-level", func(w http.ResponseWriter, _ *http.Request) {
+		writeLevel(w, level.Level())
+	})
+	mux.HandleFunc("PUT /admin/log-level", func(w http.ResponseWriter, r *http.Request) {
+		var body levelBody
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<10)).Decode(&body); err != nil {
+			http.Error(w, "invalid body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		newLevel, err := ParseLevel(string(body.Level))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		oldLevel := level.Level()
+		level.Set(newLevel)
+		slog.Warn("log level changed",
+			slog.String("from", oldLevel.String()), slog.String("to", newLevel.String()))
+		writeLevel(w, newLevel)
+	})
+}
+
+func writeLevel(w http.ResponseWriter, level slog.Level) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(levelBody{Level: Level(level.String())})
+}
@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_ParseLevel(t *testing.T) {
+	tests := map[string]struct {
+		level   string
+		want    slog.Level
+		wantErr bool
+	}{
+		"Debug": {
+			level: "DEBUG",
+			want:  slog.LevelDebug,
+		},
+		"WarnLowerCase": {
+			level: "warn",
+			want:  slog.LevelWarn,
+		},
+		"Error_UnknownLevel": {
+			level:   "TRACE",
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := ParseLevel(tc.level)
+			// then
+			assert.Equal(t, tc.wantErr, err != nil,
+				"ParseLevel() error = %v, wantErr %t", err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLogger_RegisterLevelHandler(t *testing.T) {
+	tests := map[string]struct {
+		method     string
+		body       string
+		wantStatus int
+		wantBody   string
+		wantLevel  slog.Level
+	}{
+		"Get": {
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"INFO"}`,
+			wantLevel:  slog.LevelInfo,
+		},
+		"Put": {
+			method:     http.MethodPut,
+			body:       `{"level":"debug"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"DEBUG"}`,
+			wantLevel:  slog.LevelDebug,
+		},
+		"Put_UnknownLevel": {
+			method:     http.MethodPut,
+			body:       `{"level":"TRACE"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `unknown log level "TRACE", expected one of DEBUG, INFO, WARN or ERROR`,
+			wantLevel:  slog.LevelInfo,
+		},
+		"Put_InvalidBody": {
+			method:     http.MethodPut,
+			body:       `DEBUG`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid body: invalid character 'D' looking for beginning of value",
+			wantLevel:  slog.LevelInfo,
+		},
+		"Post_MethodNotAllowed": {
+			method:     http.MethodPost,
+			body:       `{"level":"DEBUG"}`,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "Method Not Allowed",
+			wantLevel:  slog.LevelInfo,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			level := new(slog.LevelVar)
+			level.Set(slog.LevelInfo)
+			mux := http.NewServeMux()
+			RegisterLevelHandler(level, mux)
+			req := httptest.NewRequest(tc.method, "/admin/log-level", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			// when
+			mux.ServeHTTP(rec, req)
+			// then
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(rec.Body.String()))
+			assert.Equal(t, tc.wantLevel, level.Level())
+		})
+	}
+}
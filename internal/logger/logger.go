@@ -24,6 +24,12 @@ type Level string
 type LogConfig struct {
 	Environment string
 	LogLevel    Level
+	// LevelVar is set to LogLevel and controls the level of the logger, so that
+	// the level can be changed at runtime; created if nil.
+	LevelVar *slog.LevelVar
+	// SensitiveFields are the names of the fields redacted in the logs, see
+	// Redact; DefaultSensitiveFields if nil.
+	SensitiveFields []string
 }
 
 const (
@@ -34,22 +40,28 @@ const (
 )
 
 func New(cfg LogConfig) *slog.Logger {
-	level := new(slog.LevelVar)
+	level := cfg.LevelVar
+	if level == nil {
+		level = new(slog.LevelVar)
+	}
 	level.Set(logLevelToSlog(cfg.LogLevel))
+	if cfg.SensitiveFields != nil {
+		SetSensitiveFields(cfg.SensitiveFields)
+	}
 
 	opts := &slog.HandlerOptions{
 		Level: level,
 	}
 	switch cfg.Environment {
 	case "production":
-		return slog.New(slog.NewJSONHandler(os.Stdout, opts))
+		return slog.New(NewContextHandler(slog.NewJSONHandler(os.Stdout, opts)))
 	default:
 		return NewDefault(os.Stdout, opts)
 	}
 }
 
 func NewDefault(w io.Writer, opts *slog.HandlerOptions) *slog.Logger {
-	return slog.New(loghandler.New(w, opts))
+	return slog.New(NewContextHandler(loghandler.New(w, opts)))
 }
 
 func logLevelToSlog(level Level) slog.Level {
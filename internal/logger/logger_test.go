@@ -51,3 +51,14 @@ func TestLogger_New(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_New_LevelVar(t *testing.T) {
+	// given
+	level := new(slog.LevelVar)
+	got := New(LogConfig{LogLevel: INFO, LevelVar: level})
+	// when
+	level.Set(slog.LevelDebug)
+	// then
+	assert.True(t, got.Enabled(context.TODO(), slog.LevelDebug),
+		"New() debug level disabled after LevelVar set to debug")
+}
@@ -0,0 +1,134 @@
+package logger
+
+import (
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+// RedactedValue replaces the non-empty string values of the sensitive fields.
+const RedactedValue = "[REDACTED]"
+
+// maxRedactDepth bounds the nesting redacted, e.g. of cyclic values.
+const maxRedactDepth = 16
+
+// DefaultSensitiveFields are the fields holding personal data of guests,
+// including the document of the data exported about them, and their payment
+// methods.
+var DefaultSensitiveFields = []string{
+	"Email", "FullName", "Phone", "Address", "Preferences", "GuestData", "PaymentMethod",
+}
+
+var sensitiveFields atomic.Pointer[map[string]struct{}]
+
+func init() {
+	SetSensitiveFields(DefaultSensitiveFields)
+}
+
+// SetSensitiveFields sets the names of the fields redacted in the logs. Names
+// are matched ignoring case and underscores, so that FullName matches both
+// the field of a Go struct and the full_name field of a protobuf message.
+func SetSensitiveFields(names []string) {
+	fields := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if name = normalizeFieldName(name); name != "" {
+			fields[name] = struct{}{}
+		}
+	}
+	sensitiveFields.Store(&fields)
+}
+
+// Sensitive reports whether the field name is one of the sensitive fields.
+func Sensitive(name string) bool {
+	_, ok := (*sensitiveFields.Load())[normalizeFieldName(name)]
+	return ok
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+}
+
+// Redact returns a copy of v in which the exported sensitive fields of the
+// structs, including those nested in pointers, slices and maps, are redacted:
+// strings are set to RedactedValue unless empty, other types to their zero
+// value. v itself is not modified.
+func Redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	return redactValue(reflect.ValueOf(v), maxRedactDepth).Interface()
+}
+
+func redactValue(v reflect.Value, depth int) reflect.Value {
+	if depth == 0 {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(redactValue(v.Elem(), depth-1))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(redactValue(v.Elem(), depth-1))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			switch {
+			case !Sensitive(f.Name):
+				c.Field(i).Set(redactValue(v.Field(i), depth-1))
+			case f.Type.Kind() == reflect.String && v.Field(i).Len() > 0:
+				c.Field(i).SetString(RedactedValue)
+			default:
+				c.Field(i).SetZero()
+			}
+		}
+		return c
+	case reflect.Slice, reflect.Array:
+		if !composite(v.Type().Elem()) || (v.Kind() == reflect.Slice && v.IsNil()) {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		if v.Kind() == reflect.Slice {
+			c.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+		}
+		for i := range v.Len() {
+			c.Index(i).Set(redactValue(v.Index(i), depth-1))
+		}
+		return c
+	case reflect.Map:
+		if !composite(v.Type().Elem()) || v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), redactValue(iter.Value(), depth-1))
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// composite reports whether values of t may contain struct fields.
+func composite(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Struct, reflect.Slice,
+		reflect.Array, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testGuest struct {
+		GuestID  string
+		Email    string
+		FullName string
+		Phone    *string
+		note     string
+	}
+
+	testBooking struct {
+		BookingID     string
+		PaymentMethod string
+		Guest         *testGuest
+		Others        []testGuest
+		ByID          map[string]any
+	}
+)
+
+func TestLogger_Redact(t *testing.T) {
+	phone := "555-0100"
+	tests := map[string]struct {
+		v    any
+		want any
+	}{
+		"Nil": {},
+		"NotStruct": {
+			v:    "jane@example.com",
+			want: "jane@example.com",
+		},
+		"Struct": {
+			v: testGuest{
+				GuestID: "g-1", Email: "jane@example.com", FullName: "Jane Doe",
+				Phone: &phone, note: "kept",
+			},
+			want: testGuest{
+				GuestID: "g-1", Email: RedactedValue, FullName: RedactedValue, note: "kept",
+			},
+		},
+		"Struct_EmptyFieldKept": {
+			v:    testGuest{GuestID: "g-1", FullName: "Jane Doe"},
+			want: testGuest{GuestID: "g-1", FullName: RedactedValue},
+		},
+		"PaymentMethod": {
+			v:    testBooking{BookingID: "b-1", PaymentMethod: "pm_card_visa"},
+			want: testBooking{BookingID: "b-1", PaymentMethod: RedactedValue},
+		},
+		"Nested": {
+			v: &testBooking{
+				BookingID: "b-1",
+				Guest:     &testGuest{Email: "jane@example.com"},
+				Others:    []testGuest{{Email: "john@example.com"}},
+				ByID:      map[string]any{"g-2": testGuest{FullName: "John Doe"}},
+			},
+			want: &testBooking{
+				BookingID: "b-1",
+				Guest:     &testGuest{Email: RedactedValue},
+				Others:    []testGuest{{Email: RedactedValue}},
+				ByID:      map[string]any{"g-2": testGuest{FullName: RedactedValue}},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got := Redact(tc.v)
+			// then
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLogger_Redact_OriginalUnchanged(t *testing.T) {
+	// given
+	b := &testBooking{Guest: &testGuest{Email: "jane@example.com"}}
+	// when
+	_ = Redact(b)
+	// then
+	assert.Equal(t, "jane@example.com", b.Guest.Email)
+}
+
+func TestLogger_SetSensitiveFields(t *testing.T) {
+	t.Cleanup(func() { SetSensitiveFields(DefaultSensitiveFields) })
+	// when
+	SetSensitiveFields([]string{"guest_id", " Phone "})
+	// then
+	assert.True(t, Sensitive("GuestID"))
+	assert.True(t, Sensitive("guest_id"))
+	assert.True(t, Sensitive("phone"))
+	assert.False(t, Sensitive("Email"))
+	assert.Equal(t, testGuest{GuestID: RedactedValue, Email: "jane@example.com"},
+		Redact(testGuest{GuestID: "g-1", Email: "jane@example.com"}))
+}
@@ -0,0 +1,143 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+)
+
+// DeclinedPaymentMethod is the payment method token FakeGateway always declines.
+const DeclinedPaymentMethod = "pm_card_declined"
+
+type (
+	// FakeGateway is an in-process payment gateway keeping payments in memory,
+	// intended for local development and tests.
+	FakeGateway struct {
+		mu       sync.Mutex
+		seq      int
+		payments map[string]*fakePayment
+		// payment and refund references by idempotency key
+		keys map[string]string
+	}
+
+	fakePayment struct {
+		amount   int64
+		currency string
+		captured bool
+		voided   bool
+		refunded int64
+	}
+)
+
+var _ domain.PaymentGateway = (*FakeGateway)(nil)
+
+func NewFakeGateway() *FakeGateway {
+	return &FakeGateway{
+		payments: make(map[string]*fakePayment),
+		keys:     make(map[string]string),
+	}
+}
+
+func (g *FakeGateway) Authorize(
+	_ context.Context,
+	req domain.PaymentRequest,
+) (*domain.Payment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if ref, ok := g.keys[req.IdempotencyKey]; ok {
+		p := g.payments[ref]
+		return &domain.Payment{Reference: ref, Amount: p.amount, Currency: p.currency}, nil
+	}
+	if req.PaymentMethod == DeclinedPaymentMethod {
+		return nil, domain.ErrPaymentDeclined{Reason: "card declined"}
+	}
+
+	ref := g.nextReference("pi")
+	g.payments[ref] = &fakePayment{amount: req.Amount, currency: req.Currency}
+	g.keys[req.IdempotencyKey] = ref
+	return &domain.Payment{Reference: ref, Amount: req.Amount, Currency: req.Currency}, nil
+}
+
+func (g *FakeGateway) Capture(_ context.Context, paymentRef string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	p, err := g.payment(paymentRef)
+	if err != nil {
+		return err
+	}
+	if p.voided {
+		return errors.ErrFailedPrecondition.Msgf("payment %s is voided", paymentRef)
+	}
+	p.captured = true
+	return nil
+}
+
+func (g *FakeGateway) Void(_ context.Context, paymentRef string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	p, err := g.payment(paymentRef)
+	if err != nil {
+		return err
+	}
+	if p.captured {
+		return errors.ErrFailedPrecondition.Msgf("payment %s is captured", paymentRef)
+	}
+	p.voided = true
+	return nil
+}
+
+func (g *FakeGateway) Refund(_ context.Context, req domain.RefundRequest) (*domain.Refund, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if ref, ok := g.keys[req.IdempotencyKey]; ok {
+		return &domain.Refund{Reference: ref, Amount: req.Amount}, nil
+	}
+	p, err := g.payment(req.PaymentRef)
+	if err != nil {
+		return nil, err
+	}
+	if !p.captured {
+		return nil, errors.ErrFailedPrecondition.Msgf("payment %s is not captured", req.PaymentRef)
+	}
+	if p.refunded+req.Amount > p.amount {
+		return nil, errors.ErrFailedPrecondition.Msgf(
+			"refund of %d exceeds refundable amount of payment %s", req.Amount, req.PaymentRef,
+		)
+	}
+
+	p.refunded += req.Amount
+	ref := g.nextReference("re")
+	g.keys[req.IdempotencyKey] = ref
+	return &domain.Refund{Reference: ref, Amount: req.Amount}, nil
+}
+
+// Refunded returns the total amount refunded for the payment.
+func (g *FakeGateway) Refunded(paymentRef string) int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if p, ok := g.payments[paymentRef]; ok {
+		return p.refunded
+	}
+	return 0
+}
+
+func (g *FakeGateway) payment(paymentRef string) (*fakePayment, error) {
+	p, ok := g.payments[paymentRef]
+	if !ok {
+		return nil, errors.ErrNotFound.Msgf("payment %s not found", paymentRef)
+	}
+	return p, nil
+}
+
+func (g *FakeGateway) nextReference(prefix string) string {
+	g.seq++
+	return fmt.Sprintf("%s_fake_%06d", prefix, g.seq)
+}
@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeGateway_Authorize(t *testing.T) {
+	tests := map[string]struct {
+		req     domain.PaymentRequest
+		wantErr error
+	}{
+		"Success": {
+			req: domain.PaymentRequest{
+				IdempotencyKey: "booking-id", PaymentMethod: "pm_card_visa",
+				Amount: 5255, Currency: "USD",
+			},
+		},
+		"Error_PaymentDeclined": {
+			req: domain.PaymentRequest{
+				IdempotencyKey: "booking-id", PaymentMethod: DeclinedPaymentMethod,
+				Amount: 5255, Currency: "USD",
+			},
+			wantErr: domain.ErrPaymentDeclined{Reason: "card declined"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			g := NewFakeGateway()
+			// when
+			got, err := g.Authorize(context.TODO(), tc.req)
+			// then
+			assert.Equal(t, tc.wantErr, err,
+				"FakeGateway.Authorize() error = %v, wantErr %v", err, tc.wantErr)
+			if tc.wantErr == nil {
+				assert.NotEmpty(t, got.Reference)
+				assert.Equal(t, tc.req.Amount, got.Amount)
+				assert.Equal(t, tc.req.Currency, got.Currency)
+			}
+		})
+	}
+}
+
+func TestFakeGateway_Idempotency(t *testing.T) {
+	// given
+	g := NewFakeGateway()
+	req := domain.PaymentRequest{
+		IdempotencyKey: "booking-id", PaymentMethod: "pm_card_visa", Amount: 5255, Currency: "USD",
+	}
+	// when
+	first, err := g.Authorize(context.TODO(), req)
+	assert.NoError(t, err)
+	second, err := g.Authorize(context.TODO(), req)
+	assert.NoError(t, err)
+	// then
+	assert.Equal(t, first, second)
+}
+
+func TestFakeGateway_Refund(t *testing.T) {
+	// given
+	g := NewFakeGateway()
+	payment, err := g.Authorize(context.TODO(), domain.PaymentRequest{
+		IdempotencyKey: "booking-id", PaymentMethod: "pm_card_visa", Amount: 5255, Currency: "USD",
+	})
+	assert.NoError(t, err)
+	req := domain.RefundRequest{
+		IdempotencyKey: "refund-booking-id", PaymentRef: payment.Reference, Amount: 5255,
+	}
+	// when
+	_, err = g.Refund(context.TODO(), req)
+	// then
+	assert.ErrorIs(t, err, errors.ErrFailedPrecondition, "refund of uncaptured payment")
+
+	// when
+	assert.NoError(t, g.Capture(context.TODO(), payment.Reference))
+	refund, err := g.Refund(context.TODO(), req)
+	assert.NoError(t, err)
+	retried, err := g.Refund(context.TODO(), req)
+	assert.NoError(t, err)
+	// then
+	assert.Equal(t, refund, retried)
+	assert.Equal(t, int64(5255), g.Refunded(payment.Reference))
+	assert.ErrorIs(t, g.Void(context.TODO(), payment.Reference), errors.ErrFailedPrecondition)
+}
@@ -0,0 +1,169 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+)
+
+// HTTPGateway is a payment gateway talking to a Stripe-like REST API: deposits
+// are authorized as payment intents with manual capture and refunded through
+// the refunds resource.
+type HTTPGateway struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+type (
+	paymentIntentResponse struct {
+		ID       string `json:"id"`
+		Amount   int64  `json:"amount"`
+		Currency string `json:"currency"`
+		Status   string `json:"status"`
+	}
+
+	refundResponse struct {
+		ID     string `json:"id"`
+		Amount int64  `json:"amount"`
+		Status string `json:"status"`
+	}
+
+	errorResponse struct {
+		Error struct {
+			Type    string `json:"type"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+)
+
+var _ domain.PaymentGateway = (*HTTPGateway)(nil)
+
+func NewHTTPGateway(baseURL, apiKey string, client *http.Client) *HTTPGateway {
+	return &HTTPGateway{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  client,
+	}
+}
+
+func (g *HTTPGateway) Authorize(
+	ctx context.Context,
+	req domain.PaymentRequest,
+) (*domain.Payment, error) {
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(req.Amount, 10))
+	form.Set("currency", strings.ToLower(req.Currency))
+	form.Set("payment_method", req.PaymentMethod)
+	form.Set("description", req.Description)
+	form.Set("capture_method", "manual")
+	form.Set("confirm", "true")
+
+	intent := &paymentIntentResponse{}
+	if err := g.post(ctx, "/v1/payment_intents", req.IdempotencyKey, form, intent); err != nil {
+		return nil, err
+	}
+	return &domain.Payment{
+		Reference: intent.ID,
+		Amount:    intent.Amount,
+		Currency:  strings.ToUpper(intent.Currency),
+	}, nil
+}
+
+func (g *HTTPGateway) Capture(ctx context.Context, paymentRef string) error {
+	path := "/v1/payment_intents/" + url.PathEscape(paymentRef) + "/capture"
+	return g.post(ctx, path, "capture-"+paymentRef, url.Values{}, nil)
+}
+
+func (g *HTTPGateway) Void(ctx context.Context, paymentRef string) error {
+	path := "/v1/payment_intents/" + url.PathEscape(paymentRef) + "/cancel"
+	return g.post(ctx, path, "void-"+paymentRef, url.Values{}, nil)
+}
+
+func (g *HTTPGateway) Refund(
+	ctx context.Context,
+	req domain.RefundRequest,
+) (*domain.Refund, error) {
+	form := url.Values{}
+	form.Set("payment_intent", req.PaymentRef)
+	form.Set("amount", strconv.FormatInt(req.Amount, 10))
+
+	refund := &refundResponse{}
+	if err := g.post(ctx, "/v1/refunds", req.IdempotencyKey, form, refund); err != nil {
+		return nil, err
+	}
+	return &domain.Refund{Reference: refund.ID, Amount: refund.Amount}, nil
+}
+
+func (g *HTTPGateway) post(
+	ctx context.Context,
+	path string,
+	idempotencyKey string,
+	form url.Values,
+	result any,
+) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, g.baseURL+path, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "create request for %s", path)
+	}
+	req.Header.Set("Authorization", "Bearer "+g.apiKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return errors.ErrUnavailable.Wrapf(err, "post %s", path)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.ErrUnavailable.Wrapf(err, "read response of %s", path)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return responseError(resp.StatusCode, body)
+	}
+	if result == nil {
+		return nil
+	}
+	if err = json.Unmarshal(body, result); err != nil {
+		return errors.Wrapf(err, "decode response of %s", path)
+	}
+	return nil
+}
+
+// responseError maps an error response of the payment API to an error, card
+// errors are returned as domain.ErrPaymentDeclined.
+func responseError(statusCode int, body []byte) error {
+	errResp := &errorResponse{}
+	_ = json.Unmarshal(body, errResp)
+	msg := errResp.Error.Message
+	if msg == "" {
+		msg = http.StatusText(statusCode)
+	}
+
+	switch {
+	case statusCode == http.StatusPaymentRequired || errResp.Error.Type == "card_error":
+		return domain.ErrPaymentDeclined{Reason: msg}
+	case statusCode == http.StatusNotFound:
+		return errors.ErrNotFound.Msg(msg)
+	case statusCode == http.StatusConflict:
+		return errors.ErrConflict.Msg(msg)
+	case statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError:
+		return errors.ErrUnavailable.Msg(msg)
+	default:
+		return errors.ErrBadRequest.Msg(msg)
+	}
+}
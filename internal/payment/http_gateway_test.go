@@ -0,0 +1,212 @@
+package payment
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAPIKey = "sk_test_key"
+
+type recordedRequest struct {
+	method         string
+	path           string
+	authorization  string
+	idempotencyKey string
+	form           url.Values
+}
+
+func newTestServer(
+	t *testing.T,
+	status int,
+	body string,
+) (*httptest.Server, *recordedRequest) {
+	t.Helper()
+	recorded := &recordedRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		form, _ := url.ParseQuery(string(raw))
+		*recorded = recordedRequest{
+			method:         r.Method,
+			path:           r.URL.Path,
+			authorization:  r.Header.Get("Authorization"),
+			idempotencyKey: r.Header.Get("Idempotency-Key"),
+			form:           form,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, recorded
+}
+
+func TestHTTPGateway_Authorize(t *testing.T) {
+	req := domain.PaymentRequest{
+		IdempotencyKey: "booking-id",
+		PaymentMethod:  "pm_card_visa",
+		Description:    "Deposit for booking booking-id",
+		Amount:         5255,
+		Currency:       "USD",
+	}
+
+	tests := map[string]struct {
+		status  int
+		body    string
+		want    *domain.Payment
+		wantErr error
+	}{
+		"Success": {
+			status: http.StatusOK,
+			body:   `{"id":"pi_123","amount":5255,"currency":"usd","status":"requires_capture"}`,
+			want:   &domain.Payment{Reference: "pi_123", Amount: 5255, Currency: "USD"},
+		},
+		"Error_CardDeclined": {
+			status: http.StatusPaymentRequired,
+			body: `{"error":{"type":"card_error","code":"card_declined",` +
+				`"message":"Your card was declined."}}`,
+			wantErr: domain.ErrPaymentDeclined{Reason: "Your card was declined."},
+		},
+		"Error_ServerError": {
+			status:  http.StatusInternalServerError,
+			body:    `{"error":{"type":"api_error","message":"Something went wrong."}}`,
+			wantErr: errors.ErrUnavailable,
+		},
+		"Error_InvalidRequest": {
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"type":"invalid_request_error","message":"Invalid currency."}}`,
+			wantErr: errors.ErrBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			srv, recorded := newTestServer(t, tc.status, tc.body)
+			g := NewHTTPGateway(srv.URL, testAPIKey, srv.Client())
+			// when
+			got, err := g.Authorize(context.TODO(), req)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"HTTPGateway.Authorize() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, http.MethodPost, recorded.method)
+			assert.Equal(t, "/v1/payment_intents", recorded.path)
+			assert.Equal(t, "Bearer "+testAPIKey, recorded.authorization)
+			assert.Equal(t, req.IdempotencyKey, recorded.idempotencyKey)
+			assert.Equal(t, "5255", recorded.form.Get("amount"))
+			assert.Equal(t, "usd", recorded.form.Get("currency"))
+			assert.Equal(t, req.PaymentMethod, recorded.form.Get("payment_method"))
+			assert.Equal(t, "manual", recorded.form.Get("capture_method"))
+		})
+	}
+}
+
+func TestHTTPGateway_Capture(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr error
+	}{
+		"Success": {
+			status: http.StatusOK,
+			body:   `{"id":"pi_123","amount":5255,"currency":"usd","status":"succeeded"}`,
+		},
+		"Error_NotFound": {
+			status:  http.StatusNotFound,
+			body:    `{"error":{"type":"invalid_request_error","message":"No such payment_intent."}}`,
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			srv, recorded := newTestServer(t, tc.status, tc.body)
+			g := NewHTTPGateway(srv.URL, testAPIKey, srv.Client())
+			// when
+			err := g.Capture(context.TODO(), "pi_123")
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"HTTPGateway.Capture() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, "/v1/payment_intents/pi_123/capture", recorded.path)
+			assert.Equal(t, "capture-pi_123", recorded.idempotencyKey)
+		})
+	}
+}
+
+func TestHTTPGateway_Void(t *testing.T) {
+	// given
+	srv, recorded := newTestServer(t, http.StatusOK,
+		`{"id":"pi_123","amount":5255,"currency":"usd","status":"canceled"}`)
+	g := NewHTTPGateway(srv.URL, testAPIKey, srv.Client())
+	// when
+	err := g.Void(context.TODO(), "pi_123")
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/payment_intents/pi_123/cancel", recorded.path)
+	assert.Equal(t, "void-pi_123", recorded.idempotencyKey)
+}
+
+func TestHTTPGateway_Refund(t *testing.T) {
+	req := domain.RefundRequest{
+		IdempotencyKey: "refund-booking-id",
+		PaymentRef:     "pi_123",
+		Amount:         5255,
+	}
+
+	tests := map[string]struct {
+		status  int
+		body    string
+		want    *domain.Refund
+		wantErr error
+	}{
+		"Success": {
+			status: http.StatusOK,
+			body:   `{"id":"re_123","amount":5255,"status":"succeeded"}`,
+			want:   &domain.Refund{Reference: "re_123", Amount: 5255},
+		},
+		"Error_TooManyRequests": {
+			status:  http.StatusTooManyRequests,
+			body:    `{"error":{"type":"rate_limit_error","message":"Too many requests."}}`,
+			wantErr: errors.ErrUnavailable,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			srv, recorded := newTestServer(t, tc.status, tc.body)
+			g := NewHTTPGateway(srv.URL, testAPIKey, srv.Client())
+			// when
+			got, err := g.Refund(context.TODO(), req)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"HTTPGateway.Refund() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, "/v1/refunds", recorded.path)
+			assert.Equal(t, req.IdempotencyKey, recorded.idempotencyKey)
+			assert.Equal(t, req.PaymentRef, recorded.form.Get("payment_intent"))
+			assert.Equal(t, "5255", recorded.form.Get("amount"))
+		})
+	}
+}
+
+func TestHTTPGateway_Unavailable(t *testing.T) {
+	// given
+	srv := httptest.NewServer(http.NotFoundHandler())
+	g := NewHTTPGateway(srv.URL, testAPIKey, srv.Client())
+	srv.Close()
+	// when
+	err := g.Capture(context.TODO(), "pi_123")
+	// then
+	assert.ErrorIs(t, err, errors.ErrUnavailable,
+		"HTTPGateway.Capture() error = %v, wantErr %v", err, errors.ErrUnavailable)
+}
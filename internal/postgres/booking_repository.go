@@ -18,14 +18,18 @@ const (
 )
 
 type BookingRepository struct {
-	db *sql.DB
+	db DB
 }
 
-type retryableOperation func(ctx context.Context, r BookingRepository, booking *domain.Booking) error
+type retryableOperation func(
+	ctx context.Context,
+	r BookingRepository,
+	bookings []*domain.Booking,
+) error
 
 var _ domain.BookingRepository = (*BookingRepository)(nil)
 
-func NewBookingRepository(db *sql.DB) BookingRepository {
+func NewBookingRepository(db DB) BookingRepository {
 	return BookingRepository{db}
 }
 
@@ -34,15 +38,12 @@ func (r BookingRepository) Find(ctx context.Context, bookingID string) (*domain.
 	if err != nil {
 		return nil, errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
-	booking := &domain.Booking{}
-	if err = tx.QueryRowContext(
-		ctx, queries.FindBookingByBookingID, bookingID,
-	).Scan(
-		&booking.ID, &booking.BookingID, &booking.CampsiteID, &booking.Email,
-		&booking.FullName, &booking.StartDate, &booking.EndDate, &booking.Active, &booking.Version,
-	); err != nil {
+	booking, err := scanBooking(
+		tx.QueryRowContext(ctx, queries.FindBookingByBookingID, bookingID).Scan,
+	)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrBookingNotFound{BookingID: bookingID}
 		}
@@ -62,7 +63,7 @@ func (r BookingRepository) FindForDateRange(
 	if err != nil {
 		return nil, errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
 	bookings, err := r.findForDateRangeWithTx(
 		ctx, tx, queries.FindAllBookingsForDateRange, campsiteID, startDate, endDate,
@@ -76,17 +77,107 @@ func (r BookingRepository) FindForDateRange(
 	return bookings, nil
 }
 
+func (r BookingRepository) FindByGroupID(
+	ctx context.Context,
+	groupID string,
+) (bookings []*domain.Booking, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rows, err := tx.QueryContext(ctx, queries.FindAllBookingsByGroupID, groupID)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bookings by group")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var booking *domain.Booking
+		if booking, err = scanBooking(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan booking row")
+		}
+		bookings = append(bookings, booking)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish booking rows")
+	}
+	if len(bookings) == 0 {
+		return nil, domain.ErrGroupBookingNotFound{GroupID: groupID}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return bookings, nil
+}
+
+func (r BookingRepository) FindByGuestID(
+	ctx context.Context,
+	guestID string,
+) (bookings []*domain.Booking, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rows, err := tx.QueryContext(ctx, queries.FindAllBookingsByGuestID, guestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bookings by guest")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var booking *domain.Booking
+		if booking, err = scanBooking(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan booking row")
+		}
+		bookings = append(bookings, booking)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish booking rows")
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return bookings, nil
+}
+
 func (r BookingRepository) Insert(ctx context.Context, booking *domain.Booking) error {
-	return r.retryTransaction(ctx, booking, "insert booking", insertTx)
+	return r.retryTransaction(ctx, []*domain.Booking{booking}, "insert booking", insertTx)
+}
+
+func (r BookingRepository) InsertGroup(ctx context.Context, bookings []*domain.Booking) error {
+	return r.retryTransaction(ctx, bookings, "insert group booking", insertTx)
 }
 
-func insertTx(ctx context.Context, r BookingRepository, booking *domain.Booking) error {
+func insertTx(ctx context.Context, r BookingRepository, bookings []*domain.Booking) error {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false})
 	if err != nil {
 		return errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
+	for _, booking := range bookings {
+		if err = r.insertWithTx(ctx, tx, booking); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r BookingRepository) insertWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	booking *domain.Booking,
+) error {
 	query := queries.FindAllBookingsForDateRange + " FOR UPDATE"
 	bookings, err := r.findForDateRangeWithTx(
 		ctx, tx, query, booking.CampsiteID, booking.StartDate, booking.EndDate,
@@ -100,58 +191,164 @@ func insertTx(ctx context.Context, r BookingRepository, booking *domain.Booking)
 			EndDate:   booking.EndDate,
 		}
 	}
+	if err = checkBlackoutsWithTx(ctx, tx, booking); err != nil {
+		return err
+	}
+	if err = checkExternalBlocksWithTx(ctx, tx, booking); err != nil {
+		return err
+	}
+	if err = checkWaitlistOffersWithTx(ctx, tx, booking); err != nil {
+		return err
+	}
 
 	_, err = tx.ExecContext(
 		ctx, queries.InsertBooking, booking.BookingID, booking.CampsiteID, booking.Email,
-		booking.FullName, booking.StartDate, booking.EndDate, booking.Active, 1,
+		booking.FullName, booking.StartDate, booking.EndDate, booking.Status, 1, booking.Guests,
+		booking.TotalPrice, booking.Currency, booking.DepositAmount, booking.PaymentRef,
+		booking.RefundPercent, booking.RefundAmount, booking.GroupID, booking.GuestID,
 	)
 	if err != nil {
 		return errors.Wrap(err, "insert booking")
 	}
-
-	if err = tx.Commit(); err != nil {
-		return errors.Wrap(err, "commit transaction")
-	}
 	return nil
 }
 
 func (r BookingRepository) Update(ctx context.Context, booking *domain.Booking) error {
+	return r.updateTx(ctx, []*domain.Booking{booking})
+}
+
+func (r BookingRepository) UpdateGroup(ctx context.Context, bookings []*domain.Booking) error {
+	return r.updateTx(ctx, bookings)
+}
+
+func (r BookingRepository) updateTx(ctx context.Context, bookings []*domain.Booking) error {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted, ReadOnly: false})
 	if err != nil {
 		return errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
+	versions := make([]int64, len(bookings))
+	for i, booking := range bookings {
+		if versions[i], err = r.updateWithTx(ctx, tx, booking); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	// the bookings can be updated again once they carry their new version
+	for i, booking := range bookings {
+		booking.Version = versions[i]
+	}
+	return nil
+}
+
+func (r BookingRepository) updateWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	booking *domain.Booking,
+) (int64, error) {
 	query := queries.FindAllBookingsForDateRange + "FOR UPDATE"
 	bookings, err := r.findForDateRangeWithTx(
 		ctx, tx, query, booking.CampsiteID, booking.StartDate, booking.EndDate,
 	)
 	if err != nil {
-		return errors.Wrap(err, "query bookings for date range")
+		return 0, errors.Wrap(err, "query bookings for date range")
 	}
 
 	for _, b := range bookings {
 		if b.BookingID != booking.BookingID {
-			return domain.ErrBookingDatesNotAvailable{
+			return 0, domain.ErrBookingDatesNotAvailable{
 				StartDate: booking.StartDate,
 				EndDate:   booking.EndDate,
 			}
 		}
 	}
-	var newVersion int
+	if booking.Occupies() {
+		if err = checkBlackoutsWithTx(ctx, tx, booking); err != nil {
+			return 0, err
+		}
+		if err = checkExternalBlocksWithTx(ctx, tx, booking); err != nil {
+			return 0, err
+		}
+		if err = checkWaitlistOffersWithTx(ctx, tx, booking); err != nil {
+			return 0, err
+		}
+	}
+	var newVersion int64
 	err = tx.QueryRowContext(
 		ctx, queries.UpdateBooking, booking.BookingID, booking.CampsiteID, booking.Email,
-		booking.FullName, booking.StartDate, booking.EndDate, booking.Active, booking.Version,
+		booking.FullName, booking.StartDate, booking.EndDate, booking.Status, booking.Version,
+		booking.Guests, booking.TotalPrice, booking.Currency, booking.DepositAmount,
+		booking.PaymentRef, booking.RefundPercent, booking.RefundAmount,
 	).Scan(&newVersion)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrBookingConcurrentUpdate{}
+			return 0, domain.ErrBookingConcurrentUpdate{}
 		}
-		return errors.Wrap(err, "update booking")
+		return 0, errors.Wrap(err, "update booking")
 	}
+	return newVersion, nil
+}
 
-	if err = tx.Commit(); err != nil {
-		return errors.Wrap(err, "commit transaction")
+// checkBlackoutsWithTx treats blackouts of the campsite like overlapping
+// occupying bookings, reporting the reason the campsite is closed.
+func checkBlackoutsWithTx(ctx context.Context, tx *sql.Tx, booking *domain.Booking) error {
+	blackouts, err := findCampsiteBlackoutsWithTx(
+		ctx, tx, queries.FindAllCampsiteBlackoutsForDateRange,
+		booking.CampsiteID, booking.StartDate, booking.EndDate,
+	)
+	if err != nil {
+		return errors.Wrap(err, "query campsite blackouts for date range")
+	}
+	if len(blackouts) > 0 {
+		return domain.ErrBookingDatesBlackedOut{
+			StartDate: booking.StartDate,
+			EndDate:   booking.EndDate,
+			Reason:    blackouts[0].Reason,
+		}
+	}
+	return nil
+}
+
+// checkExternalBlocksWithTx treats dates reserved on third-party booking
+// platforms like overlapping occupying bookings.
+func checkExternalBlocksWithTx(ctx context.Context, tx *sql.Tx, booking *domain.Booking) error {
+	blocks, err := findExternalBlocksWithTx(
+		ctx, tx, queries.FindAllExternalBlocksForDateRange,
+		booking.CampsiteID, booking.StartDate, booking.EndDate,
+	)
+	if err != nil {
+		return errors.Wrap(err, "query external blocks for date range")
+	}
+	if len(blocks) > 0 {
+		return domain.ErrBookingDatesNotAvailable{
+			StartDate: booking.StartDate,
+			EndDate:   booking.EndDate,
+		}
+	}
+	return nil
+}
+
+// checkWaitlistOffersWithTx treats dates held by an unexpired waitlist offer
+// like overlapping occupying bookings, except for the guest offered them.
+func checkWaitlistOffersWithTx(ctx context.Context, tx *sql.Tx, booking *domain.Booking) error {
+	offers, err := findWaitlistEntriesWithTx(
+		ctx, tx, queries.FindOfferedWaitlistEntries,
+		booking.CampsiteID, booking.StartDate, booking.EndDate,
+	)
+	if err != nil {
+		return errors.Wrap(err, "query waitlist offers for date range")
+	}
+	for _, offer := range offers {
+		if domain.NormalizeEmail(offer.Email) != domain.NormalizeEmail(booking.Email) {
+			return domain.ErrBookingDatesNotAvailable{
+				StartDate: booking.StartDate,
+				EndDate:   booking.EndDate,
+			}
+		}
 	}
 	return nil
 }
@@ -164,15 +361,11 @@ func (r BookingRepository) findForDateRangeWithTx(
 	if err != nil {
 		return nil, errors.Wrap(err, "query bookings for date range")
 	}
-	defer closeRows(rows)
+	defer closeRows(ctx, rows)
 
 	for rows.Next() {
-		booking := &domain.Booking{}
-		if err = rows.Scan(
-			&booking.ID, &booking.BookingID, &booking.CampsiteID, &booking.Email,
-			&booking.FullName, &booking.StartDate, &booking.EndDate, &booking.Active,
-			&booking.Version,
-		); err != nil {
+		var booking *domain.Booking
+		if booking, err = scanBooking(rows.Scan); err != nil {
 			return nil, errors.Wrap(err, "scan booking row")
 		}
 		bookings = append(bookings, booking)
@@ -186,12 +379,12 @@ func (r BookingRepository) findForDateRangeWithTx(
 
 func (r BookingRepository) retryTransaction(
 	ctx context.Context,
-	b *domain.Booking,
+	bookings []*domain.Booking,
 	txName string,
 	op retryableOperation,
 ) error {
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		err := op(ctx, r, b)
+		err := op(ctx, r, bookings)
 		if err == nil {
 			return nil
 		}
@@ -199,7 +392,8 @@ func (r BookingRepository) retryTransaction(
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == "40001" { // serialization failure
 				backoff := backoffBase * time.Duration(attempt)
-				slog.Warn(
+				slog.WarnContext(
+					ctx,
 					"failed to execute transaction (serialization error)",
 					"tx_name",
 					txName,
@@ -221,3 +415,17 @@ func (r BookingRepository) retryTransaction(
 		maxAttempts,
 	)
 }
+
+func scanBooking(scan func(dest ...any) error) (*domain.Booking, error) {
+	booking := &domain.Booking{}
+	if err := scan(
+		&booking.ID, &booking.BookingID, &booking.CampsiteID, &booking.Email,
+		&booking.FullName, &booking.StartDate, &booking.EndDate, &booking.Status,
+		&booking.Version, &booking.Guests, &booking.TotalPrice, &booking.Currency,
+		&booking.DepositAmount, &booking.PaymentRef, &booking.RefundPercent,
+		&booking.RefundAmount, &booking.GroupID, &booking.GuestID,
+	); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
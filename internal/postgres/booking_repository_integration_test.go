@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
@@ -278,6 +279,31 @@ func (s *bookingSuite) TestBookingRepository_Insert_ErrBookingDatesNotAvailable(
 	}
 }
 
+func (s *bookingSuite) TestBookingRepository_Insert_NoShowVacatesDates() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+
+	err = bootstrap.InsertCampsite(s.db, campsite)
+	s.NoError(err)
+
+	booking1, err := bootstrap.NewBooking(campsite.CampsiteID)
+	s.NoError(err)
+	booking1.Status = domain.BookingStatusNoShow
+
+	err = bootstrap.InsertBooking(s.db, booking1)
+	s.NoError(err)
+
+	booking2, err := bootstrap.NewBooking(campsite.CampsiteID)
+	s.NoError(err)
+	booking2.StartDate = booking1.StartDate
+	booking2.EndDate = booking1.EndDate
+	// when
+	err = s.repo.Insert(context.Background(), booking2)
+	// then
+	s.NoError(err)
+}
+
 func (s *bookingSuite) TestBookingRepository_Update_Success() {
 	// given
 	campsite1, err := bootstrap.NewCampsite()
@@ -305,7 +331,7 @@ func (s *bookingSuite) TestBookingRepository_Update_Success() {
 	s.NoError(err)
 
 	bookingToUpdate.BookingID = existingBooking.BookingID
-	bookingToUpdate.Active = !existingBooking.Active
+	bookingToUpdate.Status = domain.BookingStatusCheckedIn
 	// when
 	err = s.repo.Update(context.Background(), bookingToUpdate)
 	// then
@@ -320,7 +346,7 @@ func (s *bookingSuite) TestBookingRepository_Update_Success() {
 		s.NotEqual(existingBooking.FullName, updatedBooking.FullName)
 		s.NotEqual(existingBooking.StartDate, updatedBooking.StartDate)
 		s.NotEqual(existingBooking.EndDate, updatedBooking.EndDate)
-		s.NotEqual(existingBooking.Active, updatedBooking.Active)
+		s.NotEqual(existingBooking.Status, updatedBooking.Status)
 	}
 }
 
@@ -358,3 +384,65 @@ func (s *bookingSuite) TestBookingRepository_Update_ErrBookingDatesNotAvailable(
 		s.Equal(errMsg, err.Error())
 	}
 }
+
+func (s *bookingSuite) TestBookingRepository_InsertGroup_Success() {
+	// given
+	groupID := uuid.New().String()
+	var bookings []*domain.Booking
+	for i := 0; i < 2; i++ {
+		campsite, err := bootstrap.NewCampsite()
+		s.NoError(err)
+		s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+		booking, err := bootstrap.NewBooking(campsite.CampsiteID)
+		s.NoError(err)
+		booking.GroupID = groupID
+		bookings = append(bookings, booking)
+	}
+	// when
+	err := s.repo.InsertGroup(context.Background(), bookings)
+	// then
+	if s.NoError(err) {
+		groupBookings, err := s.repo.FindByGroupID(context.Background(), groupID)
+		s.NoError(err)
+		s.Len(groupBookings, 2)
+		s.Equal(bookings[0].BookingID, groupBookings[0].BookingID)
+		s.Equal(bookings[1].BookingID, groupBookings[1].BookingID)
+	}
+}
+
+func (s *bookingSuite) TestBookingRepository_InsertGroup_ErrBookingDatesNotAvailable() {
+	// given
+	campsite1, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite1))
+
+	campsite2, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite2))
+
+	existingBooking, err := bootstrap.NewBooking(campsite2.CampsiteID)
+	s.NoError(err)
+	s.NoError(bootstrap.InsertBooking(s.db, existingBooking))
+
+	groupID := uuid.New().String()
+	booking1, err := bootstrap.NewBooking(campsite1.CampsiteID)
+	s.NoError(err)
+	booking1.GroupID = groupID
+	booking2, err := bootstrap.NewBooking(campsite2.CampsiteID)
+	s.NoError(err)
+	booking2.GroupID = groupID
+	booking2.StartDate = existingBooking.StartDate
+	booking2.EndDate = existingBooking.EndDate
+	// when
+	err = s.repo.InsertGroup(context.Background(), []*domain.Booking{booking1, booking2})
+	// then
+	if s.Error(err) {
+		s.True(errors.Is(err, domain.ErrBookingDatesNotAvailable{
+			StartDate: booking2.StartDate,
+			EndDate:   booking2.EndDate,
+		}))
+		_, err = s.repo.FindByGroupID(context.Background(), groupID)
+		s.True(errors.Is(err, domain.ErrGroupBookingNotFound{GroupID: groupID}))
+	}
+}
@@ -5,7 +5,9 @@ package postgres
 import (
 	"context"
 	"database/sql/driver"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -24,8 +26,17 @@ var columnsRow = []string{
 	"full_name",
 	"start_date",
 	"end_date",
-	"active",
+	"status",
 	"version",
+	"guests",
+	"total_price",
+	"currency",
+	"deposit_amount",
+	"payment_ref",
+	"refund_percent",
+	"refund_amount",
+	"group_id",
+	"guest_id",
 }
 
 func TestBookingRepository_Find(t *testing.T) {
@@ -232,6 +243,20 @@ func TestBookingRepository_Insert(t *testing.T) {
 		StartDate: startDate,
 		EndDate:   endDate,
 	}
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	errBookingDatesBlackedOut := domain.ErrBookingDatesBlackedOut{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    blackout.Reason,
+	}
+	// offer of the booked dates to the guest with the email
+	offer := func(email string) *domain.WaitlistEntry {
+		entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+		entry.Email = strings.ToUpper(email)
+		entry.StartDate, entry.EndDate = startDate, endDate
+		entry.Offer(campsiteID, time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+		return entry
+	}
 
 	tests := map[string]struct {
 		mockTxPhases func(mock sqlmock.Sqlmock)
@@ -244,8 +269,17 @@ func TestBookingRepository_Insert(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectExec(queries.InsertBooking).
-					WithArgs(bookingArgs(booking)...).
+					WithArgs(insertBookingArgs(booking)...).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
@@ -263,6 +297,81 @@ func TestBookingRepository_Insert(t *testing.T) {
 			},
 			wantErr: errBookingDatesNotAvailable,
 		},
+		"Error_BookingDatesBlackedOut": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow).
+						AddRow(blackoutRowValues(blackout)...))
+				mock.ExpectRollback()
+			},
+			wantErr: errBookingDatesBlackedOut,
+		},
+		"Error_BookingDatesNotAvailable_ExternalBlock": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				block := bootstrap.NewExternalBlock(bootstrap.NewCalendarSubscription(campsiteID))
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow).
+						AddRow(externalBlockRowValues(block)...))
+				mock.ExpectRollback()
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
+		"Success_OfferedToGuest": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow).
+						AddRow(waitlistEntryRowValues(offer(booking.Email))...))
+				mock.ExpectExec(queries.InsertBooking).
+					WithArgs(insertBookingArgs(booking)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_BookingDatesNotAvailable_WaitlistOffer": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow).
+						AddRow(waitlistEntryRowValues(offer("other@example.com"))...))
+				mock.ExpectRollback()
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
 		"Error_BeginTx": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
@@ -286,8 +395,17 @@ func TestBookingRepository_Insert(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectExec(queries.InsertBooking).
-					WithArgs(bookingArgs(booking)...).
+					WithArgs(insertBookingArgs(booking)...).
 					WillReturnError(bootstrap.ErrExec)
 				mock.ExpectRollback()
 			},
@@ -313,8 +431,17 @@ func TestBookingRepository_Insert(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectExec(queries.InsertBooking).
-					WithArgs(bookingArgs(booking)...).
+					WithArgs(insertBookingArgs(booking)...).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
 			},
@@ -328,8 +455,17 @@ func TestBookingRepository_Insert(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectExec(queries.InsertBooking).
-					WithArgs(bookingArgs(booking)...).
+					WithArgs(insertBookingArgs(booking)...).
 					WillReturnError(&bootstrap.ErrSerializationTx)
 				mock.ExpectRollback()
 				// 2nd attempt
@@ -337,8 +473,17 @@ func TestBookingRepository_Insert(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectExec(queries.InsertBooking).
-					WithArgs(bookingArgs(booking)...).
+					WithArgs(insertBookingArgs(booking)...).
 					WillReturnError(&bootstrap.ErrSerializationTx)
 				mock.ExpectRollback()
 			},
@@ -383,6 +528,12 @@ func TestBookingRepository_Update(t *testing.T) {
 		StartDate: startDate,
 		EndDate:   endDate,
 	}
+	blackout := bootstrap.NewCampsiteBlackout(campsiteID)
+	errBookingDatesBlackedOut := domain.ErrBookingDatesBlackedOut{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    blackout.Reason,
+	}
 
 	tests := map[string]struct {
 		mockTxPhases func(mock sqlmock.Sqlmock)
@@ -395,6 +546,15 @@ func TestBookingRepository_Update(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectQuery(queries.UpdateBooking).
 					WithArgs(bookingArgs(booking)...).
 					WillReturnRows(sqlmock.NewRows([]string{"new_version"}).AddRow(booking.Version + 1))
@@ -414,6 +574,20 @@ func TestBookingRepository_Update(t *testing.T) {
 			},
 			wantErr: errBookingDatesNotAvailable,
 		},
+		"Error_BookingDatesBlackedOut": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(booking.CampsiteID, booking.StartDate, booking.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow).
+						AddRow(blackoutRowValues(blackout)...))
+				mock.ExpectRollback()
+			},
+			wantErr: errBookingDatesBlackedOut,
+		},
 		"Error_BeginTx": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
@@ -437,6 +611,15 @@ func TestBookingRepository_Update(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectQuery(queries.UpdateBooking).
 					WithArgs(bookingArgs(booking)...).
 					WillReturnError(bootstrap.ErrQuery)
@@ -464,6 +647,15 @@ func TestBookingRepository_Update(t *testing.T) {
 				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
 					WithArgs(campsiteID, startDate, endDate).
 					WillReturnRows(rows)
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
 				mock.ExpectQuery(queries.UpdateBooking).
 					WithArgs(bookingArgs(booking)...).
 					WillReturnRows(sqlmock.NewRows([]string{"new_version"}).AddRow(booking.Version + 1))
@@ -484,17 +676,373 @@ func TestBookingRepository_Update(t *testing.T) {
 
 			tc.mockTxPhases(mock)
 			repo := NewBookingRepository(db)
+			updated := *booking
 			// when
-			err = repo.Update(context.TODO(), booking)
+			err = repo.Update(context.TODO(), &updated)
 			// then
 			assert.ErrorIs(t, err, tc.wantErr,
 				"Update() error = %v, wantErr %v", err, tc.wantErr)
+			wantVersion := booking.Version
+			if tc.wantErr == nil {
+				wantVersion++
+			}
+			assert.Equal(t, wantVersion, updated.Version)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBookingRepository_FindByGroupID(t *testing.T) {
+	groupID := uuid.New().String()
+	booking, err := bootstrap.NewBooking(uuid.New().String())
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.ID = 1
+	booking.GroupID = groupID
+	errGroupBookingNotFound := domain.ErrGroupBookingNotFound{GroupID: groupID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.Booking
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columnsRow).
+					AddRow(bookingRowValues(booking)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGroupID).
+					WithArgs(groupID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    []*domain.Booking{booking},
+			wantErr: nil,
+		},
+		"Error_NoGroupBookingFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGroupID).
+					WithArgs(groupID).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errGroupBookingNotFound,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGroupID).
+					WithArgs(groupID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewBookingRepository(db)
+			// when
+			got, err := repo.FindByGroupID(context.TODO(), groupID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindByGroupID() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindByGroupID() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBookingRepository_FindByGuestID(t *testing.T) {
+	guestID := uuid.New().String()
+	booking, err := bootstrap.NewBooking(uuid.New().String())
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.ID = 1
+	booking.GuestID = guestID
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.Booking
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columnsRow).
+					AddRow(bookingRowValues(booking)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGuestID).
+					WithArgs(guestID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    []*domain.Booking{booking},
+			wantErr: nil,
+		},
+		"Success_NoBookings": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGuestID).
+					WithArgs(guestID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsByGuestID).
+					WithArgs(guestID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewBookingRepository(db)
+			// when
+			got, err := repo.FindByGuestID(context.TODO(), guestID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindByGuestID() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindByGuestID() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBookingRepository_InsertGroup(t *testing.T) {
+	groupID := uuid.New().String()
+	var bookings []*domain.Booking
+	for i := 0; i < 2; i++ {
+		booking, err := bootstrap.NewBooking(uuid.New().String())
+		if err != nil {
+			t.Fatalf("create booking error: %v", err)
+		}
+		booking.GroupID = groupID
+		bookings = append(bookings, booking)
+	}
+	errBookingDatesNotAvailable := domain.ErrBookingDatesNotAvailable{
+		StartDate: bookings[1].StartDate,
+		EndDate:   bookings[1].EndDate,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				for _, b := range bookings {
+					mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(columnsRow))
+					mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+					mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+					mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+					mock.ExpectExec(queries.InsertBooking).
+						WithArgs(insertBookingArgs(b)...).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				}
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_BookingDatesNotAvailable_NoneInserted": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+				mock.ExpectExec(queries.InsertBooking).
+					WithArgs(insertBookingArgs(bookings[0])...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(bookings[1].CampsiteID, bookings[1].StartDate, bookings[1].EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow).
+						AddRow(bookingRowValues(bookings[1])...))
+				mock.ExpectRollback()
+			},
+			wantErr: errBookingDatesNotAvailable,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewBookingRepository(db)
+			// when
+			err = repo.InsertGroup(context.TODO(), bookings)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"InsertGroup() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBookingRepository_UpdateGroup(t *testing.T) {
+	groupID := uuid.New().String()
+	var bookings []*domain.Booking
+	for i := 0; i < 2; i++ {
+		booking, err := bootstrap.NewBooking(uuid.New().String())
+		if err != nil {
+			t.Fatalf("create booking error: %v", err)
+		}
+		booking.GroupID = groupID
+		bookings = append(bookings, booking)
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				for _, b := range bookings {
+					mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(columnsRow))
+					mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+					mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+					mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+						WithArgs(b.CampsiteID, b.StartDate, b.EndDate).
+						WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+					mock.ExpectQuery(queries.UpdateBooking).
+						WithArgs(bookingArgs(b)...).
+						WillReturnRows(sqlmock.NewRows([]string{"new_version"}).AddRow(b.Version + 1))
+				}
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_QueryUpdateBooking_NoneUpdated": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(bookings[0].CampsiteID, bookings[0].StartDate, bookings[0].EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+				mock.ExpectQuery(queries.UpdateBooking).
+					WithArgs(bookingArgs(bookings[0])...).
+					WillReturnRows(sqlmock.NewRows([]string{"new_version"}).
+						AddRow(bookings[0].Version + 1))
+				mock.ExpectQuery(queries.FindAllBookingsForDateRange+"FOR UPDATE").
+					WithArgs(bookings[1].CampsiteID, bookings[1].StartDate, bookings[1].EndDate).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(bookings[1].CampsiteID, bookings[1].StartDate, bookings[1].EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(bookings[1].CampsiteID, bookings[1].StartDate, bookings[1].EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow))
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(bookings[1].CampsiteID, bookings[1].StartDate, bookings[1].EndDate).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+				mock.ExpectQuery(queries.UpdateBooking).
+					WithArgs(bookingArgs(bookings[1])...).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewBookingRepository(db)
+			// when
+			err = repo.UpdateGroup(context.TODO(), bookings)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"UpdateGroup() error = %v, wantErr %v", err, tc.wantErr)
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
 func bookingArgs(b *domain.Booking) []driver.Value {
+	values := bookingRowValues(b)
+	return values[1 : len(values)-2] // remove ID, GroupID and GuestID
+}
+
+func insertBookingArgs(b *domain.Booking) []driver.Value {
 	return bookingRowValues(b)[1:] // remove ID
 }
 
@@ -507,7 +1055,16 @@ func bookingRowValues(b *domain.Booking) []driver.Value {
 		b.FullName,
 		b.StartDate,
 		b.EndDate,
-		b.Active,
+		b.Status,
 		b.Version,
+		b.Guests,
+		b.TotalPrice,
+		b.Currency,
+		b.DepositAmount,
+		b.PaymentRef,
+		b.RefundPercent,
+		b.RefundAmount,
+		b.GroupID,
+		b.GuestID,
 	}
 }
@@ -0,0 +1,309 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/stackus/errors"
+)
+
+type CalendarSubscriptionRepository struct {
+	db DB
+}
+
+var _ domain.CalendarSubscriptionRepository = (*CalendarSubscriptionRepository)(nil)
+
+func NewCalendarSubscriptionRepository(db DB) CalendarSubscriptionRepository {
+	return CalendarSubscriptionRepository{db}
+}
+
+func (r CalendarSubscriptionRepository) Find(
+	ctx context.Context,
+	subscriptionID string,
+) (*domain.CalendarSubscription, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	subscription, err := scanCalendarSubscription(
+		tx.QueryRowContext(
+			ctx,
+			queries.FindCalendarSubscriptionBySubscriptionID,
+			subscriptionID,
+		).Scan,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCalendarSubscriptionNotFound{SubscriptionID: subscriptionID}
+		}
+		return nil, errors.Wrap(err, "scan calendar subscription row")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return subscription, nil
+}
+
+func (r CalendarSubscriptionRepository) FindByCampsiteID(
+	ctx context.Context,
+	campsiteID string,
+) ([]*domain.CalendarSubscription, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllCalendarSubscriptionsByCampsiteID, campsiteID)
+}
+
+func (r CalendarSubscriptionRepository) FindAllWithURL(
+	ctx context.Context,
+) ([]*domain.CalendarSubscription, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllCalendarSubscriptionsWithURL)
+}
+
+func (r CalendarSubscriptionRepository) FindBlocksForDateRange(
+	ctx context.Context,
+	campsiteID string,
+	startDate time.Time,
+	endDate time.Time,
+) (blocks []*domain.ExternalBlock, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	blocks, err = findExternalBlocksWithTx(
+		ctx, tx, queries.FindAllExternalBlocksForDateRange, campsiteID, startDate, endDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return blocks, nil
+}
+
+func (r CalendarSubscriptionRepository) Insert(
+	ctx context.Context,
+	subscription *domain.CalendarSubscription,
+	blocks []*domain.ExternalBlock,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	lastSyncedAt := sql.NullTime{
+		Time:  subscription.LastSyncedAt,
+		Valid: !subscription.LastSyncedAt.IsZero(),
+	}
+	_, err = tx.ExecContext(ctx, queries.InsertCalendarSubscription,
+		subscription.SubscriptionID, subscription.CampsiteID, subscription.Name,
+		subscription.URL, lastSyncedAt)
+	if err != nil {
+		return errors.Wrap(err, "insert calendar subscription")
+	}
+	if err = insertExternalBlocksWithTx(ctx, tx, blocks); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CalendarSubscriptionRepository) ReplaceBlocks(
+	ctx context.Context,
+	subscriptionID string,
+	blocks []*domain.ExternalBlock,
+	syncedAt time.Time,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(
+		ctx, queries.UpdateCalendarSubscriptionSynced, subscriptionID, syncedAt,
+	)
+	if err != nil {
+		return errors.Wrap(err, "update calendar subscription")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCalendarSubscriptionNotFound{SubscriptionID: subscriptionID}
+		}
+		return errors.Wrap(err, "update calendar subscription")
+	}
+	if _, err = tx.ExecContext(
+		ctx, queries.DeleteExternalBlocksBySubscriptionID, subscriptionID,
+	); err != nil {
+		return errors.Wrap(err, "delete external blocks")
+	}
+	if err = insertExternalBlocksWithTx(ctx, tx, blocks); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CalendarSubscriptionRepository) UpdateSyncError(
+	ctx context.Context,
+	subscriptionID string,
+	reason string,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(
+		ctx, queries.UpdateCalendarSubscriptionSyncError, subscriptionID, reason,
+	)
+	if err != nil {
+		return errors.Wrap(err, "update calendar subscription")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCalendarSubscriptionNotFound{SubscriptionID: subscriptionID}
+		}
+		return errors.Wrap(err, "update calendar subscription")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CalendarSubscriptionRepository) Delete(ctx context.Context, subscriptionID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.DeleteCalendarSubscription, subscriptionID)
+	if err != nil {
+		return errors.Wrap(err, "delete calendar subscription")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCalendarSubscriptionNotFound{SubscriptionID: subscriptionID}
+		}
+		return errors.Wrap(err, "delete calendar subscription")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CalendarSubscriptionRepository) findAllWithQuery(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (subscriptions []*domain.CalendarSubscription, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query calendar subscriptions")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var subscription *domain.CalendarSubscription
+		if subscription, err = scanCalendarSubscription(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan calendar subscription row")
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish calendar subscription rows")
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return subscriptions, nil
+}
+
+func insertExternalBlocksWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	blocks []*domain.ExternalBlock,
+) error {
+	for _, block := range blocks {
+		_, err := tx.ExecContext(ctx, queries.InsertExternalBlock,
+			block.SubscriptionID, block.CampsiteID, block.UID, block.StartDate, block.EndDate,
+			block.Summary)
+		if err != nil {
+			return errors.Wrap(err, "insert external block")
+		}
+	}
+	return nil
+}
+
+// findExternalBlocksWithTx is shared with BookingRepository to check the
+// dates of a booking against external blocks in the transaction saving it.
+func findExternalBlocksWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	args ...any,
+) (blocks []*domain.ExternalBlock, err error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query external blocks")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		block := &domain.ExternalBlock{}
+		if err = rows.Scan(
+			&block.SubscriptionID, &block.CampsiteID, &block.UID, &block.StartDate,
+			&block.EndDate, &block.Summary,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan external block row")
+		}
+		blocks = append(blocks, block)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish external block rows")
+	}
+	return blocks, nil
+}
+
+func scanCalendarSubscription(
+	scan func(dest ...any) error,
+) (*domain.CalendarSubscription, error) {
+	subscription := &domain.CalendarSubscription{}
+	var lastSyncedAt sql.NullTime
+	if err := scan(
+		&subscription.ID, &subscription.SubscriptionID, &subscription.CampsiteID,
+		&subscription.Name, &subscription.URL, &lastSyncedAt, &subscription.LastSyncError,
+	); err != nil {
+		return nil, err
+	}
+	subscription.LastSyncedAt = lastSyncedAt.Time
+	return subscription, nil
+}
@@ -0,0 +1,179 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type calendarSubscriptionSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.CalendarSubscriptionRepository
+	bookings  postgres.BookingRepository
+	suite.Suite
+}
+
+func TestCalendarSubscriptionRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &calendarSubscriptionSuite{})
+}
+
+func (s *calendarSubscriptionSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *calendarSubscriptionSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *calendarSubscriptionSuite) SetupTest() {
+	s.repo = postgres.NewCalendarSubscriptionRepository(s.db)
+	s.bookings = postgres.NewBookingRepository(s.db)
+}
+
+func (s *calendarSubscriptionSuite) TearDownTest() {
+	err := bootstrap.DeleteBookings(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCalendarSubscriptions(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampsites(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *calendarSubscriptionSuite) TestCalendarSubscriptionRepository_Insert() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	subscription := bootstrap.NewCalendarSubscription(campsite.CampsiteID)
+	block := bootstrap.NewExternalBlock(subscription)
+	// when
+	err = s.repo.Insert(context.Background(), subscription, []*domain.ExternalBlock{block})
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), subscription.SubscriptionID)
+		s.NoError(err)
+		subscription.ID = got.ID
+		s.Equal(subscription, got)
+
+		blocks, err := s.repo.FindBlocksForDateRange(
+			context.Background(), campsite.CampsiteID, block.StartDate, block.EndDate,
+		)
+		s.NoError(err)
+		s.Equal([]*domain.ExternalBlock{block}, blocks)
+	}
+}
+
+func (s *calendarSubscriptionSuite) TestCalendarSubscriptionRepository_ReplaceBlocks() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	subscription := bootstrap.NewCalendarSubscription(campsite.CampsiteID)
+	old := bootstrap.NewExternalBlock(subscription)
+	s.NoError(s.repo.Insert(context.Background(), subscription, []*domain.ExternalBlock{old}))
+	s.NoError(s.repo.UpdateSyncError(
+		context.Background(), subscription.SubscriptionID, "unexpected status 500",
+	))
+	block := bootstrap.NewExternalBlock(subscription)
+	block.StartDate = old.EndDate
+	block.EndDate = old.EndDate.AddDate(0, 0, 2)
+	syncedAt := time.Now().UTC().Truncate(time.Second)
+	// when
+	err = s.repo.ReplaceBlocks(
+		context.Background(), subscription.SubscriptionID, []*domain.ExternalBlock{block}, syncedAt,
+	)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), subscription.SubscriptionID)
+		s.NoError(err)
+		s.True(syncedAt.Equal(got.LastSyncedAt))
+		s.Empty(got.LastSyncError)
+
+		blocks, err := s.repo.FindBlocksForDateRange(
+			context.Background(), campsite.CampsiteID, old.StartDate, block.EndDate,
+		)
+		s.NoError(err)
+		s.Equal([]*domain.ExternalBlock{block}, blocks)
+	}
+}
+
+func (s *calendarSubscriptionSuite) TestCalendarSubscriptionRepository_Delete() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	subscription := bootstrap.NewCalendarSubscription(campsite.CampsiteID)
+	block := bootstrap.NewExternalBlock(subscription)
+	s.NoError(s.repo.Insert(context.Background(), subscription, []*domain.ExternalBlock{block}))
+	// when
+	err = s.repo.Delete(context.Background(), subscription.SubscriptionID)
+	// then
+	if s.NoError(err) {
+		_, err = s.repo.Find(context.Background(), subscription.SubscriptionID)
+		s.Equal(domain.ErrCalendarSubscriptionNotFound{
+			SubscriptionID: subscription.SubscriptionID,
+		}, err)
+
+		blocks, err := s.repo.FindBlocksForDateRange(
+			context.Background(), campsite.CampsiteID, block.StartDate, block.EndDate,
+		)
+		s.NoError(err)
+		s.Empty(blocks)
+	}
+}
+
+func (s *calendarSubscriptionSuite) TestBookingRepository_Insert_ErrBookingDatesNotAvailable() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	subscription := bootstrap.NewCalendarSubscription(campsite.CampsiteID)
+	block := bootstrap.NewExternalBlock(subscription)
+	s.NoError(s.repo.Insert(context.Background(), subscription, []*domain.ExternalBlock{block}))
+	booking, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, 2, 4)
+	s.NoError(err)
+	// when
+	err = s.bookings.Insert(context.Background(), booking)
+	// then
+	s.Equal(domain.ErrBookingDatesNotAvailable{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+	}, err)
+}
@@ -0,0 +1,473 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var calendarSubscriptionColumnsRow = []string{
+	"id",
+	"subscription_id",
+	"campsite_id",
+	"name",
+	"url",
+	"last_synced_at",
+	"last_sync_error",
+}
+
+var externalBlockColumnsRow = []string{
+	"subscription_id",
+	"campsite_id",
+	"uid",
+	"start_date",
+	"end_date",
+	"summary",
+}
+
+func TestCalendarSubscriptionRepository_Find(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription(uuid.New().String())
+	subscription.LastSyncedAt = time.Now().UTC()
+	neverSynced := bootstrap.NewCalendarSubscription(uuid.New().String())
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscription.SubscriptionID,
+	}
+
+	tests := map[string]struct {
+		subscription *domain.CalendarSubscription
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.CalendarSubscription
+		wantErr      error
+	}{
+		"Success": {
+			subscription: subscription,
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCalendarSubscriptionBySubscriptionID).
+					WithArgs(subscription.SubscriptionID).
+					WillReturnRows(sqlmock.NewRows(calendarSubscriptionColumnsRow).
+						AddRow(calendarSubscriptionRowValues(subscription)...))
+				mock.ExpectCommit()
+			},
+			want:    subscription,
+			wantErr: nil,
+		},
+		"Success_NeverSynced": {
+			subscription: neverSynced,
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCalendarSubscriptionBySubscriptionID).
+					WithArgs(neverSynced.SubscriptionID).
+					WillReturnRows(sqlmock.NewRows(calendarSubscriptionColumnsRow).
+						AddRow(calendarSubscriptionRowValues(neverSynced)...))
+				mock.ExpectCommit()
+			},
+			want:    neverSynced,
+			wantErr: nil,
+		},
+		"Error_CalendarSubscriptionNotFound": {
+			subscription: subscription,
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCalendarSubscriptionBySubscriptionID).
+					WithArgs(subscription.SubscriptionID).
+					WillReturnRows(sqlmock.NewRows(calendarSubscriptionColumnsRow))
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCalendarSubscriptionNotFound,
+		},
+		"Error_BeginTx": {
+			subscription: subscription,
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), tc.subscription.SubscriptionID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarSubscriptionRepository_FindBlocksForDateRange(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription(uuid.New().String())
+	block := bootstrap.NewExternalBlock(subscription)
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.ExternalBlock
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(block.CampsiteID, block.StartDate, block.EndDate).
+					WillReturnRows(sqlmock.NewRows(externalBlockColumnsRow).
+						AddRow(externalBlockRowValues(block)...))
+				mock.ExpectCommit()
+			},
+			want:    []*domain.ExternalBlock{block},
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllExternalBlocksForDateRange).
+					WithArgs(block.CampsiteID, block.StartDate, block.EndDate).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			got, err := repo.FindBlocksForDateRange(
+				context.TODO(), block.CampsiteID, block.StartDate, block.EndDate,
+			)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindBlocksForDateRange() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindBlocksForDateRange() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarSubscriptionRepository_Insert(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription(uuid.New().String())
+	block := bootstrap.NewExternalBlock(subscription)
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCalendarSubscription).
+					WithArgs(calendarSubscriptionRowValues(subscription)[1:6]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.InsertExternalBlock).
+					WithArgs(externalBlockRowValues(block)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_ExecInsertExternalBlock": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCalendarSubscription).
+					WithArgs(calendarSubscriptionRowValues(subscription)[1:6]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.InsertExternalBlock).
+					WithArgs(externalBlockRowValues(block)...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCalendarSubscription).
+					WithArgs(calendarSubscriptionRowValues(subscription)[1:6]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.InsertExternalBlock).
+					WithArgs(externalBlockRowValues(block)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			err = repo.Insert(context.TODO(), subscription, []*domain.ExternalBlock{block})
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Insert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarSubscriptionRepository_ReplaceBlocks(t *testing.T) {
+	subscription := bootstrap.NewCalendarSubscription(uuid.New().String())
+	block := bootstrap.NewExternalBlock(subscription)
+	syncedAt := time.Now().UTC()
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscription.SubscriptionID,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCalendarSubscriptionSynced).
+					WithArgs(subscription.SubscriptionID, syncedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.DeleteExternalBlocksBySubscriptionID).
+					WithArgs(subscription.SubscriptionID).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(queries.InsertExternalBlock).
+					WithArgs(externalBlockRowValues(block)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_CalendarSubscriptionNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCalendarSubscriptionSynced).
+					WithArgs(subscription.SubscriptionID, syncedAt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCalendarSubscriptionNotFound,
+		},
+		"Error_ExecDeleteExternalBlocks": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCalendarSubscriptionSynced).
+					WithArgs(subscription.SubscriptionID, syncedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.DeleteExternalBlocksBySubscriptionID).
+					WithArgs(subscription.SubscriptionID).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			err = repo.ReplaceBlocks(
+				context.TODO(),
+				subscription.SubscriptionID,
+				[]*domain.ExternalBlock{block},
+				syncedAt,
+			)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"ReplaceBlocks() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarSubscriptionRepository_UpdateSyncError(t *testing.T) {
+	subscriptionID := uuid.New().String()
+	reason := "unexpected status 404 Not Found"
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscriptionID,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCalendarSubscriptionSyncError).
+					WithArgs(subscriptionID, reason).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_CalendarSubscriptionNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCalendarSubscriptionSyncError).
+					WithArgs(subscriptionID, reason).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCalendarSubscriptionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			err = repo.UpdateSyncError(context.TODO(), subscriptionID, reason)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"UpdateSyncError() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarSubscriptionRepository_Delete(t *testing.T) {
+	subscriptionID := uuid.New().String()
+	errCalendarSubscriptionNotFound := domain.ErrCalendarSubscriptionNotFound{
+		SubscriptionID: subscriptionID,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCalendarSubscription).
+					WithArgs(subscriptionID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_CalendarSubscriptionNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCalendarSubscription).
+					WithArgs(subscriptionID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCalendarSubscriptionNotFound,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCalendarSubscription).
+					WithArgs(subscriptionID).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCalendarSubscriptionRepository(db)
+			// when
+			err = repo.Delete(context.TODO(), subscriptionID)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Delete() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func calendarSubscriptionRowValues(s *domain.CalendarSubscription) []driver.Value {
+	var lastSyncedAt driver.Value
+	if !s.LastSyncedAt.IsZero() {
+		lastSyncedAt = s.LastSyncedAt
+	}
+	return []driver.Value{
+		s.ID,
+		s.SubscriptionID,
+		s.CampsiteID,
+		s.Name,
+		s.URL,
+		lastSyncedAt,
+		s.LastSyncError,
+	}
+}
+
+func externalBlockRowValues(b *domain.ExternalBlock) []driver.Value {
+	return []driver.Value{
+		b.SubscriptionID,
+		b.CampsiteID,
+		b.UID,
+		b.StartDate,
+		b.EndDate,
+		b.Summary,
+	}
+}
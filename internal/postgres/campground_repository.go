@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/jackc/pgconn"
+	"github.com/stackus/errors"
+)
+
+const foreignKeyViolation = "23503"
+
+type CampgroundRepository struct {
+	db DB
+}
+
+var _ domain.CampgroundRepository = (*CampgroundRepository)(nil)
+
+func NewCampgroundRepository(db DB) CampgroundRepository {
+	return CampgroundRepository{db}
+}
+
+func (r CampgroundRepository) Find(
+	ctx context.Context,
+	campgroundID string,
+) (*domain.Campground, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	campground := &domain.Campground{}
+	if err = tx.QueryRowContext(
+		ctx, queries.FindCampgroundByCampgroundID, campgroundID,
+	).Scan(
+		&campground.ID, &campground.CampgroundID, &campground.Name, &campground.Description,
+		&campground.Active,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampgroundNotFound{CampgroundID: campgroundID}
+		}
+		return nil, errors.Wrap(err, "scan campground row")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return campground, nil
+}
+
+func (r CampgroundRepository) FindAll(
+	ctx context.Context,
+) (campgrounds []*domain.Campground, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rows, err := tx.QueryContext(ctx, queries.FindAllCampgrounds)
+	if err != nil {
+		return nil, errors.Wrap(err, "query campgrounds")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		campground := &domain.Campground{}
+		err = rows.Scan(&campground.ID, &campground.CampgroundID, &campground.Name,
+			&campground.Description, &campground.Active)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan campground row")
+		}
+		campgrounds = append(campgrounds, campground)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish campground rows")
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return campgrounds, nil
+}
+
+func (r CampgroundRepository) Insert(ctx context.Context, campground *domain.Campground) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	_, err = tx.ExecContext(ctx, queries.InsertCampground,
+		campground.CampgroundID, campground.Name, campground.Description, campground.Active)
+	if err != nil {
+		return errors.Wrap(err, "insert campground")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CampgroundRepository) Update(ctx context.Context, campground *domain.Campground) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.UpdateCampground,
+		campground.CampgroundID, campground.Name, campground.Description, campground.Active)
+	if err != nil {
+		return errors.Wrap(err, "update campground")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+		}
+		return errors.Wrap(err, "update campground")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CampgroundRepository) Delete(ctx context.Context, campgroundID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.DeleteCampground, campgroundID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return domain.ErrCampgroundInUse{CampgroundID: campgroundID}
+		}
+		return errors.Wrap(err, "delete campground")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCampgroundNotFound{CampgroundID: campgroundID}
+		}
+		return errors.Wrap(err, "delete campground")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type campgroundSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.CampgroundRepository
+	suite.Suite
+}
+
+func TestCampgroundRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &campgroundSuite{})
+}
+
+func (s *campgroundSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campgroundSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *campgroundSuite) SetupTest() {
+	s.repo = postgres.NewCampgroundRepository(s.db)
+}
+
+func (s *campgroundSuite) TearDownTest() {
+	err := bootstrap.DeleteCampsites(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampgrounds(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campgroundSuite) TestCampgroundRepository_Find_Success() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(s.repo.Insert(context.Background(), campground))
+	// when
+	got, err := s.repo.Find(context.Background(), campground.CampgroundID)
+	// then
+	if s.NoError(err) {
+		campground.ID = got.ID
+		s.Equal(campground, got)
+	}
+}
+
+func (s *campgroundSuite) TestCampgroundRepository_FindAll() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(s.repo.Insert(context.Background(), campground))
+	// when
+	got, err := s.repo.FindAll(context.Background())
+	// then
+	if s.NoError(err) {
+		s.Equal(1, len(got))
+		campground.ID = got[0].ID
+		s.Equal(campground, got[0])
+	}
+}
+
+func (s *campgroundSuite) TestCampgroundRepository_Update() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(s.repo.Insert(context.Background(), campground))
+	campground.Name = "Updated Name"
+	campground.Active = false
+	// when
+	err = s.repo.Update(context.Background(), campground)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), campground.CampgroundID)
+		s.NoError(err)
+		s.Equal("Updated Name", got.Name)
+		s.False(got.Active)
+	}
+}
+
+func (s *campgroundSuite) TestCampgroundRepository_Delete_Success() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(s.repo.Insert(context.Background(), campground))
+	// when
+	err = s.repo.Delete(context.Background(), campground.CampgroundID)
+	// then
+	if s.NoError(err) {
+		_, err = s.repo.Find(context.Background(), campground.CampgroundID)
+		s.ErrorIs(err, domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID})
+	}
+}
+
+func (s *campgroundSuite) TestCampgroundRepository_Delete_CampgroundInUse() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(s.repo.Insert(context.Background(), campground))
+
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	campsite.CampgroundID = campground.CampgroundID
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	// when
+	err = s.repo.Delete(context.Background(), campground.CampgroundID)
+	// then
+	s.ErrorIs(err, domain.ErrCampgroundInUse{CampgroundID: campground.CampgroundID})
+}
@@ -0,0 +1,439 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+var campgroundColumnsRow = []string{
+	"id",
+	"campground_id",
+	"name",
+	"description",
+	"active",
+}
+
+func TestCampgroundRepository_Find(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	campground.ID = 1
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.Campground
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow).
+					AddRow(campgroundRowValues(campground)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundByCampgroundID).
+					WithArgs(campground.CampgroundID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    campground,
+			wantErr: nil,
+		},
+		"Error_NoCampgroundFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundByCampgroundID).
+					WithArgs(campground.CampgroundID).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundByCampgroundID).
+					WithArgs(campground.CampgroundID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow).
+					AddRow(campgroundRowValues(campground)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundByCampgroundID).
+					WithArgs(campground.CampgroundID).
+					WillReturnRows(rows)
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), campground.CampgroundID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampgroundRepository_FindAll(t *testing.T) {
+	var campgrounds []*domain.Campground
+	for i := 1; i < 3; i++ {
+		campground, err := bootstrap.NewCampground()
+		if err != nil {
+			t.Fatalf("create campground[%d] error: %v", i, err)
+		}
+		campground.ID = int64(i)
+		campgrounds = append(campgrounds, campground)
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.Campground
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow).
+					AddRow(campgroundRowValues(campgrounds[0])...).
+					AddRow(campgroundRowValues(campgrounds[1])...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampgrounds).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    campgrounds,
+			wantErr: nil,
+		},
+		"NoCampgroundsFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampgrounds).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampgrounds).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_Rows": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campgroundColumnsRow).
+					AddRow(campgroundRowValues(campgrounds[0])...).
+					AddRow(campgroundRowValues(campgrounds[1])...)
+				rows.RowError(1, bootstrap.ErrRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampgrounds).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrRow,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundRepository(db)
+			// when
+			got, err := repo.FindAll(context.TODO())
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindAll() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindAll() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampgroundRepository_Insert(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundRepository(db)
+			// when
+			err = repo.Insert(context.TODO(), campground)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Insert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampgroundRepository_Update(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_NoCampgroundFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateCampground).
+					WithArgs(campgroundArgs(campground)...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundRepository(db)
+			// when
+			err = repo.Update(context.TODO(), campground)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Update() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampgroundRepository_Delete(t *testing.T) {
+	campground, err := bootstrap.NewCampground()
+	if err != nil {
+		t.Fatalf("create campground error: %v", err)
+	}
+	errCampgroundNotFound := domain.ErrCampgroundNotFound{CampgroundID: campground.CampgroundID}
+	errCampgroundInUse := domain.ErrCampgroundInUse{CampgroundID: campground.CampgroundID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampground).
+					WithArgs(campground.CampgroundID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_NoCampgroundFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampground).
+					WithArgs(campground.CampgroundID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCampgroundNotFound,
+		},
+		"Error_CampgroundInUse": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampground).
+					WithArgs(campground.CampgroundID).
+					WillReturnError(&pgconn.PgError{Code: foreignKeyViolation})
+				mock.ExpectRollback()
+			},
+			wantErr: errCampgroundInUse,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampground).
+					WithArgs(campground.CampgroundID).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundRepository(db)
+			// when
+			err = repo.Delete(context.TODO(), campground.CampgroundID)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Delete() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func campgroundArgs(c *domain.Campground) []driver.Value {
+	return campgroundRowValues(c)[1:] // remove ID
+}
+
+func campgroundRowValues(c *domain.Campground) []driver.Value {
+	return []driver.Value{
+		c.ID,
+		c.CampgroundID,
+		c.Name,
+		c.Description,
+		c.Active,
+	}
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/stackus/errors"
+)
+
+type CampgroundSeasonRepository struct {
+	db DB
+}
+
+var _ domain.CampgroundSeasonRepository = (*CampgroundSeasonRepository)(nil)
+
+func NewCampgroundSeasonRepository(db DB) CampgroundSeasonRepository {
+	return CampgroundSeasonRepository{db}
+}
+
+func (r CampgroundSeasonRepository) Find(
+	ctx context.Context,
+	campgroundID string,
+) (*domain.CampgroundSeason, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	season := &domain.CampgroundSeason{}
+	var closedWeekdays string
+	if err = tx.QueryRowContext(
+		ctx, queries.FindCampgroundSeasonByCampgroundID, campgroundID,
+	).Scan(
+		&season.ID, &season.CampgroundID, &season.OpensOn, &season.ClosesOn, &closedWeekdays,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampgroundSeasonNotFound{CampgroundID: campgroundID}
+		}
+		return nil, errors.Wrap(err, "scan campground season row")
+	}
+	if closedWeekdays != "" {
+		season.ClosedWeekdays, err = domain.ParseWeekdays(strings.Split(closedWeekdays, ","))
+		if err != nil {
+			return nil, errors.Wrap(err, "parse closed weekdays")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return season, nil
+}
+
+func (r CampgroundSeasonRepository) Upsert(
+	ctx context.Context,
+	season *domain.CampgroundSeason,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	_, err = tx.ExecContext(ctx, queries.UpsertCampgroundSeason,
+		season.CampgroundID, season.OpensOn, season.ClosesOn,
+		strings.Join(domain.FormatWeekdays(season.ClosedWeekdays), ","))
+	if err != nil {
+		return errors.Wrap(err, "upsert campground season")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
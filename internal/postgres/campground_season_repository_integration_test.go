@@ -0,0 +1,115 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type campgroundSeasonSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.CampgroundSeasonRepository
+	suite.Suite
+}
+
+func TestCampgroundSeasonRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &campgroundSeasonSuite{})
+}
+
+func (s *campgroundSeasonSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campgroundSeasonSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *campgroundSeasonSuite) SetupTest() {
+	s.repo = postgres.NewCampgroundSeasonRepository(s.db)
+}
+
+func (s *campgroundSeasonSuite) TearDownTest() {
+	// campground seasons deleted in cascade
+	err := bootstrap.DeleteCampgrounds(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campgroundSeasonSuite) TestCampgroundSeasonRepository_Upsert_Insert() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampground(s.db, campground))
+	season := bootstrap.NewCampgroundSeason(campground.CampgroundID)
+	// when
+	err = s.repo.Upsert(context.Background(), season)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), campground.CampgroundID)
+		s.NoError(err)
+		season.ID = got.ID
+		s.Equal(season, got)
+	}
+}
+
+func (s *campgroundSeasonSuite) TestCampgroundSeasonRepository_Upsert_Update() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampground(s.db, campground))
+	season := bootstrap.NewCampgroundSeason(campground.CampgroundID)
+	s.NoError(s.repo.Upsert(context.Background(), season))
+
+	season.OpensOn = ""
+	season.ClosesOn = ""
+	season.ClosedWeekdays = []time.Weekday{time.Sunday, time.Saturday}
+	// when
+	err = s.repo.Upsert(context.Background(), season)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), campground.CampgroundID)
+		s.NoError(err)
+		season.ID = got.ID
+		s.Equal(season, got)
+	}
+}
+
+func (s *campgroundSeasonSuite) TestCampgroundSeasonRepository_Find_NotFound() {
+	// given
+	campgroundID := "non-existing-id"
+	// when
+	got, err := s.repo.Find(context.Background(), campgroundID)
+	// then
+	s.Nil(got)
+	s.Equal(domain.ErrCampgroundSeasonNotFound{CampgroundID: campgroundID}, err)
+}
@@ -0,0 +1,169 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var campgroundSeasonColumnsRow = []string{
+	"id",
+	"campground_id",
+	"opens_on",
+	"closes_on",
+	"closed_weekdays",
+}
+
+func TestCampgroundSeasonRepository_Find(t *testing.T) {
+	season := bootstrap.NewCampgroundSeason(uuid.New().String())
+	errCampgroundSeasonNotFound := domain.ErrCampgroundSeasonNotFound{
+		CampgroundID: season.CampgroundID,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.CampgroundSeason
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundSeasonByCampgroundID).
+					WithArgs(season.CampgroundID).
+					WillReturnRows(sqlmock.NewRows(campgroundSeasonColumnsRow).
+						AddRow(season.ID, season.CampgroundID, season.OpensOn, season.ClosesOn,
+							"MONDAY"))
+				mock.ExpectCommit()
+			},
+			want:    season,
+			wantErr: nil,
+		},
+		"Error_CampgroundSeasonNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundSeasonByCampgroundID).
+					WithArgs(season.CampgroundID).
+					WillReturnRows(sqlmock.NewRows(campgroundSeasonColumnsRow))
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCampgroundSeasonNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampgroundSeasonByCampgroundID).
+					WithArgs(season.CampgroundID).
+					WillReturnRows(sqlmock.NewRows(campgroundSeasonColumnsRow).
+						AddRow(season.ID, season.CampgroundID, season.OpensOn, season.ClosesOn,
+							"MONDAY"))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundSeasonRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), season.CampgroundID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampgroundSeasonRepository_Upsert(t *testing.T) {
+	season := bootstrap.NewCampgroundSeason(uuid.New().String())
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampgroundSeason).
+					WithArgs(season.CampgroundID, season.OpensOn, season.ClosesOn, "MONDAY").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampgroundSeason).
+					WithArgs(season.CampgroundID, season.OpensOn, season.ClosesOn, "MONDAY").
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampgroundSeason).
+					WithArgs(season.CampgroundID, season.OpensOn, season.ClosesOn, "MONDAY").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampgroundSeasonRepository(db)
+			// when
+			err = repo.Upsert(context.TODO(), season)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Upsert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/stackus/errors"
+)
+
+type CampsiteBlackoutRepository struct {
+	db DB
+}
+
+var _ domain.CampsiteBlackoutRepository = (*CampsiteBlackoutRepository)(nil)
+
+func NewCampsiteBlackoutRepository(db DB) CampsiteBlackoutRepository {
+	return CampsiteBlackoutRepository{db}
+}
+
+func (r CampsiteBlackoutRepository) Find(
+	ctx context.Context,
+	blackoutID string,
+) (*domain.CampsiteBlackout, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	blackout, err := scanCampsiteBlackout(
+		tx.QueryRowContext(ctx, queries.FindCampsiteBlackoutByBlackoutID, blackoutID).Scan,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampsiteBlackoutNotFound{BlackoutID: blackoutID}
+		}
+		return nil, errors.Wrap(err, "scan campsite blackout row")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return blackout, nil
+}
+
+func (r CampsiteBlackoutRepository) FindByCampsiteID(
+	ctx context.Context,
+	campsiteID string,
+) ([]*domain.CampsiteBlackout, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllCampsiteBlackoutsByCampsiteID, campsiteID)
+}
+
+func (r CampsiteBlackoutRepository) FindForDateRange(
+	ctx context.Context,
+	campsiteID string,
+	startDate time.Time,
+	endDate time.Time,
+) ([]*domain.CampsiteBlackout, error) {
+	return r.findAllWithQuery(
+		ctx, queries.FindAllCampsiteBlackoutsForDateRange, campsiteID, startDate, endDate,
+	)
+}
+
+func (r CampsiteBlackoutRepository) Insert(
+	ctx context.Context,
+	blackout *domain.CampsiteBlackout,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	_, err = tx.ExecContext(ctx, queries.InsertCampsiteBlackout,
+		blackout.BlackoutID, blackout.CampsiteID, blackout.StartDate, blackout.EndDate,
+		blackout.Reason)
+	if err != nil {
+		return errors.Wrap(err, "insert campsite blackout")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CampsiteBlackoutRepository) Delete(ctx context.Context, blackoutID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.DeleteCampsiteBlackout, blackoutID)
+	if err != nil {
+		return errors.Wrap(err, "delete campsite blackout")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCampsiteBlackoutNotFound{BlackoutID: blackoutID}
+		}
+		return errors.Wrap(err, "delete campsite blackout")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CampsiteBlackoutRepository) findAllWithQuery(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (blackouts []*domain.CampsiteBlackout, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	if blackouts, err = findCampsiteBlackoutsWithTx(ctx, tx, query, args...); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return blackouts, nil
+}
+
+// findCampsiteBlackoutsWithTx is shared with BookingRepository to check the
+// dates of a booking against blackouts in the transaction saving the booking.
+func findCampsiteBlackoutsWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	args ...any,
+) (blackouts []*domain.CampsiteBlackout, err error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query campsite blackouts")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var blackout *domain.CampsiteBlackout
+		if blackout, err = scanCampsiteBlackout(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan campsite blackout row")
+		}
+		blackouts = append(blackouts, blackout)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish campsite blackout rows")
+	}
+	return blackouts, nil
+}
+
+func scanCampsiteBlackout(scan func(dest ...any) error) (*domain.CampsiteBlackout, error) {
+	blackout := &domain.CampsiteBlackout{}
+	if err := scan(
+		&blackout.ID, &blackout.BlackoutID, &blackout.CampsiteID, &blackout.StartDate,
+		&blackout.EndDate, &blackout.Reason,
+	); err != nil {
+		return nil, err
+	}
+	return blackout, nil
+}
@@ -0,0 +1,147 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type campsiteBlackoutSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.CampsiteBlackoutRepository
+	bookings  postgres.BookingRepository
+	suite.Suite
+}
+
+func TestCampsiteBlackoutRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &campsiteBlackoutSuite{})
+}
+
+func (s *campsiteBlackoutSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campsiteBlackoutSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *campsiteBlackoutSuite) SetupTest() {
+	s.repo = postgres.NewCampsiteBlackoutRepository(s.db)
+	s.bookings = postgres.NewBookingRepository(s.db)
+}
+
+func (s *campsiteBlackoutSuite) TearDownTest() {
+	err := bootstrap.DeleteBookings(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampsiteBlackouts(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampsites(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campsiteBlackoutSuite) TestCampsiteBlackoutRepository_Insert() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	blackout := bootstrap.NewCampsiteBlackout(campsite.CampsiteID)
+	// when
+	err = s.repo.Insert(context.Background(), blackout)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), blackout.BlackoutID)
+		s.NoError(err)
+		blackout.ID = got.ID
+		s.Equal(blackout, got)
+	}
+}
+
+func (s *campsiteBlackoutSuite) TestCampsiteBlackoutRepository_FindForDateRange() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	blackout := bootstrap.NewCampsiteBlackout(campsite.CampsiteID)
+	s.NoError(s.repo.Insert(context.Background(), blackout))
+	// when
+	got, err := s.repo.FindForDateRange(
+		context.Background(),
+		campsite.CampsiteID,
+		blackout.EndDate,
+		blackout.EndDate.AddDate(0, 0, 1),
+	)
+	// then
+	s.NoError(err)
+	s.Empty(got)
+}
+
+func (s *campsiteBlackoutSuite) TestCampsiteBlackoutRepository_Delete() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	blackout := bootstrap.NewCampsiteBlackout(campsite.CampsiteID)
+	s.NoError(s.repo.Insert(context.Background(), blackout))
+	// when
+	err = s.repo.Delete(context.Background(), blackout.BlackoutID)
+	// then
+	if s.NoError(err) {
+		_, err = s.repo.Find(context.Background(), blackout.BlackoutID)
+		s.Equal(domain.ErrCampsiteBlackoutNotFound{BlackoutID: blackout.BlackoutID}, err)
+	}
+}
+
+func (s *campsiteBlackoutSuite) TestBookingRepository_Insert_ErrBookingDatesBlackedOut() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	blackout := bootstrap.NewCampsiteBlackout(campsite.CampsiteID)
+	s.NoError(s.repo.Insert(context.Background(), blackout))
+	booking, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, 2, 4)
+	s.NoError(err)
+	// when
+	err = s.bookings.Insert(context.Background(), booking)
+	// then
+	s.Equal(domain.ErrBookingDatesBlackedOut{
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+		Reason:    blackout.Reason,
+	}, err)
+}
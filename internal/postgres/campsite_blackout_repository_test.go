@@ -0,0 +1,304 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var blackoutColumnsRow = []string{
+	"id",
+	"blackout_id",
+	"campsite_id",
+	"start_date",
+	"end_date",
+	"reason",
+}
+
+func TestCampsiteBlackoutRepository_Find(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout(uuid.New().String())
+	errCampsiteBlackoutNotFound := domain.ErrCampsiteBlackoutNotFound{
+		BlackoutID: blackout.BlackoutID,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.CampsiteBlackout
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteBlackoutByBlackoutID).
+					WithArgs(blackout.BlackoutID).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow).
+						AddRow(blackoutRowValues(blackout)...))
+				mock.ExpectCommit()
+			},
+			want:    blackout,
+			wantErr: nil,
+		},
+		"Error_CampsiteBlackoutNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteBlackoutByBlackoutID).
+					WithArgs(blackout.BlackoutID).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCampsiteBlackoutNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteBlackoutByBlackoutID).
+					WithArgs(blackout.BlackoutID).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow).
+						AddRow(blackoutRowValues(blackout)...))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteBlackoutRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), blackout.BlackoutID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampsiteBlackoutRepository_FindForDateRange(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout(uuid.New().String())
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.CampsiteBlackout
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(blackout.CampsiteID, blackout.StartDate, blackout.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow).
+						AddRow(blackoutRowValues(blackout)...))
+				mock.ExpectCommit()
+			},
+			want:    []*domain.CampsiteBlackout{blackout},
+			wantErr: nil,
+		},
+		"Success_NoBlackoutsFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(blackout.CampsiteID, blackout.StartDate, blackout.EndDate).
+					WillReturnRows(sqlmock.NewRows(blackoutColumnsRow))
+				mock.ExpectCommit()
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampsiteBlackoutsForDateRange).
+					WithArgs(blackout.CampsiteID, blackout.StartDate, blackout.EndDate).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteBlackoutRepository(db)
+			// when
+			got, err := repo.FindForDateRange(
+				context.TODO(), blackout.CampsiteID, blackout.StartDate, blackout.EndDate,
+			)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindForDateRange() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindForDateRange() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampsiteBlackoutRepository_Insert(t *testing.T) {
+	blackout := bootstrap.NewCampsiteBlackout(uuid.New().String())
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampsiteBlackout).
+					WithArgs(blackoutRowValues(blackout)[1:]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampsiteBlackout).
+					WithArgs(blackoutRowValues(blackout)[1:]...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertCampsiteBlackout).
+					WithArgs(blackoutRowValues(blackout)[1:]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteBlackoutRepository(db)
+			// when
+			err = repo.Insert(context.TODO(), blackout)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Insert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampsiteBlackoutRepository_Delete(t *testing.T) {
+	blackoutID := uuid.New().String()
+	errCampsiteBlackoutNotFound := domain.ErrCampsiteBlackoutNotFound{BlackoutID: blackoutID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampsiteBlackout).
+					WithArgs(blackoutID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_CampsiteBlackoutNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampsiteBlackout).
+					WithArgs(blackoutID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errCampsiteBlackoutNotFound,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteCampsiteBlackout).
+					WithArgs(blackoutID).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteBlackoutRepository(db)
+			// when
+			err = repo.Delete(context.TODO(), blackoutID)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Delete() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func blackoutRowValues(b *domain.CampsiteBlackout) []driver.Value {
+	return []driver.Value{
+		b.ID,
+		b.BlackoutID,
+		b.CampsiteID,
+		b.StartDate,
+		b.EndDate,
+		b.Reason,
+	}
+}
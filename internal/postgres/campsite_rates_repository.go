@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/stackus/errors"
+)
+
+type CampsiteRatesRepository struct {
+	db DB
+}
+
+var _ domain.CampsiteRatesRepository = (*CampsiteRatesRepository)(nil)
+
+func NewCampsiteRatesRepository(db DB) CampsiteRatesRepository {
+	return CampsiteRatesRepository{db}
+}
+
+func (r CampsiteRatesRepository) Find(
+	ctx context.Context,
+	campsiteID string,
+) (*domain.CampsiteRates, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rates := &domain.CampsiteRates{}
+	if err = tx.QueryRowContext(
+		ctx, queries.FindCampsiteRatesByCampsiteID, campsiteID,
+	).Scan(
+		&rates.ID, &rates.CampsiteID, &rates.Currency, &rates.NightlyRate,
+		&rates.WeekendNightlyRate, &rates.IncludedGuests, &rates.ExtraGuestFee, &rates.TaxRateBps,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}
+		}
+		return nil, errors.Wrap(err, "scan campsite rates row")
+	}
+
+	rates.Seasons, err = findSeasonalRatesWithTx(ctx, tx, campsiteID)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return rates, nil
+}
+
+func (r CampsiteRatesRepository) Upsert(ctx context.Context, rates *domain.CampsiteRates) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	_, err = tx.ExecContext(ctx, queries.UpsertCampsiteRates,
+		rates.CampsiteID, rates.Currency, rates.NightlyRate, rates.WeekendNightlyRate,
+		rates.IncludedGuests, rates.ExtraGuestFee, rates.TaxRateBps)
+	if err != nil {
+		return errors.Wrap(err, "upsert campsite rates")
+	}
+
+	_, err = tx.ExecContext(ctx, queries.DeleteSeasonalRatesByCampsiteID, rates.CampsiteID)
+	if err != nil {
+		return errors.Wrap(err, "delete seasonal rates")
+	}
+	for _, s := range rates.Seasons {
+		_, err = tx.ExecContext(ctx, queries.InsertSeasonalRate,
+			rates.CampsiteID, s.Name, s.StartDate, s.EndDate, s.NightlyRate, s.WeekendNightlyRate)
+		if err != nil {
+			return errors.Wrap(err, "insert seasonal rate")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func findSeasonalRatesWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	campsiteID string,
+) (seasons []domain.SeasonalRate, err error) {
+	rows, err := tx.QueryContext(ctx, queries.FindSeasonalRatesByCampsiteID, campsiteID)
+	if err != nil {
+		return nil, errors.Wrap(err, "query seasonal rates")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		s := domain.SeasonalRate{}
+		if err = rows.Scan(
+			&s.Name, &s.StartDate, &s.EndDate, &s.NightlyRate, &s.WeekendNightlyRate,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan seasonal rate row")
+		}
+		seasons = append(seasons, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish seasonal rate rows")
+	}
+	return seasons, nil
+}
@@ -0,0 +1,125 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type campsiteRatesSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.CampsiteRatesRepository
+	suite.Suite
+}
+
+func TestCampsiteRatesRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &campsiteRatesSuite{})
+}
+
+func (s *campsiteRatesSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campsiteRatesSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *campsiteRatesSuite) SetupTest() {
+	s.repo = postgres.NewCampsiteRatesRepository(s.db)
+}
+
+func (s *campsiteRatesSuite) TearDownTest() {
+	err := bootstrap.DeleteCampsiteRates(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampsites(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campsiteRatesSuite) TestCampsiteRatesRepository_Upsert_Insert() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	rates := bootstrap.NewCampsiteRates(campsite.CampsiteID)
+	// when
+	err = s.repo.Upsert(context.Background(), rates)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), campsite.CampsiteID)
+		s.NoError(err)
+		rates.ID = got.ID
+		s.Equal(rates, got)
+	}
+}
+
+func (s *campsiteRatesSuite) TestCampsiteRatesRepository_Upsert_Update() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+	rates := bootstrap.NewCampsiteRates(campsite.CampsiteID)
+	s.NoError(s.repo.Upsert(context.Background(), rates))
+
+	rates.NightlyRate = 4500
+	rates.Seasons = []domain.SeasonalRate{
+		{
+			Name:        "winter",
+			StartDate:   time.Date(2006, 12, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:     time.Date(2007, 3, 1, 0, 0, 0, 0, time.UTC),
+			NightlyRate: 3000,
+		},
+	}
+	// when
+	err = s.repo.Upsert(context.Background(), rates)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), campsite.CampsiteID)
+		s.NoError(err)
+		rates.ID = got.ID
+		s.Equal(rates, got)
+	}
+}
+
+func (s *campsiteRatesSuite) TestCampsiteRatesRepository_Find_NotFound() {
+	// given
+	campsiteID := "non-existing-id"
+	// when
+	got, err := s.repo.Find(context.Background(), campsiteID)
+	// then
+	s.Nil(got)
+	s.Equal(domain.ErrCampsiteRatesNotFound{CampsiteID: campsiteID}, err)
+}
@@ -0,0 +1,259 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	campsiteRatesColumnsRow = []string{
+		"id",
+		"campsite_id",
+		"currency",
+		"nightly_rate",
+		"weekend_nightly_rate",
+		"included_guests",
+		"extra_guest_fee",
+		"tax_rate_bps",
+	}
+	seasonalRateColumnsRow = []string{
+		"name",
+		"start_date",
+		"end_date",
+		"nightly_rate",
+		"weekend_nightly_rate",
+	}
+)
+
+func TestCampsiteRatesRepository_Find(t *testing.T) {
+	rates := bootstrap.NewCampsiteRates(uuid.New().String())
+	rates.ID = 1
+	errCampsiteRatesNotFound := domain.ErrCampsiteRatesNotFound{CampsiteID: rates.CampsiteID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.CampsiteRates
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(campsiteRatesColumnsRow).
+						AddRow(campsiteRatesRowValues(rates)...))
+				mock.ExpectQuery(queries.FindSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(seasonalRateColumnsRow).
+						AddRow(seasonalRateRowValues(rates.Seasons[0])...))
+				mock.ExpectCommit()
+			},
+			want:    rates,
+			wantErr: nil,
+		},
+		"Error_NoCampsiteRatesFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(campsiteRatesColumnsRow))
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCampsiteRatesNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_SeasonalRatesQuery": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(campsiteRatesColumnsRow).
+						AddRow(campsiteRatesRowValues(rates)...))
+				mock.ExpectQuery(queries.FindSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(campsiteRatesColumnsRow).
+						AddRow(campsiteRatesRowValues(rates)...))
+				mock.ExpectQuery(queries.FindSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnRows(sqlmock.NewRows(seasonalRateColumnsRow))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteRatesRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), rates.CampsiteID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCampsiteRatesRepository_Upsert(t *testing.T) {
+	rates := bootstrap.NewCampsiteRates(uuid.New().String())
+	season := rates.Seasons[0]
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampsiteRates).
+					WithArgs(campsiteRatesRowValues(rates)[1:]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.DeleteSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.InsertSeasonalRate).
+					WithArgs(append(
+						[]driver.Value{rates.CampsiteID}, seasonalRateRowValues(season)...,
+					)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_UpsertExec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampsiteRates).
+					WithArgs(campsiteRatesRowValues(rates)[1:]...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_InsertSeasonalRateExec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampsiteRates).
+					WithArgs(campsiteRatesRowValues(rates)[1:]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.DeleteSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.InsertSeasonalRate).
+					WithArgs(append(
+						[]driver.Value{rates.CampsiteID}, seasonalRateRowValues(season)...,
+					)...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpsertCampsiteRates).
+					WithArgs(campsiteRatesRowValues(rates)[1:]...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queries.DeleteSeasonalRatesByCampsiteID).
+					WithArgs(rates.CampsiteID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.InsertSeasonalRate).
+					WithArgs(append(
+						[]driver.Value{rates.CampsiteID}, seasonalRateRowValues(season)...,
+					)...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteRatesRepository(db)
+			// when
+			err = repo.Upsert(context.TODO(), rates)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Upsert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func campsiteRatesRowValues(r *domain.CampsiteRates) []driver.Value {
+	return []driver.Value{
+		r.ID,
+		r.CampsiteID,
+		r.Currency,
+		r.NightlyRate,
+		r.WeekendNightlyRate,
+		r.IncludedGuests,
+		r.ExtraGuestFee,
+		r.TaxRateBps,
+	}
+}
+
+func seasonalRateRowValues(s domain.SeasonalRate) []driver.Value {
+	return []driver.Value{
+		s.Name,
+		s.StartDate,
+		s.EndDate,
+		s.NightlyRate,
+		s.WeekendNightlyRate,
+	}
+}
@@ -6,50 +6,56 @@ import (
 
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/jackc/pgconn"
 	"github.com/stackus/errors"
 )
 
 type CampsiteRepository struct {
-	db *sql.DB
+	db DB
 }
 
 var _ domain.CampsiteRepository = (*CampsiteRepository)(nil)
 
-func NewCampsiteRepository(db *sql.DB) CampsiteRepository {
+func NewCampsiteRepository(db DB) CampsiteRepository {
 	return CampsiteRepository{db}
 }
 
-func (r CampsiteRepository) FindAll(ctx context.Context) (campsites []*domain.Campsite, err error) {
+func (r CampsiteRepository) Find(ctx context.Context, campsiteID string) (*domain.Campsite, error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
 		return nil, errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
-	rows, err := tx.QueryContext(ctx, queries.FindAllCampsites)
-	if err != nil {
-		return nil, errors.Wrap(err, "query campsites")
-	}
-	defer closeRows(rows)
-
-	for rows.Next() {
-		campsite := &domain.Campsite{}
-		err = rows.Scan(&campsite.ID, &campsite.CampsiteID, &campsite.CampsiteCode,
-			&campsite.Capacity, &campsite.Restrooms, &campsite.DrinkingWater, &campsite.PicnicTable,
-			&campsite.FirePit, &campsite.Active)
-		if err != nil {
-			return nil, errors.Wrap(err, "scan campsite row")
+	campsite := &domain.Campsite{}
+	if err = tx.QueryRowContext(
+		ctx, queries.FindCampsiteByCampsiteID, campsiteID,
+	).Scan(
+		&campsite.ID, &campsite.CampsiteID, &campsite.CampsiteCode, &campsite.Capacity,
+		&campsite.Restrooms, &campsite.DrinkingWater, &campsite.PicnicTable, &campsite.FirePit,
+		&campsite.Active, &campsite.CampgroundID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampsiteNotFound{CampsiteID: campsiteID}
 		}
-		campsites = append(campsites, campsite)
+		return nil, errors.Wrap(err, "scan campsite row")
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, errors.Wrap(err, "finish campsite rows")
-	}
 	if err = tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "commit transaction")
 	}
-	return campsites, nil
+	return campsite, nil
+}
+
+func (r CampsiteRepository) FindAll(ctx context.Context) ([]*domain.Campsite, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllCampsites)
+}
+
+func (r CampsiteRepository) FindByCampgroundID(
+	ctx context.Context,
+	campgroundID string,
+) ([]*domain.Campsite, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllCampsitesByCampgroundID, campgroundID)
 }
 
 func (r CampsiteRepository) Insert(ctx context.Context, campsite *domain.Campsite) error {
@@ -57,11 +63,12 @@ func (r CampsiteRepository) Insert(ctx context.Context, campsite *domain.Campsit
 	if err != nil {
 		return errors.Wrap(err, "begin transaction")
 	}
-	defer rollbackTx(tx)
+	defer rollbackTx(ctx, tx)
 
 	_, err = tx.ExecContext(ctx, queries.InsertCampsite,
 		campsite.CampsiteID, campsite.CampsiteCode, campsite.Capacity, campsite.Restrooms,
-		campsite.DrinkingWater, campsite.PicnicTable, campsite.FirePit, campsite.Active)
+		campsite.DrinkingWater, campsite.PicnicTable, campsite.FirePit, campsite.Active,
+		campsite.CampgroundID)
 	if err != nil {
 		return errors.Wrap(err, "insert campsite")
 	}
@@ -71,3 +78,103 @@ func (r CampsiteRepository) Insert(ctx context.Context, campsite *domain.Campsit
 	}
 	return nil
 }
+
+func (r CampsiteRepository) Upsert(
+	ctx context.Context,
+	rows []*domain.CampsiteImportRow,
+	mode domain.CampsiteImportMode,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	bestEffort := mode == domain.CampsiteImportModeBestEffort
+	for _, row := range rows {
+		if bestEffort {
+			if _, err = tx.ExecContext(ctx, queries.SavepointCampsiteImport); err != nil {
+				return errors.Wrap(err, "create savepoint")
+			}
+		}
+
+		c := row.Campsite
+		// a campsite is left in its campground and keeps its active flag unless
+		// they are imported
+		active := sql.NullBool{Bool: c.Active, Valid: row.ActiveImported}
+		var inserted bool
+		err = tx.QueryRowContext(
+			ctx, queries.UpsertCampsite,
+			c.CampsiteID, c.CampsiteCode, c.Capacity, c.Restrooms, c.DrinkingWater,
+			c.PicnicTable, c.FirePit, active, c.CampgroundID,
+		).Scan(&c.CampsiteID, &inserted)
+		if err != nil {
+			// a constraint violation fails the row, any other error the import
+			var pgErr *pgconn.PgError
+			if !errors.As(err, &pgErr) {
+				return errors.Wrap(err, "upsert campsite")
+			}
+			row.Fail(pgErr.Message)
+			if !bestEffort {
+				domain.SkipCampsiteImportRows(rows)
+				return nil
+			}
+			if _, err = tx.ExecContext(ctx, queries.RollbackToSavepointCampsiteImport); err != nil {
+				return errors.Wrap(err, "rollback to savepoint")
+			}
+			continue
+		}
+
+		row.Status = domain.CampsiteImportStatusUpdated
+		if inserted {
+			row.Status = domain.CampsiteImportStatusCreated
+		}
+		if bestEffort {
+			if _, err = tx.ExecContext(ctx, queries.ReleaseSavepointCampsiteImport); err != nil {
+				return errors.Wrap(err, "release savepoint")
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r CampsiteRepository) findAllWithQuery(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (campsites []*domain.Campsite, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query campsites")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		campsite := &domain.Campsite{}
+		err = rows.Scan(&campsite.ID, &campsite.CampsiteID, &campsite.CampsiteCode,
+			&campsite.Capacity, &campsite.Restrooms, &campsite.DrinkingWater, &campsite.PicnicTable,
+			&campsite.FirePit, &campsite.Active, &campsite.CampgroundID)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan campsite row")
+		}
+		campsites = append(campsites, campsite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish campsite rows")
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return campsites, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -61,6 +62,51 @@ func (s *campsiteSuite) TearDownTest() {
 	if err != nil {
 		s.T().Fatal(err)
 	}
+
+	err = bootstrap.DeleteCampgrounds(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *campsiteSuite) TestCampsiteRepository_Find() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+
+	err = bootstrap.InsertCampsite(s.db, campsite)
+	s.NoError(err)
+	// when
+	got, err := s.repo.Find(context.Background(), campsite.CampsiteID)
+	// then
+	if s.NoError(err) {
+		campsite.ID = got.ID
+		s.Equal(campsite, got)
+	}
+}
+
+func (s *campsiteSuite) TestCampsiteRepository_FindByCampgroundID() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampground(s.db, campground))
+
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	campsite.CampgroundID = campground.CampgroundID
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	otherCampsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, otherCampsite))
+	// when
+	got, err := s.repo.FindByCampgroundID(context.Background(), campground.CampgroundID)
+	// then
+	if s.NoError(err) {
+		s.Equal(1, len(got))
+		campsite.ID = got[0].ID
+		s.Equal(campsite, got[0])
+	}
 }
 
 func (s *campsiteSuite) TestCampsiteRepository_FindAll() {
@@ -100,3 +146,98 @@ func (s *campsiteSuite) TestCampsiteRepository_Insert() {
 		s.Equal(createdAt, updatedAt)
 	}
 }
+
+func (s *campsiteSuite) TestCampsiteRepository_Upsert_BestEffort() {
+	// given
+	existing, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, existing))
+
+	updated := *existing
+	updated.CampsiteID = "ignored-id"
+	updated.Capacity = existing.Capacity + 1
+	created, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	orphan, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	orphan.CampgroundID = "non-existing-id"
+
+	rows := []*domain.CampsiteImportRow{
+		{Row: 1, Campsite: &updated},
+		{Row: 2, Campsite: orphan},
+		{Row: 3, Campsite: created},
+	}
+	// when
+	err = s.repo.Upsert(context.Background(), rows, domain.CampsiteImportModeBestEffort)
+	// then
+	if s.NoError(err) {
+		s.Equal(domain.CampsiteImportStatusUpdated, rows[0].Status)
+		s.Equal(existing.CampsiteID, rows[0].Campsite.CampsiteID)
+		s.Equal(domain.CampsiteImportStatusFailed, rows[1].Status)
+		s.NotEmpty(rows[1].Error)
+		s.Equal(domain.CampsiteImportStatusCreated, rows[2].Status)
+
+		got, err := s.repo.FindAll(context.Background())
+		s.NoError(err)
+		s.Equal(2, len(got))
+		for _, campsite := range got {
+			if campsite.CampsiteID == existing.CampsiteID {
+				s.Equal(updated.Capacity, campsite.Capacity)
+			}
+		}
+	}
+}
+
+func (s *campsiteSuite) TestCampsiteRepository_Upsert_KeepsCampgroundAndActive() {
+	// given
+	campground, err := bootstrap.NewCampground()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampground(s.db, campground))
+	existing, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	existing.CampgroundID = campground.CampgroundID
+	existing.Active = false
+	s.NoError(bootstrap.InsertCampsite(s.db, existing))
+
+	updated := *existing
+	updated.CampgroundID = ""
+	updated.Active = true
+	updated.Capacity = existing.Capacity + 1
+	rows := []*domain.CampsiteImportRow{{Row: 1, Campsite: &updated}}
+	// when
+	err = s.repo.Upsert(context.Background(), rows, domain.CampsiteImportModeAllOrNothing)
+	// then
+	if s.NoError(err) {
+		s.Equal(domain.CampsiteImportStatusUpdated, rows[0].Status)
+		got, err := s.repo.Find(context.Background(), existing.CampsiteID)
+		s.NoError(err)
+		s.Equal(updated.Capacity, got.Capacity)
+		s.Equal(campground.CampgroundID, got.CampgroundID)
+		s.False(got.Active)
+	}
+}
+
+func (s *campsiteSuite) TestCampsiteRepository_Upsert_AllOrNothing() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	orphan, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	orphan.CampgroundID = "non-existing-id"
+
+	rows := []*domain.CampsiteImportRow{
+		{Row: 1, Campsite: campsite},
+		{Row: 2, Campsite: orphan},
+	}
+	// when
+	err = s.repo.Upsert(context.Background(), rows, domain.CampsiteImportModeAllOrNothing)
+	// then
+	if s.NoError(err) {
+		s.Equal(domain.CampsiteImportStatusSkipped, rows[0].Status)
+		s.Equal(domain.CampsiteImportStatusFailed, rows[1].Status)
+
+		got, err := s.repo.FindAll(context.Background())
+		s.NoError(err)
+		s.Empty(got)
+	}
+}
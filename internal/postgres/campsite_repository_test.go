@@ -8,12 +8,121 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/jackc/pgconn"
 	"github.com/stretchr/testify/assert"
 )
 
+var campsiteColumnsRow = []string{
+	"id",
+	"campsite_id",
+	"campsite_code",
+	"capacity",
+	"restrooms",
+	"drinking_water",
+	"picnic_table",
+	"fire_pit",
+	"active",
+	"campground_id",
+}
+
+func TestCampsiteRepository_Find(t *testing.T) {
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.ID = 1
+	errCampsiteNotFound := domain.ErrCampsiteNotFound{CampsiteID: campsite.CampsiteID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.Campsite
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campsiteColumnsRow).
+					AddRow(campsiteRowValues(campsite)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteByCampsiteID).
+					WithArgs(campsite.CampsiteID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    campsite,
+			wantErr: nil,
+		},
+		"Error_NoCampsiteFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campsiteColumnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteByCampsiteID).
+					WithArgs(campsite.CampsiteID).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errCampsiteNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteByCampsiteID).
+					WithArgs(campsite.CampsiteID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campsiteColumnsRow).
+					AddRow(campsiteRowValues(campsite)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindCampsiteByCampsiteID).
+					WithArgs(campsite.CampsiteID).
+					WillReturnRows(rows)
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), campsite.CampsiteID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestCampsiteRepository_FindAll(t *testing.T) {
 	var campsites []*domain.Campsite
 	for i := 1; i < 4; i++ {
@@ -25,18 +134,6 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 		campsites = append(campsites, campsite)
 	}
 
-	columnsRow := []string{
-		"id",
-		"campsite_id",
-		"campsite_code",
-		"capacity",
-		"restrooms",
-		"drinking_water",
-		"picnic_table",
-		"fire_pit",
-		"active",
-	}
-
 	tests := map[string]struct {
 		mockTxPhases func(mock sqlmock.Sqlmock)
 		want         []*domain.Campsite
@@ -44,7 +141,7 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 	}{
 		"Success": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows(columnsRow).
+				rows := sqlmock.NewRows(campsiteColumnsRow).
 					AddRow(campsiteRowValues(campsites[0])...).
 					AddRow(campsiteRowValues(campsites[1])...).
 					AddRow(campsiteRowValues(campsites[2])...)
@@ -58,7 +155,7 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 		},
 		"NoCampsitesFound": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows(columnsRow)
+				rows := sqlmock.NewRows(campsiteColumnsRow)
 				mock.ExpectBegin()
 				mock.ExpectQuery(queries.FindAllCampsites).
 					WillReturnRows(rows)
@@ -87,7 +184,7 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 		},
 		"Error_Rows": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows(columnsRow).
+				rows := sqlmock.NewRows(campsiteColumnsRow).
 					AddRow(campsiteRowValues(campsites[0])...).
 					AddRow(campsiteRowValues(campsites[1])...).
 					AddRow(campsiteRowValues(campsites[2])...)
@@ -102,7 +199,7 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 		},
 		"Error_CommitTx": {
 			mockTxPhases: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows(columnsRow).
+				rows := sqlmock.NewRows(campsiteColumnsRow).
 					AddRow(campsiteRowValues(campsites[0])...).
 					AddRow(campsiteRowValues(campsites[1])...).
 					AddRow(campsiteRowValues(campsites[2])...)
@@ -140,6 +237,69 @@ func TestCampsiteRepository_FindAll(t *testing.T) {
 	}
 }
 
+func TestCampsiteRepository_FindByCampgroundID(t *testing.T) {
+	campgroundID := uuid.New().String()
+	campsite, err := bootstrap.NewCampsite()
+	if err != nil {
+		t.Fatalf("create campsite error: %v", err)
+	}
+	campsite.ID = 1
+	campsite.CampgroundID = campgroundID
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.Campsite
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(campsiteColumnsRow).
+					AddRow(campsiteRowValues(campsite)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampsitesByCampgroundID).
+					WithArgs(campgroundID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    []*domain.Campsite{campsite},
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindAllCampsitesByCampgroundID).
+					WithArgs(campgroundID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewCampsiteRepository(db)
+			// when
+			got, err := repo.FindByCampgroundID(context.TODO(), campgroundID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindByCampgroundID() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindByCampgroundID() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestCampsiteRepository_Insert(t *testing.T) {
 	campsite, err := bootstrap.NewCampsite()
 	if err != nil {
@@ -210,10 +370,174 @@ func TestCampsiteRepository_Insert(t *testing.T) {
 	}
 }
 
+func TestCampsiteRepository_Upsert(t *testing.T) {
+	newRows := func() []*domain.CampsiteImportRow {
+		var rows []*domain.CampsiteImportRow
+		for i := 1; i <= 2; i++ {
+			campsite, err := bootstrap.NewCampsite()
+			if err != nil {
+				t.Fatalf("create campsite error: %v", err)
+			}
+			rows = append(rows, &domain.CampsiteImportRow{
+				Row:            i,
+				Campsite:       campsite,
+				ActiveImported: i == 1,
+			})
+		}
+		return rows
+	}
+	upsertColumnsRow := []string{"campsite_id", "inserted"}
+	errConstraint := &pgconn.PgError{Message: "violates check constraint"}
+
+	tests := map[string]struct {
+		mode         domain.CampsiteImportMode
+		mockTxPhases func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow)
+		wantStatus   []domain.CampsiteImportStatus
+		wantErr      error
+	}{
+		"Success_AllOrNothing": {
+			mode: domain.CampsiteImportModeAllOrNothing,
+			mockTxPhases: func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[0])...).
+					WillReturnRows(sqlmock.NewRows(upsertColumnsRow).
+						AddRow(rows[0].Campsite.CampsiteID, true))
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[1])...).
+					WillReturnRows(sqlmock.NewRows(upsertColumnsRow).
+						AddRow(uuid.New().String(), false))
+				mock.ExpectCommit()
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusCreated,
+				domain.CampsiteImportStatusUpdated,
+			},
+			wantErr: nil,
+		},
+		"Success_AllOrNothing_ConstraintViolation": {
+			mode: domain.CampsiteImportModeAllOrNothing,
+			mockTxPhases: func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[0])...).
+					WillReturnRows(sqlmock.NewRows(upsertColumnsRow).
+						AddRow(rows[0].Campsite.CampsiteID, true))
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[1])...).
+					WillReturnError(errConstraint)
+				mock.ExpectRollback()
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusSkipped,
+				domain.CampsiteImportStatusFailed,
+			},
+			wantErr: nil,
+		},
+		"Success_BestEffort_ConstraintViolation": {
+			mode: domain.CampsiteImportModeBestEffort,
+			mockTxPhases: func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.SavepointCampsiteImport).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[0])...).
+					WillReturnError(errConstraint)
+				mock.ExpectExec(queries.RollbackToSavepointCampsiteImport).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(queries.SavepointCampsiteImport).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[1])...).
+					WillReturnRows(sqlmock.NewRows(upsertColumnsRow).
+						AddRow(rows[1].Campsite.CampsiteID, true))
+				mock.ExpectExec(queries.ReleaseSavepointCampsiteImport).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			wantStatus: []domain.CampsiteImportStatus{
+				domain.CampsiteImportStatusFailed,
+				domain.CampsiteImportStatusCreated,
+			},
+			wantErr: nil,
+		},
+		"Error_BeginTx": {
+			mode: domain.CampsiteImportModeAllOrNothing,
+			mockTxPhases: func(mock sqlmock.Sqlmock, _ []*domain.CampsiteImportRow) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Query": {
+			mode: domain.CampsiteImportModeBestEffort,
+			mockTxPhases: func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.SavepointCampsiteImport).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(queries.UpsertCampsite).
+					WithArgs(upsertCampsiteArgs(rows[0])...).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_CommitTx": {
+			mode: domain.CampsiteImportModeAllOrNothing,
+			mockTxPhases: func(mock sqlmock.Sqlmock, rows []*domain.CampsiteImportRow) {
+				mock.ExpectBegin()
+				for _, row := range rows {
+					mock.ExpectQuery(queries.UpsertCampsite).
+						WithArgs(upsertCampsiteArgs(row)...).
+						WillReturnRows(sqlmock.NewRows(upsertColumnsRow).
+							AddRow(row.Campsite.CampsiteID, true))
+				}
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			rows := newRows()
+			tc.mockTxPhases(mock, rows)
+			repo := NewCampsiteRepository(db)
+			// when
+			err = repo.Upsert(context.TODO(), rows, tc.mode)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Upsert() error = %v, wantErr %v", err, tc.wantErr)
+			if tc.wantStatus != nil {
+				for i, row := range rows {
+					assert.Equal(t, tc.wantStatus[i], row.Status, "row %d status", row.Row)
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func campsiteArgs(c *domain.Campsite) []driver.Value {
 	return campsiteRowValues(c)[1:] // remove ID
 }
 
+// upsertCampsiteArgs returns the arguments of the upsert of the row, whose
+// active flag is NULL unless imported.
+func upsertCampsiteArgs(row *domain.CampsiteImportRow) []driver.Value {
+	args := campsiteArgs(row.Campsite)
+	if !row.ActiveImported {
+		args[7] = nil
+	}
+	return args
+}
+
 func campsiteRowValues(c *domain.Campsite) []driver.Value {
 	return []driver.Value{
 		c.ID,
@@ -225,5 +549,6 @@ func campsiteRowValues(c *domain.Campsite) []driver.Value {
 		c.PicnicTable,
 		c.FirePit,
 		c.Active,
+		c.CampgroundID,
 	}
 }
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/jackc/pgconn"
+	"github.com/stackus/errors"
+)
+
+const uniqueViolation = "23505"
+
+type GuestRepository struct {
+	db DB
+}
+
+var _ domain.GuestRepository = (*GuestRepository)(nil)
+
+func NewGuestRepository(db DB) GuestRepository {
+	return GuestRepository{db}
+}
+
+func (r GuestRepository) Find(ctx context.Context, guestID string) (*domain.Guest, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	guest, err := scanGuest(tx.QueryRowContext(ctx, queries.FindGuestByGuestID, guestID).Scan)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrGuestNotFound{GuestID: guestID}
+		}
+		return nil, errors.Wrap(err, "scan guest row")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return guest, nil
+}
+
+func (r GuestRepository) FindOrInsert(
+	ctx context.Context,
+	guest *domain.Guest,
+) (*domain.Guest, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	found, err := scanGuest(tx.QueryRowContext(
+		ctx, queries.FindOrInsertGuest, guest.GuestID, guest.Email, guest.FullName,
+		guest.Phone, guest.Address, guest.Preferences,
+	).Scan)
+	if err != nil {
+		return nil, errors.Wrap(err, "find or insert guest")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return found, nil
+}
+
+func (r GuestRepository) Update(ctx context.Context, guest *domain.Guest) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.UpdateGuest, guest.GuestID, guest.Email,
+		guest.FullName, guest.Phone, guest.Address, guest.Preferences)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domain.ErrGuestEmailInUse{}
+		}
+		return errors.Wrap(err, "update guest")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrGuestNotFound{GuestID: guest.GuestID}
+		}
+		return errors.Wrap(err, "update guest")
+	}
+	_, err = tx.ExecContext(ctx, queries.UpdateUpcomingBookingsOfGuest,
+		guest.GuestID, guest.Email, guest.FullName)
+	if err != nil {
+		return errors.Wrap(err, "update upcoming bookings of guest")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r GuestRepository) FindData(ctx context.Context, email string) (*domain.GuestData, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	data := &domain.GuestData{Email: email}
+	data.Guest, err = scanGuest(tx.QueryRowContext(ctx, queries.FindGuestByEmail, email).Scan)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "scan guest row")
+	}
+	if data.Bookings, err = findGuestBookings(ctx, tx, email); err != nil {
+		return nil, err
+	}
+	if data.WaitlistEntries, err = findGuestWaitlistEntries(ctx, tx, email); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return data, nil
+}
+
+func (r GuestRepository) Anonymize(ctx context.Context, email string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	var occupying int
+	err = tx.QueryRowContext(ctx, queries.CountOccupyingBookingsByGuestEmail, email).
+		Scan(&occupying)
+	if err != nil {
+		return errors.Wrap(err, "count occupying bookings of guest")
+	}
+	if occupying > 0 {
+		return domain.ErrGuestHasActiveBookings{}
+	}
+	if _, err = tx.ExecContext(ctx, queries.AnonymizeBookingsByGuestEmail, email); err != nil {
+		return errors.Wrap(err, "anonymize bookings of guest")
+	}
+	if _, err = tx.ExecContext(ctx, queries.DeleteWaitlistEntriesByEmail, email); err != nil {
+		return errors.Wrap(err, "delete waitlist entries of guest")
+	}
+	if _, err = tx.ExecContext(ctx, queries.DeleteGuestByEmail, email); err != nil {
+		return errors.Wrap(err, "delete guest")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r GuestRepository) AnonymizeEndedBefore(ctx context.Context, date time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.AnonymizeBookingsEndedBefore, date)
+	if err != nil {
+		return 0, errors.Wrap(err, "anonymize bookings")
+	}
+	anonymized, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "anonymize bookings")
+	}
+	if _, err = tx.ExecContext(ctx, queries.DeleteWaitlistEntriesEndedBefore, date); err != nil {
+		return 0, errors.Wrap(err, "delete waitlist entries")
+	}
+	if _, err = tx.ExecContext(ctx, queries.DeleteGuestsWithoutBookings, date); err != nil {
+		return 0, errors.Wrap(err, "delete guests without bookings")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "commit transaction")
+	}
+	return anonymized, nil
+}
+
+func findGuestBookings(
+	ctx context.Context,
+	tx *sql.Tx,
+	email string,
+) (bookings []*domain.Booking, err error) {
+	rows, err := tx.QueryContext(ctx, queries.FindAllBookingsByGuestEmail, email)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bookings by guest email")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var booking *domain.Booking
+		if booking, err = scanBooking(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan booking row")
+		}
+		bookings = append(bookings, booking)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish booking rows")
+	}
+	return bookings, nil
+}
+
+func findGuestWaitlistEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	email string,
+) (entries []*domain.WaitlistEntry, err error) {
+	rows, err := tx.QueryContext(ctx, queries.FindAllWaitlistEntriesByEmail, email)
+	if err != nil {
+		return nil, errors.Wrap(err, "query waitlist entries by email")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var entry *domain.WaitlistEntry
+		if entry, err = scanWaitlistEntry(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan waitlist entry row")
+		}
+		entries = append(entries, entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish waitlist entry rows")
+	}
+	return entries, nil
+}
+
+func scanGuest(scan func(dest ...any) error) (*domain.Guest, error) {
+	guest := &domain.Guest{}
+	if err := scan(
+		&guest.ID, &guest.GuestID, &guest.Email, &guest.FullName, &guest.Phone,
+		&guest.Address, &guest.Preferences,
+	); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
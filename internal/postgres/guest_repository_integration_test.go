@@ -0,0 +1,282 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type guestSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	repo      postgres.GuestRepository
+	suite.Suite
+}
+
+func TestGuestRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &guestSuite{})
+}
+
+func (s *guestSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *guestSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *guestSuite) SetupTest() {
+	s.repo = postgres.NewGuestRepository(s.db)
+}
+
+func (s *guestSuite) TearDownTest() {
+	err := bootstrap.DeleteBookings(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteCampsites(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	err = bootstrap.DeleteGuests(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_FindOrInsert_Insert() {
+	// given
+	guest := bootstrap.NewGuest()
+	// when
+	got, err := s.repo.FindOrInsert(context.Background(), guest)
+	// then
+	if s.NoError(err) {
+		guest.ID = got.ID
+		s.Equal(guest, got)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_FindOrInsert_Find() {
+	// given
+	existing := bootstrap.NewGuest()
+	existing, err := s.repo.FindOrInsert(context.Background(), existing)
+	s.NoError(err)
+	guest := bootstrap.NewGuest()
+	guest.Email = existing.Email
+	// when
+	got, err := s.repo.FindOrInsert(context.Background(), guest)
+	// then
+	if s.NoError(err) {
+		s.Equal(existing, got)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_Find_NotFound() {
+	// given
+	guestID := "non-existing-id"
+	// when
+	got, err := s.repo.Find(context.Background(), guestID)
+	// then
+	s.Nil(got)
+	s.Equal(domain.ErrGuestNotFound{GuestID: guestID}, err)
+}
+
+func (s *guestSuite) TestGuestRepository_Update_UpcomingBookings() {
+	// given
+	guest, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	upcoming, err := bootstrap.NewBooking(campsite.CampsiteID)
+	s.NoError(err)
+	upcoming.GuestID = guest.GuestID
+	s.NoError(bootstrap.InsertBooking(s.db, upcoming))
+	past, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, -3, -2)
+	s.NoError(err)
+	past.GuestID = guest.GuestID
+	past.Status = domain.BookingStatusCheckedOut
+	s.NoError(bootstrap.InsertBooking(s.db, past))
+
+	guest.Email = "new." + guest.Email
+	guest.FullName = "New " + guest.FullName
+	// when
+	err = s.repo.Update(context.Background(), guest)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), guest.GuestID)
+		s.NoError(err)
+		s.Equal(guest, got)
+
+		gotUpcoming, err := bootstrap.FindBooking(s.db, upcoming.BookingID)
+		s.NoError(err)
+		s.Equal(guest.Email, gotUpcoming.Email)
+		s.Equal(guest.FullName, gotUpcoming.FullName)
+		s.Equal(upcoming.Version+1, gotUpcoming.Version)
+
+		gotPast, err := bootstrap.FindBooking(s.db, past.BookingID)
+		s.NoError(err)
+		s.Equal(past.Email, gotPast.Email)
+		s.Equal(past.FullName, gotPast.FullName)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_Update_EmailInUse() {
+	// given
+	other, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	guest, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	guest.Email = other.Email
+	// when
+	err = s.repo.Update(context.Background(), guest)
+	// then
+	s.Equal(domain.ErrGuestEmailInUse{}, err)
+}
+
+func (s *guestSuite) TestGuestRepository_FindData() {
+	// given
+	guest, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	booking, err := bootstrap.NewBooking(campsite.CampsiteID)
+	s.NoError(err)
+	booking.Email = guest.Email
+	booking.GuestID = guest.GuestID
+	s.NoError(bootstrap.InsertBooking(s.db, booking))
+	other, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, 3, 4)
+	s.NoError(err)
+	s.NoError(bootstrap.InsertBooking(s.db, other))
+	// when
+	got, err := s.repo.FindData(context.Background(), guest.Email)
+	// then
+	if s.NoError(err) {
+		s.Equal(guest.Email, got.Email)
+		s.Equal(guest, got.Guest)
+		if s.Len(got.Bookings, 1) {
+			s.Equal(booking.BookingID, got.Bookings[0].BookingID)
+		}
+		s.Empty(got.WaitlistEntries)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_Anonymize() {
+	// given
+	guest, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	past, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, -3, -2)
+	s.NoError(err)
+	past.Email = guest.Email
+	past.GuestID = guest.GuestID
+	past.Status = domain.BookingStatusCheckedOut
+	s.NoError(bootstrap.InsertBooking(s.db, past))
+	// when
+	err = s.repo.Anonymize(context.Background(), guest.Email)
+	// then
+	if s.NoError(err) {
+		got, err := bootstrap.FindBooking(s.db, past.BookingID)
+		s.NoError(err)
+		s.Empty(got.Email)
+		s.Empty(got.FullName)
+		s.Empty(got.GuestID)
+		s.Equal(past.StartDate, got.StartDate)
+		s.Equal(past.Version+1, got.Version)
+
+		_, err = s.repo.Find(context.Background(), guest.GuestID)
+		s.Equal(domain.ErrGuestNotFound{GuestID: guest.GuestID}, err)
+	}
+}
+
+func (s *guestSuite) TestGuestRepository_Anonymize_ActiveBookings() {
+	// given
+	guest, err := s.repo.FindOrInsert(context.Background(), bootstrap.NewGuest())
+	s.NoError(err)
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	upcoming, err := bootstrap.NewBooking(campsite.CampsiteID)
+	s.NoError(err)
+	upcoming.Email = guest.Email
+	upcoming.GuestID = guest.GuestID
+	s.NoError(bootstrap.InsertBooking(s.db, upcoming))
+	// when
+	err = s.repo.Anonymize(context.Background(), guest.Email)
+	// then
+	s.Equal(domain.ErrGuestHasActiveBookings{}, err)
+	got, err := bootstrap.FindBooking(s.db, upcoming.BookingID)
+	s.NoError(err)
+	s.Equal(guest.Email, got.Email)
+}
+
+func (s *guestSuite) TestGuestRepository_AnonymizeEndedBefore() {
+	// given
+	campsite, err := bootstrap.NewCampsite()
+	s.NoError(err)
+	s.NoError(bootstrap.InsertCampsite(s.db, campsite))
+
+	expired, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, -10, -8)
+	s.NoError(err)
+	expired.Status = domain.BookingStatusCheckedOut
+	s.NoError(bootstrap.InsertBooking(s.db, expired))
+	recent, err := bootstrap.NewBookingWithAddDays(campsite.CampsiteID, -3, -2)
+	s.NoError(err)
+	recent.Status = domain.BookingStatusCheckedOut
+	s.NoError(bootstrap.InsertBooking(s.db, recent))
+	// when
+	got, err := s.repo.AnonymizeEndedBefore(
+		context.Background(), bootstrap.AsStartOfDayUTC(time.Now()).AddDate(0, 0, -5),
+	)
+	// then
+	if s.NoError(err) {
+		s.Equal(int64(1), got)
+
+		gotExpired, err := bootstrap.FindBooking(s.db, expired.BookingID)
+		s.NoError(err)
+		s.Empty(gotExpired.Email)
+		s.Empty(gotExpired.FullName)
+		s.Equal(expired.EndDate, gotExpired.EndDate)
+
+		gotRecent, err := bootstrap.FindBooking(s.db, recent.BookingID)
+		s.NoError(err)
+		s.Equal(recent.Email, gotRecent.Email)
+	}
+}
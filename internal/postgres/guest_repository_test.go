@@ -0,0 +1,557 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+var guestColumnsRow = []string{
+	"id",
+	"guest_id",
+	"email",
+	"full_name",
+	"phone",
+	"address",
+	"preferences",
+}
+
+func TestGuestRepository_Find(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.Guest
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByGuestID).
+					WithArgs(guest.GuestID).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow).
+						AddRow(guestRowValues(guest)...))
+				mock.ExpectCommit()
+			},
+			want:    guest,
+			wantErr: nil,
+		},
+		"Error_GuestNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByGuestID).
+					WithArgs(guest.GuestID).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow))
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errGuestNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByGuestID).
+					WithArgs(guest.GuestID).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow).
+						AddRow(guestRowValues(guest)...))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), guest.GuestID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGuestRepository_FindOrInsert(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	existing := bootstrap.NewGuest()
+	existing.Email = guest.Email
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.Guest
+		wantErr      error
+	}{
+		"Success_Insert": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindOrInsertGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow).
+						AddRow(guestRowValues(guest)...))
+				mock.ExpectCommit()
+			},
+			want:    guest,
+			wantErr: nil,
+		},
+		"Success_Find": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindOrInsertGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow).
+						AddRow(guestRowValues(existing)...))
+				mock.ExpectCommit()
+			},
+			want:    existing,
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindOrInsertGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			got, err := repo.FindOrInsert(context.TODO(), guest)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindOrInsert() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindOrInsert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGuestRepository_Update(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	errGuestNotFound := domain.ErrGuestNotFound{GuestID: guest.GuestID}
+	errGuestEmailInUse := domain.ErrGuestEmailInUse{}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.UpdateUpcomingBookingsOfGuest).
+					WithArgs(guest.GuestID, guest.Email, guest.FullName).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_GuestNotFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errGuestNotFound,
+		},
+		"Error_GuestEmailInUse": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+				mock.ExpectRollback()
+			},
+			wantErr: errGuestEmailInUse,
+		},
+		"Error_UpdateUpcomingBookings": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.UpdateUpcomingBookingsOfGuest).
+					WithArgs(guest.GuestID, guest.Email, guest.FullName).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateGuest).
+					WithArgs(guestArgs(guest)...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.UpdateUpcomingBookingsOfGuest).
+					WithArgs(guest.GuestID, guest.Email, guest.FullName).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			err = repo.Update(context.TODO(), guest)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Update() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGuestRepository_FindData(t *testing.T) {
+	guest := bootstrap.NewGuest()
+	booking, err := bootstrap.NewBooking(uuid.New().String())
+	if err != nil {
+		t.Fatalf("create booking error: %v", err)
+	}
+	booking.GuestID = guest.GuestID
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	entry.Email = guest.Email
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.GuestData
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow).
+						AddRow(guestRowValues(guest)...))
+				mock.ExpectQuery(queries.FindAllBookingsByGuestEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(columnsRow).
+						AddRow(bookingRowValues(booking)...))
+				mock.ExpectQuery(queries.FindAllWaitlistEntriesByEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow).
+						AddRow(waitlistEntryRowValues(entry)...))
+				mock.ExpectCommit()
+			},
+			want: &domain.GuestData{
+				Email:           guest.Email,
+				Guest:           guest,
+				Bookings:        []*domain.Booking{booking},
+				WaitlistEntries: []*domain.WaitlistEntry{entry},
+			},
+			wantErr: nil,
+		},
+		"Success_NoGuest": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow))
+				mock.ExpectQuery(queries.FindAllBookingsByGuestEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(columnsRow))
+				mock.ExpectQuery(queries.FindAllWaitlistEntriesByEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(waitlistEntryColumnsRow))
+				mock.ExpectCommit()
+			},
+			want:    &domain.GuestData{Email: guest.Email},
+			wantErr: nil,
+		},
+		"Error_QueryBookings": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindGuestByEmail).
+					WithArgs(guest.Email).
+					WillReturnRows(sqlmock.NewRows(guestColumnsRow))
+				mock.ExpectQuery(queries.FindAllBookingsByGuestEmail).
+					WithArgs(guest.Email).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			got, err := repo.FindData(context.TODO(), guest.Email)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindData() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindData() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGuestRepository_Anonymize(t *testing.T) {
+	email := bootstrap.NewGuest().Email
+	countRow := []string{"count"}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.CountOccupyingBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnRows(sqlmock.NewRows(countRow).AddRow(0))
+				mock.ExpectExec(queries.AnonymizeBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(queries.DeleteWaitlistEntriesByEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.DeleteGuestByEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_GuestHasActiveBookings": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.CountOccupyingBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnRows(sqlmock.NewRows(countRow).AddRow(1))
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrGuestHasActiveBookings{},
+		},
+		"Error_AnonymizeBookings": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.CountOccupyingBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnRows(sqlmock.NewRows(countRow).AddRow(0))
+				mock.ExpectExec(queries.AnonymizeBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.CountOccupyingBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnRows(sqlmock.NewRows(countRow).AddRow(0))
+				mock.ExpectExec(queries.AnonymizeBookingsByGuestEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(queries.DeleteWaitlistEntriesByEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(queries.DeleteGuestByEmail).
+					WithArgs(email).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			err = repo.Anonymize(context.TODO(), email)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Anonymize() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGuestRepository_AnonymizeEndedBefore(t *testing.T) {
+	date := time.Date(2023, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         int64
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.AnonymizeBookingsEndedBefore).
+					WithArgs(date).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(queries.DeleteWaitlistEntriesEndedBefore).
+					WithArgs(date).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.DeleteGuestsWithoutBookings).
+					WithArgs(date).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			want:    3,
+			wantErr: nil,
+		},
+		"Error_DeleteGuests": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.AnonymizeBookingsEndedBefore).
+					WithArgs(date).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(queries.DeleteWaitlistEntriesEndedBefore).
+					WithArgs(date).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queries.DeleteGuestsWithoutBookings).
+					WithArgs(date).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			want:    0,
+			wantErr: bootstrap.ErrExec,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    0,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewGuestRepository(db)
+			// when
+			got, err := repo.AnonymizeEndedBefore(context.TODO(), date)
+			// then
+			assert.Equal(t, tc.want, got,
+				"AnonymizeEndedBefore() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"AnonymizeEndedBefore() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func guestArgs(g *domain.Guest) []driver.Value {
+	return guestRowValues(g)[1:] // remove ID
+}
+
+func guestRowValues(g *domain.Guest) []driver.Value {
+	return []driver.Value{
+		g.ID,
+		g.GuestID,
+		g.Email,
+		g.FullName,
+		g.Phone,
+		g.Address,
+		g.Preferences,
+	}
+}
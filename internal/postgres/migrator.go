@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"github.com/stackus/errors"
+)
+
+// ErrSchemaVersionBehind is returned when the schema of the database is older
+// than the schema expected by the app.
+type ErrSchemaVersionBehind struct {
+	Version  int64
+	Expected int64
+}
+
+func (e ErrSchemaVersionBehind) Error() string {
+	return fmt.Sprintf(
+		"schema version %d is behind expected version %d, migrate the database first",
+		e.Version, e.Expected,
+	)
+}
+
+// Migrator applies and rolls back the schema migrations. Migrations are run
+// under a session-level advisory lock so that replicas migrating on startup
+// apply them one at a time.
+type Migrator struct {
+	provider *goose.Provider
+}
+
+// NewMigrator returns a migrator of the migrations of fsys, waiting up to the
+// lock timeout for another replica to release the migration lock.
+func NewMigrator(db *sql.DB, fsys fs.FS, lockTimeout time.Duration) (*Migrator, error) {
+	locker, err := lock.NewPostgresSessionLocker(
+		lock.WithLockTimeout(1, uint64(max(lockTimeout/time.Second, 1))),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create migration lock")
+	}
+	provider, err := goose.NewProvider(
+		goose.DialectPostgres, db, fsys, goose.WithSessionLocker(locker),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create migration provider")
+	}
+	return &Migrator{provider: provider}, nil
+}
+
+// Up applies the pending migrations.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return results, errors.Wrap(err, "apply migrations")
+	}
+	return results, nil
+}
+
+// Down rolls back the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	result, err := m.provider.Down(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "roll back migration")
+	}
+	return result, nil
+}
+
+// Status returns the state of every migration, in ascending order of version.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get migrations status")
+	}
+	return statuses, nil
+}
+
+// Version returns the schema version of the database, and the version of the
+// latest migration known to the app.
+func (m *Migrator) Version(ctx context.Context) (version, expected int64, err error) {
+	version, expected, err = m.provider.GetVersions(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get schema version")
+	}
+	return version, expected, nil
+}
+
+// CheckVersion returns ErrSchemaVersionBehind if migrations known to the app
+// are still to be applied; a schema ahead of the app, e.g. after a rollback
+// of the app, is accepted.
+func (m *Migrator) CheckVersion(ctx context.Context) error {
+	version, expected, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	if version < expected {
+		return ErrSchemaVersionBehind{Version: version, Expected: expected}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/db/migrations"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"golang.org/x/sync/errgroup"
+)
+
+type migratorSuite struct {
+	container *pg.PostgresContainer
+	db        *sql.DB
+	migrator  *postgres.Migrator
+	suite.Suite
+}
+
+func TestMigrator(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &migratorSuite{})
+}
+
+func (s *migratorSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *migratorSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *migratorSuite) SetupTest() {
+	var err error
+	s.migrator, err = postgres.NewMigrator(s.db, migrations.FS, time.Minute)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *migratorSuite) TearDownTest() {
+	if _, err := s.migrator.Up(context.Background()); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *migratorSuite) TestMigrator_CheckVersion() {
+	// when
+	err := s.migrator.CheckVersion(context.Background())
+	// then
+	s.NoError(err)
+}
+
+func (s *migratorSuite) TestMigrator_CheckVersion_ErrSchemaVersionBehind() {
+	// given
+	_, expected, err := s.migrator.Version(context.Background())
+	s.NoError(err)
+	result, err := s.migrator.Down(context.Background())
+	s.NoError(err)
+	s.Equal(expected, result.Source.Version)
+	// when
+	err = s.migrator.CheckVersion(context.Background())
+	// then
+	s.Equal(postgres.ErrSchemaVersionBehind{Version: expected - 1, Expected: expected}, err)
+}
+
+func (s *migratorSuite) TestMigrator_Up_Concurrently() {
+	// given
+	_, err := s.migrator.Down(context.Background())
+	s.NoError(err)
+	_, err = s.migrator.Down(context.Background())
+	s.NoError(err)
+	other, err := postgres.NewMigrator(s.db, migrations.FS, time.Minute)
+	s.NoError(err)
+	// when
+	applied := make([]int, 2)
+	group, ctx := errgroup.WithContext(context.Background())
+	for i, m := range []*postgres.Migrator{s.migrator, other} {
+		group.Go(func() error {
+			results, err := m.Up(ctx)
+			applied[i] = len(results)
+			return err
+		})
+	}
+	err = group.Wait()
+	// then
+	if s.NoError(err) {
+		s.Equal(2, applied[0]+applied[1])
+		s.NoError(s.migrator.CheckVersion(context.Background()))
+	}
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+)
+
+type (
+	// DB begins the transactions of the repositories, e.g. *sql.DB or
+	// *ReplicaRouter.
+	DB interface {
+		BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	}
+
+	// ReplicaRouter begins the read-only transactions on a read replica while
+	// it is healthy, and the other transactions, and those of a context with
+	// read-your-writes, see domain.WithReadYourWrites, on the primary.
+	ReplicaRouter struct {
+		primary *sql.DB
+		replica *sql.DB
+		maxLag  time.Duration
+		healthy atomic.Bool
+		checked atomic.Bool
+	}
+)
+
+var _ DB = (*ReplicaRouter)(nil)
+
+// NewReplicaRouter returns a router to the replica, if any. The replica is
+// unhealthy until checked by CheckReplica.
+func NewReplicaRouter(primary, replica *sql.DB, maxLag time.Duration) *ReplicaRouter {
+	return &ReplicaRouter{primary: primary, replica: replica, maxLag: maxLag}
+}
+
+func (r *ReplicaRouter) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	if opts == nil || !opts.ReadOnly || !r.Healthy() || domain.ReadYourWrites(ctx) {
+		return r.primary.BeginTx(ctx, opts)
+	}
+	tx, err := r.replica.BeginTx(ctx, opts)
+	if err == nil || ctx.Err() != nil {
+		return tx, err
+	}
+	// fall back at once rather than on the next check
+	r.setHealthy(ctx, false, slog.Any("error", err))
+	return r.primary.BeginTx(ctx, opts)
+}
+
+// Healthy reports whether the read-only transactions are routed to the
+// replica.
+func (r *ReplicaRouter) Healthy() bool {
+	return r.replica != nil && r.healthy.Load()
+}
+
+// CheckReplica marks the replica healthy if it can be reached and lags behind
+// the primary by no more than the maximum lag, and unhealthy otherwise.
+func (r *ReplicaRouter) CheckReplica(ctx context.Context) {
+	if r.replica == nil {
+		return
+	}
+	var lag float64
+	if err := r.replica.QueryRowContext(ctx, queries.FindReplicationLag).Scan(&lag); err != nil {
+		r.setHealthy(ctx, false, slog.Any("error", err))
+		return
+	}
+	replicationLag := time.Duration(lag * float64(time.Second))
+	r.setHealthy(ctx, replicationLag <= r.maxLag, slog.Duration("lag", replicationLag))
+}
+
+// setHealthy logs the health of the replica on the first check and when it
+// changes, not on every check.
+func (r *ReplicaRouter) setHealthy(ctx context.Context, healthy bool, reason slog.Attr) {
+	changed := r.healthy.Swap(healthy) != healthy
+	if checked := r.checked.Swap(true); checked && !changed {
+		return
+	}
+	if healthy {
+		slog.InfoContext(ctx, "read replica healthy, read-only transactions routed to it", reason)
+	} else {
+		slog.WarnContext(ctx, "read replica unhealthy, read-only transactions routed to primary",
+			reason)
+	}
+}
@@ -0,0 +1,187 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStubDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("open stub database connection error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db, mock
+}
+
+func TestReplicaRouter_BeginTx(t *testing.T) {
+	readOnly := &sql.TxOptions{ReadOnly: true}
+
+	tests := map[string]struct {
+		ctx          context.Context
+		opts         *sql.TxOptions
+		healthy      bool
+		mockTxPhases func(primary, replica sqlmock.Sqlmock)
+		wantHealthy  bool
+		wantErr      error
+	}{
+		"Replica_ReadOnly": {
+			ctx:     context.TODO(),
+			opts:    readOnly,
+			healthy: true,
+			mockTxPhases: func(_, replica sqlmock.Sqlmock) {
+				replica.ExpectBegin()
+			},
+			wantHealthy: true,
+		},
+		"Primary_ReadWrite": {
+			ctx:     context.TODO(),
+			opts:    &sql.TxOptions{},
+			healthy: true,
+			mockTxPhases: func(primary, _ sqlmock.Sqlmock) {
+				primary.ExpectBegin()
+			},
+			wantHealthy: true,
+		},
+		"Primary_NoOptions": {
+			ctx:     context.TODO(),
+			healthy: true,
+			mockTxPhases: func(primary, _ sqlmock.Sqlmock) {
+				primary.ExpectBegin()
+			},
+			wantHealthy: true,
+		},
+		"Primary_ReadYourWrites": {
+			ctx:     domain.WithReadYourWrites(context.TODO()),
+			opts:    readOnly,
+			healthy: true,
+			mockTxPhases: func(primary, _ sqlmock.Sqlmock) {
+				primary.ExpectBegin()
+			},
+			wantHealthy: true,
+		},
+		"Primary_ReplicaUnhealthy": {
+			ctx:  context.TODO(),
+			opts: readOnly,
+			mockTxPhases: func(primary, _ sqlmock.Sqlmock) {
+				primary.ExpectBegin()
+			},
+			wantHealthy: false,
+		},
+		"Primary_ReplicaBeginTxFailed": {
+			ctx:     context.TODO(),
+			opts:    readOnly,
+			healthy: true,
+			mockTxPhases: func(primary, replica sqlmock.Sqlmock) {
+				replica.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+				primary.ExpectBegin()
+			},
+			wantHealthy: false,
+		},
+		"Error_BeginTx": {
+			ctx:     context.TODO(),
+			opts:    &sql.TxOptions{},
+			healthy: true,
+			mockTxPhases: func(primary, _ sqlmock.Sqlmock) {
+				primary.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			wantHealthy: true,
+			wantErr:     bootstrap.ErrBeginTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			primaryDB, primary := newStubDB(t)
+			replicaDB, replica := newStubDB(t)
+			tc.mockTxPhases(primary, replica)
+			router := NewReplicaRouter(primaryDB, replicaDB, time.Second)
+			router.healthy.Store(tc.healthy)
+			router.checked.Store(true)
+			// when
+			_, err := router.BeginTx(tc.ctx, tc.opts)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"BeginTx() error = %v, wantErr %v", err, tc.wantErr)
+			assert.Equal(t, tc.wantHealthy, router.Healthy())
+			assert.NoError(t, primary.ExpectationsWereMet())
+			assert.NoError(t, replica.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplicaRouter_CheckReplica(t *testing.T) {
+	lagRow := []string{"lag"}
+
+	tests := map[string]struct {
+		healthy      bool
+		mockTxPhases func(replica sqlmock.Sqlmock)
+		want         bool
+	}{
+		"Healthy": {
+			mockTxPhases: func(replica sqlmock.Sqlmock) {
+				replica.ExpectQuery(queries.FindReplicationLag).
+					WillReturnRows(sqlmock.NewRows(lagRow).AddRow(0.5))
+			},
+			want: true,
+		},
+		"Unhealthy_Lagging": {
+			healthy: true,
+			mockTxPhases: func(replica sqlmock.Sqlmock) {
+				replica.ExpectQuery(queries.FindReplicationLag).
+					WillReturnRows(sqlmock.NewRows(lagRow).AddRow(1.5))
+			},
+			want: false,
+		},
+		"Unhealthy_QueryFailed": {
+			healthy: true,
+			mockTxPhases: func(replica sqlmock.Sqlmock) {
+				replica.ExpectQuery(queries.FindReplicationLag).
+					WillReturnError(bootstrap.ErrQuery)
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			primaryDB, _ := newStubDB(t)
+			replicaDB, replica := newStubDB(t)
+			tc.mockTxPhases(replica)
+			router := NewReplicaRouter(primaryDB, replicaDB, time.Second)
+			router.healthy.Store(tc.healthy)
+			// when
+			router.CheckReplica(context.TODO())
+			// then
+			assert.Equal(t, tc.want, router.Healthy())
+			assert.NoError(t, replica.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplicaRouter_NoReplica(t *testing.T) {
+	// given
+	primaryDB, primary := newStubDB(t)
+	primary.ExpectBegin()
+	router := NewReplicaRouter(primaryDB, nil, time.Second)
+	// when
+	router.CheckReplica(context.TODO())
+	_, err := router.BeginTx(context.TODO(), &sql.TxOptions{ReadOnly: true})
+	// then
+	assert.NoError(t, err)
+	assert.False(t, router.Healthy())
+	assert.NoError(t, primary.ExpectationsWereMet())
+}
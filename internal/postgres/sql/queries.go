@@ -10,9 +10,47 @@ const (
 			drinking_water, 
 			picnic_table, 
 			fire_pit, 
-			active
+			active,
+			campground_id
 		) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''))
+	`
+
+	UpsertCampsite = `
+		INSERT INTO campsites (
+			campsite_id, 
+			campsite_code, 
+			capacity, 
+			restrooms, 
+			drinking_water, 
+			picnic_table, 
+			fire_pit, 
+			active,
+			campground_id
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE($8, true), NULLIF($9, ''))
+		ON CONFLICT (campsite_code) DO UPDATE
+		SET 
+		    capacity = EXCLUDED.capacity, 
+		    restrooms = EXCLUDED.restrooms, 
+		    drinking_water = EXCLUDED.drinking_water, 
+		    picnic_table = EXCLUDED.picnic_table, 
+		    fire_pit = EXCLUDED.fire_pit, 
+		    active = COALESCE($8, campsites.active), 
+		    campground_id = COALESCE(EXCLUDED.campground_id, campsites.campground_id)
+		RETURNING campsite_id, xmax = 0
+	`
+
+	SavepointCampsiteImport = `
+		SAVEPOINT campsite_import
+	`
+
+	ReleaseSavepointCampsiteImport = `
+		RELEASE SAVEPOINT campsite_import
+	`
+
+	RollbackToSavepointCampsiteImport = `
+		ROLLBACK TO SAVEPOINT campsite_import
 	`
 
 	FindAllCampsites = `
@@ -25,10 +63,57 @@ const (
 		    drinking_water, 
 		    picnic_table, 
 		    fire_pit, 
-		    active
+		    active,
+		    COALESCE(campground_id, '')
 		FROM campsites
 	`
 
+	FindCampsiteByCampsiteID = FindAllCampsites + `
+		WHERE campsite_id = $1
+	`
+
+	FindAllCampsitesByCampgroundID = FindAllCampsites + `
+		WHERE campground_id = $1
+	`
+
+	InsertCampground = `
+		INSERT INTO campgrounds (
+			campground_id, 
+			name, 
+			description, 
+			active
+		) 
+		VALUES ($1, $2, $3, $4)
+	`
+
+	FindAllCampgrounds = `
+		SELECT 
+		    id,
+		    campground_id, 
+		    name, 
+		    description, 
+		    active
+		FROM campgrounds
+	`
+
+	FindCampgroundByCampgroundID = FindAllCampgrounds + `
+		WHERE campground_id = $1
+	`
+
+	UpdateCampground = `
+		UPDATE campgrounds
+		SET 
+		    name = $2, 
+		    description = $3, 
+		    active = $4
+		WHERE campground_id = $1
+	`
+
+	DeleteCampground = `
+		DELETE FROM campgrounds
+		WHERE campground_id = $1
+	`
+
 	FindBookingByBookingID = `
 		SELECT 
 		    id,
@@ -38,8 +123,17 @@ const (
 		    full_name, 
 		    start_date, 
 		    end_date, 
-		    active,
-		    version
+		    status,
+		    version,
+		    guests,
+		    total_price,
+		    currency,
+		    deposit_amount,
+		    payment_ref,
+		    refund_percent,
+		    refund_amount,
+		    group_id,
+		    guest_id
 		FROM bookings
 		WHERE booking_id = $1
 	`
@@ -52,10 +146,19 @@ const (
 			full_name, 
 			start_date, 
 			end_date, 
-			active,
-		    version
+			status,
+		    version,
+			guests,
+			total_price,
+			currency,
+			deposit_amount,
+			payment_ref,
+			refund_percent,
+			refund_amount,
+			group_id,
+			guest_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
 	FindAllBookingsForDateRange = `
@@ -67,16 +170,75 @@ const (
 		    full_name, 
 		    start_date, 
 		    end_date, 
-		    active,
-		    version
+		    status,
+		    version,
+		    guests,
+		    total_price,
+		    currency,
+		    deposit_amount,
+		    payment_ref,
+		    refund_percent,
+		    refund_amount,
+		    group_id,
+		    guest_id
 		FROM bookings
-		WHERE active = TRUE 
+		WHERE status IN ('PENDING', 'CONFIRMED', 'CHECKED_IN')
 		  	AND campsite_id = $1
 		  	AND ((start_date < $2 AND $3 < end_date) 
 		            OR ($2 < end_date AND end_date <= $3) 
 		            OR ($2 <= start_date AND start_date <= $3)) 
 	`
 
+	FindAllBookingsByGroupID = `
+		SELECT
+		    id,
+		    booking_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    status,
+		    version,
+		    guests,
+		    total_price,
+		    currency,
+		    deposit_amount,
+		    payment_ref,
+		    refund_percent,
+		    refund_amount,
+		    group_id,
+		    guest_id
+		FROM bookings
+		WHERE group_id = $1
+		ORDER BY id
+	`
+
+	FindAllBookingsByGuestID = `
+		SELECT
+		    id,
+		    booking_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    status,
+		    version,
+		    guests,
+		    total_price,
+		    currency,
+		    deposit_amount,
+		    payment_ref,
+		    refund_percent,
+		    refund_amount,
+		    group_id,
+		    guest_id
+		FROM bookings
+		WHERE guest_id = $1
+		ORDER BY start_date DESC, id DESC
+	`
+
 	UpdateBooking = `
 		UPDATE bookings
 		SET 
@@ -85,9 +247,581 @@ const (
 		    full_name = $4, 
 		    start_date = $5,
 		    end_date = $6,
-		    active = $7, 
+		    status = $7,
+		    guests = $9,
+		    total_price = $10,
+		    currency = $11,
+		    deposit_amount = $12,
+		    payment_ref = $13,
+		    refund_percent = $14,
+		    refund_amount = $15,
 		    version = version + 1
 		WHERE booking_id = $1 AND version = $8
 		RETURNING version
 	`
+
+	FindCampsiteRatesByCampsiteID = `
+		SELECT 
+		    id,
+		    campsite_id, 
+		    currency, 
+		    nightly_rate, 
+		    weekend_nightly_rate, 
+		    included_guests, 
+		    extra_guest_fee, 
+		    tax_rate_bps
+		FROM campsite_rates
+		WHERE campsite_id = $1
+	`
+
+	FindSeasonalRatesByCampsiteID = `
+		SELECT 
+		    name, 
+		    start_date, 
+		    end_date, 
+		    nightly_rate, 
+		    weekend_nightly_rate
+		FROM campsite_seasonal_rates
+		WHERE campsite_id = $1
+		ORDER BY start_date
+	`
+
+	UpsertCampsiteRates = `
+		INSERT INTO campsite_rates (
+			campsite_id, 
+			currency, 
+			nightly_rate, 
+			weekend_nightly_rate, 
+			included_guests, 
+			extra_guest_fee, 
+			tax_rate_bps
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (campsite_id) DO UPDATE
+		SET 
+		    currency = EXCLUDED.currency, 
+		    nightly_rate = EXCLUDED.nightly_rate, 
+		    weekend_nightly_rate = EXCLUDED.weekend_nightly_rate, 
+		    included_guests = EXCLUDED.included_guests, 
+		    extra_guest_fee = EXCLUDED.extra_guest_fee, 
+		    tax_rate_bps = EXCLUDED.tax_rate_bps
+	`
+
+	DeleteSeasonalRatesByCampsiteID = `
+		DELETE FROM campsite_seasonal_rates
+		WHERE campsite_id = $1
+	`
+
+	InsertSeasonalRate = `
+		INSERT INTO campsite_seasonal_rates (
+			campsite_id, 
+			name, 
+			start_date, 
+			end_date, 
+			nightly_rate, 
+			weekend_nightly_rate
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	FindWaitlistEntryByEntryID = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE entry_id = $1
+	`
+
+	FindAllWaitlistEntriesByCampgroundID = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE campground_id = $1
+		ORDER BY created_at, id
+	`
+
+	FindWaitingWaitlistEntries = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE (campsite_id = $1 OR (campsite_id = '' AND campground_id = $2))
+		  	AND status = 'WAITING'
+		  	AND start_date < $4 AND $3 < end_date
+		ORDER BY created_at, id
+	`
+
+	FindOfferedWaitlistEntries = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE offer_campsite_id = $1
+		  	AND status = 'OFFERED'
+		  	AND offer_expires_at > now()
+		  	AND start_date < $3 AND $2 < end_date
+		ORDER BY created_at, id
+	`
+
+	FindExpiredWaitlistOffers = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE status = 'OFFERED'
+		  	AND offer_expires_at <= $1
+		ORDER BY offer_expires_at, id
+	`
+
+	InsertWaitlistEntry = `
+		INSERT INTO waitlist_entries (
+			entry_id, 
+			campground_id, 
+			campsite_id, 
+			email, 
+			full_name, 
+			start_date, 
+			end_date, 
+			guests,
+			status
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	UpdateWaitlistEntry = `
+		UPDATE waitlist_entries
+		SET 
+		    status = $2, 
+		    offer_campsite_id = $3, 
+		    offer_expires_at = $4, 
+		    booking_id = $5
+		WHERE entry_id = $1
+	`
+
+	DeleteWaitlistEntry = `
+		DELETE FROM waitlist_entries
+		WHERE entry_id = $1
+	`
+
+	FindCampsiteBlackoutByBlackoutID = `
+		SELECT 
+		    id,
+		    blackout_id, 
+		    campsite_id, 
+		    start_date, 
+		    end_date, 
+		    reason
+		FROM campsite_blackouts
+		WHERE blackout_id = $1
+	`
+
+	FindAllCampsiteBlackoutsByCampsiteID = `
+		SELECT 
+		    id,
+		    blackout_id, 
+		    campsite_id, 
+		    start_date, 
+		    end_date, 
+		    reason
+		FROM campsite_blackouts
+		WHERE campsite_id = $1
+		ORDER BY start_date, id
+	`
+
+	FindAllCampsiteBlackoutsForDateRange = `
+		SELECT 
+		    id,
+		    blackout_id, 
+		    campsite_id, 
+		    start_date, 
+		    end_date, 
+		    reason
+		FROM campsite_blackouts
+		WHERE campsite_id = $1
+		  	AND start_date < $3 AND $2 < end_date
+		ORDER BY start_date, id
+	`
+
+	InsertCampsiteBlackout = `
+		INSERT INTO campsite_blackouts (
+			blackout_id, 
+			campsite_id, 
+			start_date, 
+			end_date, 
+			reason
+		) 
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	DeleteCampsiteBlackout = `
+		DELETE FROM campsite_blackouts
+		WHERE blackout_id = $1
+	`
+
+	FindCalendarSubscriptionBySubscriptionID = `
+		SELECT 
+		    id,
+		    subscription_id, 
+		    campsite_id, 
+		    name, 
+		    url, 
+		    last_synced_at,
+		    last_sync_error
+		FROM calendar_subscriptions
+		WHERE subscription_id = $1
+	`
+
+	FindAllCalendarSubscriptionsByCampsiteID = `
+		SELECT 
+		    id,
+		    subscription_id, 
+		    campsite_id, 
+		    name, 
+		    url, 
+		    last_synced_at,
+		    last_sync_error
+		FROM calendar_subscriptions
+		WHERE campsite_id = $1
+		ORDER BY id
+	`
+
+	FindAllCalendarSubscriptionsWithURL = `
+		SELECT 
+		    id,
+		    subscription_id, 
+		    campsite_id, 
+		    name, 
+		    url, 
+		    last_synced_at,
+		    last_sync_error
+		FROM calendar_subscriptions
+		WHERE url <> ''
+		ORDER BY id
+	`
+
+	InsertCalendarSubscription = `
+		INSERT INTO calendar_subscriptions (
+			subscription_id, 
+			campsite_id, 
+			name, 
+			url, 
+			last_synced_at
+		) 
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	UpdateCalendarSubscriptionSynced = `
+		UPDATE calendar_subscriptions
+		SET 
+		    last_synced_at = $2, 
+		    last_sync_error = ''
+		WHERE subscription_id = $1
+	`
+
+	UpdateCalendarSubscriptionSyncError = `
+		UPDATE calendar_subscriptions
+		SET 
+		    last_sync_error = $2
+		WHERE subscription_id = $1
+	`
+
+	DeleteCalendarSubscription = `
+		DELETE FROM calendar_subscriptions
+		WHERE subscription_id = $1
+	`
+
+	FindAllExternalBlocksForDateRange = `
+		SELECT 
+		    subscription_id, 
+		    campsite_id, 
+		    uid, 
+		    start_date, 
+		    end_date, 
+		    summary
+		FROM external_blocks
+		WHERE campsite_id = $1
+		  	AND start_date < $3 AND $2 < end_date
+		ORDER BY start_date, id
+	`
+
+	InsertExternalBlock = `
+		INSERT INTO external_blocks (
+			subscription_id, 
+			campsite_id, 
+			uid, 
+			start_date, 
+			end_date, 
+			summary
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	DeleteExternalBlocksBySubscriptionID = `
+		DELETE FROM external_blocks
+		WHERE subscription_id = $1
+	`
+
+	FindCampgroundSeasonByCampgroundID = `
+		SELECT 
+		    id,
+		    campground_id, 
+		    opens_on, 
+		    closes_on, 
+		    closed_weekdays
+		FROM campground_seasons
+		WHERE campground_id = $1
+	`
+
+	UpsertCampgroundSeason = `
+		INSERT INTO campground_seasons (
+			campground_id, 
+			opens_on, 
+			closes_on, 
+			closed_weekdays
+		) 
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (campground_id) DO UPDATE
+		SET 
+		    opens_on = EXCLUDED.opens_on, 
+		    closes_on = EXCLUDED.closes_on, 
+		    closed_weekdays = EXCLUDED.closed_weekdays
+	`
+
+	FindGuestByGuestID = `
+		SELECT 
+		    id,
+		    guest_id, 
+		    email, 
+		    full_name, 
+		    phone, 
+		    address, 
+		    preferences
+		FROM guests
+		WHERE guest_id = $1
+	`
+
+	FindOrInsertGuest = `
+		INSERT INTO guests (
+			guest_id, 
+			email, 
+			full_name, 
+			phone, 
+			address, 
+			preferences
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (email) DO UPDATE
+		SET email = EXCLUDED.email
+		RETURNING 
+		    id,
+		    guest_id, 
+		    email, 
+		    full_name, 
+		    phone, 
+		    address, 
+		    preferences
+	`
+
+	UpdateGuest = `
+		UPDATE guests
+		SET 
+		    email = $2, 
+		    full_name = $3, 
+		    phone = $4, 
+		    address = $5, 
+		    preferences = $6
+		WHERE guest_id = $1
+	`
+
+	UpdateUpcomingBookingsOfGuest = `
+		UPDATE bookings
+		SET 
+		    email = $2, 
+		    full_name = $3, 
+		    version = version + 1
+		WHERE guest_id = $1 
+		  	AND start_date >= CURRENT_DATE
+		  	AND status IN ('PENDING', 'CONFIRMED')
+	`
+
+	FindGuestByEmail = `
+		SELECT 
+		    id,
+		    guest_id, 
+		    email, 
+		    full_name, 
+		    phone, 
+		    address, 
+		    preferences
+		FROM guests
+		WHERE email = $1
+	`
+
+	FindAllBookingsByGuestEmail = `
+		SELECT
+		    id,
+		    booking_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    status,
+		    version,
+		    guests,
+		    total_price,
+		    currency,
+		    deposit_amount,
+		    payment_ref,
+		    refund_percent,
+		    refund_amount,
+		    group_id,
+		    guest_id
+		FROM bookings
+		WHERE (email <> '' AND lower(email) = $1)
+		   	OR guest_id IN (SELECT guest_id FROM guests WHERE email = $1)
+		ORDER BY start_date DESC, id DESC
+	`
+
+	FindAllWaitlistEntriesByEmail = `
+		SELECT 
+		    id,
+		    entry_id, 
+		    campground_id, 
+		    campsite_id, 
+		    email, 
+		    full_name, 
+		    start_date, 
+		    end_date, 
+		    guests,
+		    status,
+		    offer_campsite_id,
+		    offer_expires_at,
+		    booking_id,
+		    created_at
+		FROM waitlist_entries
+		WHERE lower(email) = $1
+		ORDER BY created_at, id
+	`
+
+	CountOccupyingBookingsByGuestEmail = `
+		SELECT count(*)
+		FROM bookings
+		WHERE status IN ('PENDING', 'CONFIRMED', 'CHECKED_IN')
+		  	AND ((email <> '' AND lower(email) = $1)
+		   		OR guest_id IN (SELECT guest_id FROM guests WHERE email = $1))
+	`
+
+	AnonymizeBookingsByGuestEmail = `
+		UPDATE bookings
+		SET 
+		    email = '', 
+		    full_name = '', 
+		    guest_id = '', 
+		    version = version + 1
+		WHERE (email <> '' AND lower(email) = $1)
+		   	OR guest_id IN (SELECT guest_id FROM guests WHERE email = $1)
+	`
+
+	DeleteWaitlistEntriesByEmail = `
+		DELETE FROM waitlist_entries
+		WHERE lower(email) = $1
+	`
+
+	DeleteGuestByEmail = `
+		DELETE FROM guests
+		WHERE email = $1
+	`
+
+	AnonymizeBookingsEndedBefore = `
+		UPDATE bookings
+		SET 
+		    email = '', 
+		    full_name = '', 
+		    guest_id = '', 
+		    version = version + 1
+		WHERE email <> '' AND end_date < $1
+	`
+
+	DeleteWaitlistEntriesEndedBefore = `
+		DELETE FROM waitlist_entries
+		WHERE end_date < $1
+	`
+
+	DeleteGuestsWithoutBookings = `
+		DELETE FROM guests g
+		WHERE g.updated_at < $1
+		  	AND NOT EXISTS (SELECT 1 FROM bookings b WHERE b.guest_id = g.guest_id)
+	`
+
+	// seconds since the last replayed transaction, 0 if all the WAL received
+	// was replayed, or on the primary
+	FindReplicationLag = `
+		SELECT CASE
+			WHEN NOT pg_is_in_recovery()
+				OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END::float8
+	`
 )
@@ -1,21 +1,34 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"log/slog"
 
 	"github.com/stackus/errors"
 )
 
-func rollbackTx(tx *sql.Tx) {
+func rollbackTx(ctx context.Context, tx *sql.Tx) {
 	// Rollback returns sql.ErrTxDone if the transaction was already closed.
 	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-		slog.Error("rollback transaction", slog.Any("error", err))
+		slog.ErrorContext(ctx, "rollback transaction", slog.Any("error", err))
 	}
 }
 
-func closeRows(rows *sql.Rows) {
+func closeRows(ctx context.Context, rows *sql.Rows) {
 	if err := rows.Close(); err != nil {
-		slog.Error("close rows", slog.Any("error", err))
+		slog.ErrorContext(ctx, "close rows", slog.Any("error", err))
 	}
 }
+
+// requireAffectedRow returns sql.ErrNoRows if the executed statement did not affect any row.
+func requireAffectedRow(result sql.Result) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
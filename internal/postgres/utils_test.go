@@ -4,12 +4,14 @@ package postgres
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"log/slog"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
 	"github.com/stackus/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,7 +54,7 @@ func TestRollbackTx(t *testing.T) {
 			var buf bytes.Buffer
 			slog.SetDefault(logger.NewDefault(&buf, nil))
 			// when
-			rollbackTx(tx)
+			rollbackTx(context.TODO(), tx)
 			// then
 			if tc.want != "" {
 				got := buf.String()
@@ -99,7 +101,7 @@ func TestCloseRows(t *testing.T) {
 			var buf bytes.Buffer
 			slog.SetDefault(logger.NewDefault(&buf, nil))
 			// when
-			closeRows(rows)
+			closeRows(context.TODO(), rows)
 			// then
 			if tc.want != "" {
 				got := buf.String()
@@ -110,3 +112,35 @@ func TestCloseRows(t *testing.T) {
 		})
 	}
 }
+
+func TestRequireAffectedRow(t *testing.T) {
+	tests := map[string]struct {
+		result  sql.Result
+		wantErr error
+	}{
+		"Success": {
+			result:  sqlmock.NewResult(0, 1),
+			wantErr: nil,
+		},
+		"Error_NoRowsAffected": {
+			result:  sqlmock.NewResult(0, 0),
+			wantErr: sql.ErrNoRows,
+		},
+		"Error_RowsAffected": {
+			result:  sqlmock.NewErrorResult(bootstrap.ErrExec),
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+
+			// when
+			err := requireAffectedRow(tc.result)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"requireAffectedRow() error = %v, wantErr %v", err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/stackus/errors"
+)
+
+type WaitlistRepository struct {
+	db DB
+}
+
+var _ domain.WaitlistRepository = (*WaitlistRepository)(nil)
+
+func NewWaitlistRepository(db DB) WaitlistRepository {
+	return WaitlistRepository{db}
+}
+
+func (r WaitlistRepository) Find(
+	ctx context.Context,
+	entryID string,
+) (*domain.WaitlistEntry, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	entry, err := scanWaitlistEntry(
+		tx.QueryRowContext(ctx, queries.FindWaitlistEntryByEntryID, entryID).Scan,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWaitlistEntryNotFound{EntryID: entryID}
+		}
+		return nil, errors.Wrap(err, "scan waitlist entry row")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return entry, nil
+}
+
+func (r WaitlistRepository) FindByCampgroundID(
+	ctx context.Context,
+	campgroundID string,
+) ([]*domain.WaitlistEntry, error) {
+	return r.findAllWithQuery(ctx, queries.FindAllWaitlistEntriesByCampgroundID, campgroundID)
+}
+
+func (r WaitlistRepository) FindWaiting(
+	ctx context.Context,
+	campsiteID string,
+	campgroundID string,
+	startDate time.Time,
+	endDate time.Time,
+) ([]*domain.WaitlistEntry, error) {
+	return r.findAllWithQuery(
+		ctx, queries.FindWaitingWaitlistEntries, campsiteID, campgroundID, startDate, endDate,
+	)
+}
+
+func (r WaitlistRepository) FindOffered(
+	ctx context.Context,
+	campsiteID string,
+	startDate time.Time,
+	endDate time.Time,
+) ([]*domain.WaitlistEntry, error) {
+	return r.findAllWithQuery(
+		ctx, queries.FindOfferedWaitlistEntries, campsiteID, startDate, endDate,
+	)
+}
+
+func (r WaitlistRepository) FindExpiredOffers(
+	ctx context.Context,
+	now time.Time,
+) ([]*domain.WaitlistEntry, error) {
+	return r.findAllWithQuery(ctx, queries.FindExpiredWaitlistOffers, now)
+}
+
+func (r WaitlistRepository) Insert(ctx context.Context, entry *domain.WaitlistEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	_, err = tx.ExecContext(ctx, queries.InsertWaitlistEntry,
+		entry.EntryID, entry.CampgroundID, entry.CampsiteID, entry.Email, entry.FullName,
+		entry.StartDate, entry.EndDate, entry.Guests, entry.Status)
+	if err != nil {
+		return errors.Wrap(err, "insert waitlist entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r WaitlistRepository) Update(ctx context.Context, entry *domain.WaitlistEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	offerExpiresAt := sql.NullTime{
+		Time:  entry.OfferExpiresAt,
+		Valid: !entry.OfferExpiresAt.IsZero(),
+	}
+	result, err := tx.ExecContext(ctx, queries.UpdateWaitlistEntry,
+		entry.EntryID, entry.Status, entry.OfferCampsiteID, offerExpiresAt, entry.BookingID)
+	if err != nil {
+		return errors.Wrap(err, "update waitlist entry")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}
+		}
+		return errors.Wrap(err, "update waitlist entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r WaitlistRepository) Delete(ctx context.Context, entryID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	result, err := tx.ExecContext(ctx, queries.DeleteWaitlistEntry, entryID)
+	if err != nil {
+		return errors.Wrap(err, "delete waitlist entry")
+	}
+	if err = requireAffectedRow(result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrWaitlistEntryNotFound{EntryID: entryID}
+		}
+		return errors.Wrap(err, "delete waitlist entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r WaitlistRepository) findAllWithQuery(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]*domain.WaitlistEntry, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin transaction")
+	}
+	defer rollbackTx(ctx, tx)
+
+	entries, err := findWaitlistEntriesWithTx(ctx, tx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	return entries, nil
+}
+
+func findWaitlistEntriesWithTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	args ...any,
+) (entries []*domain.WaitlistEntry, err error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query waitlist entries")
+	}
+	defer closeRows(ctx, rows)
+
+	for rows.Next() {
+		var entry *domain.WaitlistEntry
+		if entry, err = scanWaitlistEntry(rows.Scan); err != nil {
+			return nil, errors.Wrap(err, "scan waitlist entry row")
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finish waitlist entry rows")
+	}
+	return entries, nil
+}
+
+func scanWaitlistEntry(scan func(dest ...any) error) (*domain.WaitlistEntry, error) {
+	entry := &domain.WaitlistEntry{}
+	var offerExpiresAt sql.NullTime
+	if err := scan(
+		&entry.ID, &entry.EntryID, &entry.CampgroundID, &entry.CampsiteID, &entry.Email,
+		&entry.FullName, &entry.StartDate, &entry.EndDate, &entry.Guests, &entry.Status,
+		&entry.OfferCampsiteID, &offerExpiresAt, &entry.BookingID, &entry.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	entry.OfferExpiresAt = offerExpiresAt.Time
+	return entry, nil
+}
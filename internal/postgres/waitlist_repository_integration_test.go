@@ -0,0 +1,200 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/stretchr/testify/suite"
+	pg "github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+type waitlistSuite struct {
+	container  *pg.PostgresContainer
+	db         *sql.DB
+	repo       postgres.WaitlistRepository
+	campground *domain.Campground
+	suite.Suite
+}
+
+func TestWaitlistRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode: skipping")
+	}
+	suite.Run(t, &waitlistSuite{})
+}
+
+func (s *waitlistSuite) SetupSuite() {
+	var err error
+	s.container, err = bootstrap.NewPostgresContainer()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.db, err = bootstrap.NewDB(s.container)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *waitlistSuite) TearDownSuite() {
+	err := s.db.Close()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err := s.container.Terminate(context.Background()); err != nil {
+		s.T().Fatal("terminate postgres container", err)
+	}
+}
+
+func (s *waitlistSuite) SetupTest() {
+	s.repo = postgres.NewWaitlistRepository(s.db)
+
+	var err error
+	s.campground, err = bootstrap.NewCampground()
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if err = bootstrap.InsertCampground(s.db, s.campground); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *waitlistSuite) TearDownTest() {
+	// waitlist entries are deleted on cascade
+	err := bootstrap.DeleteCampgrounds(s.db)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_Insert() {
+	// given
+	entry := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	// when
+	err := s.repo.Insert(context.Background(), entry)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), entry.EntryID)
+		s.NoError(err)
+		entry.ID, entry.CreatedAt = got.ID, got.CreatedAt
+		s.Equal(entry, got)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_Find_NotFound() {
+	// given
+	entryID := uuid.New().String()
+	// when
+	got, err := s.repo.Find(context.Background(), entryID)
+	// then
+	s.Nil(got)
+	s.Equal(domain.ErrWaitlistEntryNotFound{EntryID: entryID}, err)
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_FindWaiting() {
+	// given
+	waiting := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), waiting))
+	offered := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), offered))
+	offered.Offer(uuid.New().String(), time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	s.NoError(s.repo.Update(context.Background(), offered))
+	later := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	later.StartDate = waiting.EndDate
+	later.EndDate = waiting.EndDate.AddDate(0, 0, 1)
+	s.NoError(s.repo.Insert(context.Background(), later))
+	// when
+	got, err := s.repo.FindWaiting(
+		context.Background(), uuid.New().String(), s.campground.CampgroundID,
+		waiting.StartDate, waiting.EndDate,
+	)
+	// then
+	if s.NoError(err) && s.Len(got, 1) {
+		s.Equal(waiting.EntryID, got[0].EntryID)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_FindOffered() {
+	// given
+	campsiteID := uuid.New().String()
+	policy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	offered := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), offered))
+	offered.Offer(campsiteID, time.Now(), policy)
+	s.NoError(s.repo.Update(context.Background(), offered))
+	expired := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), expired))
+	expired.Offer(campsiteID, time.Now().Add(-2*time.Hour), policy)
+	s.NoError(s.repo.Update(context.Background(), expired))
+	otherCampsite := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), otherCampsite))
+	otherCampsite.Offer(uuid.New().String(), time.Now(), policy)
+	s.NoError(s.repo.Update(context.Background(), otherCampsite))
+	// when
+	got, err := s.repo.FindOffered(
+		context.Background(), campsiteID, offered.StartDate, offered.EndDate,
+	)
+	// then
+	if s.NoError(err) && s.Len(got, 1) {
+		s.Equal(offered.EntryID, got[0].EntryID)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_FindExpiredOffers() {
+	// given
+	policy := domain.WaitlistPolicy{OfferTTL: time.Hour}
+	offered := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), offered))
+	offered.Offer(uuid.New().String(), time.Now(), policy)
+	s.NoError(s.repo.Update(context.Background(), offered))
+	expired := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), expired))
+	expired.Offer(uuid.New().String(), time.Now().Add(-2*time.Hour), policy)
+	s.NoError(s.repo.Update(context.Background(), expired))
+	// when
+	got, err := s.repo.FindExpiredOffers(context.Background(), time.Now())
+	// then
+	if s.NoError(err) && s.Len(got, 1) {
+		s.Equal(expired.EntryID, got[0].EntryID)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_Update() {
+	// given
+	entry := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), entry))
+	campsiteID := uuid.New().String()
+	entry.Offer(campsiteID, time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	// when
+	err := s.repo.Update(context.Background(), entry)
+	// then
+	if s.NoError(err) {
+		got, err := s.repo.Find(context.Background(), entry.EntryID)
+		s.NoError(err)
+		s.Equal(domain.WaitlistStatusOffered, got.Status)
+		s.Equal(campsiteID, got.OfferCampsiteID)
+		s.WithinDuration(entry.OfferExpiresAt, got.OfferExpiresAt, time.Millisecond)
+	}
+}
+
+func (s *waitlistSuite) TestWaitlistRepository_Delete() {
+	// given
+	entry := bootstrap.NewWaitlistEntry(s.campground.CampgroundID)
+	s.NoError(s.repo.Insert(context.Background(), entry))
+	// when
+	err := s.repo.Delete(context.Background(), entry.EntryID)
+	// then
+	if s.NoError(err) {
+		_, err = s.repo.Find(context.Background(), entry.EntryID)
+		s.Equal(domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}, err)
+	}
+}
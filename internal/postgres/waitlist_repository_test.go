@@ -0,0 +1,497 @@
+//go:build !integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
+	queries "github.com/igor-baiborodine/campsite-booking-go/internal/postgres/sql"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/testing/bootstrap"
+	"github.com/stretchr/testify/assert"
+)
+
+var waitlistEntryColumnsRow = []string{
+	"id",
+	"entry_id",
+	"campground_id",
+	"campsite_id",
+	"email",
+	"full_name",
+	"start_date",
+	"end_date",
+	"guests",
+	"status",
+	"offer_campsite_id",
+	"offer_expires_at",
+	"booking_id",
+	"created_at",
+}
+
+func TestWaitlistRepository_Find(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	entry.ID = 1
+	errWaitlistEntryNotFound := domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         *domain.WaitlistEntry
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow).
+					AddRow(waitlistEntryRowValues(entry)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitlistEntryByEntryID).
+					WithArgs(entry.EntryID).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    entry,
+			wantErr: nil,
+		},
+		"Error_NoWaitlistEntryFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitlistEntryByEntryID).
+					WithArgs(entry.EntryID).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: errWaitlistEntryNotFound,
+		},
+		"Error_BeginTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(bootstrap.ErrBeginTx)
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrBeginTx,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitlistEntryByEntryID).
+					WithArgs(entry.EntryID).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			got, err := repo.Find(context.TODO(), entry.EntryID)
+			// then
+			assert.Equal(t, tc.want, got,
+				"Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Find() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_FindWaiting(t *testing.T) {
+	campgroundID := uuid.New().String()
+	campsiteID := uuid.New().String()
+	var entries []*domain.WaitlistEntry
+	for i := 1; i < 3; i++ {
+		entry := bootstrap.NewWaitlistEntry(campgroundID)
+		entry.ID = int64(i)
+		entries = append(entries, entry)
+	}
+	startDate, endDate := entries[0].StartDate, entries[0].EndDate
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.WaitlistEntry
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow).
+					AddRow(waitlistEntryRowValues(entries[0])...).
+					AddRow(waitlistEntryRowValues(entries[1])...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitingWaitlistEntries).
+					WithArgs(campsiteID, campgroundID, startDate, endDate).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    entries,
+			wantErr: nil,
+		},
+		"NoWaitlistEntriesFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitingWaitlistEntries).
+					WithArgs(campsiteID, campgroundID, startDate, endDate).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		"Error_Rows": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow).
+					AddRow(waitlistEntryRowValues(entries[0])...).
+					AddRow(waitlistEntryRowValues(entries[1])...)
+				rows.RowError(1, bootstrap.ErrRow)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindWaitingWaitlistEntries).
+					WithArgs(campsiteID, campgroundID, startDate, endDate).
+					WillReturnRows(rows)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrRow,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			got, err := repo.FindWaiting(
+				context.TODO(), campsiteID, campgroundID, startDate, endDate,
+			)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindWaiting() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindWaiting() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_FindOffered(t *testing.T) {
+	campsiteID := uuid.New().String()
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	entry.Offer(campsiteID, time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	startDate, endDate := entry.StartDate, entry.EndDate
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.WaitlistEntry
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow).
+					AddRow(waitlistEntryRowValues(entry)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    []*domain.WaitlistEntry{entry},
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindOfferedWaitlistEntries).
+					WithArgs(campsiteID, startDate, endDate).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			got, err := repo.FindOffered(context.TODO(), campsiteID, startDate, endDate)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindOffered() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindOffered() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_FindExpiredOffers(t *testing.T) {
+	now := time.Now()
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	entry.Offer(uuid.New().String(), now.Add(-time.Hour), domain.WaitlistPolicy{})
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		want         []*domain.WaitlistEntry
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(waitlistEntryColumnsRow).
+					AddRow(waitlistEntryRowValues(entry)...)
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindExpiredWaitlistOffers).
+					WithArgs(now).
+					WillReturnRows(rows)
+				mock.ExpectCommit()
+			},
+			want:    []*domain.WaitlistEntry{entry},
+			wantErr: nil,
+		},
+		"Error_Query": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(queries.FindExpiredWaitlistOffers).
+					WithArgs(now).
+					WillReturnError(bootstrap.ErrQuery)
+				mock.ExpectRollback()
+			},
+			want:    nil,
+			wantErr: bootstrap.ErrQuery,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			got, err := repo.FindExpiredOffers(context.TODO(), now)
+			// then
+			assert.Equal(t, tc.want, got,
+				"FindExpiredOffers() got = %v, want %v", got, tc.want)
+			assert.ErrorIs(t, err, tc.wantErr,
+				"FindExpiredOffers() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_Insert(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	args := []driver.Value{
+		entry.EntryID, entry.CampgroundID, entry.CampsiteID, entry.Email, entry.FullName,
+		entry.StartDate, entry.EndDate, entry.Guests, entry.Status,
+	}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertWaitlistEntry).
+					WithArgs(args...).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_Exec": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.InsertWaitlistEntry).
+					WithArgs(args...).
+					WillReturnError(bootstrap.ErrExec)
+				mock.ExpectRollback()
+			},
+			wantErr: bootstrap.ErrExec,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			err = repo.Insert(context.TODO(), entry)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Insert() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_Update(t *testing.T) {
+	entry := bootstrap.NewWaitlistEntry(uuid.New().String())
+	entry.Offer(uuid.New().String(), time.Now(), domain.WaitlistPolicy{OfferTTL: time.Hour})
+	args := []driver.Value{
+		entry.EntryID, entry.Status, entry.OfferCampsiteID, entry.OfferExpiresAt, entry.BookingID,
+	}
+	errWaitlistEntryNotFound := domain.ErrWaitlistEntryNotFound{EntryID: entry.EntryID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateWaitlistEntry).
+					WithArgs(args...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_NoWaitlistEntryFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateWaitlistEntry).
+					WithArgs(args...).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errWaitlistEntryNotFound,
+		},
+		"Error_CommitTx": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.UpdateWaitlistEntry).
+					WithArgs(args...).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit().WillReturnError(bootstrap.ErrCommitTx)
+			},
+			wantErr: bootstrap.ErrCommitTx,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			err = repo.Update(context.TODO(), entry)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Update() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWaitlistRepository_Delete(t *testing.T) {
+	entryID := uuid.New().String()
+	errWaitlistEntryNotFound := domain.ErrWaitlistEntryNotFound{EntryID: entryID}
+
+	tests := map[string]struct {
+		mockTxPhases func(mock sqlmock.Sqlmock)
+		wantErr      error
+	}{
+		"Success": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteWaitlistEntry).
+					WithArgs(entryID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		"Error_NoWaitlistEntryFound": {
+			mockTxPhases: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(queries.DeleteWaitlistEntry).
+					WithArgs(entryID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errWaitlistEntryNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("open stub database connection error: %v", err)
+			}
+			defer db.Close()
+
+			tc.mockTxPhases(mock)
+			repo := NewWaitlistRepository(db)
+			// when
+			err = repo.Delete(context.TODO(), entryID)
+			// then
+			assert.ErrorIs(t, err, tc.wantErr,
+				"Delete() error = %v, wantErr %v", err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func waitlistEntryRowValues(e *domain.WaitlistEntry) []driver.Value {
+	var offerExpiresAt driver.Value
+	if !e.OfferExpiresAt.IsZero() {
+		offerExpiresAt = e.OfferExpiresAt
+	}
+	return []driver.Value{
+		e.ID, e.EntryID, e.CampgroundID, e.CampsiteID, e.Email, e.FullName, e.StartDate,
+		e.EndDate, e.Guests, e.Status, e.OfferCampsiteID, offerExpiresAt, e.BookingID,
+		e.CreatedAt,
+	}
+}
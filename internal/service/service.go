@@ -7,29 +7,42 @@ import (
 	"fmt"
 	"io/fs"
 	"log/slog"
+	"math"
 	"net"
 	"net/http"
-	"net/http/pprof"
-	os "os"
 	"time"
 
+	"github.com/igor-baiborodine/campsite-booking-go/internal/admin"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/application"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/application/command"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/config"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 	rpc "github.com/igor-baiborodine/campsite-booking-go/internal/grpc"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/ical"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/logger"
+	"github.com/igor-baiborodine/campsite-booking-go/internal/payment"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/postgres"
 	"github.com/igor-baiborodine/campsite-booking-go/internal/waiter"
-	"github.com/pressly/goose/v3"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 type Service struct {
-	cfg    config.AppConfig
-	db     *sql.DB
-	rpc    *grpc.Server
-	waiter waiter.Waiter
+	cfg config.AppConfig
+	// logLevel is the level of the default logger, changed at runtime through
+	// the log level endpoint.
+	logLevel *slog.LevelVar
+	db       *sql.DB
+	// replica is the read replica, nil if none is configured.
+	replica  *sql.DB
+	router   *postgres.ReplicaRouter
+	rpc      *grpc.Server
+	mux      *http.ServeMux
+	adminMux *http.ServeMux
+	probeMux *http.ServeMux
+	app      application.App
+	waiter   waiter.Waiter
 }
 
 func New(cfg config.AppConfig) (*Service, error) {
@@ -42,6 +55,8 @@ func New(cfg config.AppConfig) (*Service, error) {
 	if err := s.initRPC(); err != nil {
 		return nil, err
 	}
+	s.initHTTP()
+	s.initAdmin()
 	s.initWaiter()
 
 	return s, nil
@@ -55,21 +70,75 @@ func (s *Service) DB() *sql.DB {
 	return s.db
 }
 
+func (s *Service) ReplicaDB() *sql.DB {
+	return s.replica
+}
+
 func (s *Service) RPC() *grpc.Server {
 	return s.rpc
 }
 
+func (s *Service) Mux() *http.ServeMux {
+	return s.mux
+}
+
+func (s *Service) AdminMux() *http.ServeMux {
+	return s.adminMux
+}
+
+func (s *Service) ProbeMux() *http.ServeMux {
+	return s.probeMux
+}
+
+func (s *Service) LogLevel() *slog.LevelVar {
+	return s.logLevel
+}
+
 func (s *Service) Waiter() waiter.Waiter {
 	return s.waiter
 }
 
 func (s *Service) initDB() (err error) {
-	s.db, err = sql.Open("pgx", config.ReplaceEnvPlaceholders(s.cfg.PG.Conn))
-	return err
+	if s.db, err = sql.Open("pgx", config.ReplaceEnvPlaceholders(s.cfg.PG.Conn)); err != nil {
+		return err
+	}
+	s.sizePool(s.db)
+	if s.cfg.PG.ReplicaConn != "" {
+		s.replica, err = sql.Open("pgx", config.ReplaceEnvPlaceholders(s.cfg.PG.ReplicaConn))
+		if err != nil {
+			return err
+		}
+		s.sizePool(s.replica)
+	}
+	s.router = postgres.NewReplicaRouter(s.db, s.replica, s.cfg.PG.ReplicaMaxLag)
+	return nil
+}
+
+// sizePool caps the connections of db, which the in-flight cap of the calls is
+// derived from, and keeps them all idle rather than reopening them under load.
+func (s *Service) sizePool(db *sql.DB) {
+	db.SetMaxOpenConns(s.cfg.PG.MaxOpenConns)
+	db.SetMaxIdleConns(s.cfg.PG.MaxOpenConns)
 }
 
 func (s *Service) initRPC() (err error) {
-	srv, err := rpc.NewServer()
+	var opts []grpc.ServerOption
+	if s.cfg.RPC.TLSEnabled() {
+		creds, err := rpc.NewTLSCredentials(rpc.TLSConfig{
+			CertFile:     s.cfg.RPC.TLSCertFile,
+			KeyFile:      s.cfg.RPC.TLSKeyFile,
+			ClientCAFile: s.cfg.RPC.TLSClientCAFile,
+			MinVersion:   s.cfg.RPC.TLSMinVersion,
+		})
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(creds))
+		slog.Info("rpc server TLS enabled",
+			slog.Bool("mtls", s.cfg.RPC.TLSClientCAFile != ""),
+			slog.String("minVersion", s.cfg.RPC.TLSMinVersion))
+	}
+	srv, err := rpc.NewServer(s.rateLimits(), opts...)
 	if err != nil {
 		return err
 	}
@@ -79,14 +148,40 @@ func (s *Service) initRPC() (err error) {
 	return nil
 }
 
+func (s *Service) initHTTP() {
+	s.mux = http.NewServeMux()
+}
+
+func (s *Service) initAdmin() {
+	s.adminMux = http.NewServeMux()
+	admin.RegisterServer(s.db, s.logLevel, s.cfg.Admin.Pprof, s.adminMux)
+	s.probeMux = http.NewServeMux()
+	admin.RegisterProbes(s.db, s.probeMux)
+}
+
+func (s *Service) rateLimits() rpc.RateLimitConfig {
+	limits := rpc.RateLimitConfig{
+		Client:      rpc.Rate{Limit: s.cfg.RateLimit.ClientRPS, Burst: s.cfg.RateLimit.ClientBurst},
+		Methods:     make(map[string]rpc.Rate, len(s.cfg.RateLimit.MethodRPS)),
+		MaxInFlight: s.cfg.MaxInFlight(),
+	}
+	for method, rps := range s.cfg.RateLimit.MethodRPS {
+		limits.Methods[method] = rpc.Rate{Limit: rps, Burst: int(math.Ceil(rps))}
+	}
+	return limits
+}
+
 func (s *Service) initWaiter() {
 	s.waiter = waiter.New(waiter.CatchSignals())
 }
 
 func (s *Service) initLogger() {
+	s.logLevel = new(slog.LevelVar)
 	l := logger.New(logger.LogConfig{
-		Environment: s.cfg.Environment,
-		LogLevel:    logger.Level(s.cfg.LogLevel),
+		Environment:     s.cfg.Environment,
+		LogLevel:        logger.Level(s.cfg.LogLevel),
+		LevelVar:        s.logLevel,
+		SensitiveFields: s.cfg.LogSensitiveFields,
 	})
 	slog.SetDefault(l)
 
@@ -95,32 +190,81 @@ func (s *Service) initLogger() {
 	}
 }
 
-func (s *Service) MigrateDB(fs fs.FS) error {
-	goose.SetLogger(&logger.SilentLogger{})
-	goose.SetBaseFS(fs)
+// Migrator returns a migrator of the migrations of fsys to the database.
+func (s *Service) Migrator(fsys fs.FS) (*postgres.Migrator, error) {
+	return postgres.NewMigrator(s.db, fsys, s.cfg.PG.MigrationLockTimeout)
+}
 
-	if err := goose.SetDialect("postgres"); err != nil {
+func (s *Service) Startup() error {
+	// setup driven adapters
+	campgrounds := postgres.NewCampgroundRepository(s.router)
+	campsites := postgres.NewCampsiteRepository(s.router)
+	bookings := postgres.NewBookingRepository(s.router)
+	guests := postgres.NewGuestRepository(s.router)
+	rates := postgres.NewCampsiteRatesRepository(s.router)
+	blackouts := postgres.NewCampsiteBlackoutRepository(s.router)
+	seasons := postgres.NewCampgroundSeasonRepository(s.router)
+	waitlist := postgres.NewWaitlistRepository(s.router)
+	subscriptions := postgres.NewCalendarSubscriptionRepository(s.router)
+	calendars := ical.NewReader(&http.Client{Timeout: s.cfg.Calendar.FetchTimeout})
+	payments, err := s.paymentGateway()
+	if err != nil {
 		return err
 	}
-	if err := goose.Up(s.db, "."); err != nil {
+	seasonPolicy, err := s.seasonPolicy()
+	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func (s *Service) Startup() error {
-	// setup driven adapters
-	campsites := postgres.NewCampsiteRepository(s.db)
-	bookings := postgres.NewBookingRepository(s.db)
 	// setup application
-	app := application.New(campsites, bookings)
+	s.app = application.New(
+		campgrounds, campsites, bookings, guests, rates, blackouts, seasons, waitlist,
+		subscriptions, payments, calendars,
+		domain.DepositPolicy{Percent: s.cfg.Payment.DepositPercent},
+		domain.NewCancellationPolicy(s.cfg.Cancellation.RefundTiers),
+		domain.WaitlistPolicy{OfferTTL: s.cfg.Waitlist.OfferTTL},
+		seasonPolicy,
+		domain.RetentionPolicy{Days: s.cfg.Retention.Days},
+	)
 	// setup driver adapters
-	if err := rpc.RegisterServer(app, s.rpc); err != nil {
+	if err := rpc.RegisterServer(s.app, s.rpc); err != nil {
 		return err
 	}
+	ical.RegisterServer(s.app, s.mux)
 	return nil
 }
 
+func (s *Service) paymentGateway() (domain.PaymentGateway, error) {
+	switch s.cfg.Payment.Gateway {
+	case "fake":
+		slog.Warn("fake payment gateway enabled, deposits are not charged")
+		return payment.NewFakeGateway(), nil
+	case "http":
+		return payment.NewHTTPGateway(
+			s.cfg.Payment.APIURL,
+			s.cfg.Payment.APIKey,
+			&http.Client{Timeout: s.cfg.Payment.Timeout},
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", s.cfg.Payment.Gateway)
+	}
+}
+
+func (s *Service) seasonPolicy() (domain.SeasonPolicy, error) {
+	closedWeekdays, err := domain.ParseWeekdays(s.cfg.Season.ClosedWeekdays)
+	if err != nil {
+		return domain.SeasonPolicy{}, err
+	}
+	season := domain.CampgroundSeason{
+		OpensOn:        s.cfg.Season.OpensOn,
+		ClosesOn:       s.cfg.Season.ClosesOn,
+		ClosedWeekdays: closedWeekdays,
+	}
+	if err = season.Validate(); err != nil {
+		return domain.SeasonPolicy{}, err
+	}
+	return domain.SeasonPolicy{Default: season}, nil
+}
+
 func (s *Service) WaitForRPC(ctx context.Context) error {
 	listener, err := net.Listen("tcp", s.cfg.RPC.Address())
 	if err != nil {
@@ -137,39 +281,12 @@ func (s *Service) WaitForRPC(ctx context.Context) error {
 		return nil
 	})
 
-	var pprofServer *http.Server
-	if os.Getenv("DEBUG_PPROF") == "true" {
-		group.Go(func() error {
-			slog.Info("✅ pprof server started")
-			defer slog.Info("🚫 pprof server shut down")
-
-			mux := http.NewServeMux()
-			mux.HandleFunc("/debug/pprof/", pprof.Index)
-			mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
-			mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
-			mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-			mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
-
-			pprofServer = &http.Server{
-				Addr:    ":6060",
-				Handler: mux,
-			}
-			if err := pprofServer.ListenAndServe(); err != nil {
-				return err
-			}
-			return nil
-		})
-	}
-
 	group.Go(func() error {
 		<-gCtx.Done()
 		slog.Info("rpc server to be shut down")
 		stopped := make(chan struct{})
 		go func() {
 			s.RPC().GracefulStop()
-			if pprofServer != nil {
-				_ = pprofServer.Shutdown(gCtx)
-			}
 			close(stopped)
 		}()
 		timeout := time.NewTimer(s.cfg.ShutdownTimeout)
@@ -177,9 +294,6 @@ func (s *Service) WaitForRPC(ctx context.Context) error {
 		case <-timeout.C:
 			// force it to stop
 			s.RPC().Stop()
-			if pprofServer != nil {
-				_ = pprofServer.Shutdown(gCtx)
-			}
 			return fmt.Errorf("rpc server failed to stop gracefully")
 		case <-stopped:
 			return nil
@@ -188,3 +302,181 @@ func (s *Service) WaitForRPC(ctx context.Context) error {
 
 	return group.Wait()
 }
+
+func (s *Service) WaitForHTTP(ctx context.Context) error {
+	httpServer := &http.Server{
+		Addr:    s.cfg.HTTP.Address(),
+		Handler: s.Mux(),
+	}
+	group, gCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		slog.Info("✅ http server started")
+		defer slog.Info("🚫 http server shut down")
+
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	group.Go(func() error {
+		<-gCtx.Done()
+		slog.Info("http server to be shut down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("http server failed to stop gracefully: %w", err)
+		}
+		return nil
+	})
+
+	return group.Wait()
+}
+
+// WaitForAdmin serves the admin endpoints until the context is done, it
+// returns right away if the admin server is disabled.
+func (s *Service) WaitForAdmin(ctx context.Context) error {
+	if !s.cfg.Admin.Enabled {
+		return nil
+	}
+	adminServer := &http.Server{
+		Addr:    s.cfg.Admin.Address(),
+		Handler: s.AdminMux(),
+	}
+	return s.waitForServer(ctx, "admin", adminServer, slog.Bool("pprof", s.cfg.Admin.Pprof))
+}
+
+// WaitForProbes serves the liveness and readiness endpoints until the context
+// is done, it returns right away if the probe server is disabled.
+func (s *Service) WaitForProbes(ctx context.Context) error {
+	if !s.cfg.Probe.Enabled {
+		return nil
+	}
+	probeServer := &http.Server{
+		Addr:    s.cfg.Probe.Address(),
+		Handler: s.ProbeMux(),
+	}
+	return s.waitForServer(ctx, "probe", probeServer)
+}
+
+// waitForServer serves HTTP on the server until the context is done, then
+// shuts it down gracefully within the shutdown timeout.
+func (s *Service) waitForServer(
+	ctx context.Context, name string, server *http.Server, attrs ...any,
+) error {
+	group, gCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		slog.Info(fmt.Sprintf("✅ %s server started", name), attrs...)
+		defer slog.Info(fmt.Sprintf("🚫 %s server shut down", name))
+
+		if err := server.ListenAndServe(); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	group.Go(func() error {
+		<-gCtx.Done()
+		slog.Info(fmt.Sprintf("%s server to be shut down", name))
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("%s server failed to stop gracefully: %w", name, err)
+		}
+		return nil
+	})
+
+	return group.Wait()
+}
+
+// WaitForReplicaCheck checks the health of the read replica on startup and
+// then at every check interval, it returns at once if there is no replica.
+func (s *Service) WaitForReplicaCheck(ctx context.Context) error {
+	if s.replica == nil {
+		return nil
+	}
+	slog.Info("✅ read replica check started")
+	defer slog.Info("🚫 read replica check stopped")
+
+	ticker := time.NewTicker(s.cfg.PG.ReplicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, s.cfg.PG.ReplicaCheckInterval)
+		s.router.CheckReplica(checkCtx)
+		cancel()
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForRetention anonymizes the bookings past the retention period on
+// startup and then at every interval, it returns at once if retention is
+// disabled.
+func (s *Service) WaitForRetention(ctx context.Context) error {
+	if s.cfg.Retention.Days <= 0 {
+		return nil
+	}
+	slog.Info("✅ retention job started")
+	defer slog.Info("🚫 retention job stopped")
+
+	ticker := time.NewTicker(s.cfg.Retention.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.app.ApplyRetentionPolicy(
+			ctx, command.ApplyRetentionPolicy{},
+		); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to apply retention policy", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForWaitlistExpiry expires the waitlist offers not accepted in time and
+// offers their dates again, on startup and then at every expiry interval.
+func (s *Service) WaitForWaitlistExpiry(ctx context.Context) error {
+	slog.Info("✅ waitlist expiry job started")
+	defer slog.Info("🚫 waitlist expiry job stopped")
+
+	ticker := time.NewTicker(s.cfg.Waitlist.ExpiryInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.app.ExpireWaitlistOffers(
+			ctx, command.ExpireWaitlistOffers{},
+		); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to expire waitlist offers", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForCalendarSync syncs the external calendars subscribed to on startup
+// and then at every sync interval, a failed sync is retried on the next one.
+func (s *Service) WaitForCalendarSync(ctx context.Context) error {
+	slog.Info("✅ calendar sync started")
+	defer slog.Info("🚫 calendar sync stopped")
+
+	ticker := time.NewTicker(s.cfg.Calendar.SyncInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.app.SyncCalendarSubscriptions(
+			ctx, command.SyncCalendarSubscriptions{},
+		); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to sync calendar subscriptions", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
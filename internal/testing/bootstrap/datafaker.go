@@ -9,6 +9,19 @@ import (
 	"github.com/igor-baiborodine/campsite-booking-go/internal/domain"
 )
 
+func NewCampground() (*domain.Campground, error) {
+	campground := domain.Campground{}
+	err := faker.FakeData(&campground)
+	if err != nil {
+		return nil, err
+	}
+	campground.ID = math.MaxInt64
+	campground.CampgroundID = uuid.New().String()
+	campground.Active = true
+
+	return &campground, nil
+}
+
 func NewCampsite() (*domain.Campsite, error) {
 	campsite := domain.Campsite{}
 	err := faker.FakeData(&campsite)
@@ -17,11 +30,32 @@ func NewCampsite() (*domain.Campsite, error) {
 	}
 	campsite.ID = math.MaxInt64
 	campsite.CampsiteID = uuid.New().String()
+	campsite.CampgroundID = ""
 	campsite.Active = true
 
 	return &campsite, nil
 }
 
+func NewCampsiteRates(campsiteID string) *domain.CampsiteRates {
+	return &domain.CampsiteRates{
+		CampsiteID:         campsiteID,
+		Currency:           "USD",
+		NightlyRate:        4000,
+		WeekendNightlyRate: 5000,
+		IncludedGuests:     2,
+		ExtraGuestFee:      500,
+		TaxRateBps:         1300,
+		Seasons: []domain.SeasonalRate{
+			{
+				Name:        "summer",
+				StartDate:   time.Date(2006, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:     time.Date(2006, 9, 1, 0, 0, 0, 0, time.UTC),
+				NightlyRate: 6000,
+			},
+		},
+	}
+}
+
 func NewBooking(campsiteID string) (*domain.Booking, error) {
 	return NewBookingWithAddDays(campsiteID, 1, 2)
 }
@@ -43,12 +77,96 @@ func NewBookingWithAddDays(
 	booking.CampsiteID = campsiteID
 	booking.StartDate = now.AddDate(0, 0, startAddDays)
 	booking.EndDate = now.AddDate(0, 0, endAddDays)
-	booking.Active = true
+	booking.Guests = 1
+	booking.TotalPrice = 0
+	booking.Currency = ""
+	booking.DepositAmount = 0
+	booking.PaymentRef = ""
+	booking.RefundPercent = 0
+	booking.RefundAmount = 0
+	booking.GroupID = ""
+	booking.GuestID = ""
+	booking.Status = domain.BookingStatusConfirmed
 	booking.Version = 1
 
 	return &booking, nil
 }
 
+func NewWaitlistEntry(campgroundID string) *domain.WaitlistEntry {
+	now := AsStartOfDayUTC(time.Now())
+
+	return &domain.WaitlistEntry{
+		ID:           math.MaxInt64,
+		EntryID:      uuid.New().String(),
+		CampgroundID: campgroundID,
+		Email:        faker.Email(),
+		FullName:     faker.Name(),
+		StartDate:    now.AddDate(0, 0, 1),
+		EndDate:      now.AddDate(0, 0, 2),
+		Guests:       2,
+		Status:       domain.WaitlistStatusWaiting,
+		CreatedAt:    now,
+	}
+}
+
 func AsStartOfDayUTC(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 }
+
+func NewCampsiteBlackout(campsiteID string) *domain.CampsiteBlackout {
+	now := AsStartOfDayUTC(time.Now())
+
+	return &domain.CampsiteBlackout{
+		ID:         math.MaxInt64,
+		BlackoutID: uuid.New().String(),
+		CampsiteID: campsiteID,
+		StartDate:  now.AddDate(0, 0, 1),
+		EndDate:    now.AddDate(0, 0, 3),
+		Reason:     "maintenance",
+	}
+}
+
+func NewCampgroundSeason(campgroundID string) *domain.CampgroundSeason {
+	return &domain.CampgroundSeason{
+		ID:             math.MaxInt64,
+		CampgroundID:   campgroundID,
+		OpensOn:        "05-01",
+		ClosesOn:       "10-31",
+		ClosedWeekdays: []time.Weekday{time.Monday},
+	}
+}
+
+func NewGuest() *domain.Guest {
+	return &domain.Guest{
+		ID:          math.MaxInt64,
+		GuestID:     uuid.New().String(),
+		Email:       domain.NormalizeEmail(faker.Email()),
+		FullName:    faker.Name(),
+		Phone:       faker.Phonenumber(),
+		Address:     "1 Lakeshore Rd",
+		Preferences: "quiet site",
+	}
+}
+
+func NewCalendarSubscription(campsiteID string) *domain.CalendarSubscription {
+	return &domain.CalendarSubscription{
+		ID:             math.MaxInt64,
+		SubscriptionID: uuid.New().String(),
+		CampsiteID:     campsiteID,
+		Name:           "Campsite A01 on Hipcamp",
+		URL:            "https://example.com/calendars/a01.ics",
+	}
+}
+
+func NewExternalBlock(subscription *domain.CalendarSubscription) *domain.ExternalBlock {
+	now := AsStartOfDayUTC(time.Now())
+
+	return &domain.ExternalBlock{
+		SubscriptionID: subscription.SubscriptionID,
+		CampsiteID:     subscription.CampsiteID,
+		UID:            uuid.New().String() + "@example.com",
+		StartDate:      now.AddDate(0, 0, 1),
+		EndDate:        now.AddDate(0, 0, 3),
+		Summary:        "Reserved",
+	}
+}
@@ -16,13 +16,36 @@ const (
 	deleteCampsitesQuery = `
 		DELETE FROM campsites
 	`
+	deleteCampgroundsQuery = `
+		DELETE FROM campgrounds
+	`
+	deleteCampsiteRatesQuery = `
+		DELETE FROM campsite_rates
+	`
+	deleteCampsiteBlackoutsQuery = `
+		DELETE FROM campsite_blackouts
+	`
+	deleteGuestsQuery = `
+		DELETE FROM guests
+	`
+	deleteCalendarSubscriptionsQuery = `
+		DELETE FROM calendar_subscriptions
+	`
 )
 
+func InsertCampground(db *sql.DB, c *domain.Campground) error {
+	_, err := db.ExecContext(
+		context.Background(), queries.InsertCampground,
+		c.CampgroundID, c.Name, c.Description, c.Active,
+	)
+	return err
+}
+
 func InsertCampsite(db *sql.DB, c *domain.Campsite) error {
 	_, err := db.ExecContext(
 		context.Background(), queries.InsertCampsite,
 		c.CampsiteID, c.CampsiteCode, c.Capacity, c.Restrooms, c.DrinkingWater, c.PicnicTable,
-		c.FirePit, c.Active,
+		c.FirePit, c.Active, c.CampgroundID,
 	)
 	return err
 }
@@ -30,7 +53,9 @@ func InsertCampsite(db *sql.DB, c *domain.Campsite) error {
 func InsertBooking(db *sql.DB, b *domain.Booking) error {
 	_, err := db.ExecContext(
 		context.Background(), queries.InsertBooking,
-		b.BookingID, b.CampsiteID, b.Email, b.FullName, b.StartDate, b.EndDate, b.Active, b.Version,
+		b.BookingID, b.CampsiteID, b.Email, b.FullName, b.StartDate, b.EndDate, b.Status, b.Version,
+		b.Guests, b.TotalPrice, b.Currency, b.DepositAmount, b.PaymentRef, b.RefundPercent,
+		b.RefundAmount, b.GroupID, b.GuestID,
 	)
 	return err
 }
@@ -41,7 +66,10 @@ func FindBooking(db *sql.DB, bookingID string) (*domain.Booking, error) {
 		context.Background(), queries.FindBookingByBookingID, bookingID,
 	).Scan(
 		&booking.ID, &booking.BookingID, &booking.CampsiteID, &booking.Email,
-		&booking.FullName, &booking.StartDate, &booking.EndDate, &booking.Active, &booking.Version,
+		&booking.FullName, &booking.StartDate, &booking.EndDate, &booking.Status,
+		&booking.Version, &booking.Guests, &booking.TotalPrice, &booking.Currency,
+		&booking.DepositAmount, &booking.PaymentRef, &booking.RefundPercent,
+		&booking.RefundAmount, &booking.GroupID, &booking.GuestID,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrBookingNotFound{BookingID: bookingID}
@@ -60,3 +88,29 @@ func DeleteCampsites(db *sql.DB) error {
 	_, err := db.ExecContext(context.Background(), deleteCampsitesQuery)
 	return err
 }
+
+func DeleteCampgrounds(db *sql.DB) error {
+	_, err := db.ExecContext(context.Background(), deleteCampgroundsQuery)
+	return err
+}
+
+func DeleteCampsiteRates(db *sql.DB) error {
+	_, err := db.ExecContext(context.Background(), deleteCampsiteRatesQuery)
+	return err
+}
+
+func DeleteCampsiteBlackouts(db *sql.DB) error {
+	_, err := db.ExecContext(context.Background(), deleteCampsiteBlackoutsQuery)
+	return err
+}
+
+func DeleteGuests(db *sql.DB) error {
+	_, err := db.ExecContext(context.Background(), deleteGuestsQuery)
+	return err
+}
+
+// DeleteCalendarSubscriptions deletes the external blocks too, by cascade.
+func DeleteCalendarSubscriptions(db *sql.DB) error {
+	_, err := db.ExecContext(context.Background(), deleteCalendarSubscriptionsQuery)
+	return err
+}
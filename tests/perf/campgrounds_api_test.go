@@ -2,6 +2,9 @@ package perf
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"log"
 	"math/rand"
 	"os"
@@ -11,6 +14,7 @@ import (
 	api "github.com/igor-baiborodine/campsite-booking-go/campgroundspb/v1"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -63,11 +67,39 @@ func BenchmarkGetVacantDates(b *testing.B) {
 }
 
 func newClientConn(b *testing.B, addr string) *grpc.ClientConn {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := transportCredentials()
+	assert.Nil(b, err)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
 	assert.Nil(b, err)
 	return conn
 }
 
+// transportCredentials returns TLS credentials if TLS_CA_CERT is set, with the
+// client certificate of TLS_CERT and TLS_KEY for mutual TLS if set too, and
+// plaintext credentials otherwise.
+func transportCredentials() (credentials.TransportCredentials, error) {
+	caCert, ok := os.LookupEnv("TLS_CA_CERT")
+	if !ok {
+		return insecure.NewCredentials(), nil
+	}
+	pem, err := os.ReadFile(caCert)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: x509.NewCertPool()}
+	if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificates found in %s", caCert)
+	}
+	if cert, ok := os.LookupEnv("TLS_CERT"); ok {
+		pair, err := tls.LoadX509KeyPair(cert, os.Getenv("TLS_KEY"))
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{pair}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
 func closeConn(conn *grpc.ClientConn) {
 	if err := conn.Close(); err != nil {
 		log.Fatalf("failed to close connection: %v", err)